@@ -16,8 +16,15 @@ function download(filename, text) {
 }
 
 function saveTextAsFile(filename, text) {
-    var textFileAsBlob = new Blob([text], { type: 'text/plain' });
+    saveChunksAsFile(filename, [text]);
+}
+
+// chunks is an array of strings or Uint8Arrays, see Blob constructor
+function saveChunksAsFile(filename, chunks) {
+    saveBlobAsFile(filename, new Blob(chunks, { type: 'text/plain' }));
+}
 
+function saveBlobAsFile(filename, textFileAsBlob) {
     var downloadLink = document.createElement("a");
     downloadLink.download = filename;
     if (window.webkitURL != null) {
@@ -46,63 +53,148 @@ function showError(value) {
     container.appendChild(output); //appendChild
 }
 
+// generateAndDownload generates the G-code for the form, shows the segment table
+// and warnings and saves the file
+function generateAndDownload() {
+    document.getElementById("resultContainer").innerHTML = "";
+    var result = generate(readFormGo());
+    if (result == null) {
+        return;
+    }
+    if (result.errors != undefined) {
+        checkGo();
+        showError(result.errors.map(function(e) { return window.lang.getString(e.message); }).join('\n'));
+        return;
+    }
+
+    var text = result.segmentTable;
+    for (var warning of result.warnings) {
+        text = text + '\n' + window.lang.getString(warning.message);
+    }
+    showError(text);
+    saveBlobAsFile(result.fileName, result.gcode);
+}
+
 function destroyClickedElement(event) {
     // remove the link from the DOM
     document.body.removeChild(event.target);
 }
 
-var formFields = [
-    "bedX",
-    "bedY",
-    "zOffset",
-    "delta",
-    "bedProbe",
-    "hotendTemperature",
-    "bedTemperature",
-    "cooling",
-    "lineWidth",
-    "firstLayerLineWidth",
-    "layerHeight",
-    "printSpeed",
-    "firstLayerPrintSpeed",
-    "travelSpeed",
-    "initRetractLength",
-    "endRetractLength",
-    "initRetractSpeed",
-    "endRetractSpeed",
-    "numSegments",
-    "segmentHeight",
-    "kFactor2",
-    "towerSpacing",
-    "flow",
-    "firmwareMarlin",
-    "firmwareKlipper",
-    "firmwareRRF",
-	"hardmode",
-	"startGcode",
-	"endGcode",
-];
-var segmentFields = [
-    "initRetractLength",
-    "endRetractLength",
-    "initRetractSpeed",
-    "endRetractSpeed",
-    "numSegments",
-];
-var segmentKeys = [
-	"init_retract_length",
-	"end_retract_length",
-	"init_retract_speed",
-	"end_retract_speed",
-	"num_segments",
-];
+// formFields are the ids of the form elements, firmware is entered with radio buttons
+var formFields = [];
+var segmentFields = [];
+var segmentKeys = [];
+for (var field of schema) {
+	// an enum field is a radio button per option
+	var ids = [field.id];
+	if (field.type == 'enum') {
+		ids = [];
+		for (var option of field.options) {
+			ids.push(optionId(field, option));
+		}
+	}
+	for (var id of ids) {
+		formFields.push(id);
+		if (field.segment) {
+			segmentFields.push(id);
+		}
+	}
+	if (field.segment) {
+		segmentKeys.push(field.key);
+	}
+}
+
+function optionId(field, option) {
+	return field.id + option.label;
+}
+
+// defaultValue returns the default of the field as shown in the form.
+// Start and end G-code may have translated comments.
+function defaultValue(field) {
+	if (field.type == 'text') {
+		var value = window.lang.getString('table.' + field.key + '.default');
+		if (value != undefined) {
+			return value;
+		}
+	}
+	return String(field.default);
+}
+
+// buildForm adds a row for every field of the schema to the form table
+function buildForm() {
+	var table = document.getElementById('formTable');
+	for (var field of schema) {
+		var row = document.createElement('tr');
+
+		var title = document.createElement('td');
+		title.className = 'lang';
+		title.id = field.title;
+		title.innerHTML = window.lang.getString(field.title);
+		row.appendChild(title);
+
+		var cell = document.createElement('td');
+		if (field.type == 'bool') {
+			var input = document.createElement('input');
+			input.type = 'checkbox';
+			input.id = field.id;
+			input.name = field.id;
+			input.checked = field.default;
+			cell.style.textAlign = 'center';
+			cell.appendChild(input);
+		} else if (field.type == 'enum') {
+			cell.style.textAlign = 'center';
+			for (var option of field.options) {
+				var input = document.createElement('input');
+				input.type = 'radio';
+				input.id = optionId(field, option);
+				input.name = field.id;
+				input.value = option.label;
+				input.checked = option.value == field.default;
+				var label = document.createElement('label');
+				label.htmlFor = input.id;
+				var text = window.lang.getString('table.' + field.key + '.' + option.value);
+				label.innerHTML = text != undefined ? text : option.label;
+				cell.appendChild(input);
+				cell.appendChild(label);
+				cell.appendChild(document.createElement('br'));
+			}
+		} else if (field.type == 'text') {
+			var input = document.createElement('textarea');
+			input.id = field.id;
+			input.name = field.id;
+			input.rows = 5;
+			input.value = defaultValue(field);
+			cell.appendChild(input);
+		} else {
+			var input = document.createElement('input');
+			input.type = 'text';
+			input.id = field.id;
+			input.name = field.id;
+			input.value = defaultValue(field);
+			cell.appendChild(input);
+		}
+		row.appendChild(cell);
+
+		var help = document.createElement('td');
+		help.className = 'lang';
+		help.id = field.help;
+		help.innerHTML = window.lang.getString(field.help);
+		row.appendChild(help);
+
+		table.appendChild(row);
+	}
+}
+
+function isCheckable(element) {
+	return element.type == 'checkbox' || element.type == 'radio';
+}
 
 var saveForm = function () {
     for (var elementId of formFields) {
         var element = document.getElementById(elementId);
         if (element) {
             var saveValue = element.value;
-            if (elementId == 'delta' || elementId == 'bedProbe' || elementId == 'firmwareMarlin' || elementId == 'firmwareKlipper' || elementId == 'firmwareRRF' || elementId == 'hardmode') {
+            if (isCheckable(element)) {
                 saveValue = element.checked;
             }
             localStorage.setItem(elementId, saveValue);
@@ -111,6 +203,14 @@ var saveForm = function () {
 }
 
 function loadForm() {
+	// k-factor used to be saved as kFactor2
+	if (localStorage.getItem('kFactor2') != null) {
+		if (localStorage.getItem('kFactor') == null) {
+			localStorage.setItem('kFactor', localStorage.getItem('kFactor2'));
+		}
+		localStorage.removeItem('kFactor2');
+	}
+
     for (var elementId of formFields) {
         let loadValue = localStorage.getItem(elementId);
         if (loadValue === undefined) {
@@ -119,8 +219,10 @@ function loadForm() {
 
         var element = document.getElementById(elementId);
         if (element) {
-            if (elementId == 'delta' || elementId == 'bedProbe' || elementId == 'firmwareMarlin' || elementId == 'firmwareKlipper' || elementId == 'firmwareRRF' || elementId == 'hardmode') {
-				element.checked = loadValue == 'true';
+            if (isCheckable(element)) {
+                if (loadValue != null) {
+                    element.checked = loadValue == 'true';
+                }
             } else {
                 if (loadValue != null) {
                     element.value = loadValue;
@@ -233,6 +335,18 @@ function initLang(key) {
 			values['generator.generate_button_loading'] = 'G-Code wird generiert...';		
 			values['generator.segment'] = ';Segment %d:   %smm @ %smm/s\n';
 			values['generator.reset_to_default'] = 'Einstellungen zurücksetzen';
+			values['warning.segment_height.rounded'] = 'Segmenthöhe ist kein Vielfaches der Schichtdicke, die Segmente werden mit einer ganzen Anzahl von Schichten gedruckt';
+			values['warning.end_retract_length.clamped'] = 'Einzüge kürzer als 0.1 mm werden außer im ersten Segment mit 0.1 mm gedruckt';
+			values['warning.wipe.firmware_retraction'] = 'Firmware-Einzüge können nicht wischen, die Wischstrecke wird ignoriert';
+			values['warning.extra_prime.klipper_negative'] = 'Klipper akzeptiert keinen negativen Zusatzvorschub für Firmware-Einzüge, er wird auf 0 gesetzt';
+			values['profile.title'] = 'Profil';
+			values['profile.load'] = 'Laden';
+			values['profile.delete'] = 'Löschen';
+			values['profile.save'] = 'Speichern als';
+			values['profile.export'] = 'In Datei exportieren';
+			values['profile.import'] = 'Aus Datei importieren';
+			values['error.profile.name_empty'] = 'Geben Sie einen Profilnamen ein';
+			values['error.profile.import'] = 'Profil konnte nicht importiert werden';
 			
 			values['navbar.back'] = ' Zurück ';
 			values['navbar.site'] = 'Webseite';
@@ -281,6 +395,177 @@ function initLang(key) {
 			values['error.firmware.not_set'] = 'Format Fehler: Firmware nicht ausgewählt';
 			values['error.k_factor.format'] = 'K-Faktor - Format Fehler';
 			values['error.k_factor.too_high'] = 'K-Faktor: (Der Wert muss zwischen 0.0 und 2.0 sein)';
+			values['table.temperature_sweep.title'] = 'Temperaturreihe';
+			values['table.temperature_sweep.description'] = 'Jedes Segment wird mit einer eigenen Hotend-Temperatur gedruckt, von der Hotend-Temperatur im unteren bis zur End-Hotend-Temperatur im oberen Segment';
+			values['table.end_hotend_temp.title'] = 'End-Hotend-Temperatur';
+			values['table.end_hotend_temp.description'] = '[°C] Hotend-Temperatur des oberen Segments bei eingeschalteter Temperaturreihe';
+			values['table.temperature_stabilization.title'] = 'Temperaturstabilisierung';
+			values['table.temperature_stabilization.description'] = 'Wie die Temperatur nach dem Wechsel zwischen den Segmenten stabilisiert wird: mit einer Pause oder durch Warten in der Parkposition vor den Türmen';
+			values['table.temperature_stabilization.dwell'] = 'Pause';
+			values['table.temperature_stabilization.park'] = 'Parken und warten';
+			values['table.temperature_dwell.title'] = 'Pause nach Temperaturwechsel';
+			values['table.temperature_dwell.description'] = '[s] Dauer der Pause nach dem Temperaturwechsel, wenn die Stabilisierung mit Pause gewählt ist';
+			values['error.end_hotend_temp.format'] = 'End-Hotend-Temperatur - Format Fehler';
+			values['error.end_hotend_temp.too_low'] = 'End-Hotend-Temperatur ist zu niedrig';
+			values['error.end_hotend_temp.too_high'] = 'End-Hotend-Temperatur ist zu hoch';
+			values['error.temperature_stabilization.format'] = 'Temperaturstabilisierung - Format Fehler';
+			values['error.temperature_dwell.format'] = 'Pause nach Temperaturwechsel - Format Fehler';
+			values['error.temperature_dwell.small_or_big'] = 'Pause nach Temperaturwechsel ist falsch (weniger als 0 oder mehr als 600 s)';
+			values['table.init_z_hop.title'] = 'Anfangs-Z-Hop';
+			values['table.init_z_hop.description'] = '[mm] Anheben der Düse bei Leerfahrten im unteren Segment. 0 in beiden Z-Hop-Feldern schaltet Z-Hop aus';
+			values['table.end_z_hop.title'] = 'End-Z-Hop';
+			values['table.end_z_hop.description'] = '[mm] Anheben der Düse bei Leerfahrten im oberen Segment';
+			values['table.z_hop_style.title'] = 'Z-Hop-Art';
+			values['table.z_hop_style.description'] = 'Wie die Düse angehoben wird: senkrecht oder schräg während der ersten Hälfte der Leerfahrt. Abgesenkt wird immer senkrecht';
+			values['table.z_hop_style.plain'] = 'Senkrecht';
+			values['table.z_hop_style.ramped'] = 'Schräg';
+			values['table.z_hop_only_crossing.title'] = 'Z-Hop nur zwischen den Türmen';
+			values['table.z_hop_only_crossing.description'] = 'Nur Leerfahrten von einem Turm zum anderen werden angehoben';
+			values['error.init_z_hop.format'] = 'Anfangs-Z-Hop - Format Fehler';
+			values['error.init_z_hop.small_or_big'] = 'Falscher Anfangs-Z-Hop (weniger als 0 oder mehr als 10 mm)';
+			values['error.end_z_hop.format'] = 'End-Z-Hop - Format Fehler';
+			values['error.end_z_hop.small_or_big'] = 'Falscher End-Z-Hop (weniger als 0 oder mehr als 10 mm)';
+			values['error.z_hop_style.format'] = 'Z-Hop-Art - Format Fehler';
+			values['table.init_wipe_distance.title'] = 'Anfangs-Wischstrecke';
+			values['table.init_wipe_distance.description'] = '[mm] Die Düse fährt beim Einziehen im unteren Segment diese Strecke auf dem gedruckten Umfang zurück. 0 in beiden Wischfeldern schaltet das Wischen aus';
+			values['table.end_wipe_distance.title'] = 'End-Wischstrecke';
+			values['table.end_wipe_distance.description'] = '[mm] Wischstrecke im oberen Segment';
+			values['table.wipe_retract.title'] = 'Einzug beim Wischen';
+			values['table.wipe_retract.description'] = '[%] Anteil des Einzugs, der während des Wischens gemacht wird. Der Rest wird vorher im Stillstand eingezogen';
+			values['error.init_wipe_distance.format'] = 'Anfangs-Wischstrecke - Format Fehler';
+			values['error.init_wipe_distance.small_or_big'] = 'Falsche Anfangs-Wischstrecke (weniger als 0 oder mehr als 20 mm)';
+			values['error.end_wipe_distance.format'] = 'End-Wischstrecke - Format Fehler';
+			values['error.end_wipe_distance.small_or_big'] = 'Falsche End-Wischstrecke (weniger als 0 oder mehr als 20 mm)';
+			values['error.wipe_retract.format'] = 'Einzug beim Wischen - Format Fehler';
+			values['error.wipe_retract.small_or_big'] = 'Falscher Einzug beim Wischen (weniger als 0 oder mehr als 100%)';
+			values['table.init_extra_prime.title'] = 'Anfangs-Zusatzvorschub';
+			values['table.init_extra_prime.description'] = '[mm] So viel mehr Filament als eingezogen wird im unteren Segment nach jedem Einzug wieder vorgeschoben, negative Werte schieben weniger vor';
+			values['table.end_extra_prime.title'] = 'End-Zusatzvorschub';
+			values['table.end_extra_prime.description'] = '[mm] Zusatzvorschub im oberen Segment';
+			values['error.init_extra_prime.format'] = 'Anfangs-Zusatzvorschub - Format Fehler';
+			values['error.init_extra_prime.small_or_big'] = 'Falscher Anfangs-Zusatzvorschub (weniger als -2 oder mehr als 2 mm)';
+			values['error.end_extra_prime.format'] = 'End-Zusatzvorschub - Format Fehler';
+			values['error.end_extra_prime.small_or_big'] = 'Falscher End-Zusatzvorschub (weniger als -2 oder mehr als 2 mm)';
+			values['table.separate_unretract_speed.title'] = 'Eigene Vorschubgeschwindigkeit';
+			values['table.separate_unretract_speed.description'] = 'Das Filament wird nach dem Einzug mit einer eigenen Geschwindigkeit statt mit der Einzugsgeschwindigkeit vorgeschoben';
+			values['table.init_unretract_speed.title'] = 'Anfangs-Vorschubgeschwindigkeit';
+			values['table.init_unretract_speed.description'] = '[mm/s] Vorschubgeschwindigkeit nach dem Einzug im unteren Segment. Gleiche Anfangs- und Endwerte ergeben eine feste Geschwindigkeit';
+			values['table.end_unretract_speed.title'] = 'End-Vorschubgeschwindigkeit';
+			values['table.end_unretract_speed.description'] = '[mm/s] Vorschubgeschwindigkeit nach dem Einzug im oberen Segment';
+			values['error.init_unretract_speed.format'] = 'Anfangs-Vorschubgeschwindigkeit - Format Fehler';
+			values['error.init_unretract_speed.slow_or_fast'] = 'Falsche Anfangs-Vorschubgeschwindigkeit (weniger als 5 oder mehr als 150 mm/s)';
+			values['error.end_unretract_speed.format'] = 'End-Vorschubgeschwindigkeit - Format Fehler';
+			values['error.end_unretract_speed.slow_or_fast'] = 'Falsche End-Vorschubgeschwindigkeit (weniger als 5 oder mehr als 150 mm/s)';
+			values['table.firmware_retraction.title'] = 'Firmware-Einzug';
+			values['table.firmware_retraction.description'] = 'Einzüge mit G10/G11. Die Einstellungen jedes Segments werden in der Firmware gesetzt: M207/M208 bei Marlin, SET_RETRACTION bei Klipper, M207 bei RRF. Die Firmware muss Firmware-Einzüge unterstützen';
+			values['table.matrix.title'] = 'Länge × Geschwindigkeit Matrix';
+			values['table.matrix.description'] = 'Mehrere Turmpaare hintereinander drucken, jedes mit eigener Einzugsgeschwindigkeit von der Anfangs- bis zur End-Einzugsgeschwindigkeit. Die Einzugslänge ändert sich wie gewohnt entlang der Türme';
+			values['table.matrix_pairs.title'] = 'Anzahl der Turmpaare';
+			values['table.matrix_pairs.description'] = 'Anzahl der Turmpaare der Matrix, von vorne nach hinten. Jedes Paar braucht die Floßgröße plus 10 mm Bettlänge Y (40 mm mit den Standardtürmen), die Reinigungslinie davor weitere 10 mm';
+			values['error.matrix_pairs.format'] = 'Anzahl der Turmpaare - Format Fehler';
+			values['error.matrix_pairs.small_or_big'] = 'Falsche Anzahl der Turmpaare (weniger als 2, mehr als 10 oder passt nicht aufs Bett)';
+			values['table.retract_length_values.title'] = 'Einzugslängen';
+			values['table.retract_length_values.description'] = '[mm] Einzugslänge jedes Segments von unten nach oben statt der Reihe von Anfangs- bis Endlänge, z.B. "0.2, 0.5, 1" oder Bereiche Anfang:Schritt:Ende wie "0.2:0.2:2.0". Die Anzahl muss der Anzahl der Segmente entsprechen. Leer lassen für die Reihe';
+			values['table.retract_speed_values.title'] = 'Einzugsgeschwindigkeiten';
+			values['table.retract_speed_values.description'] = '[mm/s] Einzugsgeschwindigkeit jedes Segments, wie die Einzugslängen';
+			values['table.stepping.title'] = 'Schrittweite';
+			values['table.stepping.description'] = 'Verteilung der Einzugslängen und -geschwindigkeiten zwischen Anfangs- und Endwert: gleiche Schritte, geometrisch (fein beim Anfangswert) oder logarithmisch (fein beim Endwert). Alle anderen Werte ändern sich linear';
+			values['table.stepping.linear'] = 'Linear';
+			values['table.stepping.geometric'] = 'Geometrisch';
+			values['table.stepping.logarithmic'] = 'Logarithmisch';
+			values['table.order.title'] = 'Reihenfolge der Werte';
+			values['table.order.description'] = 'Reihenfolge der Segmente von unten nach oben, nach Einzugslänge und dann -geschwindigkeit sortiert; jedes Segment behält seine Länge und Geschwindigkeit';
+			values['table.order.given'] = 'Wie angegeben';
+			values['table.order.ascending'] = 'Aufsteigend';
+			values['table.order.descending'] = 'Absteigend';
+			values['error.retract_length_values.format'] = 'Einzugslängen - Format Fehler';
+			values['error.retract_length_values.count'] = 'Die Anzahl der Einzugslängen weicht von der Anzahl der Segmente ab';
+			values['error.retract_length_values.small_or_big'] = 'Falsche Einzugslänge (weniger als 0 oder mehr als 20 mm)';
+			values['error.retract_speed_values.format'] = 'Einzugsgeschwindigkeiten - Format Fehler';
+			values['error.retract_speed_values.count'] = 'Die Anzahl der Einzugsgeschwindigkeiten weicht von der Anzahl der Segmente ab';
+			values['error.retract_speed_values.slow_or_fast'] = 'Falsche Einzugsgeschwindigkeit (weniger als 5 oder mehr als 150 mm/s)';
+			values['error.stepping.format'] = 'Schrittweite - Format Fehler';
+			values['error.order.format'] = 'Reihenfolge der Werte - Format Fehler';
+			values['table.pressure_advance_sweep.title'] = 'Linear/Pressure Advance Reihe';
+			values['table.pressure_advance_sweep.description'] = 'Jedes Segment wird mit einem eigenen K-Faktor gedruckt, vom K-Faktor im unteren bis zum End-K-Faktor im oberen Segment. Die Ecken und die Naht der Türme zeigen den besten Wert';
+			values['table.end_k_factor.title'] = 'End-K-Faktor';
+			values['table.end_k_factor.description'] = 'K-Faktor des oberen Segments bei eingeschalteter Linear/Pressure Advance Reihe';
+			values['error.end_k_factor.format'] = 'End-K-Faktor - Format Fehler';
+			values['error.end_k_factor.too_high'] = 'End-K-Faktor: (Der Wert muss zwischen 0.0 und 2.0 sein)';
+			values['table.travel_speed_sweep.title'] = 'Leerfahrtgeschwindigkeitsreihe';
+			values['table.travel_speed_sweep.description'] = 'Jedes Segment wird mit einer eigenen Leerfahrtgeschwindigkeit gedruckt, von der Leerfahrtgeschwindigkeit im unteren bis zur End-Leerfahrtgeschwindigkeit im oberen Segment';
+			values['table.end_travel_speed.title'] = 'End-Leerfahrtgeschwindigkeit';
+			values['table.end_travel_speed.description'] = '[mm/s] Leerfahrtgeschwindigkeit des oberen Segments bei eingeschalteter Leerfahrtgeschwindigkeitsreihe';
+			values['table.init_travel_accel.title'] = 'Anfangs-Leerfahrtbeschleunigung';
+			values['table.init_travel_accel.description'] = '[mm/s²] Beschleunigung der Leerfahrten im unteren Segment (M204 T, nicht bei Klipper). 0 in beiden Feldern behält die Beschleunigung des Druckers';
+			values['table.end_travel_accel.title'] = 'End-Leerfahrtbeschleunigung';
+			values['table.end_travel_accel.description'] = '[mm/s²] Beschleunigung der Leerfahrten im oberen Segment';
+			values['error.end_travel_speed.format'] = 'End-Leerfahrtgeschwindigkeit - Format Fehler';
+			values['error.end_travel_speed.slow_or_fast'] = 'Falsche End-Leerfahrtgeschwindigkeit (weniger als 10 oder mehr als 1000 mm/s)';
+			values['error.init_travel_accel.format'] = 'Anfangs-Leerfahrtbeschleunigung - Format Fehler';
+			values['error.init_travel_accel.small_or_big'] = 'Falsche Anfangs-Leerfahrtbeschleunigung (weniger als 0 oder mehr als 50000 mm/s²)';
+			values['error.end_travel_accel.format'] = 'End-Leerfahrtbeschleunigung - Format Fehler';
+			values['error.end_travel_accel.small_or_big'] = 'Falsche End-Leerfahrtbeschleunigung (weniger als 0 oder mehr als 50000 mm/s²)';
+			values['warning.travel_accel.klipper'] = 'Klipper hat keine eigene Beschleunigung für Leerfahrten, die Leerfahrtbeschleunigung wird ignoriert';
+			values['table.init_coast.title'] = 'Anfangs-Coasting';
+			values['table.init_coast.description'] = '[mm] Die letzten Millimeter der Perimeter jedes Turms im unteren Segment werden vor der Leerfahrt ohne Extrusion gefahren. 0 in beiden Feldern schaltet Coasting aus';
+			values['table.end_coast.title'] = 'End-Coasting';
+			values['table.end_coast.description'] = '[mm] Coasting im oberen Segment';
+			values['table.coast_volume.title'] = 'Coasting als Volumen';
+			values['table.coast_volume.description'] = 'Anfangs- und End-Coasting in mm³ Filament statt in mm Linie';
+			values['error.init_coast.format'] = 'Anfangs-Coasting - Format Fehler';
+			values['error.init_coast.small_or_big'] = 'Falsches Anfangs-Coasting (weniger als 0 oder mehr als 10)';
+			values['error.end_coast.format'] = 'End-Coasting - Format Fehler';
+			values['error.end_coast.small_or_big'] = 'Falsches End-Coasting (weniger als 0 oder mehr als 10)';
+			values['table.init_retract_dwell.title'] = 'Anfangs-Pause nach dem Einzug';
+			values['table.init_retract_dwell.description'] = '[s] Pause (G4) nach jedem Einzug auf den Türmen vor der Leerfahrt im unteren Segment, um das Nachtropfen im Stillstand zu zeigen. 0 in beiden Feldern schaltet die Pause aus';
+			values['table.end_retract_dwell.title'] = 'End-Pause nach dem Einzug';
+			values['table.end_retract_dwell.description'] = '[s] Pause nach dem Einzug im oberen Segment';
+			values['error.init_retract_dwell.format'] = 'Anfangs-Pause nach dem Einzug - Format Fehler';
+			values['error.init_retract_dwell.small_or_big'] = 'Falsche Anfangs-Pause nach dem Einzug (weniger als 0 oder mehr als 60 s)';
+			values['error.end_retract_dwell.format'] = 'End-Pause nach dem Einzug - Format Fehler';
+			values['error.end_retract_dwell.small_or_big'] = 'Falsche End-Pause nach dem Einzug (weniger als 0 oder mehr als 60 s)';
+			values['table.init_min_travel.title'] = 'Anfangs-Mindestfahrweg für Einzug';
+			values['table.init_min_travel.description'] = '[mm] Leerfahrten auf den Türmen, die im unteren Segment kürzer sind, werden ohne Einzug gemacht. 0 in beiden Feldern zieht bei jeder Leerfahrt ein';
+			values['table.end_min_travel.title'] = 'End-Mindestfahrweg für Einzug';
+			values['table.end_min_travel.description'] = '[mm] Mindestfahrweg für Einzug im oberen Segment';
+			values['table.short_hops.title'] = 'Kurze Sprünge';
+			values['table.short_hops.description'] = 'Nach dem inneren Perimeter jedes Turms fährt die Düse ohne Extrusion zum äußeren, statt die Verbindung zu drucken. So hat jeder Turm neben der langen Leerfahrt auch eine kurze';
+			values['table.short_hop_distance.title'] = 'Länge der kurzen Sprünge';
+			values['table.short_hop_distance.description'] = '[mm] Ungefähre Länge der kurzen Sprünge: der äußere Perimeter beginnt so weit entlang seiner Seite';
+			values['error.init_min_travel.format'] = 'Anfangs-Mindestfahrweg für Einzug - Format Fehler';
+			values['error.init_min_travel.small_or_big'] = 'Falscher Anfangs-Mindestfahrweg für Einzug (weniger als 0 oder mehr als 100 mm)';
+			values['error.end_min_travel.format'] = 'End-Mindestfahrweg für Einzug - Format Fehler';
+			values['error.end_min_travel.small_or_big'] = 'Falscher End-Mindestfahrweg für Einzug (weniger als 0 oder mehr als 100 mm)';
+			values['error.short_hop_distance.format'] = 'Länge der kurzen Sprünge - Format Fehler';
+			values['error.short_hop_distance.small_or_big'] = 'Falsche Länge der kurzen Sprünge (weniger als 1 oder mehr als 10 mm)';
+			values['error.short_hop_distance.too_long'] = 'Länge der kurzen Sprünge ist zu groß (mehr als 10 mm oder die halbe Turmgröße)';
+			values['table.tower_width.title'] = 'Turmgröße';
+			values['table.tower_width.description'] = '[mm] Äußere Größe der Türme. Größere Düsen brauchen größere Türme, kleinere Düsen kommen mit kleineren aus';
+			values['table.wall_spacing.title'] = 'Wandabstand';
+			values['table.wall_spacing.description'] = '[%] Abstand zwischen dem inneren und dem äußeren Perimeter in Prozent der Linienbreite';
+			values['table.raft_margin.title'] = 'Floßrand';
+			values['table.raft_margin.description'] = '[mm] Um so viel ist das Floß jedes Turms auf jeder Seite breiter als der Turm';
+			values['error.tower_width.format'] = 'Turmgröße - Format Fehler';
+			values['error.tower_width.too_small'] = 'Turmgröße ist zu klein (weniger als 5 mm)';
+			values['error.tower_width.too_big'] = 'Turmgröße ist zu groß, die Türme mit ihren Flößen passen nicht in den Abstand zwischen den Türmen';
+			values['error.wall_spacing.format'] = 'Wandabstand - Format Fehler';
+			values['error.wall_spacing.small_or_big'] = 'Falscher Wandabstand (weniger als 50 oder mehr als 200 %, oder zu breit für die Turmgröße)';
+			values['error.raft_margin.format'] = 'Floßrand - Format Fehler';
+			values['error.raft_margin.small_or_big'] = 'Falscher Floßrand (weniger als 2 oder mehr als 20 mm)';
+			values['error.raft_margin.too_big'] = 'Floßrand ist zu groß (mehr als 20 mm), oder die Flöße und die Reinigungslinie passen nicht auf das Bett';
+			values['table.tower_shape.title'] = 'Turmform';
+			values['table.tower_shape.description'] = 'Quadratische, runde oder dreieckige Türme mit einer spitzen Ecke. Die Ecken verändern, wie sich Nachtropfen an der Naht zeigt, an runden Türmen sind Fäden besser zu sehen';
+			values['table.tower_shape.square'] = 'Quadratisch';
+			values['table.tower_shape.round'] = 'Rund';
+			values['table.tower_shape.triangle'] = 'Dreieckig';
+			values['table.arc_moves.title'] = 'Bogenbewegungen';
+			values['table.arc_moves.description'] = 'Runde Türme mit G2-Bögen statt als Vielecke drucken. Klipper braucht dafür den Abschnitt [gcode_arcs]';
+			values['error.tower_shape.format'] = 'Turmform - Format Fehler';
+			values['warning.coast.too_long'] = 'Das Coasting ist auf die Hälfte des äußeren Umfangs der Türme begrenzt';
+			values['warning.arc_moves.klipper'] = 'Klipper druckt Bögen nur mit einem Abschnitt [gcode_arcs] in printer.cfg';
+			values['warning.arc_moves.marlin'] = 'Marlin druckt Bögen nur, wenn es mit ARC_SUPPORT kompiliert ist';
+			values['warning.arc_moves.shape'] = 'Nur runde Türme werden mit Bögen gedruckt, die Bogenbewegungen werden ignoriert';
 			break;
 		case 'en':
 			values['header.title'] = 'K3D retractions calibrator';
@@ -349,6 +634,18 @@ function initLang(key) {
 			values['generator.generate_button_loading'] = 'Generator loading...';		
 			values['generator.segment'] = ';Segment %d:   %smm @ %smm/s\n';
 			values['generator.reset_to_default'] = 'Reset settings';
+			values['warning.segment_height.rounded'] = 'Segment height is not a multiple of the layer height, segments are printed with a whole number of layers';
+			values['warning.end_retract_length.clamped'] = 'Retractions shorter than 0.1 mm are printed with 0.1 mm, except for the first segment';
+			values['warning.wipe.firmware_retraction'] = 'Firmware retractions can\'t wipe, the wipe distance is ignored';
+			values['warning.extra_prime.klipper_negative'] = 'Klipper doesn\'t accept a negative extra prime length for firmware retraction, it is set to 0';
+			values['profile.title'] = 'Profile';
+			values['profile.load'] = 'Load';
+			values['profile.delete'] = 'Delete';
+			values['profile.save'] = 'Save as';
+			values['profile.export'] = 'Export to file';
+			values['profile.import'] = 'Import from file';
+			values['error.profile.name_empty'] = 'Enter a profile name';
+			values['error.profile.import'] = 'Profile could not be imported';
 			
 			values['navbar.back'] = ' Back ';
 			values['navbar.site'] = 'Site';
@@ -399,6 +696,177 @@ function initLang(key) {
 			values['error.firmware.not_set'] = 'Format error: firmware not set';
 			values['error.k_factor.format'] = 'K-factor - format error';
 			values['error.k_factor.too_high'] = 'Wrong K-factor value (should be from 0.0 to 2.0)';
+			values['table.temperature_sweep.title'] = 'Temperature sweep';
+			values['table.temperature_sweep.description'] = 'Print every segment at its own hotend temperature, from the hotend temperature at the bottom segment to the final hotend temperature at the top one';
+			values['table.end_hotend_temp.title'] = 'Final hotend temperature';
+			values['table.end_hotend_temp.description'] = '[°C] Hotend temperature of the top segment when the temperature sweep is enabled';
+			values['table.temperature_stabilization.title'] = 'Temperature stabilization';
+			values['table.temperature_stabilization.description'] = 'How the temperature settles after it is changed between the segments: with a dwell or by waiting parked in front of the towers';
+			values['table.temperature_stabilization.dwell'] = 'Dwell';
+			values['table.temperature_stabilization.park'] = 'Park and wait';
+			values['table.temperature_dwell.title'] = 'Dwell after temperature change';
+			values['table.temperature_dwell.description'] = '[s] Duration of the dwell after a temperature change when the stabilization with dwell is selected';
+			values['error.end_hotend_temp.format'] = 'Final hotend temperature - format error';
+			values['error.end_hotend_temp.too_low'] = 'Final hotend temperature is too low';
+			values['error.end_hotend_temp.too_high'] = 'Final hotend temperature is too high';
+			values['error.temperature_stabilization.format'] = 'Temperature stabilization - format error';
+			values['error.temperature_dwell.format'] = 'Dwell after temperature change - format error';
+			values['error.temperature_dwell.small_or_big'] = 'Dwell after temperature change is wrong (less than 0 or greater than 600 s)';
+			values['table.init_z_hop.title'] = 'Initial Z-hop';
+			values['table.init_z_hop.description'] = '[mm] Lift of the nozzle during travels in the bottom segment. 0 in both Z-hop fields disables Z-hop';
+			values['table.end_z_hop.title'] = 'Final Z-hop';
+			values['table.end_z_hop.description'] = '[mm] Lift of the nozzle during travels in the top segment';
+			values['table.z_hop_style.title'] = 'Z-hop style';
+			values['table.z_hop_style.description'] = 'How the nozzle is lifted: vertically or ramped along the first half of the travel. It is always lowered vertically';
+			values['table.z_hop_style.plain'] = 'Plain';
+			values['table.z_hop_style.ramped'] = 'Ramped';
+			values['table.z_hop_only_crossing.title'] = 'Z-hop only between towers';
+			values['table.z_hop_only_crossing.description'] = 'Only travels from one tower to the other are lifted';
+			values['error.init_z_hop.format'] = 'Initial Z-hop - format error';
+			values['error.init_z_hop.small_or_big'] = 'Wrong initial Z-hop (less than 0 or greater than 10 mm)';
+			values['error.end_z_hop.format'] = 'Final Z-hop - format error';
+			values['error.end_z_hop.small_or_big'] = 'Wrong final Z-hop (less than 0 or greater than 10 mm)';
+			values['error.z_hop_style.format'] = 'Z-hop style - format error';
+			values['table.init_wipe_distance.title'] = 'Initial wipe distance';
+			values['table.init_wipe_distance.description'] = '[mm] While retracting in the bottom segment the nozzle moves back along the printed perimeter by this distance. 0 in both wipe fields disables wiping';
+			values['table.end_wipe_distance.title'] = 'Final wipe distance';
+			values['table.end_wipe_distance.description'] = '[mm] Wipe distance in the top segment';
+			values['table.wipe_retract.title'] = 'Retraction while wiping';
+			values['table.wipe_retract.description'] = '[%] Part of the retraction made while wiping. The rest is retracted in place before the wipe';
+			values['error.init_wipe_distance.format'] = 'Initial wipe distance - format error';
+			values['error.init_wipe_distance.small_or_big'] = 'Wrong initial wipe distance (less than 0 or greater than 20 mm)';
+			values['error.end_wipe_distance.format'] = 'Final wipe distance - format error';
+			values['error.end_wipe_distance.small_or_big'] = 'Wrong final wipe distance (less than 0 or greater than 20 mm)';
+			values['error.wipe_retract.format'] = 'Retraction while wiping - format error';
+			values['error.wipe_retract.small_or_big'] = 'Wrong retraction while wiping (less than 0 or greater than 100%)';
+			values['table.init_extra_prime.title'] = 'Initial extra prime length';
+			values['table.init_extra_prime.description'] = '[mm] After every retraction in the bottom segment this much more filament than was retracted is pushed back, negative values push back less';
+			values['table.end_extra_prime.title'] = 'Final extra prime length';
+			values['table.end_extra_prime.description'] = '[mm] Extra prime length in the top segment';
+			values['error.init_extra_prime.format'] = 'Initial extra prime length - format error';
+			values['error.init_extra_prime.small_or_big'] = 'Wrong initial extra prime length (less than -2 or greater than 2 mm)';
+			values['error.end_extra_prime.format'] = 'Final extra prime length - format error';
+			values['error.end_extra_prime.small_or_big'] = 'Wrong final extra prime length (less than -2 or greater than 2 mm)';
+			values['table.separate_unretract_speed.title'] = 'Separate unretract speed';
+			values['table.separate_unretract_speed.description'] = 'The filament is pushed back after a retraction with its own speed instead of the retraction speed';
+			values['table.init_unretract_speed.title'] = 'Initial unretract speed';
+			values['table.init_unretract_speed.description'] = '[mm/s] Speed of pushing the filament back in the bottom segment. Equal initial and final values give a fixed speed';
+			values['table.end_unretract_speed.title'] = 'Final unretract speed';
+			values['table.end_unretract_speed.description'] = '[mm/s] Speed of pushing the filament back in the top segment';
+			values['error.init_unretract_speed.format'] = 'Initial unretract speed - format error';
+			values['error.init_unretract_speed.slow_or_fast'] = 'Wrong initial unretract speed (less than 5 or greater than 150 mm/s)';
+			values['error.end_unretract_speed.format'] = 'Final unretract speed - format error';
+			values['error.end_unretract_speed.slow_or_fast'] = 'Wrong final unretract speed (less than 5 or greater than 150 mm/s)';
+			values['table.firmware_retraction.title'] = 'Firmware retraction';
+			values['table.firmware_retraction.description'] = 'Retract with G10/G11. The settings of every segment are set in the firmware: M207/M208 on Marlin, SET_RETRACTION on Klipper, M207 on RRF. Firmware retraction has to be enabled in the firmware';
+			values['table.matrix.title'] = 'Length × speed matrix';
+			values['table.matrix.description'] = 'Print several tower pairs one behind the other, each with its own retraction speed from the initial to the final retraction speed. The retraction length changes along the towers as usual';
+			values['table.matrix_pairs.title'] = 'Number of tower pairs';
+			values['table.matrix_pairs.description'] = 'Number of tower pairs of the matrix, from front to back. Every pair takes the raft size plus 10 mm of the bed Y size (40 mm with the default towers), the purge line in front of them another 10 mm';
+			values['error.matrix_pairs.format'] = 'Number of tower pairs - format error';
+			values['error.matrix_pairs.small_or_big'] = 'Wrong number of tower pairs (less than 2, greater than 10 or not fitting on the bed)';
+			values['table.retract_length_values.title'] = 'Retraction length values';
+			values['table.retract_length_values.description'] = '[mm] Retraction length of every segment from bottom to top instead of the sweep from the initial to the final length, e.g. "0.2, 0.5, 1" or ranges start:step:end like "0.2:0.2:2.0". There have to be as many values as segments. Leave empty for the sweep';
+			values['table.retract_speed_values.title'] = 'Retraction speed values';
+			values['table.retract_speed_values.description'] = '[mm/s] Retraction speed of every segment, like the retraction length values';
+			values['table.stepping.title'] = 'Stepping';
+			values['table.stepping.description'] = 'How the retraction lengths and speeds between the initial and the final one are distributed: equal steps, geometric (fine near the initial value) or logarithmic (fine near the final value). All other sweeps stay linear';
+			values['table.stepping.linear'] = 'Linear';
+			values['table.stepping.geometric'] = 'Geometric';
+			values['table.stepping.logarithmic'] = 'Logarithmic';
+			values['table.order.title'] = 'Order of values';
+			values['table.order.description'] = 'Order of the segments from bottom to top, sorted by retraction length, then speed; every segment keeps its length and speed';
+			values['table.order.given'] = 'As given';
+			values['table.order.ascending'] = 'Ascending';
+			values['table.order.descending'] = 'Descending';
+			values['error.retract_length_values.format'] = 'Retraction length values - format error';
+			values['error.retract_length_values.count'] = 'The number of retraction length values differs from the number of segments';
+			values['error.retract_length_values.small_or_big'] = 'Wrong retraction length value (less than 0 or greater than 20 mm)';
+			values['error.retract_speed_values.format'] = 'Retraction speed values - format error';
+			values['error.retract_speed_values.count'] = 'The number of retraction speed values differs from the number of segments';
+			values['error.retract_speed_values.slow_or_fast'] = 'Wrong retraction speed value (less than 5 or greater than 150 mm/s)';
+			values['error.stepping.format'] = 'Stepping - format error';
+			values['error.order.format'] = 'Order of values - format error';
+			values['table.pressure_advance_sweep.title'] = 'Linear/Pressure Advance sweep';
+			values['table.pressure_advance_sweep.description'] = 'Print every segment with its own k-factor, from the k-factor at the bottom segment to the final k-factor at the top one. The corners and the seam of the towers show the best value';
+			values['table.end_k_factor.title'] = 'Final k-factor';
+			values['table.end_k_factor.description'] = 'K-factor of the top segment when the Linear/Pressure Advance sweep is enabled';
+			values['error.end_k_factor.format'] = 'Final K-factor - format error';
+			values['error.end_k_factor.too_high'] = 'Wrong final K-factor value (should be from 0.0 to 2.0)';
+			values['table.travel_speed_sweep.title'] = 'Travel speed sweep';
+			values['table.travel_speed_sweep.description'] = 'Travel with its own speed in every segment, from the travel speed at the bottom segment to the final travel speed at the top one';
+			values['table.end_travel_speed.title'] = 'Final travel speed';
+			values['table.end_travel_speed.description'] = '[mm/s] Travel speed of the top segment when the travel speed sweep is enabled';
+			values['table.init_travel_accel.title'] = 'Initial travel acceleration';
+			values['table.init_travel_accel.description'] = '[mm/s²] Acceleration of the travels at the bottom segment (M204 T, not on Klipper). 0 in both fields keeps the acceleration of the printer';
+			values['table.end_travel_accel.title'] = 'Final travel acceleration';
+			values['table.end_travel_accel.description'] = '[mm/s²] Acceleration of the travels at the top segment';
+			values['error.end_travel_speed.format'] = 'Final travel speed - format error';
+			values['error.end_travel_speed.slow_or_fast'] = 'Wrong final travel speed (less than 10 or greater than 1000 mm/s)';
+			values['error.init_travel_accel.format'] = 'Initial travel acceleration - format error';
+			values['error.init_travel_accel.small_or_big'] = 'Wrong initial travel acceleration (less than 0 or greater than 50000 mm/s²)';
+			values['error.end_travel_accel.format'] = 'Final travel acceleration - format error';
+			values['error.end_travel_accel.small_or_big'] = 'Wrong final travel acceleration (less than 0 or greater than 50000 mm/s²)';
+			values['warning.travel_accel.klipper'] = 'Klipper has no acceleration of its own for travels, the travel acceleration is ignored';
+			values['table.init_coast.title'] = 'Initial coasting';
+			values['table.init_coast.description'] = '[mm] The last millimeters of the perimeters of every tower at the bottom segment are printed without extrusion before the travel. 0 in both fields disables coasting';
+			values['table.end_coast.title'] = 'Final coasting';
+			values['table.end_coast.description'] = '[mm] Coasting at the top segment';
+			values['table.coast_volume.title'] = 'Coasting as volume';
+			values['table.coast_volume.description'] = 'The initial and the final coasting are mm³ of filament instead of mm of line';
+			values['error.init_coast.format'] = 'Initial coasting - format error';
+			values['error.init_coast.small_or_big'] = 'Wrong initial coasting (less than 0 or greater than 10)';
+			values['error.end_coast.format'] = 'Final coasting - format error';
+			values['error.end_coast.small_or_big'] = 'Wrong final coasting (less than 0 or greater than 10)';
+			values['table.init_retract_dwell.title'] = 'Initial dwell after retraction';
+			values['table.init_retract_dwell.description'] = '[s] Pause (G4) after every retraction on the towers before the travel at the bottom segment, to show how much the hotend oozes while standing still. 0 in both fields disables the dwell';
+			values['table.end_retract_dwell.title'] = 'Final dwell after retraction';
+			values['table.end_retract_dwell.description'] = '[s] Dwell after retraction at the top segment';
+			values['error.init_retract_dwell.format'] = 'Initial dwell after retraction - format error';
+			values['error.init_retract_dwell.small_or_big'] = 'Wrong initial dwell after retraction (less than 0 or greater than 60 s)';
+			values['error.end_retract_dwell.format'] = 'Final dwell after retraction - format error';
+			values['error.end_retract_dwell.small_or_big'] = 'Wrong final dwell after retraction (less than 0 or greater than 60 s)';
+			values['table.init_min_travel.title'] = 'Initial minimum travel for retraction';
+			values['table.init_min_travel.description'] = '[mm] Travels on the towers shorter than this are made without retraction at the bottom segment. 0 in both fields retracts on every travel';
+			values['table.end_min_travel.title'] = 'Final minimum travel for retraction';
+			values['table.end_min_travel.description'] = '[mm] Minimum travel for retraction at the top segment';
+			values['table.short_hops.title'] = 'Short hops';
+			values['table.short_hops.description'] = 'After the inner perimeter of every tower the nozzle travels to the outer one instead of printing the connection, so every tower has a short travel besides the long one between the towers';
+			values['table.short_hop_distance.title'] = 'Short hop length';
+			values['table.short_hop_distance.description'] = '[mm] Approximate length of the short hops: the outer perimeter starts that far along its side';
+			values['error.init_min_travel.format'] = 'Initial minimum travel for retraction - format error';
+			values['error.init_min_travel.small_or_big'] = 'Wrong initial minimum travel for retraction (less than 0 or greater than 100 mm)';
+			values['error.end_min_travel.format'] = 'Final minimum travel for retraction - format error';
+			values['error.end_min_travel.small_or_big'] = 'Wrong final minimum travel for retraction (less than 0 or greater than 100 mm)';
+			values['error.short_hop_distance.format'] = 'Short hop length - format error';
+			values['error.short_hop_distance.small_or_big'] = 'Wrong short hop length (less than 1 or greater than 10 mm)';
+			values['error.short_hop_distance.too_long'] = 'Short hop length is too long (greater than 10 mm or half the tower size)';
+			values['table.tower_width.title'] = 'Tower size';
+			values['table.tower_width.description'] = '[mm] Outer size of the towers. Large nozzles need larger towers, micro nozzles do with smaller ones';
+			values['table.wall_spacing.title'] = 'Wall spacing';
+			values['table.wall_spacing.description'] = '[%] Distance between the inner and the outer perimeter in percent of the line width';
+			values['table.raft_margin.title'] = 'Raft margin';
+			values['table.raft_margin.description'] = '[mm] The raft of every tower is that much wider than the tower on every side';
+			values['error.tower_width.format'] = 'Tower size - format error';
+			values['error.tower_width.too_small'] = 'Tower size is too small (less than 5 mm)';
+			values['error.tower_width.too_big'] = 'Tower size is too big, the towers and their rafts don\'t fit the distance between towers';
+			values['error.wall_spacing.format'] = 'Wall spacing - format error';
+			values['error.wall_spacing.small_or_big'] = 'Wrong wall spacing (less than 50 or greater than 200 %, or too wide for the tower size)';
+			values['error.raft_margin.format'] = 'Raft margin - format error';
+			values['error.raft_margin.small_or_big'] = 'Wrong raft margin (less than 2 or greater than 20 mm)';
+			values['error.raft_margin.too_big'] = 'Raft margin is too big (greater than 20 mm), or the rafts and the purge line don\'t fit the bed';
+			values['table.tower_shape.title'] = 'Tower shape';
+			values['table.tower_shape.description'] = 'Square, round or triangular towers with a sharp corner. The corners change how ooze shows at the seam, round towers show strings more clearly';
+			values['table.tower_shape.square'] = 'Square';
+			values['table.tower_shape.round'] = 'Round';
+			values['table.tower_shape.triangle'] = 'Triangle';
+			values['table.arc_moves.title'] = 'Arc moves';
+			values['table.arc_moves.description'] = 'Print round towers with G2 arcs instead of polygons. Klipper needs the [gcode_arcs] section for it';
+			values['error.tower_shape.format'] = 'Tower shape - format error';
+			values['warning.coast.too_long'] = 'Coasting is limited to half of the outer perimeter of the towers';
+			values['warning.arc_moves.klipper'] = 'Klipper prints arcs only with a [gcode_arcs] section in printer.cfg';
+			values['warning.arc_moves.marlin'] = 'Marlin prints arcs only if it is built with ARC_SUPPORT';
+			values['warning.arc_moves.shape'] = 'Only round towers are printed with arcs, the arc moves are ignored';
 			break;
 		case 'ru':
 			values['header.title'] = 'K3D калибровщик откатов';
@@ -469,6 +937,20 @@ function initLang(key) {
 			values['generator.generate_button_loading'] = 'Генератор загружается...';		
 			values['generator.segment'] = ';Сегмент %d:   %sмм @ %sмм/с\n';
 			values['generator.reset_to_default'] = 'Сбросить настройки';
+			values['warning.segment_height.rounded'] = 'Высота сегмента не кратна толщине слоя, сегменты печатаются целым числом слоёв';
+			values['warning.end_retract_length.clamped'] = 'Откаты короче 0.1 мм печатаются длиной 0.1 мм, кроме первого сегмента';
+			values['warning.wipe.firmware_retraction'] = 'Прошивочные ретракты не умеют очищать сопло, длина очистки не учитывается';
+			values['warning.extra_prime.klipper_negative'] = 'Klipper не принимает отрицательную дополнительную подачу для прошивочного ретракта, она устанавливается в 0';
+			values['table.start_gcode.default'] = '$LA ;Установить k-фактор для Linear/Pressure Advance\nM190 S$BEDTEMP ;прогреть стол до температуры, указанной в настройках\nM109 S$HOTTEMP ;прогреть хотэнд до температуры, указанной в настройках\nG28 ;припарковать все оси\n$G29 ;снять карту высот стола\nG90 ;абсолютная система координат\nG92 E0 ;сбросить координату экструдера\nM220 S100 ;Множитель скорости 100%\nM221 S$FLOW ;Множитель потока взять из настроек';
+			values['table.end_gcode.default'] = 'M104 S0 ;выключить хотэнд\nM140 S0 ;выключить нагрев стола\nM106 S0 ;выключить вентилятор модели\nG91 ;относительная система координат\nG1 E-5 F600 ;сделать откат на 5мм\nG1 Z1 F300 ;поднять голову на 1мм';
+			values['profile.title'] = 'Профиль';
+			values['profile.load'] = 'Загрузить';
+			values['profile.delete'] = 'Удалить';
+			values['profile.save'] = 'Сохранить как';
+			values['profile.export'] = 'Экспорт в файл';
+			values['profile.import'] = 'Импорт из файла';
+			values['error.profile.name_empty'] = 'Введите имя профиля';
+			values['error.profile.import'] = 'Не удалось импортировать профиль';
 			
 			values['navbar.back'] = ' Назад ';
 			values['navbar.site'] = 'Сайт';
@@ -517,6 +999,177 @@ function initLang(key) {
 			values['error.firmware.not_set'] = 'Ошибка формата: не выбрана прошивка';
 			values['error.k_factor.format'] = 'K-фактор - ошибка формата';
 			values['error.k_factor.too_high'] = 'Неверное значение K-фактора (должно быть от 0.0 до 2.0)';
+			values['table.temperature_sweep.title'] = 'Перебор температуры';
+			values['table.temperature_sweep.description'] = 'Печатать каждый сегмент со своей температурой хотэнда: от температуры хотэнда на нижнем сегменте до конечной температуры хотэнда на верхнем';
+			values['table.end_hotend_temp.title'] = 'Конечная температура хотэнда';
+			values['table.end_hotend_temp.description'] = '[°C] Температура хотэнда верхнего сегмента при включенном переборе температуры';
+			values['table.temperature_stabilization.title'] = 'Стабилизация температуры';
+			values['table.temperature_stabilization.description'] = 'Как дождаться новой температуры при переходе между сегментами: паузой или ожиданием в точке парковки перед башенками';
+			values['table.temperature_stabilization.dwell'] = 'Пауза';
+			values['table.temperature_stabilization.park'] = 'Парковка и ожидание';
+			values['table.temperature_dwell.title'] = 'Пауза после смены температуры';
+			values['table.temperature_dwell.description'] = '[с] Длительность паузы после смены температуры, если выбрана стабилизация паузой';
+			values['error.end_hotend_temp.format'] = 'Конечная температура хотэнда - ошибка формата';
+			values['error.end_hotend_temp.too_low'] = 'Конечная температура хотэнда слишком низкая';
+			values['error.end_hotend_temp.too_high'] = 'Конечная температура хотэнда слишком высокая';
+			values['error.temperature_stabilization.format'] = 'Стабилизация температуры - ошибка формата';
+			values['error.temperature_dwell.format'] = 'Пауза после смены температуры - ошибка формата';
+			values['error.temperature_dwell.small_or_big'] = 'Пауза после смены температуры неправильная (меньше 0 или больше 600 с)';
+			values['table.init_z_hop.title'] = 'Начальный подъём по Z';
+			values['table.init_z_hop.description'] = '[мм] Подъём сопла при холостых перемещениях в нижнем сегменте. 0 в обоих полях подъёма отключает его';
+			values['table.end_z_hop.title'] = 'Конечный подъём по Z';
+			values['table.end_z_hop.description'] = '[мм] Подъём сопла при холостых перемещениях в верхнем сегменте';
+			values['table.z_hop_style.title'] = 'Вид подъёма по Z';
+			values['table.z_hop_style.description'] = 'Как поднимается сопло: вертикально или наклонно на первой половине перемещения. Опускается всегда вертикально';
+			values['table.z_hop_style.plain'] = 'Вертикальный';
+			values['table.z_hop_style.ramped'] = 'Наклонный';
+			values['table.z_hop_only_crossing.title'] = 'Подъём по Z только между башенками';
+			values['table.z_hop_only_crossing.description'] = 'Поднимать сопло только при перемещениях с одной башенки на другую';
+			values['error.init_z_hop.format'] = 'Начальный подъём по Z - ошибка формата';
+			values['error.init_z_hop.small_or_big'] = 'Неправильный начальный подъём по Z (меньше 0 или больше 10 мм)';
+			values['error.end_z_hop.format'] = 'Конечный подъём по Z - ошибка формата';
+			values['error.end_z_hop.small_or_big'] = 'Неправильный конечный подъём по Z (меньше 0 или больше 10 мм)';
+			values['error.z_hop_style.format'] = 'Вид подъёма по Z - ошибка формата';
+			values['table.init_wipe_distance.title'] = 'Начальная длина очистки';
+			values['table.init_wipe_distance.description'] = '[мм] При ретракте в нижнем сегменте сопло проходит назад по напечатанному периметру на это расстояние. 0 в обоих полях очистки отключает её';
+			values['table.end_wipe_distance.title'] = 'Конечная длина очистки';
+			values['table.end_wipe_distance.description'] = '[мм] Длина очистки в верхнем сегменте';
+			values['table.wipe_retract.title'] = 'Ретракт во время очистки';
+			values['table.wipe_retract.description'] = '[%] Доля ретракта, выполняемая во время очистки. Остаток втягивается на месте перед очисткой';
+			values['error.init_wipe_distance.format'] = 'Начальная длина очистки - ошибка формата';
+			values['error.init_wipe_distance.small_or_big'] = 'Неправильная начальная длина очистки (меньше 0 или больше 20 мм)';
+			values['error.end_wipe_distance.format'] = 'Конечная длина очистки - ошибка формата';
+			values['error.end_wipe_distance.small_or_big'] = 'Неправильная конечная длина очистки (меньше 0 или больше 20 мм)';
+			values['error.wipe_retract.format'] = 'Ретракт во время очистки - ошибка формата';
+			values['error.wipe_retract.small_or_big'] = 'Неправильный ретракт во время очистки (меньше 0 или больше 100%)';
+			values['table.init_extra_prime.title'] = 'Начальная дополнительная подача';
+			values['table.init_extra_prime.description'] = '[мм] После каждого ретракта в нижнем сегменте подаётся на столько больше филамента, чем было втянуто, отрицательные значения подают меньше';
+			values['table.end_extra_prime.title'] = 'Конечная дополнительная подача';
+			values['table.end_extra_prime.description'] = '[мм] Дополнительная подача в верхнем сегменте';
+			values['error.init_extra_prime.format'] = 'Начальная дополнительная подача - ошибка формата';
+			values['error.init_extra_prime.small_or_big'] = 'Неправильная начальная дополнительная подача (меньше -2 или больше 2 мм)';
+			values['error.end_extra_prime.format'] = 'Конечная дополнительная подача - ошибка формата';
+			values['error.end_extra_prime.small_or_big'] = 'Неправильная конечная дополнительная подача (меньше -2 или больше 2 мм)';
+			values['table.separate_unretract_speed.title'] = 'Отдельная скорость возврата';
+			values['table.separate_unretract_speed.description'] = 'Филамент после ретракта подаётся обратно с собственной скоростью, а не со скоростью ретракта';
+			values['table.init_unretract_speed.title'] = 'Начальная скорость возврата';
+			values['table.init_unretract_speed.description'] = '[мм/с] Скорость возврата филамента после ретракта в нижнем сегменте. Одинаковые начальное и конечное значения дают постоянную скорость';
+			values['table.end_unretract_speed.title'] = 'Конечная скорость возврата';
+			values['table.end_unretract_speed.description'] = '[мм/с] Скорость возврата филамента после ретракта в верхнем сегменте';
+			values['error.init_unretract_speed.format'] = 'Начальная скорость возврата - ошибка формата';
+			values['error.init_unretract_speed.slow_or_fast'] = 'Неправильная начальная скорость возврата (меньше 5 или больше 150 мм/с)';
+			values['error.end_unretract_speed.format'] = 'Конечная скорость возврата - ошибка формата';
+			values['error.end_unretract_speed.slow_or_fast'] = 'Неправильная конечная скорость возврата (меньше 5 или больше 150 мм/с)';
+			values['table.firmware_retraction.title'] = 'Прошивочный ретракт';
+			values['table.firmware_retraction.description'] = 'Ретракты командами G10/G11. Настройки каждого сегмента задаются в прошивке: M207/M208 для Marlin, SET_RETRACTION для Klipper, M207 для RRF. Прошивочный ретракт должен быть включён в прошивке';
+			values['table.matrix.title'] = 'Матрица длина × скорость';
+			values['table.matrix.description'] = 'Печатать несколько пар башенок одна за другой, каждую со своей скоростью ретракта от начальной до конечной. Длина ретракта меняется по высоте башенок как обычно';
+			values['table.matrix_pairs.title'] = 'Количество пар башенок';
+			values['table.matrix_pairs.description'] = 'Количество пар башенок матрицы, спереди назад. Каждая пара занимает размер подложки плюс 10 мм стола по Y (40 мм со стандартными башенками), линия очистки перед ними ещё 10 мм';
+			values['error.matrix_pairs.format'] = 'Количество пар башенок - ошибка формата';
+			values['error.matrix_pairs.small_or_big'] = 'Неправильное количество пар башенок (меньше 2, больше 10 или не помещается на стол)';
+			values['table.retract_length_values.title'] = 'Значения длины ретракта';
+			values['table.retract_length_values.description'] = '[мм] Длина ретракта каждого сегмента снизу вверх вместо ряда от начальной до конечной длины, например "0.2, 0.5, 1" или диапазоны начало:шаг:конец вроде "0.2:0.2:2.0". Значений должно быть столько же, сколько сегментов. Оставьте пустым для ряда';
+			values['table.retract_speed_values.title'] = 'Значения скорости ретракта';
+			values['table.retract_speed_values.description'] = '[мм/с] Скорость ретракта каждого сегмента, как значения длины ретракта';
+			values['table.stepping.title'] = 'Шаг значений';
+			values['table.stepping.description'] = 'Как распределяются длины и скорости ретракта между начальным и конечным значением: равными шагами, геометрически (мелкие шаги у начального значения) или логарифмически (мелкие шаги у конечного значения). Все остальные значения меняются линейно';
+			values['table.stepping.linear'] = 'Линейный';
+			values['table.stepping.geometric'] = 'Геометрический';
+			values['table.stepping.logarithmic'] = 'Логарифмический';
+			values['table.order.title'] = 'Порядок значений';
+			values['table.order.description'] = 'Порядок сегментов снизу вверх, по длине, затем по скорости ретракта; каждый сегмент сохраняет свои длину и скорость';
+			values['table.order.given'] = 'Как задано';
+			values['table.order.ascending'] = 'По возрастанию';
+			values['table.order.descending'] = 'По убыванию';
+			values['error.retract_length_values.format'] = 'Значения длины ретракта - ошибка формата';
+			values['error.retract_length_values.count'] = 'Количество значений длины ретракта не совпадает с количеством сегментов';
+			values['error.retract_length_values.small_or_big'] = 'Неправильное значение длины ретракта (меньше 0 или больше 20 мм)';
+			values['error.retract_speed_values.format'] = 'Значения скорости ретракта - ошибка формата';
+			values['error.retract_speed_values.count'] = 'Количество значений скорости ретракта не совпадает с количеством сегментов';
+			values['error.retract_speed_values.slow_or_fast'] = 'Неправильное значение скорости ретракта (меньше 5 или больше 150 мм/с)';
+			values['error.stepping.format'] = 'Шаг значений - ошибка формата';
+			values['error.order.format'] = 'Порядок значений - ошибка формата';
+			values['table.pressure_advance_sweep.title'] = 'Перебор Linear/Pressure Advance';
+			values['table.pressure_advance_sweep.description'] = 'Печатать каждый сегмент со своим k-фактором: от k-фактора на нижнем сегменте до конечного k-фактора на верхнем. Углы и шов башенок покажут лучшее значение';
+			values['table.end_k_factor.title'] = 'Конечный k-фактор';
+			values['table.end_k_factor.description'] = 'K-фактор верхнего сегмента при включенном переборе Linear/Pressure Advance';
+			values['error.end_k_factor.format'] = 'Конечный K-фактор - ошибка формата';
+			values['error.end_k_factor.too_high'] = 'Неверное значение конечного K-фактора (должно быть от 0.0 до 2.0)';
+			values['table.travel_speed_sweep.title'] = 'Перебор скорости перемещений';
+			values['table.travel_speed_sweep.description'] = 'Делать перемещения каждого сегмента со своей скоростью: от скорости перемещений на нижнем сегменте до конечной скорости перемещений на верхнем';
+			values['table.end_travel_speed.title'] = 'Конечная скорость перемещений';
+			values['table.end_travel_speed.description'] = '[мм/с] Скорость перемещений верхнего сегмента при включенном переборе скорости перемещений';
+			values['table.init_travel_accel.title'] = 'Начальное ускорение перемещений';
+			values['table.init_travel_accel.description'] = '[мм/с²] Ускорение перемещений на нижнем сегменте (M204 T, кроме Klipper). 0 в обоих полях оставляет ускорение принтера';
+			values['table.end_travel_accel.title'] = 'Конечное ускорение перемещений';
+			values['table.end_travel_accel.description'] = '[мм/с²] Ускорение перемещений на верхнем сегменте';
+			values['error.end_travel_speed.format'] = 'Конечная скорость перемещений - ошибка формата';
+			values['error.end_travel_speed.slow_or_fast'] = 'Неправильная конечная скорость перемещений (меньше 10 или больше 1000 мм/с)';
+			values['error.init_travel_accel.format'] = 'Начальное ускорение перемещений - ошибка формата';
+			values['error.init_travel_accel.small_or_big'] = 'Неправильное начальное ускорение перемещений (меньше 0 или больше 50000 мм/с²)';
+			values['error.end_travel_accel.format'] = 'Конечное ускорение перемещений - ошибка формата';
+			values['error.end_travel_accel.small_or_big'] = 'Неправильное конечное ускорение перемещений (меньше 0 или больше 50000 мм/с²)';
+			values['warning.travel_accel.klipper'] = 'В Klipper нет отдельного ускорения перемещений, ускорение перемещений игнорируется';
+			values['table.init_coast.title'] = 'Начальный накат';
+			values['table.init_coast.description'] = '[мм] Последние миллиметры периметров каждой башенки на нижнем сегменте проходятся без экструзии перед перемещением. 0 в обоих полях отключает накат';
+			values['table.end_coast.title'] = 'Конечный накат';
+			values['table.end_coast.description'] = '[мм] Накат на верхнем сегменте';
+			values['table.coast_volume.title'] = 'Накат объёмом';
+			values['table.coast_volume.description'] = 'Начальный и конечный накат в мм³ филамента вместо мм линии';
+			values['error.init_coast.format'] = 'Начальный накат - ошибка формата';
+			values['error.init_coast.small_or_big'] = 'Неправильный начальный накат (меньше 0 или больше 10)';
+			values['error.end_coast.format'] = 'Конечный накат - ошибка формата';
+			values['error.end_coast.small_or_big'] = 'Неправильный конечный накат (меньше 0 или больше 10)';
+			values['table.init_retract_dwell.title'] = 'Начальная пауза после ретракта';
+			values['table.init_retract_dwell.description'] = '[с] Пауза (G4) после каждого ретракта на башенках перед перемещением на нижнем сегменте, чтобы показать, сколько хотэнд подтекает при простое. 0 в обоих полях отключает паузу';
+			values['table.end_retract_dwell.title'] = 'Конечная пауза после ретракта';
+			values['table.end_retract_dwell.description'] = '[с] Пауза после ретракта на верхнем сегменте';
+			values['error.init_retract_dwell.format'] = 'Начальная пауза после ретракта - ошибка формата';
+			values['error.init_retract_dwell.small_or_big'] = 'Неправильная начальная пауза после ретракта (меньше 0 или больше 60 с)';
+			values['error.end_retract_dwell.format'] = 'Конечная пауза после ретракта - ошибка формата';
+			values['error.end_retract_dwell.small_or_big'] = 'Неправильная конечная пауза после ретракта (меньше 0 или больше 60 с)';
+			values['table.init_min_travel.title'] = 'Начальное минимальное перемещение для ретракта';
+			values['table.init_min_travel.description'] = '[мм] Перемещения на башенках короче этого делаются без ретракта на нижнем сегменте. 0 в обоих полях делает ретракт при каждом перемещении';
+			values['table.end_min_travel.title'] = 'Конечное минимальное перемещение для ретракта';
+			values['table.end_min_travel.description'] = '[мм] Минимальное перемещение для ретракта на верхнем сегменте';
+			values['table.short_hops.title'] = 'Короткие перемещения';
+			values['table.short_hops.description'] = 'После внутреннего периметра каждой башенки сопло перемещается к внешнему вместо печати перехода, так что у каждой башенки есть короткое перемещение помимо длинного между башенками';
+			values['table.short_hop_distance.title'] = 'Длина коротких перемещений';
+			values['table.short_hop_distance.description'] = '[мм] Примерная длина коротких перемещений: внешний периметр начинается на таком расстоянии вдоль своей стороны';
+			values['error.init_min_travel.format'] = 'Начальное минимальное перемещение для ретракта - ошибка формата';
+			values['error.init_min_travel.small_or_big'] = 'Неправильное начальное минимальное перемещение для ретракта (меньше 0 или больше 100 мм)';
+			values['error.end_min_travel.format'] = 'Конечное минимальное перемещение для ретракта - ошибка формата';
+			values['error.end_min_travel.small_or_big'] = 'Неправильное конечное минимальное перемещение для ретракта (меньше 0 или больше 100 мм)';
+			values['error.short_hop_distance.format'] = 'Длина коротких перемещений - ошибка формата';
+			values['error.short_hop_distance.small_or_big'] = 'Неправильная длина коротких перемещений (меньше 1 или больше 10 мм)';
+			values['error.short_hop_distance.too_long'] = 'Длина коротких перемещений слишком большая (больше 10 мм или половины размера башенки)';
+			values['table.tower_width.title'] = 'Размер башенки';
+			values['table.tower_width.description'] = '[мм] Внешний размер башенок. Большим соплам нужны башенки побольше, микросоплам хватит меньших';
+			values['table.wall_spacing.title'] = 'Расстояние между стенками';
+			values['table.wall_spacing.description'] = '[%] Расстояние между внутренним и внешним периметром в процентах от ширины линии';
+			values['table.raft_margin.title'] = 'Поле подложки';
+			values['table.raft_margin.description'] = '[мм] Подложка каждой башенки шире башенки на столько с каждой стороны';
+			values['error.tower_width.format'] = 'Размер башенки - ошибка формата';
+			values['error.tower_width.too_small'] = 'Размер башенки слишком маленький (меньше 5 мм)';
+			values['error.tower_width.too_big'] = 'Размер башенки слишком большой, башенки с подложками не помещаются в расстояние между башенками';
+			values['error.wall_spacing.format'] = 'Расстояние между стенками - ошибка формата';
+			values['error.wall_spacing.small_or_big'] = 'Неправильное расстояние между стенками (меньше 50 или больше 200 %, или слишком большое для размера башенки)';
+			values['error.raft_margin.format'] = 'Поле подложки - ошибка формата';
+			values['error.raft_margin.small_or_big'] = 'Неправильное поле подложки (меньше 2 или больше 20 мм)';
+			values['error.raft_margin.too_big'] = 'Поле подложки слишком большое (больше 20 мм), или подложки с линией очистки не помещаются на стол';
+			values['table.tower_shape.title'] = 'Форма башенок';
+			values['table.tower_shape.description'] = 'Квадратные, круглые или треугольные башенки с острым углом. От углов зависит, как видно подтекание на шве, на круглых башенках лучше видны нитки';
+			values['table.tower_shape.square'] = 'Квадрат';
+			values['table.tower_shape.round'] = 'Круг';
+			values['table.tower_shape.triangle'] = 'Треугольник';
+			values['table.arc_moves.title'] = 'Движения по дуге';
+			values['table.arc_moves.description'] = 'Печатать круглые башенки дугами G2 вместо многоугольников. Klipper нужна для этого секция [gcode_arcs]';
+			values['error.tower_shape.format'] = 'Форма башенок - ошибка формата';
+			values['warning.coast.too_long'] = 'Накат ограничен половиной внешнего периметра башенок';
+			values['warning.arc_moves.klipper'] = 'Klipper печатает дуги только с секцией [gcode_arcs] в printer.cfg';
+			values['warning.arc_moves.marlin'] = 'Marlin печатает дуги, только если он собран с ARC_SUPPORT';
+			values['warning.arc_moves.shape'] = 'Дугами печатаются только круглые башенки, движения по дуге игнорируются';
 			break;
 	}
 	
@@ -565,8 +1218,101 @@ function reset() {
 	window.location.reload(false);
 }
 
+// Named profiles are kept in localStorage as a map of profile names
+// to profile JSON produced by exportProfileGo.
+function getProfiles() {
+	var profiles = localStorage.getItem('profiles');
+	if (profiles == null) {
+		return {};
+	}
+	return JSON.parse(profiles);
+}
+
+function updateProfileList(selected) {
+	var select = document.getElementById('profileSelect');
+	select.innerHTML = '';
+	for (var name of Object.keys(getProfiles()).sort()) {
+		var option = document.createElement('option');
+		option.value = name;
+		option.text = name;
+		option.selected = name == selected;
+		select.appendChild(option);
+	}
+}
+
+function applyProfile(json) {
+	var name = importProfileGo(json);
+	if (name == null) {
+		return null;
+	}
+	document.getElementById('profileName').value = name;
+	saveForm();
+	checkGo();
+	return name;
+}
+
+function saveProfile() {
+	var name = document.getElementById('profileName').value.trim();
+	document.getElementById('resultContainer').innerHTML = '';
+	if (name == '') {
+		showError(window.lang.getString('error.profile.name_empty'));
+		return;
+	}
+	var json = exportProfileGo(name);
+	if (json == null) {
+		return;
+	}
+	var profiles = getProfiles();
+	profiles[name] = json;
+	localStorage.setItem('profiles', JSON.stringify(profiles));
+	updateProfileList(name);
+}
+
+function loadProfile() {
+	var name = document.getElementById('profileSelect').value;
+	var json = getProfiles()[name];
+	if (json != undefined) {
+		applyProfile(json);
+	}
+}
+
+function deleteProfile() {
+	var name = document.getElementById('profileSelect').value;
+	var profiles = getProfiles();
+	delete profiles[name];
+	localStorage.setItem('profiles', JSON.stringify(profiles));
+	updateProfileList();
+}
+
+function exportProfile() {
+	var name = document.getElementById('profileName').value.trim();
+	if (name == '') {
+		name = 'profile';
+	}
+	var json = exportProfileGo(name);
+	if (json != null) {
+		saveTextAsFile(name + '.json', json);
+	}
+}
+
+function importProfileFile(input) {
+	var file = input.files[0];
+	if (file == undefined) {
+		return;
+	}
+	file.text().then(function(json) {
+		var name = applyProfile(json);
+		if (name != null && name != '') {
+			var profiles = getProfiles();
+			profiles[name] = json;
+			localStorage.setItem('profiles', JSON.stringify(profiles));
+			updateProfileList(name);
+		}
+		input.value = '';
+	});
+}
+
 function init() {
-	initForm();
 	
 	const urlParams = new URLSearchParams(window.location.search);
 	var lang = urlParams.get('lang');
@@ -585,6 +1331,9 @@ function init() {
 		}
 	};
 	initLang(lang);
+	buildForm();
+	initForm();
+	updateProfileList();
 	
 	setTimeout(function() {
 		if (checkGo != undefined && window.lang != undefined) {
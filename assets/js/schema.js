@@ -0,0 +1,837 @@
+// Code generated by "k3drct -schema assets/js/schema.js"; DO NOT EDIT.
+
+var schema = [
+  {
+    "id": "bedX",
+    "key": "bed_size_x",
+    "type": "number",
+    "unit": "mm",
+    "default": 235,
+    "min": 100,
+    "max": 1000,
+    "title": "table.bed_size_x.title",
+    "help": "table.bed_size_x.description"
+  },
+  {
+    "id": "bedY",
+    "key": "bed_size_y",
+    "type": "number",
+    "unit": "mm",
+    "default": 235,
+    "min": 100,
+    "max": 1000,
+    "title": "table.bed_size_y.title",
+    "help": "table.bed_size_y.description"
+  },
+  {
+    "id": "firmware",
+    "key": "firmware",
+    "type": "enum",
+    "default": "marlin",
+    "options": [
+      {
+        "value": "marlin",
+        "label": "Marlin"
+      },
+      {
+        "value": "klipper",
+        "label": "Klipper"
+      },
+      {
+        "value": "rrf",
+        "label": "RRF"
+      }
+    ],
+    "title": "table.firmware.title",
+    "help": "table.firmware.description"
+  },
+  {
+    "id": "zOffset",
+    "key": "z_offset",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "constraint": {
+      "related": "layerHeight",
+      "min": "-layerHeight",
+      "max": "layerHeight"
+    },
+    "title": "table.z_offset.title",
+    "help": "table.z_offset.description"
+  },
+  {
+    "id": "delta",
+    "key": "delta",
+    "type": "bool",
+    "default": false,
+    "title": "table.delta.title",
+    "help": "table.delta.description"
+  },
+  {
+    "id": "bedProbe",
+    "key": "bed_probe",
+    "type": "bool",
+    "default": false,
+    "title": "table.bed_probe.title",
+    "help": "table.bed_probe.description"
+  },
+  {
+    "id": "hotendTemperature",
+    "key": "hotend_temp",
+    "type": "integer",
+    "unit": "°C",
+    "default": 210,
+    "min": 150,
+    "max": 350,
+    "title": "table.hotend_temp.title",
+    "help": "table.hotend_temp.description"
+  },
+  {
+    "id": "bedTemperature",
+    "key": "bed_temp",
+    "type": "integer",
+    "unit": "°C",
+    "default": 60,
+    "max": 150,
+    "title": "table.bed_temp.title",
+    "help": "table.bed_temp.description"
+  },
+  {
+    "id": "flow",
+    "key": "flow",
+    "type": "integer",
+    "unit": "%",
+    "default": 100,
+    "min": 50,
+    "max": 150,
+    "title": "table.flow.title",
+    "help": "table.flow.description"
+  },
+  {
+    "id": "cooling",
+    "key": "fan_speed",
+    "type": "integer",
+    "unit": "%",
+    "default": 100,
+    "title": "table.fan_speed.title",
+    "help": "table.fan_speed.description"
+  },
+  {
+    "id": "lineWidth",
+    "key": "line_width",
+    "type": "number",
+    "unit": "mm",
+    "default": 0.4,
+    "min": 0.1,
+    "max": 2,
+    "title": "table.line_width.title",
+    "help": "table.line_width.description"
+  },
+  {
+    "id": "firstLayerLineWidth",
+    "key": "first_line_width",
+    "type": "number",
+    "unit": "mm",
+    "default": 0.6,
+    "min": 0.1,
+    "max": 2,
+    "title": "table.first_line_width.title",
+    "help": "table.first_line_width.description"
+  },
+  {
+    "id": "layerHeight",
+    "key": "layer_height",
+    "type": "number",
+    "unit": "mm",
+    "default": 0.25,
+    "min": 0.05,
+    "constraint": {
+      "related": "lineWidth",
+      "max": "lineWidth * 0.75"
+    },
+    "title": "table.layer_height.title",
+    "help": "table.layer_height.description"
+  },
+  {
+    "id": "printSpeed",
+    "key": "print_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 60,
+    "min": 10,
+    "max": 1000,
+    "title": "table.print_speed.title",
+    "help": "table.print_speed.description"
+  },
+  {
+    "id": "firstLayerPrintSpeed",
+    "key": "first_print_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 30,
+    "min": 10,
+    "max": 1000,
+    "title": "table.first_print_speed.title",
+    "help": "table.first_print_speed.description"
+  },
+  {
+    "id": "travelSpeed",
+    "key": "travel_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 150,
+    "min": 10,
+    "max": 1000,
+    "title": "table.travel_speed.title",
+    "help": "table.travel_speed.description"
+  },
+  {
+    "id": "initRetractLength",
+    "key": "init_retract_length",
+    "type": "number",
+    "unit": "mm",
+    "default": 1,
+    "min": 0,
+    "max": 20,
+    "title": "table.init_retract_length.title",
+    "help": "table.init_retract_length.description",
+    "segment": true
+  },
+  {
+    "id": "endRetractLength",
+    "key": "end_retract_length",
+    "type": "number",
+    "unit": "mm",
+    "default": 0.2,
+    "min": 0,
+    "max": 20,
+    "title": "table.end_retract_length.title",
+    "help": "table.end_retract_length.description",
+    "segment": true
+  },
+  {
+    "id": "initRetractSpeed",
+    "key": "init_retract_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 30,
+    "min": 5,
+    "max": 150,
+    "title": "table.init_retract_speed.title",
+    "help": "table.init_retract_speed.description",
+    "segment": true
+  },
+  {
+    "id": "endRetractSpeed",
+    "key": "end_retract_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 30,
+    "min": 5,
+    "max": 150,
+    "title": "table.end_retract_speed.title",
+    "help": "table.end_retract_speed.description",
+    "segment": true
+  },
+  {
+    "id": "retractLengthValues",
+    "key": "retract_length_values",
+    "type": "list",
+    "unit": "mm",
+    "default": "",
+    "title": "table.retract_length_values.title",
+    "help": "table.retract_length_values.description",
+    "segment": true
+  },
+  {
+    "id": "retractSpeedValues",
+    "key": "retract_speed_values",
+    "type": "list",
+    "unit": "mm/s",
+    "default": "",
+    "title": "table.retract_speed_values.title",
+    "help": "table.retract_speed_values.description",
+    "segment": true
+  },
+  {
+    "id": "stepping",
+    "key": "stepping",
+    "type": "enum",
+    "default": "linear",
+    "options": [
+      {
+        "value": "linear",
+        "label": "Linear"
+      },
+      {
+        "value": "geometric",
+        "label": "Geometric"
+      },
+      {
+        "value": "logarithmic",
+        "label": "Logarithmic"
+      }
+    ],
+    "title": "table.stepping.title",
+    "help": "table.stepping.description",
+    "segment": true
+  },
+  {
+    "id": "order",
+    "key": "order",
+    "type": "enum",
+    "default": "given",
+    "options": [
+      {
+        "value": "given",
+        "label": "Given"
+      },
+      {
+        "value": "ascending",
+        "label": "Ascending"
+      },
+      {
+        "value": "descending",
+        "label": "Descending"
+      }
+    ],
+    "title": "table.order.title",
+    "help": "table.order.description",
+    "segment": true
+  },
+  {
+    "id": "separateUnretractSpeed",
+    "key": "separate_unretract_speed",
+    "type": "bool",
+    "default": false,
+    "title": "table.separate_unretract_speed.title",
+    "help": "table.separate_unretract_speed.description",
+    "segment": true
+  },
+  {
+    "id": "initUnretractSpeed",
+    "key": "init_unretract_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 30,
+    "min": 5,
+    "max": 150,
+    "title": "table.init_unretract_speed.title",
+    "help": "table.init_unretract_speed.description",
+    "segment": true
+  },
+  {
+    "id": "endUnretractSpeed",
+    "key": "end_unretract_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 30,
+    "min": 5,
+    "max": 150,
+    "title": "table.end_unretract_speed.title",
+    "help": "table.end_unretract_speed.description",
+    "segment": true
+  },
+  {
+    "id": "temperatureSweep",
+    "key": "temperature_sweep",
+    "type": "bool",
+    "default": false,
+    "title": "table.temperature_sweep.title",
+    "help": "table.temperature_sweep.description",
+    "segment": true
+  },
+  {
+    "id": "endHotendTemperature",
+    "key": "end_hotend_temp",
+    "type": "integer",
+    "unit": "°C",
+    "default": 230,
+    "min": 150,
+    "max": 350,
+    "title": "table.end_hotend_temp.title",
+    "help": "table.end_hotend_temp.description",
+    "segment": true
+  },
+  {
+    "id": "pressureAdvanceSweep",
+    "key": "pressure_advance_sweep",
+    "type": "bool",
+    "default": false,
+    "title": "table.pressure_advance_sweep.title",
+    "help": "table.pressure_advance_sweep.description",
+    "segment": true
+  },
+  {
+    "id": "endKFactor",
+    "key": "end_k_factor",
+    "type": "number",
+    "default": 0.1,
+    "min": 0,
+    "max": 2,
+    "title": "table.end_k_factor.title",
+    "help": "table.end_k_factor.description",
+    "segment": true
+  },
+  {
+    "id": "travelSpeedSweep",
+    "key": "travel_speed_sweep",
+    "type": "bool",
+    "default": false,
+    "title": "table.travel_speed_sweep.title",
+    "help": "table.travel_speed_sweep.description",
+    "segment": true
+  },
+  {
+    "id": "endTravelSpeed",
+    "key": "end_travel_speed",
+    "type": "number",
+    "unit": "mm/s",
+    "default": 300,
+    "min": 10,
+    "max": 1000,
+    "title": "table.end_travel_speed.title",
+    "help": "table.end_travel_speed.description",
+    "segment": true
+  },
+  {
+    "id": "initTravelAccel",
+    "key": "init_travel_accel",
+    "type": "number",
+    "unit": "mm/s²",
+    "default": 0,
+    "min": 0,
+    "max": 50000,
+    "title": "table.init_travel_accel.title",
+    "help": "table.init_travel_accel.description",
+    "segment": true
+  },
+  {
+    "id": "endTravelAccel",
+    "key": "end_travel_accel",
+    "type": "number",
+    "unit": "mm/s²",
+    "default": 0,
+    "min": 0,
+    "max": 50000,
+    "title": "table.end_travel_accel.title",
+    "help": "table.end_travel_accel.description",
+    "segment": true
+  },
+  {
+    "id": "initCoast",
+    "key": "init_coast",
+    "type": "number",
+    "default": 0,
+    "min": 0,
+    "max": 10,
+    "title": "table.init_coast.title",
+    "help": "table.init_coast.description",
+    "segment": true
+  },
+  {
+    "id": "endCoast",
+    "key": "end_coast",
+    "type": "number",
+    "default": 0,
+    "min": 0,
+    "max": 10,
+    "title": "table.end_coast.title",
+    "help": "table.end_coast.description",
+    "segment": true
+  },
+  {
+    "id": "initRetractDwell",
+    "key": "init_retract_dwell",
+    "type": "number",
+    "unit": "s",
+    "default": 0,
+    "min": 0,
+    "max": 60,
+    "title": "table.init_retract_dwell.title",
+    "help": "table.init_retract_dwell.description",
+    "segment": true
+  },
+  {
+    "id": "endRetractDwell",
+    "key": "end_retract_dwell",
+    "type": "number",
+    "unit": "s",
+    "default": 0,
+    "min": 0,
+    "max": 60,
+    "title": "table.end_retract_dwell.title",
+    "help": "table.end_retract_dwell.description",
+    "segment": true
+  },
+  {
+    "id": "initMinTravel",
+    "key": "init_min_travel",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 100,
+    "title": "table.init_min_travel.title",
+    "help": "table.init_min_travel.description",
+    "segment": true
+  },
+  {
+    "id": "endMinTravel",
+    "key": "end_min_travel",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 100,
+    "title": "table.end_min_travel.title",
+    "help": "table.end_min_travel.description",
+    "segment": true
+  },
+  {
+    "id": "initZHop",
+    "key": "init_z_hop",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 10,
+    "title": "table.init_z_hop.title",
+    "help": "table.init_z_hop.description",
+    "segment": true
+  },
+  {
+    "id": "endZHop",
+    "key": "end_z_hop",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 10,
+    "title": "table.end_z_hop.title",
+    "help": "table.end_z_hop.description",
+    "segment": true
+  },
+  {
+    "id": "initWipeDistance",
+    "key": "init_wipe_distance",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 20,
+    "title": "table.init_wipe_distance.title",
+    "help": "table.init_wipe_distance.description",
+    "segment": true
+  },
+  {
+    "id": "endWipeDistance",
+    "key": "end_wipe_distance",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": 0,
+    "max": 20,
+    "title": "table.end_wipe_distance.title",
+    "help": "table.end_wipe_distance.description",
+    "segment": true
+  },
+  {
+    "id": "initExtraPrime",
+    "key": "init_extra_prime",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": -2,
+    "max": 2,
+    "title": "table.init_extra_prime.title",
+    "help": "table.init_extra_prime.description",
+    "segment": true
+  },
+  {
+    "id": "endExtraPrime",
+    "key": "end_extra_prime",
+    "type": "number",
+    "unit": "mm",
+    "default": 0,
+    "min": -2,
+    "max": 2,
+    "title": "table.end_extra_prime.title",
+    "help": "table.end_extra_prime.description",
+    "segment": true
+  },
+  {
+    "id": "matrix",
+    "key": "matrix",
+    "type": "bool",
+    "default": false,
+    "title": "table.matrix.title",
+    "help": "table.matrix.description",
+    "segment": true
+  },
+  {
+    "id": "matrixPairs",
+    "key": "matrix_pairs",
+    "type": "integer",
+    "default": 3,
+    "min": 2,
+    "max": 10,
+    "constraint": {
+      "related": "bedY",
+      "max": "(bedY - 10) / (towerWidth + 2 * raftMargin + 10)"
+    },
+    "title": "table.matrix_pairs.title",
+    "help": "table.matrix_pairs.description",
+    "segment": true
+  },
+  {
+    "id": "numSegments",
+    "key": "num_segments",
+    "type": "integer",
+    "default": 10,
+    "min": 2,
+    "max": 100,
+    "title": "table.num_segments.title",
+    "help": "table.num_segments.description",
+    "segment": true
+  },
+  {
+    "id": "temperatureStabilization",
+    "key": "temperature_stabilization",
+    "type": "enum",
+    "default": "dwell",
+    "options": [
+      {
+        "value": "dwell",
+        "label": "Dwell"
+      },
+      {
+        "value": "park",
+        "label": "Park"
+      }
+    ],
+    "title": "table.temperature_stabilization.title",
+    "help": "table.temperature_stabilization.description"
+  },
+  {
+    "id": "temperatureDwell",
+    "key": "temperature_dwell",
+    "type": "number",
+    "unit": "s",
+    "default": 15,
+    "min": 0,
+    "max": 600,
+    "title": "table.temperature_dwell.title",
+    "help": "table.temperature_dwell.description"
+  },
+  {
+    "id": "zHopStyle",
+    "key": "z_hop_style",
+    "type": "enum",
+    "default": "plain",
+    "options": [
+      {
+        "value": "plain",
+        "label": "Plain"
+      },
+      {
+        "value": "ramped",
+        "label": "Ramped"
+      }
+    ],
+    "title": "table.z_hop_style.title",
+    "help": "table.z_hop_style.description"
+  },
+  {
+    "id": "zHopOnlyCrossing",
+    "key": "z_hop_only_crossing",
+    "type": "bool",
+    "default": false,
+    "title": "table.z_hop_only_crossing.title",
+    "help": "table.z_hop_only_crossing.description"
+  },
+  {
+    "id": "wipeRetract",
+    "key": "wipe_retract",
+    "type": "integer",
+    "unit": "%",
+    "default": 100,
+    "min": 0,
+    "max": 100,
+    "title": "table.wipe_retract.title",
+    "help": "table.wipe_retract.description"
+  },
+  {
+    "id": "firmwareRetraction",
+    "key": "firmware_retraction",
+    "type": "bool",
+    "default": false,
+    "title": "table.firmware_retraction.title",
+    "help": "table.firmware_retraction.description"
+  },
+  {
+    "id": "coastVolume",
+    "key": "coast_volume",
+    "type": "bool",
+    "default": false,
+    "title": "table.coast_volume.title",
+    "help": "table.coast_volume.description"
+  },
+  {
+    "id": "shortHops",
+    "key": "short_hops",
+    "type": "bool",
+    "default": false,
+    "title": "table.short_hops.title",
+    "help": "table.short_hops.description"
+  },
+  {
+    "id": "shortHopDistance",
+    "key": "short_hop_distance",
+    "type": "number",
+    "unit": "mm",
+    "default": 3,
+    "min": 1,
+    "max": 10,
+    "constraint": {
+      "related": "towerWidth",
+      "max": "towerWidth / 2"
+    },
+    "title": "table.short_hop_distance.title",
+    "help": "table.short_hop_distance.description"
+  },
+  {
+    "id": "segmentHeight",
+    "key": "segment_height",
+    "type": "number",
+    "unit": "mm",
+    "default": 3,
+    "min": 0.5,
+    "max": 20,
+    "title": "table.segment_height.title",
+    "help": "table.segment_height.description"
+  },
+  {
+    "id": "kFactor",
+    "key": "k_factor",
+    "type": "number",
+    "default": 0,
+    "min": 0,
+    "max": 2,
+    "title": "table.k_factor.title",
+    "help": "table.k_factor.description"
+  },
+  {
+    "id": "towerSpacing",
+    "key": "tower_spacing",
+    "type": "number",
+    "unit": "mm",
+    "default": 100,
+    "min": 40,
+    "constraint": {
+      "related": "bedX",
+      "max": "bedX - towerWidth - 2 * raftMargin - 10"
+    },
+    "title": "table.tower_spacing.title",
+    "help": "table.tower_spacing.description"
+  },
+  {
+    "id": "towerWidth",
+    "key": "tower_width",
+    "type": "number",
+    "unit": "mm",
+    "default": 15,
+    "min": 5,
+    "max": 50,
+    "constraint": {
+      "related": "towerSpacing",
+      "max": "towerSpacing - 2 * raftMargin - 10"
+    },
+    "title": "table.tower_width.title",
+    "help": "table.tower_width.description"
+  },
+  {
+    "id": "wallSpacing",
+    "key": "wall_spacing",
+    "type": "integer",
+    "unit": "%",
+    "default": 90,
+    "min": 50,
+    "max": 200,
+    "constraint": {
+      "related": "towerWidth",
+      "max": "(towerWidth / lineWidth - 2.5) * 50, for triangles (towerWidth / (1 + √5) / lineWidth - 1.25) * 100"
+    },
+    "title": "table.wall_spacing.title",
+    "help": "table.wall_spacing.description"
+  },
+  {
+    "id": "raftMargin",
+    "key": "raft_margin",
+    "type": "number",
+    "unit": "mm",
+    "default": 7.5,
+    "min": 2,
+    "max": 20,
+    "constraint": {
+      "related": "bedY",
+      "max": "(bedY - towerWidth - 20) / 2"
+    },
+    "title": "table.raft_margin.title",
+    "help": "table.raft_margin.description"
+  },
+  {
+    "id": "towerShape",
+    "key": "tower_shape",
+    "type": "enum",
+    "default": "square",
+    "options": [
+      {
+        "value": "square",
+        "label": "Square"
+      },
+      {
+        "value": "round",
+        "label": "Round"
+      },
+      {
+        "value": "triangle",
+        "label": "Triangle"
+      }
+    ],
+    "title": "table.tower_shape.title",
+    "help": "table.tower_shape.description"
+  },
+  {
+    "id": "arcMoves",
+    "key": "arc_moves",
+    "type": "bool",
+    "default": false,
+    "title": "table.arc_moves.title",
+    "help": "table.arc_moves.description"
+  },
+  {
+    "id": "hardmode",
+    "key": "hardmode",
+    "type": "bool",
+    "default": false,
+    "title": "table.hardmode.title",
+    "help": "table.hardmode.description"
+  },
+  {
+    "id": "startGcode",
+    "key": "start_gcode",
+    "type": "text",
+    "default": "$LA ;set k-factor for Linear/Pressure Advance\nM190 S$BEDTEMP ;heat bed to the temperature from settings\nM109 S$HOTTEMP ;heat hotend to the temperature from settings\nG28 ;home all axes\n$G29 ;probe bed heightmap\nG90 ;absolute positioning\nG92 E0 ;reset extruder position\nM220 S100 ;speed multiplier 100%\nM221 S$FLOW ;flow multiplier from settings",
+    "title": "table.start_gcode.title",
+    "help": "table.start_gcode.description"
+  },
+  {
+    "id": "endGcode",
+    "key": "end_gcode",
+    "type": "text",
+    "default": "M104 S0 ;turn off hotend\nM140 S0 ;turn off bed\nM106 S0 ;turn off part cooling fan\nG91 ;relative positioning\nG1 E-5 F600 ;retract 5mm\nG1 Z1 F300 ;lift head by 1mm",
+    "title": "table.end_gcode.title",
+    "help": "table.end_gcode.description"
+  }
+];
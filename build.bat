@@ -1,4 +1,5 @@
 @echo off
+go run ./cmd/k3drct -schema assets/js/schema.js
 set GOOS=js
 set GOARCH=wasm
 
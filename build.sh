@@ -2,4 +2,5 @@
 
 rm assets/wasm/rct_lib.wasm
 mkdir -p assets/wasm/
+go run ./cmd/k3drct -schema assets/js/schema.js
 GOOS=js GOARCH=wasm go build -o assets\wasm\rct_lib.wasm main.go
\ No newline at end of file
@@ -0,0 +1,321 @@
+// Command k3drct generates K3D retraction calibration towers without a browser.
+//
+// Every parameter of the web form is available as a flag with the same name as
+// the form field. Parameters can also be read from a JSON or YAML file given
+// with -config or from a profile given with -profile; flags given on the command
+// line override values from the files.
+//
+//	k3drct -config ender3.yaml -hotendTemperature 235
+//
+// -save-profile writes the resulting parameters into a named profile
+// instead of generating G-code:
+//
+//	k3drct -profile voron.json -bedX 350 -name "Voron 350 ABS" -save-profile voron350.json
+//
+// With -serve the command runs an HTTP service instead, see newServer.
+//
+//	k3drct -serve localhost:8080
+//
+// -schema writes the description of the parameters, generator.Fields, as JSON
+// or as the script the web form is built from:
+//
+//	k3drct -schema assets/js/schema.js
+package main
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"k3d_rct/generator"
+)
+
+// options are the flags which are not calibration parameters.
+type options struct {
+	config      string
+	profile     string
+	saveProfile string
+	name        string
+	output      string
+	serve       string
+	schema      string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	// The config file has to be loaded before the flags are applied on top of it,
+	// so the arguments are parsed twice: once to find -config and once for real.
+	var opts options
+	scratch := generator.DefaultParams()
+	fs := newFlagSet(&scratch, &opts, io.Discard)
+	if err := fs.Parse(args); err != nil {
+		newFlagSet(&scratch, &opts, stderr).Parse(args)
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	if opts.serve != "" {
+		return serve(opts.serve, stderr)
+	}
+	if opts.schema != "" {
+		if err := saveSchema(opts.schema, stdout); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	p := generator.DefaultParams()
+	if opts.config != "" {
+		if err := loadParams(opts.config, &p); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+	}
+
+	var profileName string
+	if opts.profile != "" {
+		profile, err := loadProfile(opts.profile, p)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		p = profile.Params
+		profileName = profile.Name
+	}
+
+	fs = newFlagSet(&p, &opts, stderr)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if opts.name == "" {
+		opts.name = profileName
+	}
+
+	if opts.saveProfile != "" {
+		data, err := generator.MarshalProfile(generator.NewProfile(opts.name, p))
+		if err == nil {
+			err = os.WriteFile(opts.saveProfile, data, 0644)
+		}
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		fmt.Fprintln(stdout, "Saved profile", opts.saveProfile)
+		return 0
+	}
+
+	if errs := generator.Validate(p); len(errs) > 0 {
+		for _, e := range errs {
+			fmt.Fprintln(stderr, e)
+		}
+		return 1
+	}
+	for _, w := range generator.Warnings(p) {
+		fmt.Fprintln(stderr, "Warning:", w)
+	}
+
+	if opts.output == "-" {
+		if err := generator.GenerateTo(stdout, p, generator.Options{Version: generator.Version}); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		return 0
+	}
+
+	output := opts.output
+	if output == "" {
+		output = generator.FileName(p)
+	} else if info, err := os.Stat(output); err == nil && info.IsDir() {
+		output = filepath.Join(output, generator.FileName(p))
+	}
+	if err := writeGcode(output, p); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	fmt.Fprint(stdout, generator.SegmentTable(p, generator.DefaultSegmentFormat))
+	fmt.Fprintln(stdout, "Saved", output)
+	return 0
+}
+
+// writeGcode streams the G-code for p into the file.
+func writeGcode(path string, p generator.Params) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := generator.GenerateTo(f, p, generator.Options{Version: generator.Version}); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func newFlagSet(p *generator.Params, opts *options, output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("k3drct", flag.ContinueOnError)
+	fs.SetOutput(output)
+
+	fs.StringVar(&opts.config, "config", "", "read parameters from a JSON or YAML `file`")
+	fs.StringVar(&opts.profile, "profile", "", "read parameters from a profile `file` (applied after -config)")
+	fs.StringVar(&opts.saveProfile, "save-profile", "", "save parameters to a profile `file` instead of generating G-code")
+	fs.StringVar(&opts.name, "name", "", "profile name for -save-profile (default name of the -profile)")
+	fs.StringVar(&opts.serve, "serve", "", "run the HTTP service on `address` instead of writing a file")
+	fs.StringVar(&opts.schema, "schema", "", "write the parameter schema to a JSON or JS `file` (- for stdout) instead of generating G-code")
+	fs.StringVar(&opts.output, "o", "", "output `file` or directory, - for stdout (default K3D_RCT_H..-B.._...gcode)")
+
+	for _, f := range generator.Fields {
+		switch v := f.Pointer(p).(type) {
+		case *float64:
+			floatVar(fs, v, f.ID, usage(f))
+		case *int:
+			intVar(fs, v, f.ID, usage(f))
+		case *bool:
+			fs.BoolVar(v, f.ID, *v, usage(f))
+		case *string:
+			fs.StringVar(v, f.ID, *v, usage(f))
+		case textValue:
+			fs.TextVar(v, f.ID, v, usage(f))
+		}
+	}
+
+	return fs
+}
+
+// textValue is a parameter of an enum type, such as generator.Firmware.
+type textValue interface {
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+// usage describes a parameter flag by its English title, unit and limits.
+func usage(f generator.Field) string {
+	text := generator.Message(f.Title)
+	if f.Unit != "" {
+		text += " [" + f.Unit + "]"
+	}
+
+	// the tighter of a static and a computed limit applies
+	bound := func(static *float64, computed, tighter string) string {
+		switch {
+		case static != nil && computed != "":
+			return tighter + "(" + strconv.FormatFloat(*static, 'f', -1, 64) + ", " + computed + ")"
+		case computed != "":
+			return computed
+		case static != nil:
+			return strconv.FormatFloat(*static, 'f', -1, 64)
+		}
+		return ""
+	}
+	var min, max string
+	if c := f.Constraint; c != nil {
+		min, max = bound(f.Min, c.Min, "max"), bound(f.Max, c.Max, "min")
+	} else {
+		min, max = bound(f.Min, "", ""), bound(f.Max, "", "")
+	}
+	switch {
+	case min != "" && max != "":
+		text += ", " + min + ".." + max
+	case min != "":
+		text += ", at least " + min
+	case max != "":
+		text += ", at most " + max
+	}
+
+	if len(f.Options) > 0 {
+		values := make([]string, len(f.Options))
+		for i, o := range f.Options {
+			values[i] = o.Value
+		}
+		text += ": " + strings.Join(values, ", ")
+	}
+	return text
+}
+
+// number is a flag.Value which accepts both "." and "," as decimal separator,
+// like the web form, and reports errors with the web form messages.
+type number struct {
+	float *float64
+	int   *int
+	field string
+}
+
+func floatVar(fs *flag.FlagSet, p *float64, name, usage string) {
+	fs.Var(&number{float: p, field: name}, name, usage)
+}
+
+func intVar(fs *flag.FlagSet, p *int, name, usage string) {
+	fs.Var(&number{int: p, field: name}, name, usage)
+}
+
+func (n *number) String() string {
+	if n.float != nil {
+		return strconv.FormatFloat(*n.float, 'f', -1, 64)
+	} else if n.int != nil {
+		return strconv.Itoa(*n.int)
+	}
+	return ""
+}
+
+func (n *number) Set(val string) error {
+	f, err := strconv.ParseFloat(strings.ReplaceAll(val, ",", "."), 64)
+	if err != nil {
+		return generator.FormatError(n.field, val)
+	}
+	if n.float != nil {
+		*n.float = f
+	} else {
+		*n.int = int(math.Round(f))
+	}
+	return nil
+}
+
+// loadParams reads a JSON or YAML parameter file into p.
+// Parameters missing from the file keep their values.
+func loadParams(path string, p *generator.Params) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, p)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, p)
+	default:
+		return fmt.Errorf("%s: unknown config format, expected .json, .yaml or .yml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// loadProfile reads a profile file of any known version. Parameters missing
+// from the profile keep their values in base.
+func loadProfile(path string, base generator.Params) (generator.Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return generator.Profile{}, err
+	}
+	profile, err := generator.UnmarshalProfileOnto(data, base)
+	if err != nil {
+		return generator.Profile{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return profile, nil
+}
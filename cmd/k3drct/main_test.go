@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k3d_rct/generator"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	config := write("config.yaml", "bedX: 300\nbedY: 300\nhotendTemperature: 220\n")
+	profile := write("profile.json", `{"version": 1, "name": "PETG", "params": {"bedY": 250, "hotendTemperature": 230}}`)
+	saved := filepath.Join(dir, "saved.json")
+	outDir := filepath.Join(dir, "out")
+	if err := os.Mkdir(outDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	defaultName := generator.FileName(generator.DefaultParams())
+
+	for _, tc := range []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, stdout, stderr string)
+	}{
+		{"config, then profile, then flags",
+			[]string{"-config", config, "-profile", profile, "-hotendTemperature", "240", "-save-profile", saved}, 0,
+			func(t *testing.T, stdout, stderr string) {
+				data, err := os.ReadFile(saved)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := generator.UnmarshalProfile(data)
+				if err != nil {
+					t.Fatal(err)
+				}
+				p := got.Params
+				if got.Name != "PETG" || p.BedX != 300 || p.BedY != 250 || p.HotendTemperature != 240 {
+					t.Errorf("saved profile %q with bed %vx%v at %d °C, want PETG with 300x250 at 240 °C",
+						got.Name, p.BedX, p.BedY, p.HotendTemperature)
+				}
+			}},
+		{"bad flag", []string{"-bedX", "abc"}, 2, func(t *testing.T, stdout, stderr string) {
+			if !strings.Contains(stderr, "bedX") {
+				t.Errorf("stderr %q doesn't name the flag", stderr)
+			}
+		}},
+		{"unknown flag", []string{"-nozzle", "0.4"}, 2, nil},
+		{"invalid parameters", []string{"-bedX", "50", "-o", "-"}, 1, func(t *testing.T, stdout, stderr string) {
+			if stdout != "" || !strings.Contains(stderr, generator.Message("error.tower_spacing.too_big")) {
+				t.Errorf("stdout %q, stderr %q", stdout, stderr)
+			}
+		}},
+		{"stdout", []string{"-o", "-"}, 0, func(t *testing.T, stdout, stderr string) {
+			if !strings.HasPrefix(stdout, "; generated by K3D") || !strings.Contains(stdout, "G28") {
+				t.Errorf("stdout is not G-code: %.100q", stdout)
+			}
+		}},
+		{"directory", []string{"-o", outDir + string(filepath.Separator)}, 0, func(t *testing.T, stdout, stderr string) {
+			if _, err := os.Stat(filepath.Join(outDir, defaultName)); err != nil {
+				t.Error(err)
+			}
+		}},
+		{"default file name", nil, 0, func(t *testing.T, stdout, stderr string) {
+			if _, err := os.Stat(filepath.Join(dir, defaultName)); err != nil {
+				t.Error(err)
+			}
+			if !strings.Contains(stdout, "Saved "+defaultName) {
+				t.Errorf("stdout %q", stdout)
+			}
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// the default file name is relative to the working directory
+			wd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chdir(dir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(wd)
+
+			var stdout, stderr bytes.Buffer
+			if code := run(tc.args, &stdout, &stderr); code != tc.code {
+				t.Fatalf("exit code %d, want %d; stderr %q", code, tc.code, stderr.String())
+			}
+			if tc.check != nil {
+				tc.check(t, stdout.String(), stderr.String())
+			}
+		})
+	}
+}
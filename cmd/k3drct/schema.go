@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k3d_rct/generator"
+)
+
+//go:generate go run . -schema ../../assets/js/schema.js
+
+// schemaJSHeader starts the script with the schema for the web page.
+const schemaJSHeader = "// Code generated by \"k3drct -schema assets/js/schema.js\"; DO NOT EDIT.\n\n"
+
+// schema returns generator.Fields as indented JSON, or as a script
+// defining the schema variable if js is set.
+func schema(js bool) ([]byte, error) {
+	data, err := json.MarshalIndent(generator.Fields, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if !js {
+		return append(data, '\n'), nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(schemaJSHeader)
+	buf.WriteString("var schema = ")
+	buf.Write(data)
+	buf.WriteString(";\n")
+	return buf.Bytes(), nil
+}
+
+// saveSchema writes the schema to path, as a script if it ends with .js.
+// "-" writes JSON to stdout.
+func saveSchema(path string, stdout io.Writer) error {
+	data, err := schema(strings.ToLower(filepath.Ext(path)) == ".js")
+	if err != nil {
+		return err
+	}
+	if path == "-" {
+		_, err = stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestSchemaUpToDate checks that the schema the web form is built from
+// matches generator.Fields. Run "go generate ./cmd/k3drct" after changing them.
+func TestSchemaUpToDate(t *testing.T) {
+	want, err := schema(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../assets/js/schema.js")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("assets/js/schema.js is out of date, run go generate ./cmd/k3drct")
+	}
+}
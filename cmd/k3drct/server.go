@@ -0,0 +1,207 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"k3d_rct/generator"
+)
+
+// maxRequestSize limits the size of a parameter set accepted by the server.
+const maxRequestSize = 1 << 20
+
+// fieldError is a validation error together with its English text.
+type fieldError struct {
+	generator.ValidationError
+	Text string `json:"text"`
+}
+
+type validateResponse struct {
+	Errors []fieldError `json:"errors"`
+}
+
+type segmentsResponse struct {
+	Segments []generator.Segment `json:"segments"`
+	Table    string              `json:"table"`
+}
+
+type generateResponse struct {
+	FileName string              `json:"fileName"`
+	Segments []generator.Segment `json:"segments"`
+	Stats    generator.Stats     `json:"stats"`
+	Warnings []warning           `json:"warnings"`
+	GCode    string              `json:"gcode"`
+}
+
+// warning is a generator warning together with its English text.
+type warning struct {
+	generator.Warning
+	Text string `json:"text"`
+}
+
+// newServer returns the handler of the HTTP service. Every endpoint accepts
+// a JSON parameter set in a POST request; missing parameters take their
+// default values. Invalid parameters are answered with 422 and the list of errors.
+//
+// /generate?format=gcode streams the plain G-code as an attachment
+// instead of returning it inside a JSON object. GET /schema returns
+// the description of the parameters.
+func newServer() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/schema", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, generator.Fields)
+	})
+	mux.HandleFunc("/validate", handle(func(p generator.Params) (interface{}, error) {
+		return validateResponse{Errors: fieldErrors(generator.Validate(p))}, nil
+	}))
+	mux.HandleFunc("/segments", handle(func(p generator.Params) (interface{}, error) {
+		if errs := generator.Validate(p); len(errs) > 0 {
+			return nil, errs
+		}
+		return segmentsResponse{
+			Segments: generator.Segments(p),
+			Table:    generator.SegmentTable(p, generator.DefaultSegmentFormat),
+		}, nil
+	}))
+	mux.HandleFunc("/generate", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") == "gcode" {
+			streamGcode(w, r)
+			return
+		}
+		handle(func(p generator.Params) (interface{}, error) {
+			var gcode strings.Builder
+			stats, err := generator.GenerateWithStats(&gcode, p, generator.Options{Version: generator.Version})
+			if err != nil {
+				return nil, err
+			}
+			return generateResponse{
+				FileName: generator.FileName(p),
+				Segments: generator.Segments(p),
+				Stats:    stats,
+				Warnings: warnings(generator.Warnings(p)),
+				GCode:    gcode.String(),
+			}, nil
+		})(w, r)
+	})
+	return mux
+}
+
+func serve(addr string, stderr io.Writer) int {
+	fmt.Fprintln(stderr, "Listening on", addr)
+	if err := http.ListenAndServe(addr, newServer()); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// decodeParams reads the parameter set of the request. Values which can't
+// be parsed are answered with 422 and format errors like invalid parameters.
+// If it fails, the error is already written to w.
+func decodeParams(w http.ResponseWriter, r *http.Request) (generator.Params, bool) {
+	p := generator.DefaultParams()
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return p, false
+	}
+	var values map[string]json.RawMessage
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&values); err != nil && err != io.EOF {
+		http.Error(w, "invalid parameters: "+err.Error(), http.StatusBadRequest)
+		return p, false
+	}
+
+	var errs generator.ValidationErrors
+	for _, f := range generator.Fields {
+		v, ok := values[f.ID]
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal(v, f.Pointer(&p)); err != nil {
+			errs = append(errs, generator.FormatError(f.ID, rawInput(v)))
+		}
+	}
+	if len(errs) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, validateResponse{Errors: fieldErrors(errs)})
+		return p, false
+	}
+	return p, true
+}
+
+// rawInput returns the text of a JSON value as the user typed it.
+func rawInput(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	return string(v)
+}
+
+// handle decodes the parameter set of the request, calls f and writes its result as JSON.
+func handle(f func(p generator.Params) (interface{}, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		p, ok := decodeParams(w, r)
+		if !ok {
+			return
+		}
+
+		resp, err := f(p)
+		status := http.StatusOK
+		if err != nil {
+			var verr generator.ValidationErrors
+			if !errors.As(err, &verr) {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp, status = validateResponse{Errors: fieldErrors(verr)}, http.StatusUnprocessableEntity
+		}
+		writeJSON(w, status, resp)
+	}
+}
+
+// streamGcode writes the G-code directly into the response.
+func streamGcode(w http.ResponseWriter, r *http.Request) {
+	p, ok := decodeParams(w, r)
+	if !ok {
+		return
+	}
+	if errs := generator.Validate(p); len(errs) > 0 {
+		writeJSON(w, http.StatusUnprocessableEntity, validateResponse{Errors: fieldErrors(errs)})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/x-gcode")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", generator.FileName(p)))
+	if err := generator.GenerateTo(w, p, generator.Options{Version: generator.Version}); err != nil {
+		log.Println(err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Println(err)
+	}
+}
+
+func fieldErrors(errs generator.ValidationErrors) []fieldError {
+	ret := make([]fieldError, 0, len(errs))
+	for _, e := range errs {
+		ret = append(ret, fieldError{ValidationError: e, Text: e.Error()})
+	}
+	return ret
+}
+
+func warnings(ws []generator.Warning) []warning {
+	ret := make([]warning, 0, len(ws))
+	for _, w := range ws {
+		ret = append(ret, warning{Warning: w, Text: w.String()})
+	}
+	return ret
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"k3d_rct/generator"
+)
+
+// post sends params to the endpoint and returns the status and the body of the answer.
+func post(t *testing.T, srv *httptest.Server, endpoint, params string) (*http.Response, string) {
+	t.Helper()
+	resp, err := http.Post(srv.URL+endpoint, "application/json", strings.NewReader(params))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
+
+func TestServerMethod(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	for _, endpoint := range []string{"/validate", "/segments", "/generate", "/generate?format=gcode"} {
+		resp, err := http.Get(srv.URL + endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed || resp.Header.Get("Allow") != http.MethodPost {
+			t.Errorf("GET %s: status %d, Allow %q", endpoint, resp.StatusCode, resp.Header.Get("Allow"))
+		}
+	}
+}
+
+func TestServerValidate(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	for _, tc := range []struct {
+		params string
+		fields []string
+	}{
+		{`{}`, nil},
+		{`{"bedX": 300, "bedY": 300}`, nil},
+		{`{"bedX": 50}`, []string{"bedX", "towerSpacing"}},
+	} {
+		resp, body := post(t, srv, "/validate", tc.params)
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: status %d", tc.params, resp.StatusCode)
+			continue
+		}
+		var got validateResponse
+		if err := json.Unmarshal([]byte(body), &got); err != nil {
+			t.Fatal(err)
+		}
+		var fields []string
+		for _, e := range got.Errors {
+			fields = append(fields, e.Field)
+		}
+		if fmt.Sprint(fields) != fmt.Sprint(tc.fields) {
+			t.Errorf("%s: errors in %v, want %v", tc.params, fields, tc.fields)
+		}
+	}
+}
+
+func TestServerInvalid(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	for _, endpoint := range []string{"/segments", "/generate", "/generate?format=gcode"} {
+		resp, body := post(t, srv, endpoint, `{"bedX": 50}`)
+		if resp.StatusCode != http.StatusUnprocessableEntity || resp.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("%s: status %d, content type %q", endpoint, resp.StatusCode, resp.Header.Get("Content-Type"))
+			continue
+		}
+		var got validateResponse
+		if err := json.Unmarshal([]byte(body), &got); err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Errors) == 0 || got.Errors[0].Field != "bedX" || got.Errors[0].Text == "" {
+			t.Errorf("%s: errors %+v", endpoint, got.Errors)
+		}
+	}
+
+	resp, _ := post(t, srv, "/generate", `{"bedX": `)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("broken JSON: status %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestServerGenerate(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	p := generator.DefaultParams()
+	p.HotendTemperature = 215
+	want, err := generator.Generate(p, generator.Options{Version: generator.Version})
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := `{"hotendTemperature": 215}`
+
+	resp, body := post(t, srv, "/generate", params)
+	var got generateResponse
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatalf("status %d: %v", resp.StatusCode, err)
+	}
+	if got.GCode != want || got.FileName != generator.FileName(p) || len(got.Segments) != p.NumSegments {
+		t.Errorf("/generate returned %q with %d segments and different G-code", got.FileName, len(got.Segments))
+	}
+
+	resp, body = post(t, srv, "/generate?format=gcode", params)
+	if body != want {
+		t.Error("/generate?format=gcode returned different G-code")
+	}
+	if cd := resp.Header.Get("Content-Disposition"); !strings.Contains(cd, generator.FileName(p)) {
+		t.Errorf("Content-Disposition %q", cd)
+	}
+
+	resp, body = post(t, srv, "/segments", params)
+	var segments segmentsResponse
+	if err := json.Unmarshal([]byte(body), &segments); err != nil {
+		t.Fatalf("status %d: %v", resp.StatusCode, err)
+	}
+	if segments.Table != generator.SegmentTable(p, generator.DefaultSegmentFormat) {
+		t.Errorf("/segments table %q", segments.Table)
+	}
+}
+
+// TestServerParallel checks that concurrent requests with different
+// parameters don't share any state.
+func TestServerParallel(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, 2*n)
+	for i := 0; i < n; i++ {
+		p := generator.DefaultParams()
+		p.HotendTemperature = 200 + i
+		p.EndRetractLength = 1 + float64(i)/2
+		want, err := generator.Generate(p, generator.Options{Version: generator.Version})
+		if err != nil {
+			t.Fatal(err)
+		}
+		params := fmt.Sprintf(`{"hotendTemperature": %d, "endRetractLength": %v}`, p.HotendTemperature, p.EndRetractLength)
+
+		for _, endpoint := range []string{"/generate", "/generate?format=gcode"} {
+			wg.Add(1)
+			go func(endpoint string) {
+				defer wg.Done()
+				resp, err := http.Post(srv.URL+endpoint, "application/json", strings.NewReader(params))
+				if err != nil {
+					errs <- err
+					return
+				}
+				defer resp.Body.Close()
+				body, err := io.ReadAll(resp.Body)
+				if err != nil {
+					errs <- err
+					return
+				}
+				got := string(body)
+				if endpoint == "/generate" {
+					var r generateResponse
+					if err := json.Unmarshal(body, &r); err != nil {
+						errs <- err
+						return
+					}
+					got = r.GCode
+				}
+				if got != want {
+					errs <- fmt.Errorf("%s %s: G-code of other parameters", endpoint, params)
+				}
+			}(endpoint)
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestServerFormatError(t *testing.T) {
+	srv := httptest.NewServer(newServer())
+	defer srv.Close()
+
+	for _, endpoint := range []string{"/validate", "/segments", "/generate", "/generate?format=gcode"} {
+		resp, body := post(t, srv, endpoint, `{"firmware": "foo", "bedX": "abc", "bedY": 300}`)
+		if resp.StatusCode != http.StatusUnprocessableEntity {
+			t.Errorf("%s: status %d, want %d", endpoint, resp.StatusCode, http.StatusUnprocessableEntity)
+			continue
+		}
+		var got validateResponse
+		if err := json.Unmarshal([]byte(body), &got); err != nil {
+			t.Fatal(err)
+		}
+		want := []generator.ValidationError{generator.FormatError("bedX", "abc"), generator.FormatError("firmware", "foo")}
+		if len(got.Errors) != len(want) {
+			t.Fatalf("%s: errors %+v, want %+v", endpoint, got.Errors, want)
+		}
+		for i, e := range got.Errors {
+			if e.ValidationError != want[i] || e.Code != "format" || e.Text == "" {
+				t.Errorf("%s: error %+v, want %+v", endpoint, e, want[i])
+			}
+		}
+	}
+}
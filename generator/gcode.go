@@ -0,0 +1,241 @@
+package generator
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// bufferSize is the size of the output buffer. The G-code is passed to the
+// underlying writer in chunks of this size.
+const bufferSize = 64 * 1024
+
+// gcodeWriter turns moves into G-code text.
+type gcodeWriter struct {
+	p            Params
+	w            *bufio.Writer
+	currentSpeed float64
+}
+
+func newGcodeWriter(w io.Writer, p Params) *gcodeWriter {
+	return &gcodeWriter{p: p, w: bufio.NewWriterSize(w, bufferSize)}
+}
+
+// write adds G-code to the output. Write errors are kept
+// by the buffered writer and reported by flush.
+func (gw *gcodeWriter) write(gcode ...string) {
+	for _, s := range gcode {
+		gw.w.WriteString(s)
+	}
+}
+
+func (gw *gcodeWriter) flush() error {
+	return gw.w.Flush()
+}
+
+func (gw *gcodeWriter) writeHeader(opts Options) {
+	p := gw.p
+	cooling := p.fanSpeed()
+
+	gw.write("; generated by K3D Retraction calibration towers generator ", opts.Version, "\n",
+		"; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP\n",
+		fmt.Sprintf(";Bedsize: %s:%s [mm]\n", fmt.Sprint(roundFloat(p.BedX, 1)), fmt.Sprint(roundFloat(p.BedY, 1))),
+		fmt.Sprintf(";Firmware (0-Marlin, 1-Klipper, 2-RRF): %d\n", p.Firmware),
+		fmt.Sprintf(";Z-offset: %s [mm]\n", fmt.Sprint(roundFloat(p.ZOffset, 3))),
+		fmt.Sprintf(";Delta: %s\n", strconv.FormatBool(p.Delta)),
+		fmt.Sprintf(";G29: %s\n", strconv.FormatBool(p.BedProbe)),
+		fmt.Sprintf(";Temp: %d/%d [°C]\n", p.HotendTemperature, p.BedTemperature),
+		fmt.Sprintf(";Flow: %d\n", p.Flow),
+		fmt.Sprintf(";Fan: %s\n", fmt.Sprint(roundFloat(float64(cooling)/2.55, 1))),
+		fmt.Sprintf(";Line width: %s [mm]\n", fmt.Sprint(roundFloat(p.LineWidth, 2))),
+		fmt.Sprintf(";First layer line width: %s [mm]\n", fmt.Sprint(roundFloat(p.LineWidth, 2))),
+		fmt.Sprintf(";Layer height: %s [mm]\n", fmt.Sprint(roundFloat(p.LayerHeight, 2))),
+		fmt.Sprintf(";Print speed: %s [mm/s]\n", fmt.Sprint(roundFloat(p.PrintSpeed, 2))),
+		fmt.Sprintf(";First layer print speed: %s [mm/s]\n", fmt.Sprint(roundFloat(p.FirstLayerPrintSpeed, 2))),
+		fmt.Sprintf(";Travel speed: %s [mm/s]\n", fmt.Sprint(roundFloat(p.TravelSpeed, 2))),
+		fmt.Sprintf(";K-Factor: %s [s]\n", fmt.Sprint(roundFloat(p.KFactor, 2))),
+		fmt.Sprintf(";Segment height: %s [mm]\n", fmt.Sprint(roundFloat(p.SegmentHeight, 2))),
+		fmt.Sprintf(";Towers spacing: %s [mm]\n", fmt.Sprint(roundFloat(p.TowerSpacing, 2))),
+		fmt.Sprintf(";Hardmode: %s\n", strconv.FormatBool(p.Hardmode)))
+	if p.customFootprint() {
+		gw.write(fmt.Sprintf(";Tower size: %s [mm], wall spacing: %d%%, raft margin: %s [mm]\n",
+			fmt.Sprint(roundFloat(p.TowerWidth, 2)), p.WallSpacing, fmt.Sprint(roundFloat(p.RaftMargin, 2))))
+	}
+	if p.arcMoves() {
+		gw.write(fmt.Sprintf(";Tower shape: %s, G2 arcs\n", p.TowerShape))
+	} else if p.TowerShape != ShapeSquare {
+		gw.write(fmt.Sprintf(";Tower shape: %s\n", p.TowerShape))
+	}
+	if p.TemperatureSweep {
+		gw.write(fmt.Sprintf(";Temperature sweep: %d-%d [°C]\n", p.HotendTemperature, p.EndHotendTemperature))
+		if p.TemperatureStabilization == StabilizationPark {
+			gw.write(";Temperature stabilization: park\n")
+		} else {
+			gw.write(fmt.Sprintf(";Temperature stabilization: dwell %s [s]\n", fmt.Sprint(roundFloat(p.TemperatureDwell, 1))))
+		}
+	}
+	if p.zHop() {
+		only := ""
+		if p.ZHopOnlyCrossing {
+			only = ", only crossing"
+		}
+		gw.write(fmt.Sprintf(";Z-hop: %s-%s [mm], %s%s\n",
+			fmt.Sprint(roundFloat(p.InitZHop, 2)), fmt.Sprint(roundFloat(p.EndZHop, 2)), p.ZHopStyle, only))
+	}
+	if p.wipe() {
+		gw.write(fmt.Sprintf(";Wipe: %s-%s [mm], retract while wiping: %d%%\n",
+			fmt.Sprint(roundFloat(p.InitWipeDistance, 2)), fmt.Sprint(roundFloat(p.EndWipeDistance, 2)), p.WipeRetract))
+	}
+	if p.Matrix {
+		gw.write(fmt.Sprintf(";Matrix: %d pairs, retraction length from bottom to top, speed from front to back\n", p.MatrixPairs))
+	}
+	if p.FirmwareRetraction {
+		gw.write(";Firmware retraction: true\n")
+	}
+	if p.SeparateUnretractSpeed {
+		gw.write(fmt.Sprintf(";Unretract speed: %s-%s [mm/s]\n",
+			fmt.Sprint(roundFloat(p.InitUnretractSpeed, 2)), fmt.Sprint(roundFloat(p.EndUnretractSpeed, 2))))
+	}
+	if p.extraPrime() {
+		gw.write(fmt.Sprintf(";Extra prime: %s-%s [mm]\n",
+			fmt.Sprint(roundFloat(p.InitExtraPrime, 2)), fmt.Sprint(roundFloat(p.EndExtraPrime, 2))))
+	}
+	if p.PressureAdvanceSweep {
+		gw.write(fmt.Sprintf(";K-Factor sweep: %s-%s [s]\n",
+			fmt.Sprint(roundFloat(p.KFactor, 3)), fmt.Sprint(roundFloat(p.EndKFactor, 3))))
+	}
+	if p.TravelSpeedSweep {
+		gw.write(fmt.Sprintf(";Travel speed sweep: %s-%s [mm/s]\n",
+			fmt.Sprint(roundFloat(p.TravelSpeed, 2)), fmt.Sprint(roundFloat(p.EndTravelSpeed, 2))))
+	}
+	if p.travelAccel() {
+		gw.write(fmt.Sprintf(";Travel acceleration: %s-%s [mm/s²]\n",
+			fmt.Sprint(roundFloat(p.InitTravelAccel, 0)), fmt.Sprint(roundFloat(p.EndTravelAccel, 0))))
+	}
+	if p.coast() {
+		gw.write(fmt.Sprintf(";Coasting: %s-%s [%s]\n",
+			fmt.Sprint(roundFloat(p.InitCoast, 2)), fmt.Sprint(roundFloat(p.EndCoast, 2)), p.coastUnit()))
+	}
+	if p.retractDwell() {
+		gw.write(fmt.Sprintf(";Dwell after retraction: %s-%s [s]\n",
+			fmt.Sprint(roundFloat(p.InitRetractDwell, 2)), fmt.Sprint(roundFloat(p.EndRetractDwell, 2))))
+	}
+	if p.minTravel() {
+		gw.write(fmt.Sprintf(";Minimum travel for retraction: %s-%s [mm]\n",
+			fmt.Sprint(roundFloat(p.InitMinTravel, 2)), fmt.Sprint(roundFloat(p.EndMinTravel, 2))))
+	}
+	if p.ShortHops {
+		gw.write(fmt.Sprintf(";Short hops: %s [mm]\n", fmt.Sprint(roundFloat(p.ShortHopDistance, 2))))
+	}
+	gw.write(SegmentTable(p, opts.SegmentFormat))
+}
+
+func (gw *gcodeWriter) writeMove(m Move) {
+	switch m.Kind {
+	case MoveRaw:
+		gw.write(m.Text)
+	case MoveComment:
+		gw.write(";", m.Text, "\n")
+	case MoveFan:
+		gw.write(fmt.Sprintf("M106 S%d\n", int(m.Value)))
+	case MoveTemperature:
+		gw.writeTemperature(int(m.Value), m.Wait)
+	case MoveDwell:
+		gw.write(fmt.Sprintf("G4 P%d\n", int(math.Round(m.Value*1000))))
+	case MoveSetPosition:
+		gw.write(fmt.Sprintf("G92 Z%s\n", fmt.Sprint(roundFloat(m.To.Z, 2))))
+	case MoveZ:
+		gw.write(fmt.Sprintf("G1 Z%s F%s\n", fmt.Sprint(roundFloat(m.To.Z, 2)), fmt.Sprint(roundFloat(m.Feedrate*60, 0))))
+		gw.currentSpeed = m.Feedrate
+	case MoveRetract, MoveUnretract:
+		if gw.p.FirmwareRetraction {
+			// the firmware retracts with its own settings and doesn't change E or F
+			if m.Kind == MoveRetract {
+				gw.write("G10\n")
+			} else {
+				gw.write("G11\n")
+			}
+			return
+		}
+		gw.write(fmt.Sprintf("G1 E%s F%s\n", fmt.Sprint(roundFloat(m.E, 2)), fmt.Sprint(roundFloat(m.Feedrate*60, 0))))
+		gw.currentSpeed = m.Feedrate
+	case MoveTravel, MoveExtrude, MoveWipe, MoveCoast:
+		if m.Arc {
+			gw.writeArc(m)
+			return
+		}
+		gw.writeLinear(m)
+	}
+}
+
+// writeTemperature sets the hotend temperature in the dialect of the firmware.
+// Waiting for the temperature settles it in both directions.
+func (gw *gcodeWriter) writeTemperature(temperature int, wait bool) {
+	switch gw.p.Firmware {
+	case FirmwareKlipper:
+		gw.write(fmt.Sprintf("SET_HEATER_TEMPERATURE HEATER=extruder TARGET=%d\n", temperature))
+		if wait {
+			gw.write(fmt.Sprintf("TEMPERATURE_WAIT SENSOR=extruder MINIMUM=%d MAXIMUM=%d\n", temperature-2, temperature+2))
+		}
+	case FirmwareRRF:
+		gw.write(fmt.Sprintf("G10 P0 S%d\n", temperature))
+		if wait {
+			gw.write("M116 P0\n")
+		}
+	default:
+		if wait {
+			gw.write(fmt.Sprintf("M109 R%d\n", temperature))
+		} else {
+			gw.write(fmt.Sprintf("M104 S%d\n", temperature))
+		}
+	}
+}
+
+// writeLinear writes a G1 move with only the coordinates which change.
+func (gw *gcodeWriter) writeLinear(m Move) {
+	start, end := m.From, m.To
+	command := "G1"
+
+	// add X
+	if end.X != start.X {
+		command = command + fmt.Sprintf(" X%s", fmt.Sprint(roundFloat(end.X, 2)))
+	}
+
+	// add Y
+	if end.Y != start.Y {
+		command = command + fmt.Sprintf(" Y%s", fmt.Sprint(roundFloat(end.Y, 2)))
+	}
+
+	// add Z or E. Z move can't be with extrusion
+	if end.Z != start.Z {
+		command = command + fmt.Sprintf(" Z%s", fmt.Sprint(roundFloat(end.Z, 2)))
+	} else if m.Extrusion != 0 {
+		command = command + fmt.Sprintf(" E%s", fmt.Sprint(roundFloat(m.E, 4)))
+	}
+
+	// add F, the first layer always gets it explicitly
+	if (m.Kind == MoveExtrude && m.Layer == 1) || gw.currentSpeed != m.Feedrate {
+		command = command + fmt.Sprintf(" F%s", fmt.Sprint(roundFloat(m.Feedrate*60, 0)))
+		gw.currentSpeed = m.Feedrate
+	}
+
+	gw.write(command + "\n")
+}
+
+// writeArc writes a clockwise G2 arc with the center relative to the start.
+func (gw *gcodeWriter) writeArc(m Move) {
+	start, end := m.From, m.To
+	command := fmt.Sprintf("G2 X%s Y%s I%s J%s",
+		fmt.Sprint(roundFloat(end.X, 2)), fmt.Sprint(roundFloat(end.Y, 2)),
+		fmt.Sprint(roundFloat(m.Center.X-start.X, 3)), fmt.Sprint(roundFloat(m.Center.Y-start.Y, 3)))
+	if m.Extrusion != 0 {
+		command = command + fmt.Sprintf(" E%s", fmt.Sprint(roundFloat(m.E, 4)))
+	}
+	if gw.currentSpeed != m.Feedrate {
+		command = command + fmt.Sprintf(" F%s", fmt.Sprint(roundFloat(m.Feedrate*60, 0)))
+		gw.currentSpeed = m.Feedrate
+	}
+	gw.write(command + "\n")
+}
@@ -0,0 +1,940 @@
+// Package generator builds G-code for the K3D retraction calibration towers.
+// It has no dependency on the browser, so it can be used from the WASM page,
+// native tools and tests alike.
+package generator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const filamentDiameter = 1.75
+
+// zHopSpeed is the speed of vertical Z-hop moves in mm/s.
+const zHopSpeed = 10.0
+
+// Version is the calibrator version written by the native tools.
+// The web page passes its own version through Options.
+const Version = "v1.8"
+
+// DefaultSegmentFormat is the English format of a segment table line.
+// It takes the segment number, retraction length and retraction speed.
+const DefaultSegmentFormat = ";Segment %d:   %smm @ %smm/s\n"
+
+// Options control the parts of the output that do not affect the print.
+type Options struct {
+	Version       string // calibrator version written into the header
+	SegmentFormat string // format of segment table lines, DefaultSegmentFormat if empty
+}
+
+// generator holds the state of a single generation run. It turns the towers
+// into moves and passes them to emit one by one.
+type generator struct {
+	p    Params
+	emit func(Move)
+
+	layer, segment                        int
+	currentE                              float64
+	retractLength, retractSpeed           float64
+	towerWidth, firstLayerLineWidth       float64
+	retractLengthDelta, retractSpeedDelta float64
+	retractLengths, retractSpeeds         []float64 // nil for the linear sweep
+	zHop, wipeDistance, extraPrime        float64
+	unretractSpeed, travelSpeed           float64
+	coastDistance, retractDwell           float64
+	minTravel                             float64
+	nextPair                              int     // matrix pair the current travel goes to, -1 outside of travels to a pair
+	wipePath                              []Point // line printed since the last travel
+	currentCoordinates, bedCenter         Point
+	retracted                             bool
+}
+
+// Segment describes the retraction settings of one tower segment.
+type Segment struct {
+	Number        int     `json:"number"`
+	RetractLength float64 `json:"retractLength"`
+	RetractSpeed  float64 `json:"retractSpeed"`
+	// UnretractSpeed is the deretraction speed, the same as RetractSpeed
+	// unless Params.SeparateUnretractSpeed is set.
+	UnretractSpeed float64 `json:"unretractSpeed"`
+	Temperature    int     `json:"temperature"` // hotend temperature in °C
+	ZHop           float64 `json:"zHop"`        // lift of the travels in mm
+	WipeDistance   float64 `json:"wipeDistance"`
+	ExtraPrime     float64 `json:"extraPrime"`
+	KFactor        float64 `json:"kFactor"` // linear/pressure advance
+	TravelSpeed    float64 `json:"travelSpeed"`
+	// TravelAccel is the travel acceleration in mm/s², 0 if it isn't set.
+	TravelAccel float64 `json:"travelAccel"`
+	// Coast is the coasting in mm, or in mm³ with Params.CoastVolume.
+	Coast float64 `json:"coast"`
+	// RetractDwell is the pause after the retractions in seconds.
+	RetractDwell float64 `json:"retractDwell"`
+	// MinTravel is the shortest travel which is retracted.
+	MinTravel float64 `json:"minTravel"`
+}
+
+// Segments returns the settings of every segment, bottom segment first.
+// Values are rounded the same way as in the segment table. In a matrix
+// RetractSpeed is the speed of the front pair, see Params.Matrix.
+func Segments(p Params) []Segment {
+	segments := make([]Segment, p.NumSegments)
+	retractions := p.retractions()
+	for i := range segments {
+		speed := retractions.speed(i)
+		if p.Matrix {
+			speed = p.InitRetractSpeed
+		}
+		segments[i] = Segment{
+			Number:        i + 1,
+			RetractLength: roundFloat(retractions.length(i), 2),
+			RetractSpeed:  roundFloat(speed, 2),
+			Temperature:   p.segmentTemperature(i),
+			ZHop:          roundFloat(p.segmentZHop(i), 2),
+			WipeDistance:  roundFloat(p.segmentWipeDistance(i), 2),
+			ExtraPrime:    roundFloat(p.segmentExtraPrime(i), 2),
+			KFactor:       roundFloat(p.segmentKFactor(i), 3),
+			TravelSpeed:   roundFloat(p.segmentTravelSpeed(i), 2),
+			Coast:         roundFloat(p.segmentCoast(i), 2),
+			RetractDwell:  roundFloat(p.segmentRetractDwell(i), 2),
+			MinTravel:     roundFloat(p.segmentMinTravel(i), 2),
+		}
+		if p.travelAccel() {
+			segments[i].TravelAccel = roundFloat(p.segmentTravelAccel(i), 0)
+		}
+		segments[i].UnretractSpeed = segments[i].RetractSpeed
+		if p.SeparateUnretractSpeed {
+			segments[i].UnretractSpeed = roundFloat(p.segmentUnretractSpeed(i), 2)
+		}
+	}
+	return segments
+}
+
+// SegmentTable returns one line per segment, top segment first, with its
+// retraction length and speed and the values of every other active sweep,
+// followed by the speed of every pair of a matrix, front pair first.
+func SegmentTable(p Params, format string) string {
+	if format == "" {
+		format = DefaultSegmentFormat
+	}
+	segments := Segments(p)
+
+	caliParams := ""
+	for i := len(segments) - 1; i >= 0; i-- {
+		speed := fmt.Sprint(segments[i].RetractSpeed)
+		if p.Matrix {
+			speed = fmt.Sprint(roundFloat(p.InitRetractSpeed, 2)) + "-" + fmt.Sprint(roundFloat(p.EndRetractSpeed, 2))
+		}
+		line := fmt.Sprintf(format,
+			segments[i].Number,
+			fmt.Sprint(segments[i].RetractLength),
+			speed)
+
+		var sweeps []string
+		if p.TemperatureSweep {
+			sweeps = append(sweeps, fmt.Sprintf(" @ %d°C", segments[i].Temperature))
+		}
+		if p.SeparateUnretractSpeed {
+			sweeps = append(sweeps, fmt.Sprintf(" @ unretract %smm/s", fmt.Sprint(segments[i].UnretractSpeed)))
+		}
+		if p.zHop() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ Z-hop %smm", fmt.Sprint(segments[i].ZHop)))
+		}
+		if p.wipe() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ wipe %smm", fmt.Sprint(segments[i].WipeDistance)))
+		}
+		if p.extraPrime() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ extra prime %smm", fmt.Sprint(segments[i].ExtraPrime)))
+		}
+		if p.PressureAdvanceSweep {
+			sweeps = append(sweeps, fmt.Sprintf(" @ K%s", fmt.Sprint(segments[i].KFactor)))
+		}
+		if p.TravelSpeedSweep {
+			sweeps = append(sweeps, fmt.Sprintf(" @ travel %smm/s", fmt.Sprint(segments[i].TravelSpeed)))
+		}
+		if p.travelAccel() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ travel accel %smm/s²", fmt.Sprint(segments[i].TravelAccel)))
+		}
+		if p.coast() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ coast %s%s", fmt.Sprint(segments[i].Coast), p.coastUnit()))
+		}
+		if p.retractDwell() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ dwell %ss", fmt.Sprint(segments[i].RetractDwell)))
+		}
+		if p.minTravel() {
+			sweeps = append(sweeps, fmt.Sprintf(" @ min travel %smm", fmt.Sprint(segments[i].MinTravel)))
+		}
+		if len(sweeps) > 0 {
+			line = strings.TrimSuffix(line, "\n") + strings.Join(sweeps, "") + "\n"
+		}
+		caliParams = caliParams + line
+	}
+	if p.Matrix {
+		for k := 0; k < p.MatrixPairs; k++ {
+			caliParams += fmt.Sprintf(";Pair %d:   %smm/s\n", k+1, fmt.Sprint(roundFloat(p.pairRetractSpeed(k), 2)))
+		}
+	}
+	return caliParams
+}
+
+// FileName returns the suggested name of the G-code file for p.
+func FileName(p Params) string {
+	hotend := strconv.Itoa(p.HotendTemperature)
+	if p.TemperatureSweep {
+		hotend += "-" + strconv.Itoa(p.EndHotendTemperature)
+	}
+	var unretract string
+	if p.SeparateUnretractSpeed {
+		unretract = fmt.Sprintf("_U%s-%smms", fmt.Sprint(roundFloat(p.InitUnretractSpeed, 2)), fmt.Sprint(roundFloat(p.EndUnretractSpeed, 2)))
+	}
+	var zHop string
+	if p.zHop() {
+		zHop = fmt.Sprintf("_ZH%s-%smm", fmt.Sprint(roundFloat(p.InitZHop, 2)), fmt.Sprint(roundFloat(p.EndZHop, 2)))
+	}
+	var wipe string
+	if p.wipe() {
+		wipe = fmt.Sprintf("_W%s-%smm", fmt.Sprint(roundFloat(p.InitWipeDistance, 2)), fmt.Sprint(roundFloat(p.EndWipeDistance, 2)))
+	}
+	var prime string
+	if p.extraPrime() {
+		prime = fmt.Sprintf("_P%s-%smm", fmt.Sprint(roundFloat(p.InitExtraPrime, 2)), fmt.Sprint(roundFloat(p.EndExtraPrime, 2)))
+	}
+	var kFactor string
+	if p.PressureAdvanceSweep {
+		kFactor = fmt.Sprintf("_K%s-%s", fmt.Sprint(roundFloat(p.KFactor, 3)), fmt.Sprint(roundFloat(p.EndKFactor, 3)))
+	}
+	var travel string
+	if p.TravelSpeedSweep {
+		travel = fmt.Sprintf("_T%s-%smms", fmt.Sprint(roundFloat(p.TravelSpeed, 2)), fmt.Sprint(roundFloat(p.EndTravelSpeed, 2)))
+	}
+	if p.travelAccel() {
+		travel += fmt.Sprintf("_TA%s-%s", fmt.Sprint(roundFloat(p.InitTravelAccel, 0)), fmt.Sprint(roundFloat(p.EndTravelAccel, 0)))
+	}
+	var coast string
+	if p.coast() {
+		unit := "mm"
+		if p.CoastVolume {
+			unit = "mm3"
+		}
+		coast = fmt.Sprintf("_C%s-%s%s", fmt.Sprint(roundFloat(p.InitCoast, 2)), fmt.Sprint(roundFloat(p.EndCoast, 2)), unit)
+	}
+	var dwell string
+	if p.retractDwell() {
+		dwell = fmt.Sprintf("_D%s-%ss", fmt.Sprint(roundFloat(p.InitRetractDwell, 2)), fmt.Sprint(roundFloat(p.EndRetractDwell, 2)))
+	}
+	var minTravel string
+	if p.minTravel() {
+		minTravel = fmt.Sprintf("_MT%s-%smm", fmt.Sprint(roundFloat(p.InitMinTravel, 2)), fmt.Sprint(roundFloat(p.EndMinTravel, 2)))
+	}
+	if p.ShortHops {
+		minTravel += fmt.Sprintf("_SH%smm", fmt.Sprint(roundFloat(p.ShortHopDistance, 2)))
+	}
+	var shape string
+	if p.TowerShape != ShapeSquare {
+		shape = "_" + p.TowerShape.String()
+	}
+	if p.arcMoves() {
+		shape += "-arcs"
+	}
+	retractions := p.retractions()
+	return fmt.Sprintf("K3D_RCT_H%s-B%d_%s-%smm_%s-%smms%s%s%s%s%s%s%s%s%s%s.gcode",
+		hotend,
+		p.BedTemperature,
+		fmt.Sprint(roundFloat(retractions.length(0), 2)),
+		fmt.Sprint(roundFloat(retractions.length(p.NumSegments-1), 2)),
+		fmt.Sprint(roundFloat(retractions.speed(0), 0)),
+		fmt.Sprint(roundFloat(retractions.speed(p.NumSegments-1), 2)),
+		unretract, zHop, wipe, prime, kFactor, travel, coast, dwell, minTravel, shape)
+}
+
+// Generate validates p and returns the calibration G-code.
+// If p is invalid, the returned error is ValidationErrors.
+func Generate(p Params, opts Options) (string, error) {
+	var sb strings.Builder
+	if err := GenerateTo(&sb, p, opts); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// GenerateTo validates p and writes the calibration G-code to w in chunks,
+// so that tall towers don't have to be kept in memory. If p is invalid,
+// nothing is written and the returned error is ValidationErrors.
+func GenerateTo(w io.Writer, p Params, opts Options) error {
+	_, err := GenerateWithStats(w, p, opts)
+	return err
+}
+
+// GenerateWithStats works like GenerateTo and also returns
+// the statistics of the print, collected in the same run.
+func GenerateWithStats(w io.Writer, p Params, opts Options) (Stats, error) {
+	var stats Stats
+	if errs := Validate(p); len(errs) > 0 {
+		return stats, errs
+	}
+
+	gw := newGcodeWriter(w, p)
+	gw.writeHeader(opts)
+	newGenerator(p, func(m Move) {
+		stats.Add(m)
+		gw.writeMove(m)
+	}).generate()
+	return stats, gw.flush()
+}
+
+func newGenerator(p Params, emit func(Move)) *generator {
+	retractions := p.retractions()
+	return &generator{
+		p:                   p,
+		emit:                emit,
+		retractLength:       retractions.length(0),
+		retractSpeed:        retractions.speed(0),
+		firstLayerLineWidth: p.FirstLayerLineWidth,
+		retractLengthDelta:  p.retractLengthDelta(),
+		retractSpeedDelta:   p.retractSpeedDelta(),
+		retractLengths:      retractions.lengths,
+		retractSpeeds:       retractions.speeds,
+		zHop:                p.InitZHop,
+		wipeDistance:        p.InitWipeDistance,
+		extraPrime:          p.InitExtraPrime,
+		unretractSpeed:      p.InitUnretractSpeed,
+		travelSpeed:         p.TravelSpeed,
+		coastDistance:       p.segmentCoastDistance(0),
+		retractDwell:        p.InitRetractDwell,
+		minTravel:           p.InitMinTravel,
+		nextPair:            -1,
+	}
+}
+
+// add stamps the move with the current layer and segment and emits it.
+func (g *generator) add(m Move) {
+	m.Layer, m.Segment = g.layer, g.segment
+	g.emit(m)
+}
+
+func (g *generator) generate() {
+	p := g.p
+	cooling := p.fanSpeed()
+
+	var g29 string
+	if p.BedProbe {
+		g29 = "G29"
+	} else {
+		g29 = ""
+	}
+	replacer := strings.NewReplacer("$LA", generateLACommand(p.Firmware, p.KFactor), "$BEDTEMP", strconv.Itoa(p.BedTemperature), "$HOTTEMP", strconv.Itoa(p.HotendTemperature), "$G29", g29, "$FLOW", strconv.Itoa(p.Flow))
+	g.add(Move{Kind: MoveRaw, Text: replacer.Replace(p.StartGcode) + "\n"})
+
+	g.add(Move{Kind: MoveRaw, Text: "M82\n"})
+	if p.FirmwareRetraction {
+		g.add(Move{Kind: MoveRaw, Text: g.retractionSettings()})
+	}
+	// the K-factor of the bottom segment, also without $LA in the start G-code
+	if p.PressureAdvanceSweep {
+		g.add(Move{Kind: MoveRaw, Text: generateLACommand(p.Firmware, p.segmentKFactor(0)) + "\n"})
+	}
+	if p.travelAccel() {
+		g.add(Move{Kind: MoveRaw, Text: travelAccelCommand(p.segmentTravelAccel(0))})
+	}
+	g.add(Move{Kind: MoveFan, Value: float64(cooling / 3)})
+
+	// generate first layer
+	var bedCenter Point
+	if p.Delta {
+		bedCenter.X, bedCenter.Y, bedCenter.Z = 0, 0, p.LayerHeight
+	} else {
+		bedCenter.X, bedCenter.Y, bedCenter.Z = p.BedX/2, p.BedY/2, p.LayerHeight
+	}
+	g.bedCenter = bedCenter
+	pairs := towerPairs(p, bedCenter)
+	g.currentE = 0
+	g.currentCoordinates.X, g.currentCoordinates.Y, g.currentCoordinates.Z = 0, 0, 0
+
+	// purge nozzle in front of the first pair
+	var purgeStart Point
+	purgeStart.X, purgeStart.Y, purgeStart.Z = pairs[0].left.X-p.raftWidth()/2, pairs[0].left.Y-p.raftWidth()/2-10, p.LayerHeight
+	purgeTwo := purgeStart
+	purgeTwo.X = pairs[0].right.X + p.raftWidth()/2
+	purgeThree := purgeTwo
+	purgeThree.Y += g.firstLayerLineWidth
+	purgeEnd := purgeThree
+	purgeEnd.X = purgeStart.X
+
+	// move Z to first layer coordinates
+	firstLayer := g.currentCoordinates
+	firstLayer.Z = p.LayerHeight + p.ZOffset
+	g.add(Move{Kind: MoveZ, From: g.currentCoordinates, To: firstLayer, Feedrate: 450.0 / 60})
+
+	// make printer think, that he is on layerHeight
+	g.currentCoordinates.Z = p.LayerHeight
+	g.add(Move{Kind: MoveSetPosition, From: firstLayer, To: g.currentCoordinates})
+	g.layer, g.segment = 1, 1
+
+	// move to start of purge
+	g.generateMove(g.currentCoordinates, purgeStart, 0.0)
+
+	// add purge to gcode
+	g.generateMove(g.currentCoordinates, purgeTwo, g.firstLayerLineWidth)
+	g.generateMove(g.currentCoordinates, purgeThree, g.firstLayerLineWidth)
+	g.generateMove(g.currentCoordinates, purgeEnd, g.firstLayerLineWidth)
+
+	for k, pair := range pairs {
+		// generate raft trajectory for left tower
+		trajectory := g.generateZigZagTrajectory(pair.left, g.firstLayerLineWidth)
+
+		// move to start of left tower raft
+		g.travelToPair(trajectory[0], k)
+
+		// print left tower raft
+		for i := 1; i < len(trajectory); i++ {
+			g.generateMove(g.currentCoordinates, trajectory[i], g.firstLayerLineWidth)
+		}
+
+		// generate raft trajectory for right tower
+		for i := 0; i < len(trajectory); i++ {
+			trajectory[i].X = trajectory[i].X + p.TowerSpacing
+		}
+
+		// move to start of right tower raft
+		g.generateMove(g.currentCoordinates, trajectory[0], 0.0)
+
+		// print right tower raft
+		for i := 1; i < len(trajectory); i++ {
+			g.generateMove(g.currentCoordinates, trajectory[i], g.firstLayerLineWidth)
+		}
+	}
+
+	// the nozzle waits for the temperature of the next segment
+	// between the towers, in front of them
+	parkPoint := purgeStart
+	parkPoint.X = bedCenter.X
+
+	// generate towers
+	layersPerSegment := p.layersPerSegment()
+	for i := 1; i < p.NumSegments*layersPerSegment; i++ {
+		// set new layer coordinates
+		layerZ := g.currentCoordinates.Z + p.LayerHeight
+		for k := range pairs {
+			pairs[k].left.Z, pairs[k].right.Z = layerZ, layerZ
+		}
+		g.layer = int(roundFloat(layerZ/p.LayerHeight, 0))
+		g.segment = i/layersPerSegment + 1
+
+		// add layer start comment
+		g.add(Move{Kind: MoveComment, Text: fmt.Sprintf("layer #%d", g.layer)})
+
+		// change fan speed
+		if i == 1 {
+			g.add(Move{Kind: MoveFan, Value: float64(cooling * 2 / 3)})
+		} else if i == 2 {
+			g.add(Move{Kind: MoveFan, Value: float64(cooling)})
+		}
+
+		// modify print settings if switching segments
+		if i%layersPerSegment == 0 {
+			if g.retractLengths != nil {
+				g.retractLength = g.retractLengths[g.segment-1]
+			} else {
+				g.retractLength = g.retractLength - g.retractLengthDelta
+			}
+			if g.retractLength < minRetractLength {
+				g.retractLength = minRetractLength
+			}
+			// in a matrix the speed is the one of the pair
+			if !p.Matrix {
+				if g.retractSpeeds != nil {
+					g.retractSpeed = g.retractSpeeds[g.segment-1]
+				} else {
+					g.retractSpeed = g.retractSpeed - g.retractSpeedDelta
+				}
+				if g.retractSpeed < 5 {
+					g.retractSpeed = 5
+				}
+			}
+			g.towerWidth = p.TowerWidth + p.LineWidth/2
+			g.zHop = p.segmentZHop(g.segment - 1)
+			g.wipeDistance = p.segmentWipeDistance(g.segment - 1)
+			g.extraPrime = p.segmentExtraPrime(g.segment - 1)
+			g.unretractSpeed = p.segmentUnretractSpeed(g.segment - 1)
+			g.travelSpeed = p.segmentTravelSpeed(g.segment - 1)
+			g.coastDistance = p.segmentCoastDistance(g.segment - 1)
+			g.retractDwell = p.segmentRetractDwell(g.segment - 1)
+			g.minTravel = p.segmentMinTravel(g.segment - 1)
+			if p.FirmwareRetraction {
+				g.add(Move{Kind: MoveRaw, Text: g.retractionSettings()})
+			}
+			if p.PressureAdvanceSweep {
+				g.add(Move{Kind: MoveRaw, Text: generateLACommand(p.Firmware, p.segmentKFactor(g.segment-1)) + "\n"})
+			}
+			if p.travelAccel() {
+				g.add(Move{Kind: MoveRaw, Text: travelAccelCommand(p.segmentTravelAccel(g.segment - 1))})
+			}
+			if p.TemperatureSweep {
+				g.changeTemperature(p.segmentTemperature(g.segment-1), parkPoint)
+			}
+		} else {
+			g.towerWidth = p.TowerWidth
+		}
+
+		// the pairs of a matrix are printed back and forth
+		for n := range pairs {
+			k := n
+			if i%2 == 1 {
+				k = len(pairs) - 1 - n
+			}
+
+			// interchange tower centers on odd layers
+			firstTowerCenter := pairs[k].right
+			secondTowerCenter := pairs[k].left
+			if !p.Hardmode {
+				if i%2 == 0 {
+					firstTowerCenter = pairs[k].left
+					secondTowerCenter = pairs[k].right
+				}
+			}
+
+			// generate first tower trajectory, rotated on the right tower
+			first := g.towerLoops(firstTowerCenter, firstTowerCenter == pairs[k].right)
+			towerStart := first[0].points[0]
+
+			if n == 0 {
+				// move to start of first tower on the previous layer
+				start := towerStart
+				start.Z = g.currentCoordinates.Z
+				g.travelToPair(start, k)
+
+				// move to new layer
+				g.add(Move{Kind: MoveZ, From: start, To: towerStart, Feedrate: 300.0 / 60})
+				g.currentCoordinates = towerStart
+			} else {
+				// move to start of first tower of the next pair
+				g.travelToPair(towerStart, k)
+			}
+
+			// print first tower
+			g.printTower(first)
+
+			// generate second tower trajectory, rotated on the right tower
+			second := g.towerLoops(secondTowerCenter, secondTowerCenter == pairs[k].right)
+
+			// move to start of second tower
+			g.generateMove(g.currentCoordinates, second[0].points[0], 0.0)
+
+			// print second tower
+			g.printTower(second)
+		}
+	}
+
+	// end gcode
+	g.layer, g.segment = 0, 0
+	g.add(Move{Kind: MoveComment, Text: "end gcode"})
+	g.add(Move{Kind: MoveRaw, Text: replacer.Replace(p.EndGcode)})
+}
+
+// towerPair is the left and the right tower printed with the same settings.
+type towerPair struct {
+	left, right Point
+}
+
+// towerPairs returns the centers of the towers, front pair first. Only a matrix
+// has more than one pair, with the pairs next to each other along Y, one
+// footprint apart.
+func towerPairs(p Params, bedCenter Point) []towerPair {
+	n := 1
+	if p.Matrix {
+		n = p.MatrixPairs
+	}
+	pairs := make([]towerPair, n)
+	for k := range pairs {
+		center := bedCenter
+		center.Y += (float64(k) - float64(n-1)/2) * p.footprint()
+		pairs[k].left, pairs[k].right = center, center
+		pairs[k].left.X -= p.TowerSpacing / 2
+		pairs[k].right.X += p.TowerSpacing / 2
+	}
+	return pairs
+}
+
+// travelToPair travels to end on the k-th pair of a matrix. The retraction
+// before the travel is made with the speed of the previous pair, the
+// deretraction after it and every retraction on the pair with its own.
+func (g *generator) travelToPair(end Point, k int) {
+	g.nextPair = k
+	g.generateMove(g.currentCoordinates, end, 0.0)
+	// the travel may have been too short for a retraction
+	g.enterPair(k)
+	g.nextPair = -1
+}
+
+// enterPair switches to the retraction speed of the k-th pair of a matrix.
+func (g *generator) enterPair(k int) {
+	if !g.p.Matrix {
+		return
+	}
+	speed := g.p.pairRetractSpeed(k)
+	if speed == g.retractSpeed {
+		return
+	}
+	g.retractSpeed = speed
+	if g.p.FirmwareRetraction {
+		g.add(Move{Kind: MoveRaw, Text: g.retractionSettings()})
+	}
+}
+
+// changeTemperature sets the hotend temperature of the next segment
+// and lets it settle the way p.TemperatureStabilization says.
+func (g *generator) changeTemperature(temperature int, parkPoint Point) {
+	if g.p.TemperatureStabilization == StabilizationPark {
+		// park retracted, the following travel back to the towers deretracts
+		parkPoint.Z = g.currentCoordinates.Z
+		g.generateRetraction()
+		g.add(Move{Kind: MoveTravel, From: g.currentCoordinates, To: parkPoint, E: g.currentE, Feedrate: g.travelSpeed})
+		g.currentCoordinates = parkPoint
+		g.add(Move{Kind: MoveTemperature, From: parkPoint, To: parkPoint, Value: float64(temperature), Wait: true})
+		return
+	}
+	g.add(Move{Kind: MoveTemperature, From: g.currentCoordinates, To: g.currentCoordinates, Value: float64(temperature)})
+	if g.p.TemperatureDwell > 0 {
+		// wait retracted so that the ooze doesn't leave a blob on the tower,
+		// the following travel deretracts
+		g.generateRetraction()
+		g.add(Move{Kind: MoveDwell, From: g.currentCoordinates, To: g.currentCoordinates, Value: g.p.TemperatureDwell})
+	}
+}
+
+func generateLACommand(firmware Firmware, kFactor float64) string {
+	if firmware == FirmwareMarlin {
+		return fmt.Sprintf("M900 K%s", fmt.Sprint(roundFloat(kFactor, 3)))
+	} else if firmware == FirmwareKlipper {
+		return fmt.Sprintf("SET_PRESSURE_ADVANCE ADVANCE=%s", fmt.Sprint(roundFloat(kFactor, 3)))
+	} else if firmware == FirmwareRRF {
+		return fmt.Sprintf("M572 D0 S%s", fmt.Sprint(roundFloat(kFactor, 3)))
+	}
+
+	return ";no firmware information"
+}
+
+// travelAccelCommand sets the travel acceleration, the same way on Marlin and RRF.
+func travelAccelCommand(accel float64) string {
+	return fmt.Sprintf("M204 T%s\n", fmt.Sprint(roundFloat(accel, 0)))
+}
+
+// retractionSettings returns the command which sets the firmware retraction
+// to the one of the current segment.
+func (g *generator) retractionSettings() string {
+	length := fmt.Sprint(roundFloat(g.retractLength, 2))
+	extra := fmt.Sprint(roundFloat(g.firmwareExtraPrime(), 2))
+	switch g.p.Firmware {
+	case FirmwareKlipper:
+		return fmt.Sprintf("SET_RETRACTION RETRACT_LENGTH=%s RETRACT_SPEED=%s UNRETRACT_EXTRA_LENGTH=%s UNRETRACT_SPEED=%s\n",
+			length, fmt.Sprint(roundFloat(g.retractSpeed, 2)), extra, fmt.Sprint(roundFloat(g.deretractionSpeed(), 2)))
+	case FirmwareRRF:
+		return fmt.Sprintf("M207 S%s R%s F%s T%s\n",
+			length, extra, fmt.Sprint(roundFloat(g.retractSpeed*60, 0)), fmt.Sprint(roundFloat(g.deretractionSpeed()*60, 0)))
+	default:
+		return fmt.Sprintf("M207 S%s F%s\nM208 S%s F%s\n",
+			length, fmt.Sprint(roundFloat(g.retractSpeed*60, 0)), extra, fmt.Sprint(roundFloat(g.deretractionSpeed()*60, 0)))
+	}
+}
+
+// firmwareExtraPrime is the extra prime length set in the firmware.
+// Klipper doesn't accept negative ones.
+func (g *generator) firmwareExtraPrime() float64 {
+	if g.p.Firmware == FirmwareKlipper && g.extraPrime < 0 {
+		return 0
+	}
+	return g.extraPrime
+}
+
+// deretractionSpeed is the speed of the deretractions of the current segment.
+func (g *generator) deretractionSpeed() float64 {
+	if g.p.SeparateUnretractSpeed {
+		return g.unretractSpeed
+	}
+	return g.retractSpeed
+}
+
+// generateMove adds a move from start to end. Moves with zero width are travels,
+// they are wrapped into retraction and deretraction unless only Z changes.
+func (g *generator) generateMove(start, end Point, width float64) {
+	p := g.p
+	extrude := width > 0
+	isMoveOnlyZ := start.X == end.X && start.Y == end.Y
+
+	// if it's travel move, do retraction unless the nozzle is parked retracted
+	// or the travel is too short for it
+	retract := !extrude && !isMoveOnlyZ && !g.shortTravel(start, end)
+	if retract && !g.retracted {
+		g.generateRetraction()
+		// the travel starts where the wipe ended
+		start = g.currentCoordinates
+		// the nozzle oozes while it waits retracted on the towers
+		if g.retractDwell > 0 && g.layer >= 2 {
+			g.add(Move{Kind: MoveDwell, From: start, To: start, Value: g.retractDwell})
+		}
+	}
+
+	if extrude {
+		m := Move{Kind: MoveExtrude, From: start, To: end, Width: width, Feedrate: p.PrintSpeed}
+		if g.layer == 1 {
+			m.Feedrate = p.FirstLayerPrintSpeed
+		}
+		// Z move can't be with extrusion, too short lines are not extruded at all
+		lineLength := math.Sqrt(float64(math.Pow((end.X-start.X), 2) + math.Pow((end.Y-start.Y), 2)))
+		if end.Z == start.Z && lineLength > 0.8 {
+			m.Extrusion = g.calcExtrusion(lineLength, width)
+			g.currentE = g.currentE + m.Extrusion
+		}
+		m.E = g.currentE
+		g.add(m)
+		if len(g.wipePath) == 0 {
+			g.wipePath = append(g.wipePath, start)
+		}
+		g.wipePath = append(g.wipePath, end)
+	} else if hop := g.travelZHop(start, end); hop > 0 && retract {
+		g.generateZHopTravel(start, end, hop)
+	} else {
+		g.add(Move{Kind: MoveTravel, From: start, To: end, E: g.currentE, Feedrate: g.travelSpeed})
+	}
+	g.currentCoordinates = end
+
+	// if there was retraction, than do deretraction
+	if !extrude && !isMoveOnlyZ {
+		g.wipePath = g.wipePath[:0]
+		if retract {
+			if g.nextPair >= 0 {
+				g.enterPair(g.nextPair)
+			}
+			g.generateDeretraction()
+		}
+	}
+}
+
+// shortTravel reports whether a travel of the towers is shorter than the
+// minimum travel for retraction, so that it is made without retraction.
+func (g *generator) shortTravel(start, end Point) bool {
+	return g.layer >= 2 && !g.retracted && distance(start, end) < g.minTravel
+}
+
+// towerLoops returns the inner and the outer perimeter of the tower at center,
+// g.towerWidth wide. The seam of the right tower is moved to the next corner.
+func (g *generator) towerLoops(center Point, right bool) []loop {
+	lineWidth := g.p.LineWidth
+	spacing := float64(g.p.WallSpacing) / 100
+	loops := []loop{
+		towerLoop(g.p.TowerShape, center, g.towerWidth, g.towerWidth-(0.5+2*spacing)*lineWidth, g.p.arcMoves()),
+		towerLoop(g.p.TowerShape, center, g.towerWidth, g.towerWidth-0.5*lineWidth, g.p.arcMoves()),
+	}
+	if right {
+		for i, l := range loops {
+			loops[i] = l.rotate(l.seamStep())
+		}
+	}
+	return loops
+}
+
+// printTower prints the perimeters of a tower from the nozzle at the start of
+// the inner one. With p.ShortHops the nozzle travels from the inner perimeter
+// to the outer one, which starts p.ShortHopDistance mm later, instead of
+// printing the connection. The last g.coastDistance mm are coasted: the nozzle
+// follows the line without extruding, so the pressure left in the nozzle
+// prints its end.
+func (g *generator) printTower(loops []loop) {
+	inner, outer := loops[0], loops[1]
+	for i := 1; i < len(inner.points); i++ {
+		g.printSide(inner, i, g.p.LineWidth)
+	}
+	if g.p.ShortHops {
+		outer = outer.shift(g.p.ShortHopDistance)
+		g.generateMove(g.currentCoordinates, outer.points[0], 0.0)
+	} else {
+		g.generateMove(g.currentCoordinates, outer.points[0], g.p.LineWidth)
+	}
+
+	outer, coastFrom := outer.splitEnd(g.coastDistance)
+	for i := 1; i < len(outer.points); i++ {
+		if i <= coastFrom {
+			g.printSide(outer, i, g.p.LineWidth)
+		} else {
+			g.printSide(outer, i, 0)
+		}
+	}
+}
+
+// printSide prints the side of l ending at its i-th point as a line or an arc
+// width wide, or coasts along it for zero width. Unlike other lines, sides
+// shorter than 0.8 mm are extruded too: the polygons of small round towers
+// have no longer ones.
+func (g *generator) printSide(l loop, i int, width float64) {
+	start, end := g.currentCoordinates, l.points[i]
+	length := l.length(i)
+	m := Move{Kind: MoveCoast, From: start, To: end, E: g.currentE, Feedrate: g.p.PrintSpeed}
+	// a tiny arc would be rounded to a full circle
+	arc := l.arcs && length > 0.1
+	if arc {
+		m.Arc, m.Center = true, l.center
+	}
+	if width > 0 {
+		m.Kind, m.Width = MoveExtrude, width
+		m.Extrusion = g.calcExtrusion(length, width)
+		g.currentE = g.currentE + m.Extrusion
+		m.E = g.currentE
+	}
+	g.add(m)
+	if len(g.wipePath) == 0 {
+		g.wipePath = append(g.wipePath, start)
+	}
+	// the wipe follows arcs in steps of 1 mm, without a step next to end
+	for d := 1.0; arc && d < length-0.5; d++ {
+		g.wipePath = append(g.wipePath, l.at(i, d))
+	}
+	g.wipePath = append(g.wipePath, end)
+	g.currentCoordinates = end
+}
+
+// travelZHop returns the lift of a travel from start to end. Only the travels
+// of the towers are lifted, with ZHopOnlyCrossing only those which cross from
+// one tower to the other.
+func (g *generator) travelZHop(start, end Point) float64 {
+	if g.layer < 2 || start.X == end.X && start.Y == end.Y {
+		return 0
+	}
+	if g.p.ZHopOnlyCrossing && (start.X-g.bedCenter.X)*(end.X-g.bedCenter.X) >= 0 {
+		return 0
+	}
+	return g.zHop
+}
+
+// generateZHopTravel travels from start to end lifted by hop. The nozzle is
+// lowered vertically at end, the lift is vertical or ramped along the first
+// half of the travel depending on p.ZHopStyle.
+func (g *generator) generateZHopTravel(start, end Point, hop float64) {
+	p := g.p
+	lifted := start
+	lifted.Z += hop
+	if p.ZHopStyle == ZHopRamped {
+		lifted.X, lifted.Y = (start.X+end.X)/2, (start.Y+end.Y)/2
+		g.add(Move{Kind: MoveTravel, From: start, To: lifted, E: g.currentE, Feedrate: g.travelSpeed})
+	} else {
+		g.add(Move{Kind: MoveZ, From: start, To: lifted, Feedrate: zHopSpeed})
+	}
+	above := end
+	above.Z = lifted.Z
+	g.add(Move{Kind: MoveTravel, From: lifted, To: above, E: g.currentE, Feedrate: g.travelSpeed})
+	g.add(Move{Kind: MoveZ, From: above, To: end, Feedrate: zHopSpeed})
+}
+
+// calcExtrusion returns the filament length for a line or an arc of the given length.
+func (g *generator) calcExtrusion(lineLength, width float64) float64 {
+	extrusion := width * g.p.LayerHeight * lineLength * 4 / math.Pi / math.Pow(filamentDiameter, 2)
+	return extrusion
+}
+
+func (g *generator) generateRetraction() {
+	if g.retracted {
+		panic("generator: retraction while retracted")
+	}
+	g.retracted = true
+	wipes := g.wipeTrajectory()
+	if len(wipes) == 0 {
+		g.add(Move{
+			Kind:      MoveRetract,
+			From:      g.currentCoordinates,
+			To:        g.currentCoordinates,
+			Extrusion: -g.retractLength,
+			E:         g.currentE - g.retractLength,
+			Feedrate:  g.retractSpeed,
+		})
+		return
+	}
+
+	// retract the part before the wipe in place,
+	// the rest proportionally to the wiped distance
+	wipeRetract := g.retractLength * float64(g.p.WipeRetract) / 100
+	e := g.currentE - (g.retractLength - wipeRetract)
+	if wipeRetract < g.retractLength {
+		g.add(Move{
+			Kind:      MoveRetract,
+			From:      g.currentCoordinates,
+			To:        g.currentCoordinates,
+			Extrusion: wipeRetract - g.retractLength,
+			E:         e,
+			Feedrate:  g.retractSpeed,
+		})
+	}
+	wiped := 0.0
+	for i := 1; i < len(wipes); i++ {
+		wiped += distance(wipes[i-1], wipes[i])
+	}
+	for i := 1; i < len(wipes); i++ {
+		extrusion := -wipeRetract * distance(wipes[i-1], wipes[i]) / wiped
+		if i == len(wipes)-1 {
+			// no rounding leftovers, the deretraction restores exactly the retraction
+			extrusion = g.currentE - g.retractLength - e
+		}
+		e += extrusion
+		g.add(Move{Kind: MoveWipe, From: wipes[i-1], To: wipes[i], Extrusion: extrusion, E: e, Feedrate: g.travelSpeed})
+	}
+	g.currentCoordinates = wipes[len(wipes)-1]
+}
+
+// wipeTrajectory returns the points of the wipe from the current position back
+// along the printed line, or nil if the retraction doesn't wipe. Wipes are made
+// on the towers only and are shorter than the wipe distance if the line is.
+// G10 can't be combined with moves, so firmware retractions don't wipe.
+func (g *generator) wipeTrajectory() []Point {
+	if g.wipeDistance <= 0 || g.layer < 2 || len(g.wipePath) < 2 || g.p.FirmwareRetraction {
+		return nil
+	}
+	left := g.wipeDistance
+	trajectory := []Point{g.wipePath[len(g.wipePath)-1]}
+	// a rest below the resolution of the G-code would repeat the last point
+	for i := len(g.wipePath) - 1; i > 0 && left >= 0.01; i-- {
+		from, to := g.wipePath[i], g.wipePath[i-1]
+		d := distance(from, to)
+		if d == 0 {
+			continue
+		}
+		if d > left {
+			to.X = from.X + (to.X-from.X)*left/d
+			to.Y = from.Y + (to.Y-from.Y)*left/d
+			d = left
+		}
+		trajectory = append(trajectory, to)
+		left -= d
+	}
+	if len(trajectory) < 2 {
+		return nil
+	}
+	return trajectory
+}
+
+func (g *generator) generateDeretraction() {
+	if !g.retracted {
+		panic("generator: deretraction while not retracted")
+	}
+	g.retracted = false
+	// the extra prime is extruded filament, so it moves currentE on,
+	// unless the firmware primes it without changing E
+	extra := g.extraPrime
+	if g.p.FirmwareRetraction {
+		extra = g.firmwareExtraPrime()
+	} else {
+		g.currentE += extra
+	}
+	g.add(Move{
+		Kind:      MoveUnretract,
+		From:      g.currentCoordinates,
+		To:        g.currentCoordinates,
+		Extrusion: g.retractLength + extra,
+		E:         g.currentE,
+		Feedrate:  g.deretractionSpeed(),
+	})
+}
+
+func roundFloat(val float64, precision uint) float64 {
+	ratio := math.Pow(10, float64(precision))
+	return math.Round(val*ratio) / ratio
+}
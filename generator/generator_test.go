@@ -0,0 +1,166 @@
+package generator
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// tallFineParams is the most demanding tower the form allows to generate
+// in reasonable time: 100 segments printed with 0.05 mm layers.
+func tallFineParams() Params {
+	p := DefaultParams()
+	p.NumSegments = 100
+	p.LayerHeight = 0.05
+	p.SegmentHeight = 3
+	return p
+}
+
+// chunkWriter records the size of the largest write.
+type chunkWriter struct {
+	bytes.Buffer
+	maxChunk int
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	if len(p) > w.maxChunk {
+		w.maxChunk = len(p)
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestGenerateToWritesInChunks(t *testing.T) {
+	p := tallFineParams()
+
+	var w chunkWriter
+	if err := GenerateTo(&w, p, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if w.maxChunk > bufferSize {
+		t.Errorf("largest write is %d bytes, want at most %d", w.maxChunk, bufferSize)
+	}
+
+	gcode, err := Generate(p, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gcode != w.String() {
+		t.Error("Generate and GenerateTo returned different G-code")
+	}
+}
+
+func TestGenerateToInvalidParams(t *testing.T) {
+	p := DefaultParams()
+	p.BedX = 0
+
+	var w bytes.Buffer
+	err := GenerateTo(&w, p, Options{})
+	if _, ok := err.(ValidationErrors); !ok {
+		t.Fatalf("got error %v, want ValidationErrors", err)
+	}
+	if w.Len() > 0 {
+		t.Errorf("%d bytes written for invalid parameters", w.Len())
+	}
+}
+
+func TestValidateValueLists(t *testing.T) {
+	for _, tc := range []struct {
+		values  string
+		message string
+	}{
+		{"", ""},
+		{"0.2:0.2:2", ""},
+		{"2:-0.2:0.2", ""},
+		{"0.5, 1; 1.5 2:1:8", ""},
+		{"0.2:0.2:1", "error.retract_length_values.count"},
+		{"0.2:-0.2:2", "error.retract_length_values.format"},
+		{"0.2:0.2", "error.retract_length_values.format"},
+		{"1 2 x", "error.retract_length_values.format"},
+		{"0.2:0.2:1.8, 25", "error.retract_length_values.small_or_big"},
+	} {
+		p := DefaultParams()
+		p.RetractLengthValues = tc.values
+		message := ""
+		if errs := Validate(p); len(errs) > 0 {
+			message = errs[0].Message
+		}
+		if message != tc.message {
+			t.Errorf("%q: got %q, want %q", tc.values, message, tc.message)
+		}
+	}
+}
+
+func TestRetractionsKeepPairs(t *testing.T) {
+	for _, tc := range []struct {
+		order           Order
+		lengths, speeds []float64
+	}{
+		{OrderAsGiven, []float64{0.2, 2, 1, 1}, []float64{20, 25, 50, 35}},
+		{OrderAscending, []float64{0.2, 1, 1, 2}, []float64{20, 35, 50, 25}},
+		{OrderDescending, []float64{2, 1, 1, 0.2}, []float64{25, 50, 35, 20}},
+	} {
+		p := DefaultParams()
+		p.NumSegments, p.Order = 4, tc.order
+		p.RetractLengthValues, p.RetractSpeedValues = "0.2 2 1 1", "20 25 50 35"
+		r := p.retractions()
+		for i := range tc.lengths {
+			if r.length(i) != tc.lengths[i] || r.speed(i) != tc.speeds[i] {
+				t.Errorf("%s: segment %d has %v mm @ %v mm/s, want %v mm @ %v mm/s",
+					tc.order, i+1, r.length(i), r.speed(i), tc.lengths[i], tc.speeds[i])
+			}
+		}
+	}
+}
+
+func TestValidateFootprint(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		modify         func(p *Params)
+		field, related string
+	}{
+		{"rafts overlap", func(p *Params) { p.TowerSpacing, p.TowerWidth = 60, 40 }, "towerWidth", "towerSpacing"},
+		{"rafts off the bed", func(p *Params) { p.BedX, p.TowerSpacing, p.RaftMargin = 200, 160, 10 }, "towerSpacing", "bedX"},
+		{"pairs off the bed", func(p *Params) { p.Matrix, p.MatrixPairs, p.TowerWidth = true, 5, 25 }, "matrixPairs", "bedY"},
+		{"no inner perimeter", func(p *Params) { p.LineWidth, p.LayerHeight, p.TowerWidth, p.WallSpacing = 2, 1, 8, 150 }, "wallSpacing", "towerWidth"},
+		{"purge line off the bed", func(p *Params) { p.BedY, p.TowerWidth, p.RaftMargin = 100, 50, 20 }, "raftMargin", "bedY"},
+		{"hop off the tower", func(p *Params) { p.ShortHops, p.TowerWidth, p.ShortHopDistance = true, 6, 4 }, "shortHopDistance", "towerWidth"},
+		{"no inner triangle", func(p *Params) {
+			p.TowerShape, p.LineWidth, p.LayerHeight, p.TowerWidth, p.WallSpacing = ShapeTriangle, 1, 0.5, 8, 150
+		}, "wallSpacing", "towerWidth"},
+	} {
+		p := DefaultParams()
+		tc.modify(&p)
+		errs := Validate(p)
+		if len(errs) != 1 || errs[0].Field != tc.field || errs[0].Code != ErrCrossField || errs[0].Related != tc.related {
+			t.Errorf("%s: got %v, want %s limited by %s", tc.name, errs, tc.field, tc.related)
+		}
+	}
+
+	// the short hops are limited by the tower only when they are used
+	p := DefaultParams()
+	p.TowerWidth = 5
+	if errs := Validate(p); len(errs) != 0 {
+		t.Errorf("small towers without short hops: %v", errs)
+	}
+	p.ShortHops = true
+	if errs := Validate(p); len(errs) != 1 || errs[0].Message != "error.short_hop_distance.too_long" {
+		t.Errorf("small towers with short hops: %v", errs)
+	}
+}
+
+func BenchmarkGenerateDefault(b *testing.B) {
+	benchmarkGenerate(b, DefaultParams())
+}
+
+func BenchmarkGenerateTallFine(b *testing.B) {
+	benchmarkGenerate(b, tallFineParams())
+}
+
+func benchmarkGenerate(b *testing.B, p Params) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := GenerateTo(io.Discard, p, Options{}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
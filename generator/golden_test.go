@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata/golden")
+
+// TestGolden generates G-code for every parameter file in testdata/golden and
+// compares it with the checked-in .gcode file of the same name. Parameters
+// missing from a file take their default values. After an intended change of
+// the output, run
+//
+//	go test ./generator -run TestGolden -update
+//
+// and review the diff of testdata/golden.
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "golden", "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden parameter files found")
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			p := DefaultParams()
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(data, &p); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Generate(p, Options{Version: "golden"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			goldenFile := strings.TrimSuffix(file, ".json") + ".gcode"
+			if *update {
+				if err := os.WriteFile(goldenFile, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatalf("%v (run with -update to create it)", err)
+			}
+			if diff := diffLines(string(want), got); diff != "" {
+				t.Errorf("G-code differs from %s:\n%s", goldenFile, diff)
+			}
+		})
+	}
+}
+
+// diffLines returns a short description of the differences between two texts:
+// the number of differing lines and the first differing lines with context.
+// It is empty if the texts are equal.
+func diffLines(want, got string) string {
+	if want == got {
+		return ""
+	}
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	first, count := -1, 0
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		if i >= len(wantLines) || i >= len(gotLines) || wantLines[i] != gotLines[i] {
+			if first < 0 {
+				first = i
+			}
+			count++
+		}
+	}
+
+	const context, shown = 3, 10
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d of %d lines differ (%d lines expected), first difference at line %d:\n",
+		count, len(gotLines), len(wantLines), first+1)
+	for i := first - context; i < first+shown; i++ {
+		if i < 0 {
+			continue
+		}
+		w, g := line(wantLines, i), line(gotLines, i)
+		if w == g {
+			if i < len(wantLines) {
+				fmt.Fprintf(&sb, "  %5d   %s\n", i+1, w)
+			}
+			continue
+		}
+		if i < len(wantLines) {
+			fmt.Fprintf(&sb, "- %5d   %s\n", i+1, w)
+		}
+		if i < len(gotLines) {
+			fmt.Fprintf(&sb, "+ %5d   %s\n", i+1, g)
+		}
+	}
+	return sb.String()
+}
+
+func line(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
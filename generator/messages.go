@@ -0,0 +1,206 @@
+package generator
+
+// messages holds the English texts of validation errors and field titles, as shown by the web page.
+var messages = map[string]string{
+	"error.bed_size_x.format":                  "Bed size Х - format error",
+	"error.bed_size_x.small_or_big":            "Bed size X is incorrect (less than 100 or greater than 1000 mm)",
+	"error.bed_size_y.format":                  "Bed size Y - format error",
+	"error.bed_size_y.small_or_big":            "Bed size Y is incorrect (less than 100 or greater than 1000 mm)",
+	"error.hotend_temp.format":                 "Hotend temperature - format error",
+	"error.hotend_temp.too_low":                "Hotend temperature is too low",
+	"error.hotend_temp.too_high":               "Hotend temperature is too high",
+	"error.bed_temp.format":                    "Bed temperature - format error: ",
+	"error.bed_temp.too_high":                  "Bed temperature is too high",
+	"error.fan_speed.format":                   "Fan speed - format error",
+	"error.line_width.format":                  "Line width - format error",
+	"error.line_width.small_or_big":            "Wrong line width (less than 0.1 or greater than 2.0 mm)",
+	"error.first_line_width.format":            "First layer line width - format error",
+	"error.first_line_width.small_or_big":      "Wrong first line width (less than 0.1 or greater than 2.0 mm)",
+	"error.layer_height.format":                "Layer height - format error",
+	"error.layer_height.small_or_big":          "Wrong layer height (less than 0.05 mm or greater than 75% from line width)",
+	"error.print_speed.format":                 "Print speed - format error",
+	"error.print_speed.slow_or_fast":           "Wrong print speed (less than 10 or greater than 1000 mm/s)",
+	"error.first_print_speed.format":           "First layer print speed - format error",
+	"error.first_print_speed.slow_or_fast":     "Wrong first layer print speed (less than 10 or greater than 1000 mm/s)",
+	"error.travel_speed.format":                "Travel speed - format error",
+	"error.travel_speed.slow_or_fast":          "Wrong travel speed (less than 10 or greater than 1000 mm/s)",
+	"error.num_segments.format":                "Number of segments - format error",
+	"error.num_segments.slow_or_fast":          "Wrong number of segments (less than 2 or greater than 100)",
+	"error.init_retract_length.format":         "Initial retraction length - format error",
+	"error.init_retract_length.small_or_big":   "Wrong initial retraction length (less than 0 or greater than 20 mm)",
+	"error.end_retract_length.format":          "Final retraction length - format error",
+	"error.end_retract_length.small_or_big":    "Wrong final retraction length (less than 0 or greater than 20 mm)",
+	"error.init_retract_speed.format":          "Initial retraction speed - format error",
+	"error.init_retract_speed.slow_or_fast":    "Wrong initial retraction speed (less than 5 or greater than 150 mm/s)",
+	"error.end_retract_speed.format":           "Final retraction speed - format error",
+	"error.end_retract_speed.slow_or_fast":     "Wrong final retraction speed (less than 5 or greater than 150 mm/s)",
+	"error.segment_height.format":              "Segment height - format error",
+	"error.segment_height.small_or_big":        "Wrong segment height (less than 0.5 or greater than 20 mm)",
+	"error.tower_spacing.format":               "Distance between towers - format error",
+	"error.tower_spacing.too_small":            "Distance between towers is too low",
+	"error.tower_spacing.too_big":              "Distance between towers is too high",
+	"error.z_offset.format":                    "Z-offset - format error",
+	"error.z_offset.too_big":                   "Offset value is wrong (exceeds the layer thickness in absolute value)",
+	"error.flow.format":                        "Flow - format error",
+	"error.flow.low_or_high":                   "Value error: flow should be from 50 to 150%",
+	"error.firmware.not_set":                   "Format error: firmware not set",
+	"error.k_factor.format":                    "K-factor - format error",
+	"error.k_factor.too_high":                  "Wrong K-factor value (should be from 0.0 to 2.0)",
+	"error.end_hotend_temp.format":             "Final hotend temperature - format error",
+	"error.end_hotend_temp.too_low":            "Final hotend temperature is too low",
+	"error.end_hotend_temp.too_high":           "Final hotend temperature is too high",
+	"error.temperature_stabilization.format":   "Temperature stabilization - format error",
+	"error.temperature_dwell.format":           "Dwell after temperature change - format error",
+	"error.temperature_dwell.small_or_big":     "Dwell after temperature change is wrong (less than 0 or greater than 600 s)",
+	"error.init_z_hop.format":                  "Initial Z-hop - format error",
+	"error.init_z_hop.small_or_big":            "Wrong initial Z-hop (less than 0 or greater than 10 mm)",
+	"error.end_z_hop.format":                   "Final Z-hop - format error",
+	"error.end_z_hop.small_or_big":             "Wrong final Z-hop (less than 0 or greater than 10 mm)",
+	"error.z_hop_style.format":                 "Z-hop style - format error",
+	"error.init_wipe_distance.format":          "Initial wipe distance - format error",
+	"error.init_wipe_distance.small_or_big":    "Wrong initial wipe distance (less than 0 or greater than 20 mm)",
+	"error.end_wipe_distance.format":           "Final wipe distance - format error",
+	"error.end_wipe_distance.small_or_big":     "Wrong final wipe distance (less than 0 or greater than 20 mm)",
+	"error.wipe_retract.format":                "Retraction while wiping - format error",
+	"error.wipe_retract.small_or_big":          "Wrong retraction while wiping (less than 0 or greater than 100%)",
+	"error.init_extra_prime.format":            "Initial extra prime length - format error",
+	"error.init_extra_prime.small_or_big":      "Wrong initial extra prime length (less than -2 or greater than 2 mm)",
+	"error.end_extra_prime.format":             "Final extra prime length - format error",
+	"error.end_extra_prime.small_or_big":       "Wrong final extra prime length (less than -2 or greater than 2 mm)",
+	"error.init_unretract_speed.format":        "Initial unretract speed - format error",
+	"error.init_unretract_speed.slow_or_fast":  "Wrong initial unretract speed (less than 5 or greater than 150 mm/s)",
+	"error.end_unretract_speed.format":         "Final unretract speed - format error",
+	"error.end_unretract_speed.slow_or_fast":   "Wrong final unretract speed (less than 5 or greater than 150 mm/s)",
+	"error.matrix_pairs.format":                "Number of tower pairs - format error",
+	"error.matrix_pairs.small_or_big":          "Wrong number of tower pairs (less than 2, greater than 10 or not fitting on the bed)",
+	"error.retract_length_values.format":       "Retraction length values - format error",
+	"error.retract_length_values.count":        "The number of retraction length values differs from the number of segments",
+	"error.retract_length_values.small_or_big": "Wrong retraction length value (less than 0 or greater than 20 mm)",
+	"error.retract_speed_values.format":        "Retraction speed values - format error",
+	"error.retract_speed_values.count":         "The number of retraction speed values differs from the number of segments",
+	"error.retract_speed_values.slow_or_fast":  "Wrong retraction speed value (less than 5 or greater than 150 mm/s)",
+	"error.stepping.format":                    "Stepping - format error",
+	"error.order.format":                       "Order of values - format error",
+	"error.end_k_factor.format":                "Final K-factor - format error",
+	"error.end_k_factor.too_high":              "Wrong final K-factor value (should be from 0.0 to 2.0)",
+	"error.end_travel_speed.format":            "Final travel speed - format error",
+	"error.end_travel_speed.slow_or_fast":      "Wrong final travel speed (less than 10 or greater than 1000 mm/s)",
+	"error.init_travel_accel.format":           "Initial travel acceleration - format error",
+	"error.init_travel_accel.small_or_big":     "Wrong initial travel acceleration (less than 0 or greater than 50000 mm/s²)",
+	"error.end_travel_accel.format":            "Final travel acceleration - format error",
+	"error.end_travel_accel.small_or_big":      "Wrong final travel acceleration (less than 0 or greater than 50000 mm/s²)",
+	"error.init_coast.format":                  "Initial coasting - format error",
+	"error.init_coast.small_or_big":            "Wrong initial coasting (less than 0 or greater than 10)",
+	"error.end_coast.format":                   "Final coasting - format error",
+	"error.end_coast.small_or_big":             "Wrong final coasting (less than 0 or greater than 10)",
+	"error.init_retract_dwell.format":          "Initial dwell after retraction - format error",
+	"error.init_retract_dwell.small_or_big":    "Wrong initial dwell after retraction (less than 0 or greater than 60 s)",
+	"error.end_retract_dwell.format":           "Final dwell after retraction - format error",
+	"error.end_retract_dwell.small_or_big":     "Wrong final dwell after retraction (less than 0 or greater than 60 s)",
+	"error.init_min_travel.format":             "Initial minimum travel for retraction - format error",
+	"error.init_min_travel.small_or_big":       "Wrong initial minimum travel for retraction (less than 0 or greater than 100 mm)",
+	"error.end_min_travel.format":              "Final minimum travel for retraction - format error",
+	"error.end_min_travel.small_or_big":        "Wrong final minimum travel for retraction (less than 0 or greater than 100 mm)",
+	"error.short_hop_distance.format":          "Short hop length - format error",
+	"error.short_hop_distance.small_or_big":    "Wrong short hop length (less than 1 or greater than 10 mm)",
+	"error.short_hop_distance.too_long":        "Short hop length is too long (greater than 10 mm or half the tower size)",
+	"error.tower_width.format":                 "Tower size - format error",
+	"error.tower_width.too_small":              "Tower size is too small (less than 5 mm)",
+	"error.tower_width.too_big":                "Tower size is too big, the towers and their rafts don't fit the distance between towers",
+	"error.wall_spacing.format":                "Wall spacing - format error",
+	"error.wall_spacing.small_or_big":          "Wrong wall spacing (less than 50 or greater than 200 %, or too wide for the tower size)",
+	"error.raft_margin.format":                 "Raft margin - format error",
+	"error.raft_margin.small_or_big":           "Wrong raft margin (less than 2 or greater than 20 mm)",
+	"error.raft_margin.too_big":                "Raft margin is too big (greater than 20 mm), or the rafts and the purge line don't fit the bed",
+	"error.tower_shape.format":                 "Tower shape - format error",
+
+	"table.bed_size_x.title":                "Bed size X",
+	"table.bed_size_y.title":                "Bed size Y",
+	"table.z_offset.title":                  "Z-offset",
+	"table.delta.title":                     "Origin at the center of the bed",
+	"table.bed_probe.title":                 "Bed auto-calibration",
+	"table.hotend_temp.title":               "Hotend temperature",
+	"table.bed_temp.title":                  "Bed temperature",
+	"table.fan_speed.title":                 "Fan speed",
+	"table.line_width.title":                "Line width",
+	"table.first_line_width.title":          "First layer line width",
+	"table.layer_height.title":              "Layer height",
+	"table.print_speed.title":               "Print speed",
+	"table.first_print_speed.title":         "First layer print speed",
+	"table.travel_speed.title":              "Travel speed",
+	"table.init_retract_length.title":       "Initial retraction length",
+	"table.end_retract_length.title":        "Final retraction length",
+	"table.init_retract_speed.title":        "Initial retraction speed",
+	"table.end_retract_speed.title":         "Final retraction speed",
+	"table.num_segments.title":              "Number of segments",
+	"table.segment_height.title":            "Segment height",
+	"table.k_factor.title":                  "Linear Advance k-factor",
+	"table.tower_spacing.title":             "Distance between towers",
+	"table.firmware.title":                  "Firmware",
+	"table.start_gcode.title":               "Start G-Code",
+	"table.end_gcode.title":                 "End G-Code",
+	"table.hardmode.title":                  "Hardmode",
+	"table.flow.title":                      "Flow",
+	"table.temperature_sweep.title":         "Temperature sweep",
+	"table.end_hotend_temp.title":           "Final hotend temperature",
+	"table.temperature_stabilization.title": "Temperature stabilization",
+	"table.temperature_dwell.title":         "Dwell after temperature change",
+	"table.init_z_hop.title":                "Initial Z-hop",
+	"table.end_z_hop.title":                 "Final Z-hop",
+	"table.z_hop_style.title":               "Z-hop style",
+	"table.z_hop_only_crossing.title":       "Z-hop only between towers",
+	"table.init_wipe_distance.title":        "Initial wipe distance",
+	"table.end_wipe_distance.title":         "Final wipe distance",
+	"table.wipe_retract.title":              "Retraction while wiping",
+	"table.init_extra_prime.title":          "Initial extra prime length",
+	"table.end_extra_prime.title":           "Final extra prime length",
+	"table.separate_unretract_speed.title":  "Separate unretract speed",
+	"table.init_unretract_speed.title":      "Initial unretract speed",
+	"table.end_unretract_speed.title":       "Final unretract speed",
+	"table.firmware_retraction.title":       "Firmware retraction",
+	"table.matrix.title":                    "Length × speed matrix",
+	"table.matrix_pairs.title":              "Number of tower pairs",
+	"table.retract_length_values.title":     "Retraction length values",
+	"table.retract_speed_values.title":      "Retraction speed values",
+	"table.stepping.title":                  "Stepping",
+	"table.order.title":                     "Order of values",
+	"table.pressure_advance_sweep.title":    "Linear/Pressure Advance sweep",
+	"table.end_k_factor.title":              "Final k-factor",
+	"table.travel_speed_sweep.title":        "Travel speed sweep",
+	"table.end_travel_speed.title":          "Final travel speed",
+	"table.init_travel_accel.title":         "Initial travel acceleration",
+	"table.end_travel_accel.title":          "Final travel acceleration",
+	"table.init_coast.title":                "Initial coasting",
+	"table.end_coast.title":                 "Final coasting",
+	"table.coast_volume.title":              "Coasting as volume",
+	"table.init_retract_dwell.title":        "Initial dwell after retraction",
+	"table.end_retract_dwell.title":         "Final dwell after retraction",
+	"table.init_min_travel.title":           "Initial minimum travel for retraction",
+	"table.end_min_travel.title":            "Final minimum travel for retraction",
+	"table.short_hops.title":                "Short hops",
+	"table.short_hop_distance.title":        "Short hop length",
+	"table.tower_width.title":               "Tower size",
+	"table.wall_spacing.title":              "Wall spacing",
+	"table.raft_margin.title":               "Raft margin",
+	"table.tower_shape.title":               "Tower shape",
+	"table.arc_moves.title":                 "Arc moves",
+
+	"warning.segment_height.rounded":       "Segment height is not a multiple of the layer height, segments are printed with a whole number of layers",
+	"warning.end_retract_length.clamped":   "Retractions shorter than 0.1 mm are printed with 0.1 mm, except for the first segment",
+	"warning.wipe.firmware_retraction":     "Firmware retractions can't wipe, the wipe distance is ignored",
+	"warning.extra_prime.klipper_negative": "Klipper doesn't accept a negative extra prime length for firmware retraction, it is set to 0",
+	"warning.travel_accel.klipper":         "Klipper has no acceleration of its own for travels, the travel acceleration is ignored",
+	"warning.coast.too_long":               "Coasting is limited to half of the outer perimeter of the towers",
+	"warning.arc_moves.klipper":            "Klipper prints arcs only with a [gcode_arcs] section in printer.cfg",
+	"warning.arc_moves.marlin":             "Marlin prints arcs only if it is built with ARC_SUPPORT",
+	"warning.arc_moves.shape":              "Only round towers are printed with arcs, the arc moves are ignored",
+}
+
+// Message returns the English text for a localization key,
+// or the key itself if it is unknown.
+func Message(key string) string {
+	if msg, ok := messages[key]; ok {
+		return msg
+	}
+	return key
+}
@@ -0,0 +1,595 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Firmware selects the dialect of firmware specific commands.
+type Firmware int
+
+const (
+	FirmwareMarlin Firmware = iota
+	FirmwareKlipper
+	FirmwareRRF
+)
+
+var firmwareNames = []string{"marlin", "klipper", "rrf"}
+
+func (f Firmware) String() string {
+	return enumString(firmwareNames, int(f))
+}
+
+// MarshalText encodes the firmware by name, so that parameter files stay readable.
+func (f Firmware) MarshalText() ([]byte, error) {
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText accepts a firmware name (case insensitive) or its number.
+func (f *Firmware) UnmarshalText(text []byte) error {
+	i, err := enumParse(firmwareNames, text, "firmware")
+	*f = Firmware(i)
+	return err
+}
+
+// Stabilization selects how the hotend temperature settles after it is
+// changed between the segments of a temperature sweep.
+type Stabilization int
+
+const (
+	StabilizationDwell Stabilization = iota // set the temperature and dwell for TemperatureDwell seconds
+	StabilizationPark                       // park away from the towers and wait for the temperature
+)
+
+var stabilizationNames = []string{"dwell", "park"}
+
+func (s Stabilization) String() string {
+	return enumString(stabilizationNames, int(s))
+}
+
+// MarshalText encodes the stabilization by name.
+func (s Stabilization) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts a stabilization name (case insensitive) or its number.
+func (s *Stabilization) UnmarshalText(text []byte) error {
+	i, err := enumParse(stabilizationNames, text, "temperature stabilization")
+	*s = Stabilization(i)
+	return err
+}
+
+// ZHopStyle selects how the nozzle is lifted for travels.
+type ZHopStyle int
+
+const (
+	ZHopPlain  ZHopStyle = iota // lift vertically, travel, lower vertically
+	ZHopRamped                  // lift while moving along the first half of the travel, lower vertically
+)
+
+var zHopStyleNames = []string{"plain", "ramped"}
+
+func (s ZHopStyle) String() string {
+	return enumString(zHopStyleNames, int(s))
+}
+
+// MarshalText encodes the Z-hop style by name.
+func (s ZHopStyle) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts a Z-hop style name (case insensitive) or its number.
+func (s *ZHopStyle) UnmarshalText(text []byte) error {
+	i, err := enumParse(zHopStyleNames, text, "Z-hop style")
+	*s = ZHopStyle(i)
+	return err
+}
+
+// Stepping selects how the retraction lengths and speeds of the segments
+// between the initial and the final one are distributed. The other sweeps
+// are always linear.
+type Stepping int
+
+const (
+	SteppingLinear      Stepping = iota // equal steps
+	SteppingGeometric                   // steps growing by a constant factor, fine near the initial value
+	SteppingLogarithmic                 // steps shrinking by a constant factor, fine near the final value
+)
+
+var steppingNames = []string{"linear", "geometric", "logarithmic"}
+
+func (s Stepping) String() string {
+	return enumString(steppingNames, int(s))
+}
+
+// MarshalText encodes the stepping by name.
+func (s Stepping) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts a stepping name (case insensitive) or its number.
+func (s *Stepping) UnmarshalText(text []byte) error {
+	i, err := enumParse(steppingNames, text, "stepping")
+	*s = Stepping(i)
+	return err
+}
+
+// Order sorts the segments by retraction length, then speed. Every segment
+// keeps the length and the speed it was given.
+type Order int
+
+const (
+	OrderAsGiven    Order = iota // from the initial value or the first listed one at the bottom
+	OrderAscending               // shortest retraction at the bottom
+	OrderDescending              // longest retraction at the bottom
+)
+
+var orderNames = []string{"given", "ascending", "descending"}
+
+func (o Order) String() string {
+	return enumString(orderNames, int(o))
+}
+
+// MarshalText encodes the order by name.
+func (o Order) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText accepts an order name (case insensitive) or its number.
+func (o *Order) UnmarshalText(text []byte) error {
+	i, err := enumParse(orderNames, text, "order")
+	*o = Order(i)
+	return err
+}
+
+// TowerShape is the outline of the towers. The corners of a shape change how
+// ooze shows at the seam, round towers show strings more clearly.
+type TowerShape int
+
+const (
+	ShapeSquare   TowerShape = iota // square with the seam at a corner
+	ShapeRound                      // printed as a polygon or, with ArcMoves, as G2 arcs
+	ShapeTriangle                   // isosceles triangle with a sharp corner at the back
+)
+
+var towerShapeNames = []string{"square", "round", "triangle"}
+
+func (s TowerShape) String() string {
+	return enumString(towerShapeNames, int(s))
+}
+
+// MarshalText encodes the tower shape by name.
+func (s TowerShape) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText accepts a tower shape name (case insensitive) or its number.
+func (s *TowerShape) UnmarshalText(text []byte) error {
+	i, err := enumParse(towerShapeNames, text, "tower shape")
+	*s = TowerShape(i)
+	return err
+}
+
+func enumString(names []string, i int) string {
+	if i < 0 || i >= len(names) {
+		return strconv.Itoa(i)
+	}
+	return names[i]
+}
+
+// enumParse returns the index of a name (case insensitive) or parses a number.
+func enumParse(names []string, text []byte, what string) (int, error) {
+	name := strings.ToLower(strings.TrimSpace(string(text)))
+	for i, n := range names {
+		if n == name {
+			return i, nil
+		}
+	}
+	i, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, fmt.Errorf("unknown %s %q", what, string(text))
+	}
+	return i, nil
+}
+
+// Params holds every user-facing setting of the calibration towers.
+// Field names in parameter files match the ids of the web form.
+type Params struct {
+	BedX                 float64  `json:"bedX" yaml:"bedX"`
+	BedY                 float64  `json:"bedY" yaml:"bedY"`
+	Firmware             Firmware `json:"firmware" yaml:"firmware"`
+	ZOffset              float64  `json:"zOffset" yaml:"zOffset"`
+	Delta                bool     `json:"delta" yaml:"delta"`
+	BedProbe             bool     `json:"bedProbe" yaml:"bedProbe"`
+	HotendTemperature    int      `json:"hotendTemperature" yaml:"hotendTemperature"`
+	BedTemperature       int      `json:"bedTemperature" yaml:"bedTemperature"`
+	Flow                 int      `json:"flow" yaml:"flow"`
+	Cooling              int      `json:"cooling" yaml:"cooling"` // fan speed in percent
+	LineWidth            float64  `json:"lineWidth" yaml:"lineWidth"`
+	FirstLayerLineWidth  float64  `json:"firstLayerLineWidth" yaml:"firstLayerLineWidth"`
+	LayerHeight          float64  `json:"layerHeight" yaml:"layerHeight"`
+	PrintSpeed           float64  `json:"printSpeed" yaml:"printSpeed"`
+	FirstLayerPrintSpeed float64  `json:"firstLayerPrintSpeed" yaml:"firstLayerPrintSpeed"`
+	TravelSpeed          float64  `json:"travelSpeed" yaml:"travelSpeed"`
+	InitRetractLength    float64  `json:"initRetractLength" yaml:"initRetractLength"`
+	EndRetractLength     float64  `json:"endRetractLength" yaml:"endRetractLength"`
+	InitRetractSpeed     float64  `json:"initRetractSpeed" yaml:"initRetractSpeed"`
+	EndRetractSpeed      float64  `json:"endRetractSpeed" yaml:"endRetractSpeed"`
+	NumSegments          int      `json:"numSegments" yaml:"numSegments"`
+	SegmentHeight        float64  `json:"segmentHeight" yaml:"segmentHeight"`
+	KFactor              float64  `json:"kFactor" yaml:"kFactor"`
+	TowerSpacing         float64  `json:"towerSpacing" yaml:"towerSpacing"`
+	Hardmode             bool     `json:"hardmode" yaml:"hardmode"`
+	StartGcode           string   `json:"startGcode" yaml:"startGcode"`
+	EndGcode             string   `json:"endGcode" yaml:"endGcode"`
+
+	// TemperatureSweep prints every segment at its own hotend temperature,
+	// from HotendTemperature at the bottom to EndHotendTemperature at the top.
+	TemperatureSweep         bool          `json:"temperatureSweep" yaml:"temperatureSweep"`
+	EndHotendTemperature     int           `json:"endHotendTemperature" yaml:"endHotendTemperature"`
+	TemperatureStabilization Stabilization `json:"temperatureStabilization" yaml:"temperatureStabilization"`
+	TemperatureDwell         float64       `json:"temperatureDwell" yaml:"temperatureDwell"` // seconds
+
+	// Travels of the towers lift the nozzle by InitZHop at the bottom segment
+	// to EndZHop at the top one. Zero in both disables Z-hop.
+	InitZHop         float64   `json:"initZHop" yaml:"initZHop"`
+	EndZHop          float64   `json:"endZHop" yaml:"endZHop"`
+	ZHopStyle        ZHopStyle `json:"zHopStyle" yaml:"zHopStyle"`
+	ZHopOnlyCrossing bool      `json:"zHopOnlyCrossing" yaml:"zHopOnlyCrossing"` // lift only for travels from one tower to the other
+
+	// Retractions of the towers wipe the nozzle back along the printed
+	// perimeter, by InitWipeDistance at the bottom segment to EndWipeDistance
+	// at the top one. WipeRetract percent of the retraction is made while
+	// wiping, the rest before it. Zero in both distances disables wiping.
+	InitWipeDistance float64 `json:"initWipeDistance" yaml:"initWipeDistance"`
+	EndWipeDistance  float64 `json:"endWipeDistance" yaml:"endWipeDistance"`
+	WipeRetract      int     `json:"wipeRetract" yaml:"wipeRetract"`
+
+	// Every deretraction primes InitExtraPrime more filament than was
+	// retracted at the bottom segment to EndExtraPrime at the top one.
+	// Negative values prime less.
+	InitExtraPrime float64 `json:"initExtraPrime" yaml:"initExtraPrime"`
+	EndExtraPrime  float64 `json:"endExtraPrime" yaml:"endExtraPrime"`
+
+	// SeparateUnretractSpeed deretracts with InitUnretractSpeed at the bottom
+	// segment to EndUnretractSpeed at the top one instead of the retraction speed.
+	SeparateUnretractSpeed bool    `json:"separateUnretractSpeed" yaml:"separateUnretractSpeed"`
+	InitUnretractSpeed     float64 `json:"initUnretractSpeed" yaml:"initUnretractSpeed"`
+	EndUnretractSpeed      float64 `json:"endUnretractSpeed" yaml:"endUnretractSpeed"`
+
+	// FirmwareRetraction retracts with G10/G11 and sets the retraction of every
+	// segment in the firmware, with M207/M208 on Marlin, SET_RETRACTION on Klipper
+	// and M207 on RRF.
+	FirmwareRetraction bool `json:"firmwareRetraction" yaml:"firmwareRetraction"`
+
+	// Matrix prints MatrixPairs tower pairs from the front to the back of the bed,
+	// each with its own retraction speed from InitRetractSpeed to EndRetractSpeed.
+	// The retraction length changes along the towers as usual.
+	Matrix      bool `json:"matrix" yaml:"matrix"`
+	MatrixPairs int  `json:"matrixPairs" yaml:"matrixPairs"`
+
+	// RetractLengthValues and RetractSpeedValues list the retraction of every
+	// segment, bottom first, instead of the sweep from the initial to the final
+	// value, see parseValues. Stepping distributes the values of the sweep and
+	// Order sorts the segments by length, then speed. Both apply only to the
+	// retraction; every other sweep stays linear from the bottom segment up.
+	RetractLengthValues string   `json:"retractLengthValues" yaml:"retractLengthValues"`
+	RetractSpeedValues  string   `json:"retractSpeedValues" yaml:"retractSpeedValues"`
+	Stepping            Stepping `json:"stepping" yaml:"stepping"`
+	Order               Order    `json:"order" yaml:"order"`
+
+	// PressureAdvanceSweep sets the linear/pressure advance of every segment,
+	// from KFactor at the bottom to EndKFactor at the top, with the command
+	// of the firmware also used for $LA.
+	PressureAdvanceSweep bool    `json:"pressureAdvanceSweep" yaml:"pressureAdvanceSweep"`
+	EndKFactor           float64 `json:"endKFactor" yaml:"endKFactor"`
+
+	// TravelSpeedSweep travels with TravelSpeed at the bottom segment to
+	// EndTravelSpeed at the top one. Travels are accelerated with
+	// InitTravelAccel at the bottom segment to EndTravelAccel at the top one
+	// where the firmware has a travel acceleration (M204 T on Marlin and RRF).
+	// Zero in both accelerations leaves the one of the printer.
+	TravelSpeedSweep bool    `json:"travelSpeedSweep" yaml:"travelSpeedSweep"`
+	EndTravelSpeed   float64 `json:"endTravelSpeed" yaml:"endTravelSpeed"`
+	InitTravelAccel  float64 `json:"initTravelAccel" yaml:"initTravelAccel"` // mm/s²
+	EndTravelAccel   float64 `json:"endTravelAccel" yaml:"endTravelAccel"`
+
+	// The last InitCoast mm of the perimeters of a tower at the bottom segment
+	// to EndCoast at the top one are printed without extrusion before the
+	// travel. With CoastVolume they are mm³ of filament, converted to the
+	// length of line they would print. Zero in both disables coasting.
+	InitCoast   float64 `json:"initCoast" yaml:"initCoast"`
+	EndCoast    float64 `json:"endCoast" yaml:"endCoast"`
+	CoastVolume bool    `json:"coastVolume" yaml:"coastVolume"`
+
+	// Retractions of the towers are followed by a dwell of InitRetractDwell
+	// seconds at the bottom segment to EndRetractDwell at the top one before
+	// the travel. Zero in both disables the dwell.
+	InitRetractDwell float64 `json:"initRetractDwell" yaml:"initRetractDwell"`
+	EndRetractDwell  float64 `json:"endRetractDwell" yaml:"endRetractDwell"`
+
+	// Travels of the towers shorter than InitMinTravel at the bottom segment
+	// to EndMinTravel at the top one are not retracted. ShortHops travels from
+	// the inner perimeter of every tower to the outer one, which starts
+	// ShortHopDistance mm along its side, instead of printing the connection.
+	InitMinTravel    float64 `json:"initMinTravel" yaml:"initMinTravel"`
+	EndMinTravel     float64 `json:"endMinTravel" yaml:"endMinTravel"`
+	ShortHops        bool    `json:"shortHops" yaml:"shortHops"`
+	ShortHopDistance float64 `json:"shortHopDistance" yaml:"shortHopDistance"`
+
+	// TowerWidth is the outer size of the towers. Their perimeters are
+	// WallSpacing percent of the line width apart and the raft of every tower
+	// is RaftMargin wider than the tower on every side.
+	TowerWidth  float64 `json:"towerWidth" yaml:"towerWidth"`
+	WallSpacing int     `json:"wallSpacing" yaml:"wallSpacing"`
+	RaftMargin  float64 `json:"raftMargin" yaml:"raftMargin"`
+
+	// TowerShape is the outline of the towers, TowerWidth wide. ArcMoves prints
+	// round towers with G2 arcs instead of polygons.
+	TowerShape TowerShape `json:"towerShape" yaml:"towerShape"`
+	ArcMoves   bool       `json:"arcMoves" yaml:"arcMoves"`
+}
+
+// DefaultStartGcode and DefaultEndGcode are the start and end G-code of the web form.
+const (
+	DefaultStartGcode = `$LA ;set k-factor for Linear/Pressure Advance
+M190 S$BEDTEMP ;heat bed to the temperature from settings
+M109 S$HOTTEMP ;heat hotend to the temperature from settings
+G28 ;home all axes
+$G29 ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S$FLOW ;flow multiplier from settings`
+	DefaultEndGcode = `M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm`
+)
+
+// DefaultParams returns the default values of the web form.
+func DefaultParams() Params {
+	return Params{
+		BedX:                 235,
+		BedY:                 235,
+		Firmware:             FirmwareMarlin,
+		ZOffset:              0.0,
+		HotendTemperature:    210,
+		BedTemperature:       60,
+		EndHotendTemperature: 230,
+		TemperatureDwell:     15,
+		WipeRetract:          100,
+		InitUnretractSpeed:   30,
+		MatrixPairs:          3,
+		EndUnretractSpeed:    30,
+		EndKFactor:           0.1,
+		EndTravelSpeed:       300,
+		ShortHopDistance:     3,
+		TowerWidth:           15,
+		WallSpacing:          90,
+		RaftMargin:           7.5,
+		Flow:                 100,
+		Cooling:              100,
+		LineWidth:            0.4,
+		FirstLayerLineWidth:  0.6,
+		LayerHeight:          0.25,
+		PrintSpeed:           60,
+		FirstLayerPrintSpeed: 30,
+		TravelSpeed:          150,
+		InitRetractLength:    1.0,
+		EndRetractLength:     0.2,
+		InitRetractSpeed:     30,
+		EndRetractSpeed:      30,
+		NumSegments:          10,
+		SegmentHeight:        3,
+		KFactor:              0.0,
+		TowerSpacing:         100,
+		StartGcode:           DefaultStartGcode,
+		EndGcode:             DefaultEndGcode,
+	}
+}
+
+// retractLengthDelta is the retraction length change between two segments.
+func (p Params) retractLengthDelta() float64 {
+	return (p.InitRetractLength - p.EndRetractLength) / float64(p.NumSegments-1)
+}
+
+// retractSpeedDelta is the retraction speed change between two segments.
+func (p Params) retractSpeedDelta() float64 {
+	return (p.InitRetractSpeed - p.EndRetractSpeed) / float64(p.NumSegments-1)
+}
+
+// layersPerSegment is the number of layers of a tower segment.
+func (p Params) layersPerSegment() int {
+	return int(p.SegmentHeight / p.LayerHeight)
+}
+
+// raftWidth is the size of the square raft of a tower.
+func (p Params) raftWidth() float64 {
+	return p.TowerWidth + 2*p.RaftMargin
+}
+
+// footprint is the space a tower takes on the bed: its raft
+// and the clearance to the next tower.
+func (p Params) footprint() float64 {
+	return p.raftWidth() + 10
+}
+
+// arcMoves reports whether round towers are printed with arcs.
+func (p Params) arcMoves() bool {
+	return p.TowerShape == ShapeRound && p.ArcMoves
+}
+
+// customFootprint reports whether the towers differ from the default ones.
+func (p Params) customFootprint() bool {
+	d := DefaultParams()
+	return p.TowerWidth != d.TowerWidth || p.WallSpacing != d.WallSpacing || p.RaftMargin != d.RaftMargin
+}
+
+// segmentTemperature is the hotend temperature of the segment with
+// the given index, counted from 0 at the bottom.
+func (p Params) segmentTemperature(i int) int {
+	if !p.TemperatureSweep {
+		return p.HotendTemperature
+	}
+	delta := float64(p.EndHotendTemperature-p.HotendTemperature) / float64(p.NumSegments-1)
+	return int(math.Round(float64(p.HotendTemperature) + delta*float64(i)))
+}
+
+// sweep is the value of a linear sweep from init at the bottom segment
+// to end at the top one for the segment with the given index.
+func (p Params) sweep(init, end float64, i int) float64 {
+	return init + (end-init)/float64(p.NumSegments-1)*float64(i)
+}
+
+// zHop reports whether the travels are lifted.
+func (p Params) zHop() bool {
+	return p.InitZHop > 0 || p.EndZHop > 0
+}
+
+// segmentZHop is the Z-hop height of the segment with the given index,
+// counted from 0 at the bottom.
+func (p Params) segmentZHop(i int) float64 {
+	return p.sweep(p.InitZHop, p.EndZHop, i)
+}
+
+// wipe reports whether the retractions wipe the nozzle.
+func (p Params) wipe() bool {
+	return p.InitWipeDistance > 0 || p.EndWipeDistance > 0
+}
+
+// segmentWipeDistance is the wipe distance of the segment with the given
+// index, counted from 0 at the bottom.
+func (p Params) segmentWipeDistance(i int) float64 {
+	return p.sweep(p.InitWipeDistance, p.EndWipeDistance, i)
+}
+
+// extraPrime reports whether the deretractions prime extra filament.
+func (p Params) extraPrime() bool {
+	return p.InitExtraPrime != 0 || p.EndExtraPrime != 0
+}
+
+// segmentExtraPrime is the extra prime length of the segment with the given
+// index, counted from 0 at the bottom.
+func (p Params) segmentExtraPrime(i int) float64 {
+	return p.sweep(p.InitExtraPrime, p.EndExtraPrime, i)
+}
+
+// pairRetractSpeed is the retraction speed of the tower pair of a matrix
+// with the given index, counted from 0 at the front.
+func (p Params) pairRetractSpeed(i int) float64 {
+	return p.InitRetractSpeed + (p.EndRetractSpeed-p.InitRetractSpeed)/float64(p.MatrixPairs-1)*float64(i)
+}
+
+// segmentUnretractSpeed is the deretraction speed of the segment with the given
+// index, counted from 0 at the bottom, if SeparateUnretractSpeed is set.
+func (p Params) segmentUnretractSpeed(i int) float64 {
+	return p.sweep(p.InitUnretractSpeed, p.EndUnretractSpeed, i)
+}
+
+// segmentKFactor is the linear/pressure advance of the segment with the
+// given index, counted from 0 at the bottom.
+func (p Params) segmentKFactor(i int) float64 {
+	if !p.PressureAdvanceSweep {
+		return p.KFactor
+	}
+	return p.sweep(p.KFactor, p.EndKFactor, i)
+}
+
+// segmentTravelSpeed is the travel speed of the segment with the given index,
+// counted from 0 at the bottom.
+func (p Params) segmentTravelSpeed(i int) float64 {
+	if !p.TravelSpeedSweep {
+		return p.TravelSpeed
+	}
+	return p.sweep(p.TravelSpeed, p.EndTravelSpeed, i)
+}
+
+// travelAccel reports whether the travel acceleration is set.
+// Klipper has no acceleration of its own for travels.
+func (p Params) travelAccel() bool {
+	return (p.InitTravelAccel > 0 || p.EndTravelAccel > 0) && p.Firmware != FirmwareKlipper
+}
+
+// segmentTravelAccel is the travel acceleration of the segment with the given
+// index, counted from 0 at the bottom.
+func (p Params) segmentTravelAccel(i int) float64 {
+	return p.sweep(p.InitTravelAccel, p.EndTravelAccel, i)
+}
+
+// coast reports whether the towers coast before the travels.
+func (p Params) coast() bool {
+	return p.InitCoast > 0 || p.EndCoast > 0
+}
+
+// segmentCoast is the coasting of the segment with the given index,
+// counted from 0 at the bottom, in mm or mm³ depending on CoastVolume.
+func (p Params) segmentCoast(i int) float64 {
+	return p.sweep(p.InitCoast, p.EndCoast, i)
+}
+
+// coastUnit is the unit of InitCoast and EndCoast.
+func (p Params) coastUnit() string {
+	if p.CoastVolume {
+		return "mm³"
+	}
+	return "mm"
+}
+
+// segmentCoastDistance is the length of line the segment with the
+// given index coasts, counted from 0 at the bottom, at most maxCoastDistance.
+func (p Params) segmentCoastDistance(i int) float64 {
+	return math.Min(p.coastDistance(p.segmentCoast(i)), p.maxCoastDistance())
+}
+
+// coastDistance converts coasting in mm or mm³ to the length of line.
+func (p Params) coastDistance(coast float64) float64 {
+	if p.CoastVolume {
+		return coast / (p.LineWidth * p.LayerHeight)
+	}
+	return coast
+}
+
+// maxCoastDistance is the longest coasting: half of the outer perimeter
+// of the towers, so that the rest of it is still printed.
+func (p Params) maxCoastDistance() float64 {
+	outer := towerLoop(p.TowerShape, Point{}, p.TowerWidth, p.TowerWidth-0.5*p.LineWidth, p.arcMoves())
+	return outer.perimeter() / 2
+}
+
+// retractDwell reports whether the nozzle dwells after the retractions.
+func (p Params) retractDwell() bool {
+	return p.InitRetractDwell > 0 || p.EndRetractDwell > 0
+}
+
+// segmentRetractDwell is the dwell after the retractions of the segment
+// with the given index, counted from 0 at the bottom.
+func (p Params) segmentRetractDwell(i int) float64 {
+	return p.sweep(p.InitRetractDwell, p.EndRetractDwell, i)
+}
+
+// minTravel reports whether short travels are not retracted.
+func (p Params) minTravel() bool {
+	return p.InitMinTravel > 0 || p.EndMinTravel > 0
+}
+
+// segmentMinTravel is the minimum travel for retraction of the segment
+// with the given index, counted from 0 at the bottom.
+func (p Params) segmentMinTravel(i int) float64 {
+	return p.sweep(p.InitMinTravel, p.EndMinTravel, i)
+}
+
+// fanSpeed converts Cooling from percent to the 0..255 range of M106.
+func (p Params) fanSpeed() int {
+	cooling := int(float64(p.Cooling) * 2.55)
+	if cooling < 0 {
+		cooling = 0
+	} else if cooling > 255 {
+		cooling = 255
+	}
+	return cooling
+}
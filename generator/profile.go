@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ProfileVersion is the version of the profile format written by MarshalProfile.
+// It has to be increased together with a new entry in migrations whenever
+// a parameter is renamed or changes its meaning. New parameters don't need
+// a migration, they take their default values when missing.
+const ProfileVersion = 1
+
+// Profile is a named set of parameters, e.g. "Ender3 PETG direct",
+// which can be shared as a JSON file.
+type Profile struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	Params  Params `json:"params"`
+}
+
+// NewProfile returns a profile of the current version.
+func NewProfile(name string, p Params) Profile {
+	return Profile{Version: ProfileVersion, Name: name, Params: p}
+}
+
+// MarshalProfile encodes the profile as indented JSON.
+func MarshalProfile(profile Profile) ([]byte, error) {
+	profile.Version = ProfileVersion
+	data, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// UnmarshalProfile decodes a profile of any known version and migrates it
+// to the current one. Parameters missing from the profile take their default values.
+//
+// Data without a version is treated as version 0, which is the set of form
+// values saved by the web page: form element ids mapped to their values as strings.
+func UnmarshalProfile(data []byte) (Profile, error) {
+	return UnmarshalProfileOnto(data, DefaultParams())
+}
+
+// UnmarshalProfileOnto is UnmarshalProfile with the parameters missing from
+// the profile taken from base instead of the defaults.
+func UnmarshalProfileOnto(data []byte, base Params) (Profile, error) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Profile{}, err
+	}
+
+	version := 0
+	if v, ok := raw["version"]; ok {
+		f, ok := v.(float64)
+		if !ok {
+			return Profile{}, fmt.Errorf("invalid profile version %v", v)
+		}
+		version = int(f)
+	}
+	if version < 0 || version > ProfileVersion {
+		return Profile{}, fmt.Errorf("unsupported profile version %d, the newest known is %d", version, ProfileVersion)
+	}
+
+	for ; version < ProfileVersion; version++ {
+		if err := migrations[version](raw); err != nil {
+			return Profile{}, fmt.Errorf("migrating profile from version %d: %w", version, err)
+		}
+		raw["version"] = version + 1
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return Profile{}, err
+	}
+	profile := Profile{Params: base}
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return Profile{}, err
+	}
+	return profile, nil
+}
+
+// migrations[i] converts a decoded profile of version i to version i+1.
+var migrations = []func(raw map[string]interface{}) error{
+	migrateFormValues,
+}
+
+// migrateFormValues converts form values saved by the web page (version 0)
+// to a profile of version 1. Form elements are named after the parameters,
+// except for enums, which are radio buttons named after their options,
+// and the k-factor, which was saved as kFactor2.
+func migrateFormValues(raw map[string]interface{}) error {
+	params := make(map[string]interface{})
+	name, _ := raw["name"].(string)
+	if v, ok := raw["kFactor2"]; ok {
+		raw["kFactor"] = v
+	}
+
+	for _, f := range Fields {
+		if f.Type == TypeEnum {
+			for _, o := range f.Options {
+				if v, ok := raw[f.ID+o.Label]; ok && (v == true || v == "true") {
+					params[f.ID] = o.Value
+				}
+			}
+			continue
+		}
+
+		v, ok := raw[f.ID]
+		if !ok {
+			continue
+		}
+		s, ok := v.(string)
+		if !ok {
+			params[f.ID] = v
+			continue
+		}
+		switch f.Type {
+		case TypeText, TypeList:
+			params[f.ID] = s
+		case TypeBool:
+			params[f.ID] = s == "true"
+		default:
+			n, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", "."), 64)
+			if err != nil {
+				return FormatError(f.ID, s)
+			}
+			if f.Type == TypeInteger {
+				n = math.Round(n)
+			}
+			params[f.ID] = n
+		}
+	}
+
+	for k := range raw {
+		delete(raw, k)
+	}
+	raw["name"] = name
+	raw["params"] = params
+	return nil
+}
@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProfileRoundTrip(t *testing.T) {
+	p := DefaultParams()
+	p.Firmware = FirmwareRRF
+	p.KFactor = 0.04
+	p.Hardmode = true
+
+	data, err := MarshalProfile(NewProfile("Voron ABS", p))
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err := UnmarshalProfile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Name != "Voron ABS" || profile.Version != ProfileVersion {
+		t.Errorf("got name %q version %d", profile.Name, profile.Version)
+	}
+	if !reflect.DeepEqual(profile.Params, p) {
+		t.Errorf("got params %+v, want %+v", profile.Params, p)
+	}
+}
+
+func TestProfileMissingParamsTakeDefaults(t *testing.T) {
+	profile, err := UnmarshalProfile([]byte(`{"version": 1, "name": "short", "params": {"bedX": 300}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DefaultParams()
+	want.BedX = 300
+	if !reflect.DeepEqual(profile.Params, want) {
+		t.Errorf("got params %+v, want %+v", profile.Params, want)
+	}
+}
+
+func TestProfileOntoBase(t *testing.T) {
+	base := DefaultParams()
+	base.BedX, base.Firmware = 300, FirmwareKlipper
+	profile, err := UnmarshalProfileOnto([]byte(`{"version": 1, "name": "short", "params": {"bedY": 250}}`), base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := base
+	want.BedY = 250
+	if !reflect.DeepEqual(profile.Params, want) {
+		t.Errorf("got params %+v, want %+v", profile.Params, want)
+	}
+}
+
+func TestProfileMigratesFormValues(t *testing.T) {
+	form := `{
+		"bedX": "220", "bedY": "220,5", "kFactor2": "0.05", "cooling": "80",
+		"delta": "false", "hardmode": "true",
+		"firmwareMarlin": "false", "firmwareKlipper": "true", "firmwareRRF": "false",
+		"startGcode": "G28"
+	}`
+	profile, err := UnmarshalProfile([]byte(form))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := DefaultParams()
+	want.BedX, want.BedY, want.KFactor, want.Cooling = 220, 220.5, 0.05, 80
+	want.Hardmode = true
+	want.Firmware = FirmwareKlipper
+	want.StartGcode = "G28"
+	if !reflect.DeepEqual(profile.Params, want) {
+		t.Errorf("got params %+v, want %+v", profile.Params, want)
+	}
+	if profile.Version != ProfileVersion {
+		t.Errorf("got version %d, want %d", profile.Version, ProfileVersion)
+	}
+}
+
+func TestProfileFromTheFuture(t *testing.T) {
+	if _, err := UnmarshalProfile([]byte(`{"version": 1000, "params": {}}`)); err == nil {
+		t.Error("expected an error for unknown version")
+	}
+}
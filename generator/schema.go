@@ -0,0 +1,305 @@
+package generator
+
+import (
+	"math"
+	"reflect"
+)
+
+// FieldType is the kind of value of a parameter.
+type FieldType string
+
+const (
+	TypeNumber  FieldType = "number"
+	TypeInteger FieldType = "integer"
+	TypeBool    FieldType = "bool"
+	TypeEnum    FieldType = "enum"
+	TypeText    FieldType = "text"
+	TypeList    FieldType = "list" // numbers and ranges of segment values, see parseValues
+)
+
+// Option is an allowed value of an enum parameter.
+type Option struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// Constraint is a limit of a parameter which is computed from another parameter.
+// Min and Max describe the computed limits, e.g. "bedX - 40".
+type Constraint struct {
+	Related string `json:"related"`
+	Min     string `json:"min,omitempty"`
+	Max     string `json:"max,omitempty"`
+
+	min, max func(p Params) float64
+}
+
+// Field describes a parameter: how it is entered, its default value and limits.
+// It is the single source of the web form, the command line flags and Validate.
+type Field struct {
+	ID   string    `json:"id"`
+	Key  string    `json:"key"` // localization key, as in "table.<key>.title" and "error.<key>.format"
+	Type FieldType `json:"type"`
+	Unit string    `json:"unit,omitempty"`
+	// Default is the value of DefaultParams.
+	Default    interface{} `json:"default"`
+	Min        *float64    `json:"min,omitempty"`
+	Max        *float64    `json:"max,omitempty"`
+	Options    []Option    `json:"options,omitempty"`
+	Constraint *Constraint `json:"constraint,omitempty"`
+	Title      string      `json:"title"` // localization key of the name
+	Help       string      `json:"help"`  // localization key of the description
+	// Segment is set for the fields which change the segment table.
+	Segment bool `json:"segment,omitempty"`
+
+	ref             func(p *Params) interface{}
+	lowMsg, highMsg string
+	item            string // ID of the field whose limits apply to the values of a list
+}
+
+// Pointer returns a pointer to the field in p: *float64, *int, *bool, *string,
+// or a pointer to an enum type such as *Firmware, which implements
+// encoding.TextMarshaler and encoding.TextUnmarshaler.
+func (f Field) Pointer(p *Params) interface{} {
+	return f.ref(p)
+}
+
+func limit(v float64) *float64 {
+	return &v
+}
+
+// Fields lists the parameters in the order of the web form, which is also
+// the order of validation errors.
+var Fields = []Field{
+	{ID: "bedX", Key: "bed_size_x", Type: TypeNumber, Unit: "mm", Min: limit(100), Max: limit(1000),
+		ref: func(p *Params) interface{} { return &p.BedX }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "bedY", Key: "bed_size_y", Type: TypeNumber, Unit: "mm", Min: limit(100), Max: limit(1000),
+		ref: func(p *Params) interface{} { return &p.BedY }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "firmware", Key: "firmware", Type: TypeEnum,
+		Options: []Option{{"marlin", "Marlin"}, {"klipper", "Klipper"}, {"rrf", "RRF"}},
+		ref:     func(p *Params) interface{} { return &p.Firmware }, lowMsg: "not_set"},
+	{ID: "zOffset", Key: "z_offset", Type: TypeNumber, Unit: "mm",
+		Constraint: &Constraint{Related: "layerHeight", Min: "-layerHeight", Max: "layerHeight",
+			min: func(p Params) float64 { return -p.LayerHeight },
+			max: func(p Params) float64 { return p.LayerHeight }},
+		ref: func(p *Params) interface{} { return &p.ZOffset }, lowMsg: "too_big", highMsg: "too_big"},
+	{ID: "delta", Key: "delta", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.Delta }},
+	{ID: "bedProbe", Key: "bed_probe", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.BedProbe }},
+	{ID: "hotendTemperature", Key: "hotend_temp", Type: TypeInteger, Unit: "°C", Min: limit(150), Max: limit(350),
+		ref: func(p *Params) interface{} { return &p.HotendTemperature }, lowMsg: "too_low", highMsg: "too_high"},
+	{ID: "bedTemperature", Key: "bed_temp", Type: TypeInteger, Unit: "°C", Max: limit(150),
+		ref: func(p *Params) interface{} { return &p.BedTemperature }, highMsg: "too_high"},
+	{ID: "flow", Key: "flow", Type: TypeInteger, Unit: "%", Min: limit(50), Max: limit(150),
+		ref: func(p *Params) interface{} { return &p.Flow }, lowMsg: "low_or_high", highMsg: "low_or_high"},
+	{ID: "cooling", Key: "fan_speed", Type: TypeInteger, Unit: "%",
+		ref: func(p *Params) interface{} { return &p.Cooling }},
+	{ID: "lineWidth", Key: "line_width", Type: TypeNumber, Unit: "mm", Min: limit(0.1), Max: limit(2),
+		ref: func(p *Params) interface{} { return &p.LineWidth }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "firstLayerLineWidth", Key: "first_line_width", Type: TypeNumber, Unit: "mm", Min: limit(0.1), Max: limit(2),
+		ref: func(p *Params) interface{} { return &p.FirstLayerLineWidth }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "layerHeight", Key: "layer_height", Type: TypeNumber, Unit: "mm", Min: limit(0.05),
+		Constraint: &Constraint{Related: "lineWidth", Max: "lineWidth * 0.75",
+			max: func(p Params) float64 { return p.LineWidth * 0.75 }},
+		ref: func(p *Params) interface{} { return &p.LayerHeight }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "printSpeed", Key: "print_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(10), Max: limit(1000),
+		ref: func(p *Params) interface{} { return &p.PrintSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "firstLayerPrintSpeed", Key: "first_print_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(10), Max: limit(1000),
+		ref: func(p *Params) interface{} { return &p.FirstLayerPrintSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "travelSpeed", Key: "travel_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(10), Max: limit(1000),
+		ref: func(p *Params) interface{} { return &p.TravelSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "initRetractLength", Key: "init_retract_length", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(20), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitRetractLength }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endRetractLength", Key: "end_retract_length", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(20), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndRetractLength }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initRetractSpeed", Key: "init_retract_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(5), Max: limit(150), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitRetractSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "endRetractSpeed", Key: "end_retract_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(5), Max: limit(150), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndRetractSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "retractLengthValues", Key: "retract_length_values", Type: TypeList, Unit: "mm", Segment: true,
+		ref: func(p *Params) interface{} { return &p.RetractLengthValues }, item: "initRetractLength"},
+	{ID: "retractSpeedValues", Key: "retract_speed_values", Type: TypeList, Unit: "mm/s", Segment: true,
+		ref: func(p *Params) interface{} { return &p.RetractSpeedValues }, item: "initRetractSpeed"},
+	{ID: "stepping", Key: "stepping", Type: TypeEnum, Segment: true,
+		Options: []Option{{"linear", "Linear"}, {"geometric", "Geometric"}, {"logarithmic", "Logarithmic"}},
+		ref:     func(p *Params) interface{} { return &p.Stepping }, lowMsg: "format"},
+	{ID: "order", Key: "order", Type: TypeEnum, Segment: true,
+		Options: []Option{{"given", "Given"}, {"ascending", "Ascending"}, {"descending", "Descending"}},
+		ref:     func(p *Params) interface{} { return &p.Order }, lowMsg: "format"},
+	{ID: "separateUnretractSpeed", Key: "separate_unretract_speed", Type: TypeBool, Segment: true,
+		ref: func(p *Params) interface{} { return &p.SeparateUnretractSpeed }},
+	{ID: "initUnretractSpeed", Key: "init_unretract_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(5), Max: limit(150), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitUnretractSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "endUnretractSpeed", Key: "end_unretract_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(5), Max: limit(150), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndUnretractSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "temperatureSweep", Key: "temperature_sweep", Type: TypeBool, Segment: true,
+		ref: func(p *Params) interface{} { return &p.TemperatureSweep }},
+	{ID: "endHotendTemperature", Key: "end_hotend_temp", Type: TypeInteger, Unit: "°C", Min: limit(150), Max: limit(350), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndHotendTemperature }, lowMsg: "too_low", highMsg: "too_high"},
+	{ID: "pressureAdvanceSweep", Key: "pressure_advance_sweep", Type: TypeBool, Segment: true,
+		ref: func(p *Params) interface{} { return &p.PressureAdvanceSweep }},
+	{ID: "endKFactor", Key: "end_k_factor", Type: TypeNumber, Min: limit(0), Max: limit(2), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndKFactor }, lowMsg: "too_high", highMsg: "too_high"},
+	{ID: "travelSpeedSweep", Key: "travel_speed_sweep", Type: TypeBool, Segment: true,
+		ref: func(p *Params) interface{} { return &p.TravelSpeedSweep }},
+	{ID: "endTravelSpeed", Key: "end_travel_speed", Type: TypeNumber, Unit: "mm/s", Min: limit(10), Max: limit(1000), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndTravelSpeed }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "initTravelAccel", Key: "init_travel_accel", Type: TypeNumber, Unit: "mm/s²", Min: limit(0), Max: limit(50000), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitTravelAccel }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endTravelAccel", Key: "end_travel_accel", Type: TypeNumber, Unit: "mm/s²", Min: limit(0), Max: limit(50000), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndTravelAccel }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initCoast", Key: "init_coast", Type: TypeNumber, Min: limit(0), Max: limit(10), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitCoast }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endCoast", Key: "end_coast", Type: TypeNumber, Min: limit(0), Max: limit(10), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndCoast }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initRetractDwell", Key: "init_retract_dwell", Type: TypeNumber, Unit: "s", Min: limit(0), Max: limit(60), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitRetractDwell }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endRetractDwell", Key: "end_retract_dwell", Type: TypeNumber, Unit: "s", Min: limit(0), Max: limit(60), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndRetractDwell }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initMinTravel", Key: "init_min_travel", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(100), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitMinTravel }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endMinTravel", Key: "end_min_travel", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(100), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndMinTravel }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initZHop", Key: "init_z_hop", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(10), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitZHop }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endZHop", Key: "end_z_hop", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(10), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndZHop }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initWipeDistance", Key: "init_wipe_distance", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(20), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitWipeDistance }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endWipeDistance", Key: "end_wipe_distance", Type: TypeNumber, Unit: "mm", Min: limit(0), Max: limit(20), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndWipeDistance }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "initExtraPrime", Key: "init_extra_prime", Type: TypeNumber, Unit: "mm", Min: limit(-2), Max: limit(2), Segment: true,
+		ref: func(p *Params) interface{} { return &p.InitExtraPrime }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "endExtraPrime", Key: "end_extra_prime", Type: TypeNumber, Unit: "mm", Min: limit(-2), Max: limit(2), Segment: true,
+		ref: func(p *Params) interface{} { return &p.EndExtraPrime }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "matrix", Key: "matrix", Type: TypeBool, Segment: true,
+		ref: func(p *Params) interface{} { return &p.Matrix }},
+	{ID: "matrixPairs", Key: "matrix_pairs", Type: TypeInteger, Min: limit(2), Max: limit(10), Segment: true,
+		Constraint: &Constraint{Related: "bedY", Max: "(bedY - 10) / (towerWidth + 2 * raftMargin + 10)",
+			max: func(p Params) float64 {
+				if !p.Matrix {
+					return math.Inf(1)
+				}
+				// the pairs are centered on the bed, the purge line is 10 mm in front of them
+				return (p.BedY - 10) / p.footprint()
+			}},
+		ref: func(p *Params) interface{} { return &p.MatrixPairs }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "numSegments", Key: "num_segments", Type: TypeInteger, Min: limit(2), Max: limit(100), Segment: true,
+		ref: func(p *Params) interface{} { return &p.NumSegments }, lowMsg: "slow_or_fast", highMsg: "slow_or_fast"},
+	{ID: "temperatureStabilization", Key: "temperature_stabilization", Type: TypeEnum,
+		Options: []Option{{"dwell", "Dwell"}, {"park", "Park"}},
+		ref:     func(p *Params) interface{} { return &p.TemperatureStabilization }, lowMsg: "format"},
+	{ID: "temperatureDwell", Key: "temperature_dwell", Type: TypeNumber, Unit: "s", Min: limit(0), Max: limit(600),
+		ref: func(p *Params) interface{} { return &p.TemperatureDwell }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "zHopStyle", Key: "z_hop_style", Type: TypeEnum,
+		Options: []Option{{"plain", "Plain"}, {"ramped", "Ramped"}},
+		ref:     func(p *Params) interface{} { return &p.ZHopStyle }, lowMsg: "format"},
+	{ID: "zHopOnlyCrossing", Key: "z_hop_only_crossing", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.ZHopOnlyCrossing }},
+	{ID: "wipeRetract", Key: "wipe_retract", Type: TypeInteger, Unit: "%", Min: limit(0), Max: limit(100),
+		ref: func(p *Params) interface{} { return &p.WipeRetract }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "firmwareRetraction", Key: "firmware_retraction", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.FirmwareRetraction }},
+	{ID: "coastVolume", Key: "coast_volume", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.CoastVolume }},
+	{ID: "shortHops", Key: "short_hops", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.ShortHops }},
+	{ID: "shortHopDistance", Key: "short_hop_distance", Type: TypeNumber, Unit: "mm", Min: limit(1), Max: limit(10),
+		Constraint: &Constraint{Related: "towerWidth", Max: "towerWidth / 2",
+			max: func(p Params) float64 {
+				if !p.ShortHops {
+					return math.Inf(1)
+				}
+				return p.TowerWidth / 2
+			}},
+		ref: func(p *Params) interface{} { return &p.ShortHopDistance }, lowMsg: "small_or_big", highMsg: "too_long"},
+	{ID: "segmentHeight", Key: "segment_height", Type: TypeNumber, Unit: "mm", Min: limit(0.5), Max: limit(20),
+		ref: func(p *Params) interface{} { return &p.SegmentHeight }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "kFactor", Key: "k_factor", Type: TypeNumber, Min: limit(0), Max: limit(2),
+		ref: func(p *Params) interface{} { return &p.KFactor }, lowMsg: "too_high", highMsg: "too_high"},
+	{ID: "towerSpacing", Key: "tower_spacing", Type: TypeNumber, Unit: "mm", Min: limit(40),
+		Constraint: &Constraint{Related: "bedX", Max: "bedX - towerWidth - 2 * raftMargin - 10",
+			max: func(p Params) float64 { return p.BedX - p.footprint() }},
+		ref: func(p *Params) interface{} { return &p.TowerSpacing }, lowMsg: "too_small", highMsg: "too_big"},
+	{ID: "towerWidth", Key: "tower_width", Type: TypeNumber, Unit: "mm", Min: limit(5), Max: limit(50),
+		Constraint: &Constraint{Related: "towerSpacing", Max: "towerSpacing - 2 * raftMargin - 10",
+			max: func(p Params) float64 { return p.TowerSpacing - 2*p.RaftMargin - 10 }},
+		ref: func(p *Params) interface{} { return &p.TowerWidth }, lowMsg: "too_small", highMsg: "too_big"},
+	{ID: "wallSpacing", Key: "wall_spacing", Type: TypeInteger, Unit: "%", Min: limit(50), Max: limit(200),
+		Constraint: &Constraint{Related: "towerWidth", Max: "(towerWidth / lineWidth - 2.5) * 50, for triangles (towerWidth / (1 + √5) / lineWidth - 1.25) * 100",
+			max: func(p Params) float64 {
+				// the inner perimeter keeps a line width to the center, or to the incenter of triangles
+				if p.TowerShape == ShapeTriangle {
+					return (p.TowerWidth/(1+math.Sqrt(5))/p.LineWidth - 1.25) * 100
+				}
+				return (p.TowerWidth/p.LineWidth - 2.5) * 50
+			}},
+		ref: func(p *Params) interface{} { return &p.WallSpacing }, lowMsg: "small_or_big", highMsg: "small_or_big"},
+	{ID: "raftMargin", Key: "raft_margin", Type: TypeNumber, Unit: "mm", Min: limit(2), Max: limit(20),
+		Constraint: &Constraint{Related: "bedY", Max: "(bedY - towerWidth - 20) / 2",
+			max: func(p Params) float64 {
+				// a matrix is limited by matrixPairs
+				if p.Matrix {
+					return math.Inf(1)
+				}
+				// the rafts are centered on the bed, the purge line is 10 mm in front of them
+				return (p.BedY - p.TowerWidth - 20) / 2
+			}},
+		ref: func(p *Params) interface{} { return &p.RaftMargin }, lowMsg: "small_or_big", highMsg: "too_big"},
+	{ID: "towerShape", Key: "tower_shape", Type: TypeEnum,
+		Options: []Option{{"square", "Square"}, {"round", "Round"}, {"triangle", "Triangle"}},
+		ref:     func(p *Params) interface{} { return &p.TowerShape }, lowMsg: "format"},
+	{ID: "arcMoves", Key: "arc_moves", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.ArcMoves }},
+	{ID: "hardmode", Key: "hardmode", Type: TypeBool,
+		ref: func(p *Params) interface{} { return &p.Hardmode }},
+	{ID: "startGcode", Key: "start_gcode", Type: TypeText,
+		ref: func(p *Params) interface{} { return &p.StartGcode }},
+	{ID: "endGcode", Key: "end_gcode", Type: TypeText,
+		ref: func(p *Params) interface{} { return &p.EndGcode }},
+}
+
+func init() {
+	defaults := DefaultParams()
+	for i := range Fields {
+		f := &Fields[i]
+		f.Title = "table." + f.Key + ".title"
+		f.Help = "table." + f.Key + ".description"
+		f.Default = f.value(&defaults)
+	}
+}
+
+// value returns the value of the field in p.
+func (f Field) value(p *Params) interface{} {
+	return reflect.ValueOf(f.ref(p)).Elem().Interface()
+}
+
+// number returns the value of a numeric or enum field in p.
+func (f Field) number(p *Params) float64 {
+	v := reflect.ValueOf(f.ref(p)).Elem()
+	switch v.Kind() {
+	case reflect.Float64:
+		return v.Float()
+	case reflect.Int:
+		return float64(v.Int())
+	}
+	return 0
+}
+
+// FieldByID returns the field with the given id.
+func FieldByID(id string) (Field, bool) {
+	for _, f := range Fields {
+		if f.ID == id {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// FieldKey returns the localization key of the field with the given id.
+func FieldKey(id string) string {
+	if f, ok := FieldByID(id); ok {
+		return f.Key
+	}
+	return id
+}
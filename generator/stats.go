@@ -0,0 +1,85 @@
+package generator
+
+import "math"
+
+// Stats summarizes a calibration print.
+type Stats struct {
+	Layers         int     `json:"layers"`
+	Height         float64 `json:"height"`         // height of the towers in mm
+	FilamentLength float64 `json:"filamentLength"` // extruded filament in mm, retractions not counted, extra primes counted
+	FilamentVolume float64 `json:"filamentVolume"` // extruded filament in cm³
+	PrintDistance  float64 `json:"printDistance"`  // length of extruding moves in mm
+	TravelDistance float64 `json:"travelDistance"` // length of non-extruding moves in mm
+	Retractions    int     `json:"retractions"`
+	// PrintTime is the estimated duration of the moves and dwells in seconds.
+	// Acceleration, heating and the start and end G-code are not taken into account.
+	PrintTime float64 `json:"printTime"`
+
+	retracted float64 // filament retracted so far, a retraction split into a stationary part and wipes is counted once
+}
+
+// Add accounts for a move of the print.
+func (s *Stats) Add(m Move) {
+	switch m.Kind {
+	case MoveExtrude:
+		d := moveLength(m)
+		s.PrintDistance += d
+		s.FilamentLength += m.Extrusion
+		s.FilamentVolume += m.Extrusion * math.Pi * math.Pow(filamentDiameter/2, 2) / 1000
+		s.PrintTime += d / m.Feedrate
+		if m.Layer > s.Layers {
+			s.Layers = m.Layer
+		}
+		if m.To.Z > s.Height {
+			s.Height = m.To.Z
+		}
+	case MoveTravel, MoveZ, MoveCoast:
+		d := moveLength(m)
+		s.TravelDistance += d
+		s.PrintTime += d / m.Feedrate
+	case MoveRetract:
+		s.retract(m)
+		s.PrintTime += math.Abs(m.Extrusion) / m.Feedrate
+	case MoveWipe:
+		s.retract(m)
+		d := distance(m.From, m.To)
+		s.TravelDistance += d
+		s.PrintTime += d / m.Feedrate
+	case MoveUnretract:
+		// what is primed beyond the retraction is extruded
+		extra := m.Extrusion - s.retracted
+		s.FilamentLength += extra
+		s.FilamentVolume += extra * math.Pi * math.Pow(filamentDiameter/2, 2) / 1000
+		s.retracted = 0
+		s.PrintTime += math.Abs(m.Extrusion) / m.Feedrate
+	case MoveDwell:
+		s.PrintTime += m.Value
+	}
+}
+
+func (s *Stats) retract(m Move) {
+	if s.retracted == 0 {
+		s.Retractions++
+	}
+	s.retracted -= m.Extrusion
+}
+
+// PrintStats validates p and returns the statistics of its print.
+// If p is invalid, the returned error is ValidationErrors.
+func PrintStats(p Params) (Stats, error) {
+	var s Stats
+	err := Toolpath(p, s.Add)
+	return s, err
+}
+
+func distance(a, b Point) float64 {
+	return math.Sqrt(math.Pow(b.X-a.X, 2) + math.Pow(b.Y-a.Y, 2) + math.Pow(b.Z-a.Z, 2))
+}
+
+// moveLength is the length of the path of m, along the arc for arc moves.
+func moveLength(m Move) float64 {
+	if m.Arc {
+		return arcSweep(m.Center, m.From, m.To) * math.Hypot(m.From.X-m.Center.X, m.From.Y-m.Center.Y)
+	}
+	return distance(m.From, m.To)
+}
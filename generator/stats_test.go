@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	p := DefaultParams()
+
+	var gcode strings.Builder
+	stats, err := GenerateWithStats(&gcode, p, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, err := PrintStats(p); err != nil || stats != want {
+		t.Errorf("GenerateWithStats returned %+v, PrintStats %+v, %v", stats, want, err)
+	}
+
+	if want := p.NumSegments * p.layersPerSegment(); stats.Layers != want {
+		t.Errorf("Layers = %d, want %d", stats.Layers, want)
+	}
+	if want := float64(stats.Layers) * p.LayerHeight; math.Abs(stats.Height-want) > 1e-6 {
+		t.Errorf("Height = %v, want %v", stats.Height, want)
+	}
+
+	// count decreasing extruder positions up to the end G-code
+	retractions := 0
+	lastE := 0.0
+	towers := gcode.String()[:strings.Index(gcode.String(), ";end gcode")]
+	for _, line := range strings.Split(towers, "\n") {
+		fields := strings.Fields(line)
+		for _, f := range fields {
+			if !strings.HasPrefix(f, "E") || fields[0] != "G1" {
+				continue
+			}
+			e, err := strconv.ParseFloat(f[1:], 64)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e < lastE {
+				retractions++
+			}
+			lastE = e
+		}
+	}
+	if stats.Retractions != retractions {
+		t.Errorf("Retractions = %d, want %d", stats.Retractions, retractions)
+	}
+
+	if stats.FilamentLength <= 0 || stats.PrintTime <= 0 || stats.TravelDistance <= 0 {
+		t.Errorf("empty stats %+v", stats)
+	}
+}
+
+// TestStatsExtraPrime checks that extra primes are counted as extruded
+// filament, so that the filament length matches the final extruder position.
+func TestStatsExtraPrime(t *testing.T) {
+	p := DefaultParams()
+	p.InitExtraPrime, p.EndExtraPrime = 0.3, -0.2
+	p.InitWipeDistance, p.WipeRetract = 2, 50
+
+	var stats Stats
+	lastE := 0.0
+	err := Toolpath(p, func(m Move) {
+		stats.Add(m)
+		if m.Kind == MoveExtrude {
+			lastE = m.E
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(stats.FilamentLength-lastE) > 1e-6 {
+		t.Errorf("FilamentLength = %v, extruder ends at %v", stats.FilamentLength, lastE)
+	}
+}
+
+// TestStatsRetractDwell checks that the dwells after the retractions
+// are included in the print time.
+func TestStatsRetractDwell(t *testing.T) {
+	p := DefaultParams()
+	without, err := PrintStats(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.InitRetractDwell, p.EndRetractDwell = 1, 2
+	dwells := 0.0
+	err = Toolpath(p, func(m Move) {
+		if m.Kind == MoveDwell {
+			dwells += m.Value
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	with, err := PrintStats(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dwells == 0 || math.Abs(with.PrintTime-without.PrintTime-dwells) > 1e-6 {
+		t.Errorf("PrintTime = %v, %v without %v s of dwells", with.PrintTime, without.PrintTime, dwells)
+	}
+}
+
+func TestWarnings(t *testing.T) {
+	if w := Warnings(DefaultParams()); len(w) != 0 {
+		t.Errorf("default parameters have warnings %v", w)
+	}
+
+	p := DefaultParams()
+	p.LayerHeight, p.SegmentHeight = 0.2, 0.7
+	p.EndRetractLength = 0
+	w := Warnings(p)
+	if len(w) != 2 {
+		t.Fatalf("got warnings %v, want 2", w)
+	}
+	if w[0].Field != "segmentHeight" || w[0].Actual != 0.6 {
+		t.Errorf("segment height warning %+v", w[0])
+	}
+	if w[1].Field != "endRetractLength" || w[1].Actual != minRetractLength {
+		t.Errorf("retraction warning %+v", w[1])
+	}
+	if _, err := GenerateWithStats(io.Discard, p, Options{}); err != nil {
+		t.Error(err)
+	}
+
+	p = DefaultParams()
+	p.Firmware, p.FirmwareRetraction = FirmwareKlipper, true
+	p.InitWipeDistance, p.InitExtraPrime, p.EndExtraPrime = 2, 0.2, -0.3
+	w = Warnings(p)
+	if len(w) != 2 || w[0].Field != "initWipeDistance" || w[1].Field != "endExtraPrime" || w[1].Actual != 0 {
+		t.Errorf("firmware retraction warnings %v", w)
+	}
+
+	p = DefaultParams()
+	p.Firmware, p.InitTravelAccel, p.EndTravelAccel = FirmwareKlipper, 1000, 5000
+	if w = Warnings(p); len(w) != 1 || w[0].Field != "initTravelAccel" {
+		t.Errorf("travel acceleration warnings %v", w)
+	}
+	if p.Firmware = FirmwareRRF; len(Warnings(p)) != 0 {
+		t.Errorf("travel acceleration warnings on RRF %v", Warnings(p))
+	}
+
+	p = DefaultParams()
+	p.TowerShape, p.CoastVolume, p.InitCoast, p.EndCoast = ShapeRound, true, 1, 10
+	if w = Warnings(p); len(w) != 1 || w[0].Field != "endCoast" || w[0].Actual >= 10 {
+		t.Errorf("coast warnings %v", w)
+	}
+	if p.EndCoast = 1; len(Warnings(p)) != 0 {
+		t.Errorf("coast warnings of a short coasting %v", Warnings(p))
+	}
+
+	p = DefaultParams()
+	p.ArcMoves = true
+	if w = Warnings(p); len(w) != 1 || w[0].Field != "arcMoves" {
+		t.Errorf("arc moves warnings %v", w)
+	}
+	for firmware, message := range map[Firmware]string{
+		FirmwareMarlin:  "warning.arc_moves.marlin",
+		FirmwareKlipper: "warning.arc_moves.klipper",
+	} {
+		p.TowerShape, p.Firmware = ShapeRound, firmware
+		if w = Warnings(p); len(w) != 1 || w[0].Field != "arcMoves" || w[0].Message != message {
+			t.Errorf("arc moves warnings on %v %v", firmware, w)
+		}
+	}
+	if p.Firmware = FirmwareRRF; len(Warnings(p)) != 0 {
+		t.Errorf("arc moves warnings on RRF %v", Warnings(p))
+	}
+}
@@ -0,0 +1,1629 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Coasting: 0.2-2 [mm]
+;Segment 4:   0.2mm @ 30mm/s @ coast 2mm
+;Segment 3:   0.47mm @ 30mm/s @ coast 1.4mm
+;Segment 2:   0.73mm @ 30mm/s @ coast 0.8mm
+;Segment 1:   1mm @ 30mm/s @ coast 0.2mm
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 E202.83 F1800
+G1 X160.46 Y110.46 F9000
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 Y124.54 E204.42 F3600
+G1 X174.54 E205.0054
+G1 Y110.46 E205.5908
+G1 X160.46 E206.1762
+G1 X160.1 Y110.1
+G1 Y124.9 E206.7915
+G1 X174.9 E207.4068
+G1 Y110.1 E208.0221
+G1 X160.3 E208.6291
+G1 X160.1
+G1 E207.63 F1800
+G1 X74.54 Y110.46 F9000
+G1 E208.63 F1800
+G1 X60.46 E209.2145 F3600
+G1 Y124.54 E209.7999
+G1 X74.54 E210.3853
+G1 Y110.46 E210.9706
+G1 X74.9 Y110.1
+G1 X60.1 E211.5859
+G1 Y124.9 E212.2013
+G1 X74.9 E212.8166
+G1 Y110.3 E213.4236
+G1 Y110.1
+;layer #3
+M106 S254
+G1 E212.42 F1800
+G1 X74.54 Y110.46 F9000
+G1 E213.42 F1800
+G1 Z0.75 F300
+G1 X60.46 E214.0089 F3600
+G1 Y124.54 E214.5943
+G1 X74.54 E215.1797
+G1 Y110.46 E215.7651
+G1 X74.9 Y110.1
+G1 X60.1 E216.3804
+G1 Y124.9 E216.9957
+G1 X74.9 E217.611
+G1 Y110.3 E218.218
+G1 Y110.1
+G1 E217.22 F1800
+G1 X160.46 Y110.46 F9000
+G1 E218.22 F1800
+G1 Y124.54 E218.8034 F3600
+G1 X174.54 E219.3888
+G1 Y110.46 E219.9742
+G1 X160.46 E220.5595
+G1 X160.1 Y110.1
+G1 Y124.9 E221.1748
+G1 X174.9 E221.7902
+G1 Y110.1 E222.4055
+G1 X160.3 E223.0125
+G1 X160.1
+;layer #4
+G1 E222.01 F1800
+G1 X160.46 Y110.46 F9000
+G1 E223.01 F1800
+G1 Z1 F300
+G1 Y124.54 E223.5978 F3600
+G1 X174.54 E224.1832
+G1 Y110.46 E224.7686
+G1 X160.46 E225.354
+G1 X160.1 Y110.1
+G1 Y124.9 E225.9693
+G1 X174.9 E226.5846
+G1 Y110.1 E227.1999
+G1 X160.3 E227.8069
+G1 X160.1
+G1 E226.81 F1800
+G1 X74.54 Y110.46 F9000
+G1 E227.81 F1800
+G1 X60.46 E228.3923 F3600
+G1 Y124.54 E228.9777
+G1 X74.54 E229.563
+G1 Y110.46 E230.1484
+G1 X74.9 Y110.1
+G1 X60.1 E230.7637
+G1 Y124.9 E231.3791
+G1 X74.9 E231.9944
+G1 Y110.3 E232.6014
+G1 Y110.1
+;layer #5
+G1 E231.6 F1800
+G1 X74.54 Y110.46 F9000
+G1 E232.6 F1800
+G1 Z1.25 F300
+G1 X60.46 E233.1867 F3600
+G1 Y124.54 E233.7721
+G1 X74.54 E234.3575
+G1 Y110.46 E234.9429
+G1 X74.9 Y110.1
+G1 X60.1 E235.5582
+G1 Y124.9 E236.1735
+G1 X74.9 E236.7888
+G1 Y110.3 E237.3958
+G1 Y110.1
+G1 E236.4 F1800
+G1 X160.46 Y110.46 F9000
+G1 E237.4 F1800
+G1 Y124.54 E237.9812 F3600
+G1 X174.54 E238.5666
+G1 Y110.46 E239.1519
+G1 X160.46 E239.7373
+G1 X160.1 Y110.1
+G1 Y124.9 E240.3526
+G1 X174.9 E240.9679
+G1 Y110.1 E241.5833
+G1 X160.3 E242.1903
+G1 X160.1
+;layer #6
+G1 E241.19 F1800
+G1 X160.46 Y110.46 F9000
+G1 E242.19 F1800
+G1 Z1.5 F300
+G1 Y124.54 E242.7756 F3600
+G1 X174.54 E243.361
+G1 Y110.46 E243.9464
+G1 X160.46 E244.5318
+G1 X160.1 Y110.1
+G1 Y124.9 E245.1471
+G1 X174.9 E245.7624
+G1 Y110.1 E246.3777
+G1 X160.3 E246.9847
+G1 X160.1
+G1 E245.98 F1800
+G1 X74.54 Y110.46 F9000
+G1 E246.98 F1800
+G1 X60.46 E247.5701 F3600
+G1 Y124.54 E248.1555
+G1 X74.54 E248.7408
+G1 Y110.46 E249.3262
+G1 X74.9 Y110.1
+G1 X60.1 E249.9415
+G1 Y124.9 E250.5568
+G1 X74.9 E251.1722
+G1 Y110.3 E251.7792
+G1 Y110.1
+;layer #7
+G1 E250.78 F1800
+G1 X74.54 Y110.46 F9000
+G1 E251.78 F1800
+G1 Z1.75 F300
+G1 X60.46 E252.3645 F3600
+G1 Y124.54 E252.9499
+G1 X74.54 E253.5353
+G1 Y110.46 E254.1207
+G1 X74.9 Y110.1
+G1 X60.1 E254.736
+G1 Y124.9 E255.3513
+G1 X74.9 E255.9666
+G1 Y110.3 E256.5736
+G1 Y110.1
+G1 E255.57 F1800
+G1 X160.46 Y110.46 F9000
+G1 E256.57 F1800
+G1 Y124.54 E257.159 F3600
+G1 X174.54 E257.7444
+G1 Y110.46 E258.3297
+G1 X160.46 E258.9151
+G1 X160.1 Y110.1
+G1 Y124.9 E259.5304
+G1 X174.9 E260.1457
+G1 Y110.1 E260.7611
+G1 X160.3 E261.3681
+G1 X160.1
+;layer #8
+G1 E260.37 F1800
+G1 X160.46 Y110.46 F9000
+G1 E261.37 F1800
+G1 Z2 F300
+G1 Y124.54 E261.9534 F3600
+G1 X174.54 E262.5388
+G1 Y110.46 E263.1242
+G1 X160.46 E263.7096
+G1 X160.1 Y110.1
+G1 Y124.9 E264.3249
+G1 X174.9 E264.9402
+G1 Y110.1 E265.5555
+G1 X160.3 E266.1625
+G1 X160.1
+G1 E265.16 F1800
+G1 X74.54 Y110.46 F9000
+G1 E266.16 F1800
+G1 X60.46 E266.7479 F3600
+G1 Y124.54 E267.3333
+G1 X74.54 E267.9186
+G1 Y110.46 E268.504
+G1 X74.9 Y110.1
+G1 X60.1 E269.1193
+G1 Y124.9 E269.7346
+G1 X74.9 E270.35
+G1 Y110.3 E270.9569
+G1 Y110.1
+;layer #9
+G1 E269.96 F1800
+G1 X74.54 Y110.46 F9000
+G1 E270.96 F1800
+G1 Z2.25 F300
+G1 X60.46 E271.5423 F3600
+G1 Y124.54 E272.1277
+G1 X74.54 E272.7131
+G1 Y110.46 E273.2985
+G1 X74.9 Y110.1
+G1 X60.1 E273.9138
+G1 Y124.9 E274.5291
+G1 X74.9 E275.1444
+G1 Y110.3 E275.7514
+G1 Y110.1
+G1 E274.75 F1800
+G1 X160.46 Y110.46 F9000
+G1 E275.75 F1800
+G1 Y124.54 E276.3368 F3600
+G1 X174.54 E276.9222
+G1 Y110.46 E277.5075
+G1 X160.46 E278.0929
+G1 X160.1 Y110.1
+G1 Y124.9 E278.7082
+G1 X174.9 E279.3235
+G1 Y110.1 E279.9388
+G1 X160.3 E280.5458
+G1 X160.1
+;layer #10
+G1 E279.55 F1800
+G1 X160.46 Y110.46 F9000
+G1 E280.55 F1800
+G1 Z2.5 F300
+G1 Y124.54 E281.1312 F3600
+G1 X174.54 E281.7166
+G1 Y110.46 E282.302
+G1 X160.46 E282.8874
+G1 X160.1 Y110.1
+G1 Y124.9 E283.5027
+G1 X174.9 E284.118
+G1 Y110.1 E284.7333
+G1 X160.3 E285.3403
+G1 X160.1
+G1 E284.34 F1800
+G1 X74.54 Y110.46 F9000
+G1 E285.34 F1800
+G1 X60.46 E285.9257 F3600
+G1 Y124.54 E286.5111
+G1 X74.54 E287.0964
+G1 Y110.46 E287.6818
+G1 X74.9 Y110.1
+G1 X60.1 E288.2971
+G1 Y124.9 E288.9124
+G1 X74.9 E289.5277
+G1 Y110.3 E290.1347
+G1 Y110.1
+;layer #11
+G1 E289.13 F1800
+G1 X74.54 Y110.46 F9000
+G1 E290.13 F1800
+G1 Z2.75 F300
+G1 X60.46 E290.7201 F3600
+G1 Y124.54 E291.3055
+G1 X74.54 E291.8909
+G1 Y110.46 E292.4763
+G1 X74.9 Y110.1
+G1 X60.1 E293.0916
+G1 Y124.9 E293.7069
+G1 X74.9 E294.3222
+G1 Y110.3 E294.9292
+G1 Y110.1
+G1 E293.93 F1800
+G1 X160.46 Y110.46 F9000
+G1 E294.93 F1800
+G1 Y124.54 E295.5146 F3600
+G1 X174.54 E296.0999
+G1 Y110.46 E296.6853
+G1 X160.46 E297.2707
+G1 X160.1 Y110.1
+G1 Y124.9 E297.886
+G1 X174.9 E298.5013
+G1 Y110.1 E299.1166
+G1 X160.3 E299.7236
+G1 X160.1
+;layer #12
+G1 E298.72 F1800
+G1 X160.46 Y110.46 F9000
+G1 E299.72 F1800
+G1 Z3 F300
+G1 Y124.54 E300.309 F3600
+G1 X174.54 E300.8944
+G1 Y110.46 E301.4798
+G1 X160.46 E302.0652
+G1 X160.1 Y110.1
+G1 Y124.9 E302.6805
+G1 X174.9 E303.2958
+G1 Y110.1 E303.9111
+G1 X160.3 E304.5181
+G1 X160.1
+G1 E303.52 F1800
+G1 X74.54 Y110.46 F9000
+G1 E304.52 F1800
+G1 X60.46 E305.1035 F3600
+G1 Y124.54 E305.6888
+G1 X74.54 E306.2742
+G1 Y110.46 E306.8596
+G1 X74.9 Y110.1
+G1 X60.1 E307.4749
+G1 Y124.9 E308.0902
+G1 X74.9 E308.7055
+G1 Y110.3 E309.3125
+G1 Y110.1
+;layer #13
+G1 E308.58 F1800
+G1 X74.64 Y110.36 F9000
+G1 E309.31 F1800
+G1 Z3.25 F300
+G1 X60.36 E309.9062 F3600
+G1 Y124.64 E310.4999
+G1 X74.64 E311.0936
+G1 Y110.36 E311.6873
+G1 X75 Y110
+G1 X60 E312.3109
+G1 Y125 E312.9346
+G1 X75 E313.5582
+G1 Y110.8 E314.1486
+G1 Y110
+G1 E313.42 F1800
+G1 X160.36 Y110.36 F9000
+G1 E314.15 F1800
+G1 Y124.64 E314.7423 F3600
+G1 X174.64 E315.3359
+G1 Y110.36 E315.9296
+G1 X160.36 E316.5233
+G1 X160 Y110
+G1 Y125 E317.147
+G1 X175 E317.7706
+G1 Y110 E318.3942
+G1 X160.8 E318.9846
+G1 X160
+;layer #14
+G1 E318.25 F1800
+G1 X160.46 Y110.46 F9000
+G1 E318.98 F1800
+G1 Z3.5 F300
+G1 Y124.54 E319.57 F3600
+G1 X174.54 E320.1553
+G1 Y110.46 E320.7407
+G1 X160.46 E321.3261
+G1 X160.1 Y110.1
+G1 Y124.9 E321.9414
+G1 X174.9 E322.5567
+G1 Y110.1 E323.172
+G1 X160.9 E323.7541
+G1 X160.1
+G1 E323.02 F1800
+G1 X74.54 Y110.46 F9000
+G1 E323.75 F1800
+G1 X60.46 E324.3395 F3600
+G1 Y124.54 E324.9248
+G1 X74.54 E325.5102
+G1 Y110.46 E326.0956
+G1 X74.9 Y110.1
+G1 X60.1 E326.7109
+G1 Y124.9 E327.3262
+G1 X74.9 E327.9415
+G1 Y110.9 E328.5236
+G1 Y110.1
+;layer #15
+G1 E327.79 F1800
+G1 X74.54 Y110.46 F9000
+G1 E328.52 F1800
+G1 Z3.75 F300
+G1 X60.46 E329.109 F3600
+G1 Y124.54 E329.6943
+G1 X74.54 E330.2797
+G1 Y110.46 E330.8651
+G1 X74.9 Y110.1
+G1 X60.1 E331.4804
+G1 Y124.9 E332.0957
+G1 X74.9 E332.711
+G1 Y110.9 E333.2931
+G1 Y110.1
+G1 E332.56 F1800
+G1 X160.46 Y110.46 F9000
+G1 E333.29 F1800
+G1 Y124.54 E333.8785 F3600
+G1 X174.54 E334.4639
+G1 Y110.46 E335.0492
+G1 X160.46 E335.6346
+G1 X160.1 Y110.1
+G1 Y124.9 E336.2499
+G1 X174.9 E336.8652
+G1 Y110.1 E337.4805
+G1 X160.9 E338.0626
+G1 X160.1
+;layer #16
+G1 E337.33 F1800
+G1 X160.46 Y110.46 F9000
+G1 E338.06 F1800
+G1 Z4 F300
+G1 Y124.54 E338.648 F3600
+G1 X174.54 E339.2334
+G1 Y110.46 E339.8187
+G1 X160.46 E340.4041
+G1 X160.1 Y110.1
+G1 Y124.9 E341.0194
+G1 X174.9 E341.6347
+G1 Y110.1 E342.25
+G1 X160.9 E342.8321
+G1 X160.1
+G1 E342.1 F1800
+G1 X74.54 Y110.46 F9000
+G1 E342.83 F1800
+G1 X60.46 E343.4175 F3600
+G1 Y124.54 E344.0029
+G1 X74.54 E344.5882
+G1 Y110.46 E345.1736
+G1 X74.9 Y110.1
+G1 X60.1 E345.7889
+G1 Y124.9 E346.4042
+G1 X74.9 E347.0196
+G1 Y110.9 E347.6016
+G1 Y110.1
+;layer #17
+G1 E346.87 F1800
+G1 X74.54 Y110.46 F9000
+G1 E347.6 F1800
+G1 Z4.25 F300
+G1 X60.46 E348.187 F3600
+G1 Y124.54 E348.7724
+G1 X74.54 E349.3577
+G1 Y110.46 E349.9431
+G1 X74.9 Y110.1
+G1 X60.1 E350.5584
+G1 Y124.9 E351.1737
+G1 X74.9 E351.7891
+G1 Y110.9 E352.3711
+G1 Y110.1
+G1 E351.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E352.37 F1800
+G1 Y124.54 E352.9565 F3600
+G1 X174.54 E353.5419
+G1 Y110.46 E354.1272
+G1 X160.46 E354.7126
+G1 X160.1 Y110.1
+G1 Y124.9 E355.3279
+G1 X174.9 E355.9432
+G1 Y110.1 E356.5586
+G1 X160.9 E357.1406
+G1 X160.1
+;layer #18
+G1 E356.41 F1800
+G1 X160.46 Y110.46 F9000
+G1 E357.14 F1800
+G1 Z4.5 F300
+G1 Y124.54 E357.726 F3600
+G1 X174.54 E358.3114
+G1 Y110.46 E358.8967
+G1 X160.46 E359.4821
+G1 X160.1 Y110.1
+G1 Y124.9 E360.0974
+G1 X174.9 E360.7127
+G1 Y110.1 E361.3281
+G1 X160.9 E361.9101
+G1 X160.1
+G1 E361.18 F1800
+G1 X74.54 Y110.46 F9000
+G1 E361.91 F1800
+G1 X60.46 E362.4955 F3600
+G1 Y124.54 E363.0809
+G1 X74.54 E363.6662
+G1 Y110.46 E364.2516
+G1 X74.9 Y110.1
+G1 X60.1 E364.8669
+G1 Y124.9 E365.4823
+G1 X74.9 E366.0976
+G1 Y110.9 E366.6796
+G1 Y110.1
+;layer #19
+G1 E365.95 F1800
+G1 X74.54 Y110.46 F9000
+G1 E366.68 F1800
+G1 Z4.75 F300
+G1 X60.46 E367.265 F3600
+G1 Y124.54 E367.8504
+G1 X74.54 E368.4358
+G1 Y110.46 E369.0211
+G1 X74.9 Y110.1
+G1 X60.1 E369.6364
+G1 Y124.9 E370.2518
+G1 X74.9 E370.8671
+G1 Y110.9 E371.4491
+G1 Y110.1
+G1 E370.72 F1800
+G1 X160.46 Y110.46 F9000
+G1 E371.45 F1800
+G1 Y124.54 E372.0345 F3600
+G1 X174.54 E372.6199
+G1 Y110.46 E373.2053
+G1 X160.46 E373.7906
+G1 X160.1 Y110.1
+G1 Y124.9 E374.4059
+G1 X174.9 E375.0213
+G1 Y110.1 E375.6366
+G1 X160.9 E376.2186
+G1 X160.1
+;layer #20
+G1 E375.49 F1800
+G1 X160.46 Y110.46 F9000
+G1 E376.22 F1800
+G1 Z5 F300
+G1 Y124.54 E376.804 F3600
+G1 X174.54 E377.3894
+G1 Y110.46 E377.9748
+G1 X160.46 E378.5601
+G1 X160.1 Y110.1
+G1 Y124.9 E379.1755
+G1 X174.9 E379.7908
+G1 Y110.1 E380.4061
+G1 X160.9 E380.9881
+G1 X160.1
+G1 E380.25 F1800
+G1 X74.54 Y110.46 F9000
+G1 E380.99 F1800
+G1 X60.46 E381.5735 F3600
+G1 Y124.54 E382.1589
+G1 X74.54 E382.7443
+G1 Y110.46 E383.3296
+G1 X74.9 Y110.1
+G1 X60.1 E383.945
+G1 Y124.9 E384.5603
+G1 X74.9 E385.1756
+G1 Y110.9 E385.7576
+G1 Y110.1
+;layer #21
+G1 E385.02 F1800
+G1 X74.54 Y110.46 F9000
+G1 E385.76 F1800
+G1 Z5.25 F300
+G1 X60.46 E386.343 F3600
+G1 Y124.54 E386.9284
+G1 X74.54 E387.5138
+G1 Y110.46 E388.0991
+G1 X74.9 Y110.1
+G1 X60.1 E388.7145
+G1 Y124.9 E389.3298
+G1 X74.9 E389.9451
+G1 Y110.9 E390.5271
+G1 Y110.1
+G1 E389.79 F1800
+G1 X160.46 Y110.46 F9000
+G1 E390.53 F1800
+G1 Y124.54 E391.1125 F3600
+G1 X174.54 E391.6979
+G1 Y110.46 E392.2833
+G1 X160.46 E392.8686
+G1 X160.1 Y110.1
+G1 Y124.9 E393.484
+G1 X174.9 E394.0993
+G1 Y110.1 E394.7146
+G1 X160.9 E395.2966
+G1 X160.1
+;layer #22
+G1 E394.56 F1800
+G1 X160.46 Y110.46 F9000
+G1 E395.3 F1800
+G1 Z5.5 F300
+G1 Y124.54 E395.882 F3600
+G1 X174.54 E396.4674
+G1 Y110.46 E397.0528
+G1 X160.46 E397.6382
+G1 X160.1 Y110.1
+G1 Y124.9 E398.2535
+G1 X174.9 E398.8688
+G1 Y110.1 E399.4841
+G1 X160.9 E400.0661
+G1 X160.1
+G1 E399.33 F1800
+G1 X74.54 Y110.46 F9000
+G1 E400.07 F1800
+G1 X60.46 E400.6515 F3600
+G1 Y124.54 E401.2369
+G1 X74.54 E401.8223
+G1 Y110.46 E402.4077
+G1 X74.9 Y110.1
+G1 X60.1 E403.023
+G1 Y124.9 E403.6383
+G1 X74.9 E404.2536
+G1 Y110.9 E404.8356
+G1 Y110.1
+;layer #23
+G1 E404.1 F1800
+G1 X74.54 Y110.46 F9000
+G1 E404.84 F1800
+G1 Z5.75 F300
+G1 X60.46 E405.421 F3600
+G1 Y124.54 E406.0064
+G1 X74.54 E406.5918
+G1 Y110.46 E407.1772
+G1 X74.9 Y110.1
+G1 X60.1 E407.7925
+G1 Y124.9 E408.4078
+G1 X74.9 E409.0231
+G1 Y110.9 E409.6051
+G1 Y110.1
+G1 E408.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E409.61 F1800
+G1 Y124.54 E410.1905 F3600
+G1 X174.54 E410.7759
+G1 Y110.46 E411.3613
+G1 X160.46 E411.9467
+G1 X160.1 Y110.1
+G1 Y124.9 E412.562
+G1 X174.9 E413.1773
+G1 Y110.1 E413.7926
+G1 X160.9 E414.3747
+G1 X160.1
+;layer #24
+G1 E413.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E414.37 F1800
+G1 Z6 F300
+G1 Y124.54 E414.96 F3600
+G1 X174.54 E415.5454
+G1 Y110.46 E416.1308
+G1 X160.46 E416.7162
+G1 X160.1 Y110.1
+G1 Y124.9 E417.3315
+G1 X174.9 E417.9468
+G1 Y110.1 E418.5621
+G1 X160.9 E419.1442
+G1 X160.1
+G1 E418.41 F1800
+G1 X74.54 Y110.46 F9000
+G1 E419.14 F1800
+G1 X60.46 E419.7295 F3600
+G1 Y124.54 E420.3149
+G1 X74.54 E420.9003
+G1 Y110.46 E421.4857
+G1 X74.9 Y110.1
+G1 X60.1 E422.101
+G1 Y124.9 E422.7163
+G1 X74.9 E423.3316
+G1 Y110.9 E423.9137
+G1 Y110.1
+;layer #25
+G1 E423.45 F1800
+G1 X74.64 Y110.36 F9000
+G1 E423.91 F1800
+G1 Z6.25 F300
+G1 X60.36 E424.5074 F3600
+G1 Y124.64 E425.101
+G1 X74.64 E425.6947
+G1 Y110.36 E426.2884
+G1 X75 Y110
+G1 X60 E426.9121
+G1 Y125 E427.5357
+G1 X75 E428.1593
+G1 Y111.4 E428.7247
+G1 Y110
+G1 E428.26 F1800
+G1 X160.36 Y110.36 F9000
+G1 E428.72 F1800
+G1 Y124.64 E429.3184 F3600
+G1 X174.64 E429.9121
+G1 Y110.36 E430.5058
+G1 X160.36 E431.0995
+G1 X160 Y110
+G1 Y125 E431.7231
+G1 X175 E432.3468
+G1 Y110 E432.9704
+G1 X161.4 E433.5358
+G1 X160
+;layer #26
+G1 E433.07 F1800
+G1 X160.46 Y110.46 F9000
+G1 E433.54 F1800
+G1 Z6.5 F300
+G1 Y124.54 E434.1212 F3600
+G1 X174.54 E434.7066
+G1 Y110.46 E435.292
+G1 X160.46 E435.8773
+G1 X160.1 Y110.1
+G1 Y124.9 E436.4926
+G1 X174.9 E437.108
+G1 Y110.1 E437.7233
+G1 X161.5 E438.2804
+G1 X160.1
+G1 E437.81 F1800
+G1 X74.54 Y110.46 F9000
+G1 E438.28 F1800
+G1 X60.46 E438.8658 F3600
+G1 Y124.54 E439.4511
+G1 X74.54 E440.0365
+G1 Y110.46 E440.6219
+G1 X74.9 Y110.1
+G1 X60.1 E441.2372
+G1 Y124.9 E441.8525
+G1 X74.9 E442.4678
+G1 Y111.5 E443.0249
+G1 Y110.1
+;layer #27
+G1 E442.56 F1800
+G1 X74.54 Y110.46 F9000
+G1 E443.02 F1800
+G1 Z6.75 F300
+G1 X60.46 E443.6103 F3600
+G1 Y124.54 E444.1957
+G1 X74.54 E444.7811
+G1 Y110.46 E445.3664
+G1 X74.9 Y110.1
+G1 X60.1 E445.9818
+G1 Y124.9 E446.5971
+G1 X74.9 E447.2124
+G1 Y111.5 E447.7695
+G1 Y110.1
+G1 E447.3 F1800
+G1 X160.46 Y110.46 F9000
+G1 E447.77 F1800
+G1 Y124.54 E448.3549 F3600
+G1 X174.54 E448.9402
+G1 Y110.46 E449.5256
+G1 X160.46 E450.111
+G1 X160.1 Y110.1
+G1 Y124.9 E450.7263
+G1 X174.9 E451.3416
+G1 Y110.1 E451.9569
+G1 X161.5 E452.514
+G1 X160.1
+;layer #28
+G1 E452.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E452.51 F1800
+G1 Z7 F300
+G1 Y124.54 E453.0994 F3600
+G1 X174.54 E453.6848
+G1 Y110.46 E454.2702
+G1 X160.46 E454.8556
+G1 X160.1 Y110.1
+G1 Y124.9 E455.4709
+G1 X174.9 E456.0862
+G1 Y110.1 E456.7015
+G1 X161.5 E457.2586
+G1 X160.1
+G1 E456.79 F1800
+G1 X74.54 Y110.46 F9000
+G1 E457.26 F1800
+G1 X60.46 E457.844 F3600
+G1 Y124.54 E458.4294
+G1 X74.54 E459.0147
+G1 Y110.46 E459.6001
+G1 X74.9 Y110.1
+G1 X60.1 E460.2154
+G1 Y124.9 E460.8307
+G1 X74.9 E461.4461
+G1 Y111.5 E462.0032
+G1 Y110.1
+;layer #29
+G1 E461.54 F1800
+G1 X74.54 Y110.46 F9000
+G1 E462 F1800
+G1 Z7.25 F300
+G1 X60.46 E462.5885 F3600
+G1 Y124.54 E463.1739
+G1 X74.54 E463.7593
+G1 Y110.46 E464.3447
+G1 X74.9 Y110.1
+G1 X60.1 E464.96
+G1 Y124.9 E465.5753
+G1 X74.9 E466.1906
+G1 Y111.5 E466.7477
+G1 Y110.1
+G1 E466.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E466.75 F1800
+G1 Y124.54 E467.3331 F3600
+G1 X174.54 E467.9185
+G1 Y110.46 E468.5039
+G1 X160.46 E469.0892
+G1 X160.1 Y110.1
+G1 Y124.9 E469.7045
+G1 X174.9 E470.3199
+G1 Y110.1 E470.9352
+G1 X161.5 E471.4923
+G1 X160.1
+;layer #30
+G1 E471.03 F1800
+G1 X160.46 Y110.46 F9000
+G1 E471.49 F1800
+G1 Z7.5 F300
+G1 Y124.54 E472.0777 F3600
+G1 X174.54 E472.663
+G1 Y110.46 E473.2484
+G1 X160.46 E473.8338
+G1 X160.1 Y110.1
+G1 Y124.9 E474.4491
+G1 X174.9 E475.0644
+G1 Y110.1 E475.6797
+G1 X161.5 E476.2368
+G1 X160.1
+G1 E475.77 F1800
+G1 X74.54 Y110.46 F9000
+G1 E476.24 F1800
+G1 X60.46 E476.8222 F3600
+G1 Y124.54 E477.4076
+G1 X74.54 E477.993
+G1 Y110.46 E478.5784
+G1 X74.9 Y110.1
+G1 X60.1 E479.1937
+G1 Y124.9 E479.809
+G1 X74.9 E480.4243
+G1 Y111.5 E480.9814
+G1 Y110.1
+;layer #31
+G1 E480.51 F1800
+G1 X74.54 Y110.46 F9000
+G1 E480.98 F1800
+G1 Z7.75 F300
+G1 X60.46 E481.5668 F3600
+G1 Y124.54 E482.1522
+G1 X74.54 E482.7375
+G1 Y110.46 E483.3229
+G1 X74.9 Y110.1
+G1 X60.1 E483.9382
+G1 Y124.9 E484.5535
+G1 X74.9 E485.1688
+G1 Y111.5 E485.726
+G1 Y110.1
+G1 E485.26 F1800
+G1 X160.46 Y110.46 F9000
+G1 E485.73 F1800
+G1 Y124.54 E486.3113 F3600
+G1 X174.54 E486.8967
+G1 Y110.46 E487.4821
+G1 X160.46 E488.0675
+G1 X160.1 Y110.1
+G1 Y124.9 E488.6828
+G1 X174.9 E489.2981
+G1 Y110.1 E489.9134
+G1 X161.5 E490.4705
+G1 X160.1
+;layer #32
+G1 E490 F1800
+G1 X160.46 Y110.46 F9000
+G1 E490.47 F1800
+G1 Z8 F300
+G1 Y124.54 E491.0559 F3600
+G1 X174.54 E491.6413
+G1 Y110.46 E492.2266
+G1 X160.46 E492.812
+G1 X160.1 Y110.1
+G1 Y124.9 E493.4273
+G1 X174.9 E494.0427
+G1 Y110.1 E494.658
+G1 X161.5 E495.2151
+G1 X160.1
+G1 E494.75 F1800
+G1 X74.54 Y110.46 F9000
+G1 E495.22 F1800
+G1 X60.46 E495.8005 F3600
+G1 Y124.54 E496.3858
+G1 X74.54 E496.9712
+G1 Y110.46 E497.5566
+G1 X74.9 Y110.1
+G1 X60.1 E498.1719
+G1 Y124.9 E498.7872
+G1 X74.9 E499.4025
+G1 Y111.5 E499.9596
+G1 Y110.1
+;layer #33
+G1 E499.49 F1800
+G1 X74.54 Y110.46 F9000
+G1 E499.96 F1800
+G1 Z8.25 F300
+G1 X60.46 E500.545 F3600
+G1 Y124.54 E501.1304
+G1 X74.54 E501.7158
+G1 Y110.46 E502.3011
+G1 X74.9 Y110.1
+G1 X60.1 E502.9165
+G1 Y124.9 E503.5318
+G1 X74.9 E504.1471
+G1 Y111.5 E504.7042
+G1 Y110.1
+G1 E504.24 F1800
+G1 X160.46 Y110.46 F9000
+G1 E504.7 F1800
+G1 Y124.54 E505.2896 F3600
+G1 X174.54 E505.8749
+G1 Y110.46 E506.4603
+G1 X160.46 E507.0457
+G1 X160.1 Y110.1
+G1 Y124.9 E507.661
+G1 X174.9 E508.2763
+G1 Y110.1 E508.8916
+G1 X161.5 E509.4487
+G1 X160.1
+;layer #34
+G1 E508.98 F1800
+G1 X160.46 Y110.46 F9000
+G1 E509.45 F1800
+G1 Z8.5 F300
+G1 Y124.54 E510.0341 F3600
+G1 X174.54 E510.6195
+G1 Y110.46 E511.2049
+G1 X160.46 E511.7903
+G1 X160.1 Y110.1
+G1 Y124.9 E512.4056
+G1 X174.9 E513.0209
+G1 Y110.1 E513.6362
+G1 X161.5 E514.1933
+G1 X160.1
+G1 E513.73 F1800
+G1 X74.54 Y110.46 F9000
+G1 E514.19 F1800
+G1 X60.46 E514.7787 F3600
+G1 Y124.54 E515.3641
+G1 X74.54 E515.9494
+G1 Y110.46 E516.5348
+G1 X74.9 Y110.1
+G1 X60.1 E517.1501
+G1 Y124.9 E517.7654
+G1 X74.9 E518.3808
+G1 Y111.5 E518.9379
+G1 Y110.1
+;layer #35
+G1 E518.47 F1800
+G1 X74.54 Y110.46 F9000
+G1 E518.94 F1800
+G1 Z8.75 F300
+G1 X60.46 E519.5232 F3600
+G1 Y124.54 E520.1086
+G1 X74.54 E520.694
+G1 Y110.46 E521.2794
+G1 X74.9 Y110.1
+G1 X60.1 E521.8947
+G1 Y124.9 E522.51
+G1 X74.9 E523.1253
+G1 Y111.5 E523.6824
+G1 Y110.1
+G1 E523.22 F1800
+G1 X160.46 Y110.46 F9000
+G1 E523.68 F1800
+G1 Y124.54 E524.2678 F3600
+G1 X174.54 E524.8532
+G1 Y110.46 E525.4386
+G1 X160.46 E526.0239
+G1 X160.1 Y110.1
+G1 Y124.9 E526.6392
+G1 X174.9 E527.2546
+G1 Y110.1 E527.8699
+G1 X161.5 E528.427
+G1 X160.1
+;layer #36
+G1 E527.96 F1800
+G1 X160.46 Y110.46 F9000
+G1 E528.43 F1800
+G1 Z9 F300
+G1 Y124.54 E529.0124 F3600
+G1 X174.54 E529.5977
+G1 Y110.46 E530.1831
+G1 X160.46 E530.7685
+G1 X160.1 Y110.1
+G1 Y124.9 E531.3838
+G1 X174.9 E531.9991
+G1 Y110.1 E532.6144
+G1 X161.5 E533.1715
+G1 X160.1
+G1 E532.7 F1800
+G1 X74.54 Y110.46 F9000
+G1 E533.17 F1800
+G1 X60.46 E533.7569 F3600
+G1 Y124.54 E534.3423
+G1 X74.54 E534.9277
+G1 Y110.46 E535.5131
+G1 X74.9 Y110.1
+G1 X60.1 E536.1284
+G1 Y124.9 E536.7437
+G1 X74.9 E537.359
+G1 Y111.5 E537.9161
+G1 Y110.1
+;layer #37
+G1 E537.72 F1800
+G1 X74.64 Y110.36 F9000
+G1 E537.92 F1800
+G1 Z9.25 F300
+G1 X60.36 E538.5098 F3600
+G1 Y124.64 E539.1035
+G1 X74.64 E539.6972
+G1 Y110.36 E540.2909
+G1 X75 Y110
+G1 X60 E540.9145
+G1 Y125 E541.5381
+G1 X75 E542.1618
+G1 Y112 E542.7022
+G1 Y110
+G1 E542.5 F1800
+G1 X160.36 Y110.36 F9000
+G1 E542.7 F1800
+G1 Y124.64 E543.2959 F3600
+G1 X174.64 E543.8896
+G1 Y110.36 E544.4833
+G1 X160.36 E545.077
+G1 X160 Y110
+G1 Y125 E545.7006
+G1 X175 E546.3243
+G1 Y110 E546.9479
+G1 X162 E547.4884
+G1 X160
+;layer #38
+G1 E547.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E547.49 F1800
+G1 Z9.5 F300
+G1 Y124.54 E548.0737 F3600
+G1 X174.54 E548.6591
+G1 Y110.46 E549.2445
+G1 X160.46 E549.8299
+G1 X160.1 Y110.1
+G1 Y124.9 E550.4452
+G1 X174.9 E551.0605
+G1 Y110.1 E551.6758
+G1 X162.1 E552.208
+G1 X160.1
+G1 E552.01 F1800
+G1 X74.54 Y110.46 F9000
+G1 E552.21 F1800
+G1 X60.46 E552.7934 F3600
+G1 Y124.54 E553.3787
+G1 X74.54 E553.9641
+G1 Y110.46 E554.5495
+G1 X74.9 Y110.1
+G1 X60.1 E555.1648
+G1 Y124.9 E555.7801
+G1 X74.9 E556.3954
+G1 Y112.1 E556.9276
+G1 Y110.1
+;layer #39
+G1 E556.73 F1800
+G1 X74.54 Y110.46 F9000
+G1 E556.93 F1800
+G1 Z9.75 F300
+G1 X60.46 E557.513 F3600
+G1 Y124.54 E558.0983
+G1 X74.54 E558.6837
+G1 Y110.46 E559.2691
+G1 X74.9 Y110.1
+G1 X60.1 E559.8844
+G1 Y124.9 E560.4997
+G1 X74.9 E561.115
+G1 Y112.1 E561.6472
+G1 Y110.1
+G1 E561.45 F1800
+G1 X160.46 Y110.46 F9000
+G1 E561.65 F1800
+G1 Y124.54 E562.2326 F3600
+G1 X174.54 E562.818
+G1 Y110.46 E563.4033
+G1 X160.46 E563.9887
+G1 X160.1 Y110.1
+G1 Y124.9 E564.604
+G1 X174.9 E565.2193
+G1 Y110.1 E565.8347
+G1 X162.1 E566.3668
+G1 X160.1
+;layer #40
+G1 E566.17 F1800
+G1 X160.46 Y110.46 F9000
+G1 E566.37 F1800
+G1 Z10 F300
+G1 Y124.54 E566.9522 F3600
+G1 X174.54 E567.5376
+G1 Y110.46 E568.123
+G1 X160.46 E568.7083
+G1 X160.1 Y110.1
+G1 Y124.9 E569.3236
+G1 X174.9 E569.939
+G1 Y110.1 E570.5543
+G1 X162.1 E571.0864
+G1 X160.1
+G1 E570.89 F1800
+G1 X74.54 Y110.46 F9000
+G1 E571.09 F1800
+G1 X60.46 E571.6718 F3600
+G1 Y124.54 E572.2572
+G1 X74.54 E572.8426
+G1 Y110.46 E573.4279
+G1 X74.9 Y110.1
+G1 X60.1 E574.0433
+G1 Y124.9 E574.6586
+G1 X74.9 E575.2739
+G1 Y112.1 E575.806
+G1 Y110.1
+;layer #41
+G1 E575.61 F1800
+G1 X74.54 Y110.46 F9000
+G1 E575.81 F1800
+G1 Z10.25 F300
+G1 X60.46 E576.3914 F3600
+G1 Y124.54 E576.9768
+G1 X74.54 E577.5622
+G1 Y110.46 E578.1476
+G1 X74.9 Y110.1
+G1 X60.1 E578.7629
+G1 Y124.9 E579.3782
+G1 X74.9 E579.9935
+G1 Y112.1 E580.5257
+G1 Y110.1
+G1 E580.33 F1800
+G1 X160.46 Y110.46 F9000
+G1 E580.53 F1800
+G1 Y124.54 E581.111 F3600
+G1 X174.54 E581.6964
+G1 Y110.46 E582.2818
+G1 X160.46 E582.8672
+G1 X160.1 Y110.1
+G1 Y124.9 E583.4825
+G1 X174.9 E584.0978
+G1 Y110.1 E584.7131
+G1 X162.1 E585.2453
+G1 X160.1
+;layer #42
+G1 E585.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E585.25 F1800
+G1 Z10.5 F300
+G1 Y124.54 E585.8306 F3600
+G1 X174.54 E586.416
+G1 Y110.46 E587.0014
+G1 X160.46 E587.5868
+G1 X160.1 Y110.1
+G1 Y124.9 E588.2021
+G1 X174.9 E588.8174
+G1 Y110.1 E589.4327
+G1 X162.1 E589.9649
+G1 X160.1
+G1 E589.76 F1800
+G1 X74.54 Y110.46 F9000
+G1 E589.96 F1800
+G1 X60.46 E590.5503 F3600
+G1 Y124.54 E591.1356
+G1 X74.54 E591.721
+G1 Y110.46 E592.3064
+G1 X74.9 Y110.1
+G1 X60.1 E592.9217
+G1 Y124.9 E593.537
+G1 X74.9 E594.1523
+G1 Y112.1 E594.6845
+G1 Y110.1
+;layer #43
+G1 E594.48 F1800
+G1 X74.54 Y110.46 F9000
+G1 E594.68 F1800
+G1 Z10.75 F300
+G1 X60.46 E595.2699 F3600
+G1 Y124.54 E595.8553
+G1 X74.54 E596.4406
+G1 Y110.46 E597.026
+G1 X74.9 Y110.1
+G1 X60.1 E597.6413
+G1 Y124.9 E598.2566
+G1 X74.9 E598.8719
+G1 Y112.1 E599.4041
+G1 Y110.1
+G1 E599.2 F1800
+G1 X160.46 Y110.46 F9000
+G1 E599.4 F1800
+G1 Y124.54 E599.9895 F3600
+G1 X174.54 E600.5749
+G1 Y110.46 E601.1602
+G1 X160.46 E601.7456
+G1 X160.1 Y110.1
+G1 Y124.9 E602.3609
+G1 X174.9 E602.9762
+G1 Y110.1 E603.5916
+G1 X162.1 E604.1237
+G1 X160.1
+;layer #44
+G1 E603.92 F1800
+G1 X160.46 Y110.46 F9000
+G1 E604.12 F1800
+G1 Z11 F300
+G1 Y124.54 E604.7091 F3600
+G1 X174.54 E605.2945
+G1 Y110.46 E605.8799
+G1 X160.46 E606.4652
+G1 X160.1 Y110.1
+G1 Y124.9 E607.0805
+G1 X174.9 E607.6959
+G1 Y110.1 E608.3112
+G1 X162.1 E608.8433
+G1 X160.1
+G1 E608.64 F1800
+G1 X74.54 Y110.46 F9000
+G1 E608.84 F1800
+G1 X60.46 E609.4287 F3600
+G1 Y124.54 E610.0141
+G1 X74.54 E610.5995
+G1 Y110.46 E611.1848
+G1 X74.9 Y110.1
+G1 X60.1 E611.8002
+G1 Y124.9 E612.4155
+G1 X74.9 E613.0308
+G1 Y112.1 E613.5629
+G1 Y110.1
+;layer #45
+G1 E613.36 F1800
+G1 X74.54 Y110.46 F9000
+G1 E613.56 F1800
+G1 Z11.25 F300
+G1 X60.46 E614.1483 F3600
+G1 Y124.54 E614.7337
+G1 X74.54 E615.3191
+G1 Y110.46 E615.9045
+G1 X74.9 Y110.1
+G1 X60.1 E616.5198
+G1 Y124.9 E617.1351
+G1 X74.9 E617.7504
+G1 Y112.1 E618.2826
+G1 Y110.1
+G1 E618.08 F1800
+G1 X160.46 Y110.46 F9000
+G1 E618.28 F1800
+G1 Y124.54 E618.8679 F3600
+G1 X174.54 E619.4533
+G1 Y110.46 E620.0387
+G1 X160.46 E620.6241
+G1 X160.1 Y110.1
+G1 Y124.9 E621.2394
+G1 X174.9 E621.8547
+G1 Y110.1 E622.47
+G1 X162.1 E623.0022
+G1 X160.1
+;layer #46
+G1 E622.8 F1800
+G1 X160.46 Y110.46 F9000
+G1 E623 F1800
+G1 Z11.5 F300
+G1 Y124.54 E623.5876 F3600
+G1 X174.54 E624.1729
+G1 Y110.46 E624.7583
+G1 X160.46 E625.3437
+G1 X160.1 Y110.1
+G1 Y124.9 E625.959
+G1 X174.9 E626.5743
+G1 Y110.1 E627.1896
+G1 X162.1 E627.7218
+G1 X160.1
+G1 E627.52 F1800
+G1 X74.54 Y110.46 F9000
+G1 E627.72 F1800
+G1 X60.46 E628.3072 F3600
+G1 Y124.54 E628.8925
+G1 X74.54 E629.4779
+G1 Y110.46 E630.0633
+G1 X74.9 Y110.1
+G1 X60.1 E630.6786
+G1 Y124.9 E631.2939
+G1 X74.9 E631.9092
+G1 Y112.1 E632.4414
+G1 Y110.1
+;layer #47
+G1 E632.24 F1800
+G1 X74.54 Y110.46 F9000
+G1 E632.44 F1800
+G1 Z11.75 F300
+G1 X60.46 E633.0268 F3600
+G1 Y124.54 E633.6122
+G1 X74.54 E634.1975
+G1 Y110.46 E634.7829
+G1 X74.9 Y110.1
+G1 X60.1 E635.3982
+G1 Y124.9 E636.0135
+G1 X74.9 E636.6289
+G1 Y112.1 E637.161
+G1 Y110.1
+G1 E636.96 F1800
+G1 X160.46 Y110.46 F9000
+G1 E637.16 F1800
+G1 Y124.54 E637.7464 F3600
+G1 X174.54 E638.3318
+G1 Y110.46 E638.9171
+G1 X160.46 E639.5025
+G1 X160.1 Y110.1
+G1 Y124.9 E640.1178
+G1 X174.9 E640.7332
+G1 Y110.1 E641.3485
+G1 X162.1 E641.8806
+G1 X160.1
+;layer #48
+G1 E641.68 F1800
+G1 X160.46 Y110.46 F9000
+G1 E641.88 F1800
+G1 Z12 F300
+G1 Y124.54 E642.466 F3600
+G1 X174.54 E643.0514
+G1 Y110.46 E643.6368
+G1 X160.46 E644.2221
+G1 X160.1 Y110.1
+G1 Y124.9 E644.8375
+G1 X174.9 E645.4528
+G1 Y110.1 E646.0681
+G1 X162.1 E646.6002
+G1 X160.1
+G1 E646.4 F1800
+G1 X74.54 Y110.46 F9000
+G1 E646.6 F1800
+G1 X60.46 E647.1856 F3600
+G1 Y124.54 E647.771
+G1 X74.54 E648.3564
+G1 Y110.46 E648.9418
+G1 X74.9 Y110.1
+G1 X60.1 E649.5571
+G1 Y124.9 E650.1724
+G1 X74.9 E650.7877
+G1 Y112.1 E651.3199
+G1 Y110.1
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,1316 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 2
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Wipe: 1-1 [mm], retract while wiping: 100%
+;Coasting: 0.05-0.2 [mm³]
+;Segment 3:   0.2mm @ 30mm/s @ wipe 1mm @ coast 0.2mm³
+;Segment 2:   0.6mm @ 30mm/s @ wipe 1mm @ coast 0.13mm³
+;Segment 1:   1mm @ 30mm/s @ wipe 1mm @ coast 0.05mm³
+M572 D0 S0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 Y103.72 E202.9159 F9000
+G1 X182.14 Y103.66 E202.8347
+G1 X160.46 Y110.46
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 Y124.54 E204.42 F3600
+G1 X174.54 E205.0054
+G1 Y110.46 E205.5908
+G1 X160.46 E206.1762
+G1 X160.1 Y110.1
+G1 Y124.9 E206.7915
+G1 X174.9 E207.4068
+G1 Y110.1 E208.0221
+G1 X160.6 E208.6166
+G1 X160.1
+G1 X160.6 E208.1166 F9000
+G1 X161.1 E207.6166
+G1 X74.54 Y110.46
+G1 E208.62 F1800
+G1 X60.46 E209.202 F3600
+G1 Y124.54 E209.7874
+G1 X74.54 E210.3728
+G1 Y110.46 E210.9582
+G1 X74.9 Y110.1
+G1 X60.1 E211.5735
+G1 Y124.9 E212.1888
+G1 X74.9 E212.8041
+G1 Y110.6 E213.3986
+G1 Y110.1
+;layer #3
+M106 S254
+G1 Y110.6 E212.8986 F9000
+G1 Y111.1 E212.3986
+G1 X74.54 Y110.46
+G1 E213.4 F1800
+G1 Z0.75 F300
+G1 X60.46 E213.984 F3600
+G1 Y124.54 E214.5694
+G1 X74.54 E215.1548
+G1 Y110.46 E215.7401
+G1 X74.9 Y110.1
+G1 X60.1 E216.3554
+G1 Y124.9 E216.9708
+G1 X74.9 E217.5861
+G1 Y110.6 E218.1806
+G1 Y110.1
+G1 Y110.6 E217.6806 F9000
+G1 Y111.1 E217.1806
+G1 X160.46 Y110.46
+G1 E218.18 F1800
+G1 Y124.54 E218.766 F3600
+G1 X174.54 E219.3514
+G1 Y110.46 E219.9367
+G1 X160.46 E220.5221
+G1 X160.1 Y110.1
+G1 Y124.9 E221.1374
+G1 X174.9 E221.7527
+G1 Y110.1 E222.368
+G1 X160.6 E222.9626
+G1 X160.1
+;layer #4
+G1 X160.6 E222.4626 F9000
+G1 X161.1 E221.9626
+G1 X160.46 Y110.46
+G1 E222.96 F1800
+G1 Z1 F300
+G1 Y124.54 E223.548 F3600
+G1 X174.54 E224.1333
+G1 Y110.46 E224.7187
+G1 X160.46 E225.3041
+G1 X160.1 Y110.1
+G1 Y124.9 E225.9194
+G1 X174.9 E226.5347
+G1 Y110.1 E227.15
+G1 X160.6 E227.7445
+G1 X160.1
+G1 X160.6 E227.2445 F9000
+G1 X161.1 E226.7445
+G1 X74.54 Y110.46
+G1 E227.74 F1800
+G1 X60.46 E228.3299 F3600
+G1 Y124.54 E228.9153
+G1 X74.54 E229.5007
+G1 Y110.46 E230.0861
+G1 X74.9 Y110.1
+G1 X60.1 E230.7014
+G1 Y124.9 E231.3167
+G1 X74.9 E231.932
+G1 Y110.6 E232.5265
+G1 Y110.1
+;layer #5
+G1 Y110.6 E232.0265 F9000
+G1 Y111.1 E231.5265
+G1 X74.54 Y110.46
+G1 E232.53 F1800
+G1 Z1.25 F300
+G1 X60.46 E233.1119 F3600
+G1 Y124.54 E233.6973
+G1 X74.54 E234.2827
+G1 Y110.46 E234.868
+G1 X74.9 Y110.1
+G1 X60.1 E235.4834
+G1 Y124.9 E236.0987
+G1 X74.9 E236.714
+G1 Y110.6 E237.3085
+G1 Y110.1
+G1 Y110.6 E236.8085 F9000
+G1 Y111.1 E236.3085
+G1 X160.46 Y110.46
+G1 E237.31 F1800
+G1 Y124.54 E237.8939 F3600
+G1 X174.54 E238.4793
+G1 Y110.46 E239.0646
+G1 X160.46 E239.65
+G1 X160.1 Y110.1
+G1 Y124.9 E240.2653
+G1 X174.9 E240.8806
+G1 Y110.1 E241.496
+G1 X160.6 E242.0905
+G1 X160.1
+;layer #6
+G1 X160.6 E241.5905 F9000
+G1 X161.1 E241.0905
+G1 X160.46 Y110.46
+G1 E242.09 F1800
+G1 Z1.5 F300
+G1 Y124.54 E242.6759 F3600
+G1 X174.54 E243.2612
+G1 Y110.46 E243.8466
+G1 X160.46 E244.432
+G1 X160.1 Y110.1
+G1 Y124.9 E245.0473
+G1 X174.9 E245.6626
+G1 Y110.1 E246.2779
+G1 X160.6 E246.8725
+G1 X160.1
+G1 X160.6 E246.3725 F9000
+G1 X161.1 E245.8725
+G1 X74.54 Y110.46
+G1 E246.87 F1800
+G1 X60.46 E247.4578 F3600
+G1 Y124.54 E248.0432
+G1 X74.54 E248.6286
+G1 Y110.46 E249.214
+G1 X74.9 Y110.1
+G1 X60.1 E249.8293
+G1 Y124.9 E250.4446
+G1 X74.9 E251.0599
+G1 Y110.6 E251.6544
+G1 Y110.1
+;layer #7
+G1 Y110.6 E251.1544 F9000
+G1 Y111.1 E250.6544
+G1 X74.54 Y110.46
+G1 E251.65 F1800
+G1 Z1.75 F300
+G1 X60.46 E252.2398 F3600
+G1 Y124.54 E252.8252
+G1 X74.54 E253.4106
+G1 Y110.46 E253.9959
+G1 X74.9 Y110.1
+G1 X60.1 E254.6113
+G1 Y124.9 E255.2266
+G1 X74.9 E255.8419
+G1 Y110.6 E256.4364
+G1 Y110.1
+G1 Y110.6 E255.9364 F9000
+G1 Y111.1 E255.4364
+G1 X160.46 Y110.46
+G1 E256.44 F1800
+G1 Y124.54 E257.0218 F3600
+G1 X174.54 E257.6072
+G1 Y110.46 E258.1925
+G1 X160.46 E258.7779
+G1 X160.1 Y110.1
+G1 Y124.9 E259.3932
+G1 X174.9 E260.0085
+G1 Y110.1 E260.6239
+G1 X160.6 E261.2184
+G1 X160.1
+;layer #8
+G1 X160.6 E260.7184 F9000
+G1 X161.1 E260.2184
+G1 X160.46 Y110.46
+G1 E261.22 F1800
+G1 Z2 F300
+G1 Y124.54 E261.8038 F3600
+G1 X174.54 E262.3891
+G1 Y110.46 E262.9745
+G1 X160.46 E263.5599
+G1 X160.1 Y110.1
+G1 Y124.9 E264.1752
+G1 X174.9 E264.7905
+G1 Y110.1 E265.4058
+G1 X160.6 E266.0004
+G1 X160.1
+G1 X160.6 E265.5004 F9000
+G1 X161.1 E265.0004
+G1 X74.54 Y110.46
+G1 E266 F1800
+G1 X60.46 E266.5857 F3600
+G1 Y124.54 E267.1711
+G1 X74.54 E267.7565
+G1 Y110.46 E268.3419
+G1 X74.9 Y110.1
+G1 X60.1 E268.9572
+G1 Y124.9 E269.5725
+G1 X74.9 E270.1878
+G1 Y110.6 E270.7823
+G1 Y110.1
+;layer #9
+G1 Y110.6 E270.2823 F9000
+G1 Y111.1 E269.7823
+G1 X74.54 Y110.46
+G1 E270.78 F1800
+G1 Z2.25 F300
+G1 X60.46 E271.3677 F3600
+G1 Y124.54 E271.9531
+G1 X74.54 E272.5385
+G1 Y110.46 E273.1238
+G1 X74.9 Y110.1
+G1 X60.1 E273.7392
+G1 Y124.9 E274.3545
+G1 X74.9 E274.9698
+G1 Y110.6 E275.5643
+G1 Y110.1
+G1 Y110.6 E275.0643 F9000
+G1 Y111.1 E274.5643
+G1 X160.46 Y110.46
+G1 E275.56 F1800
+G1 Y124.54 E276.1497 F3600
+G1 X174.54 E276.7351
+G1 Y110.46 E277.3204
+G1 X160.46 E277.9058
+G1 X160.1 Y110.1
+G1 Y124.9 E278.5211
+G1 X174.9 E279.1364
+G1 Y110.1 E279.7518
+G1 X160.6 E280.3463
+G1 X160.1
+;layer #10
+G1 X160.6 E279.8463 F9000
+G1 X161.1 E279.3463
+G1 X160.46 Y110.46
+G1 E280.35 F1800
+G1 Z2.5 F300
+G1 Y124.54 E280.9317 F3600
+G1 X174.54 E281.517
+G1 Y110.46 E282.1024
+G1 X160.46 E282.6878
+G1 X160.1 Y110.1
+G1 Y124.9 E283.3031
+G1 X174.9 E283.9184
+G1 Y110.1 E284.5337
+G1 X160.6 E285.1283
+G1 X160.1
+G1 X160.6 E284.6283 F9000
+G1 X161.1 E284.1283
+G1 X74.54 Y110.46
+G1 E285.13 F1800
+G1 X60.46 E285.7136 F3600
+G1 Y124.54 E286.299
+G1 X74.54 E286.8844
+G1 Y110.46 E287.4698
+G1 X74.9 Y110.1
+G1 X60.1 E288.0851
+G1 Y124.9 E288.7004
+G1 X74.9 E289.3157
+G1 Y110.6 E289.9102
+G1 Y110.1
+;layer #11
+G1 Y110.6 E289.4102 F9000
+G1 Y111.1 E288.9102
+G1 X74.54 Y110.46
+G1 E289.91 F1800
+G1 Z2.75 F300
+G1 X60.46 E290.4956 F3600
+G1 Y124.54 E291.081
+G1 X74.54 E291.6664
+G1 Y110.46 E292.2518
+G1 X74.9 Y110.1
+G1 X60.1 E292.8671
+G1 Y124.9 E293.4824
+G1 X74.9 E294.0977
+G1 Y110.6 E294.6922
+G1 Y110.1
+G1 Y110.6 E294.1922 F9000
+G1 Y111.1 E293.6922
+G1 X160.46 Y110.46
+G1 E294.69 F1800
+G1 Y124.54 E295.2776 F3600
+G1 X174.54 E295.863
+G1 Y110.46 E296.4483
+G1 X160.46 E297.0337
+G1 X160.1 Y110.1
+G1 Y124.9 E297.649
+G1 X174.9 E298.2644
+G1 Y110.1 E298.8797
+G1 X160.6 E299.4742
+G1 X160.1
+;layer #12
+G1 X160.6 E298.9742 F9000
+G1 X161.1 E298.4742
+G1 X160.46 Y110.46
+G1 E299.47 F1800
+G1 Z3 F300
+G1 Y124.54 E300.0596 F3600
+G1 X174.54 E300.6449
+G1 Y110.46 E301.2303
+G1 X160.46 E301.8157
+G1 X160.1 Y110.1
+G1 Y124.9 E302.431
+G1 X174.9 E303.0463
+G1 Y110.1 E303.6616
+G1 X160.6 E304.2562
+G1 X160.1
+G1 X160.6 E303.7562 F9000
+G1 X161.1 E303.2562
+G1 X74.54 Y110.46
+G1 E304.26 F1800
+G1 X60.46 E304.8415 F3600
+G1 Y124.54 E305.4269
+G1 X74.54 E306.0123
+G1 Y110.46 E306.5977
+G1 X74.9 Y110.1
+G1 X60.1 E307.213
+G1 Y124.9 E307.8283
+G1 X74.9 E308.4436
+G1 Y110.6 E309.0381
+G1 Y110.1
+;layer #13
+G1 Y110.6 E308.7381 F9000
+G1 Y111.1 E308.4381
+G1 X74.64 Y110.36
+G1 E309.04 F1800
+G1 Z3.25 F300
+G1 X60.36 E309.6318 F3600
+G1 Y124.64 E310.2255
+G1 X74.64 E310.8192
+G1 Y110.36 E311.4129
+G1 X75 Y110
+G1 X60 E312.0365
+G1 Y125 E312.6602
+G1 X75 E313.2838
+G1 Y111.25 E313.8555
+G1 Y110
+G1 Y111 E313.2555 F9000
+G1 X160.36 Y110.36
+G1 E313.86 F1800
+G1 Y124.64 E314.4491 F3600
+G1 X174.64 E315.0428
+G1 Y110.36 E315.6365
+G1 X160.36 E316.2302
+G1 X160 Y110
+G1 Y125 E316.8539
+G1 X175 E317.4775
+G1 Y110 E318.1011
+G1 X161.25 E318.6728
+G1 X160
+;layer #14
+G1 X161 E318.0728 F9000
+G1 X160.46 Y110.46
+G1 E318.67 F1800
+G1 Z3.5 F300
+G1 Y124.54 E319.2581 F3600
+G1 X174.54 E319.8435
+G1 Y110.46 E320.4289
+G1 X160.46 E321.0143
+G1 X160.1 Y110.1
+G1 Y124.9 E321.6296
+G1 X174.9 E322.2449
+G1 Y110.1 E322.8602
+G1 X161.35 E323.4236
+G1 X160.1
+G1 X161.1 E322.8236 F9000
+G1 X74.54 Y110.46
+G1 E323.42 F1800
+G1 X60.46 E324.0089 F3600
+G1 Y124.54 E324.5943
+G1 X74.54 E325.1797
+G1 Y110.46 E325.7651
+G1 X74.9 Y110.1
+G1 X60.1 E326.3804
+G1 Y124.9 E326.9957
+G1 X74.9 E327.611
+G1 Y111.35 E328.1744
+G1 Y110.1
+;layer #15
+G1 Y111.1 E327.5744 F9000
+G1 X74.54 Y110.46
+G1 E328.17 F1800
+G1 Z3.75 F300
+G1 X60.46 E328.7597 F3600
+G1 Y124.54 E329.3451
+G1 X74.54 E329.9305
+G1 Y110.46 E330.5159
+G1 X74.9 Y110.1
+G1 X60.1 E331.1312
+G1 Y124.9 E331.7465
+G1 X74.9 E332.3618
+G1 Y111.35 E332.9252
+G1 Y110.1
+G1 Y111.1 E332.3252 F9000
+G1 X160.46 Y110.46
+G1 E332.93 F1800
+G1 Y124.54 E333.5105 F3600
+G1 X174.54 E334.0959
+G1 Y110.46 E334.6813
+G1 X160.46 E335.2667
+G1 X160.1 Y110.1
+G1 Y124.9 E335.882
+G1 X174.9 E336.4973
+G1 Y110.1 E337.1126
+G1 X161.35 E337.6759
+G1 X160.1
+;layer #16
+G1 X161.1 E337.0759 F9000
+G1 X160.46 Y110.46
+G1 E337.68 F1800
+G1 Z4 F300
+G1 Y124.54 E338.2613 F3600
+G1 X174.54 E338.8467
+G1 Y110.46 E339.4321
+G1 X160.46 E340.0175
+G1 X160.1 Y110.1
+G1 Y124.9 E340.6328
+G1 X174.9 E341.2481
+G1 Y110.1 E341.8634
+G1 X161.35 E342.4267
+G1 X160.1
+G1 X161.1 E341.8267 F9000
+G1 X74.54 Y110.46
+G1 E342.43 F1800
+G1 X60.46 E343.0121 F3600
+G1 Y124.54 E343.5975
+G1 X74.54 E344.1829
+G1 Y110.46 E344.7683
+G1 X74.9 Y110.1
+G1 X60.1 E345.3836
+G1 Y124.9 E345.9989
+G1 X74.9 E346.6142
+G1 Y111.35 E347.1775
+G1 Y110.1
+;layer #17
+G1 Y111.1 E346.5775 F9000
+G1 X74.54 Y110.46
+G1 E347.18 F1800
+G1 Z4.25 F300
+G1 X60.46 E347.7629 F3600
+G1 Y124.54 E348.3483
+G1 X74.54 E348.9337
+G1 Y110.46 E349.5191
+G1 X74.9 Y110.1
+G1 X60.1 E350.1344
+G1 Y124.9 E350.7497
+G1 X74.9 E351.365
+G1 Y111.35 E351.9283
+G1 Y110.1
+G1 Y111.1 E351.3283 F9000
+G1 X160.46 Y110.46
+G1 E351.93 F1800
+G1 Y124.54 E352.5137 F3600
+G1 X174.54 E353.0991
+G1 Y110.46 E353.6845
+G1 X160.46 E354.2698
+G1 X160.1 Y110.1
+G1 Y124.9 E354.8852
+G1 X174.9 E355.5005
+G1 Y110.1 E356.1158
+G1 X161.35 E356.6791
+G1 X160.1
+;layer #18
+G1 X161.1 E356.0791 F9000
+G1 X160.46 Y110.46
+G1 E356.68 F1800
+G1 Z4.5 F300
+G1 Y124.54 E357.2645 F3600
+G1 X174.54 E357.8499
+G1 Y110.46 E358.4353
+G1 X160.46 E359.0206
+G1 X160.1 Y110.1
+G1 Y124.9 E359.636
+G1 X174.9 E360.2513
+G1 Y110.1 E360.8666
+G1 X161.35 E361.4299
+G1 X160.1
+G1 X161.1 E360.8299 F9000
+G1 X74.54 Y110.46
+G1 E361.43 F1800
+G1 X60.46 E362.0153 F3600
+G1 Y124.54 E362.6007
+G1 X74.54 E363.1861
+G1 Y110.46 E363.7714
+G1 X74.9 Y110.1
+G1 X60.1 E364.3867
+G1 Y124.9 E365.0021
+G1 X74.9 E365.6174
+G1 Y111.35 E366.1807
+G1 Y110.1
+;layer #19
+G1 Y111.1 E365.5807 F9000
+G1 X74.54 Y110.46
+G1 E366.18 F1800
+G1 Z4.75 F300
+G1 X60.46 E366.7661 F3600
+G1 Y124.54 E367.3515
+G1 X74.54 E367.9369
+G1 Y110.46 E368.5222
+G1 X74.9 Y110.1
+G1 X60.1 E369.1375
+G1 Y124.9 E369.7529
+G1 X74.9 E370.3682
+G1 Y111.35 E370.9315
+G1 Y110.1
+G1 Y111.1 E370.3315 F9000
+G1 X160.46 Y110.46
+G1 E370.93 F1800
+G1 Y124.54 E371.5169 F3600
+G1 X174.54 E372.1023
+G1 Y110.46 E372.6876
+G1 X160.46 E373.273
+G1 X160.1 Y110.1
+G1 Y124.9 E373.8883
+G1 X174.9 E374.5036
+G1 Y110.1 E375.119
+G1 X161.35 E375.6823
+G1 X160.1
+;layer #20
+G1 X161.1 E375.0823 F9000
+G1 X160.46 Y110.46
+G1 E375.68 F1800
+G1 Z5 F300
+G1 Y124.54 E376.2677 F3600
+G1 X174.54 E376.8531
+G1 Y110.46 E377.4384
+G1 X160.46 E378.0238
+G1 X160.1 Y110.1
+G1 Y124.9 E378.6391
+G1 X174.9 E379.2544
+G1 Y110.1 E379.8698
+G1 X161.35 E380.4331
+G1 X160.1
+G1 X161.1 E379.8331 F9000
+G1 X74.54 Y110.46
+G1 E380.43 F1800
+G1 X60.46 E381.0185 F3600
+G1 Y124.54 E381.6039
+G1 X74.54 E382.1892
+G1 Y110.46 E382.7746
+G1 X74.9 Y110.1
+G1 X60.1 E383.3899
+G1 Y124.9 E384.0052
+G1 X74.9 E384.6206
+G1 Y111.35 E385.1839
+G1 Y110.1
+;layer #21
+G1 Y111.1 E384.5839 F9000
+G1 X74.54 Y110.46
+G1 E385.18 F1800
+G1 Z5.25 F300
+G1 X60.46 E385.7693 F3600
+G1 Y124.54 E386.3547
+G1 X74.54 E386.94
+G1 Y110.46 E387.5254
+G1 X74.9 Y110.1
+G1 X60.1 E388.1407
+G1 Y124.9 E388.756
+G1 X74.9 E389.3713
+G1 Y111.35 E389.9347
+G1 Y110.1
+G1 Y111.1 E389.3347 F9000
+G1 X160.46 Y110.46
+G1 E389.93 F1800
+G1 Y124.54 E390.5201 F3600
+G1 X174.54 E391.1054
+G1 Y110.46 E391.6908
+G1 X160.46 E392.2762
+G1 X160.1 Y110.1
+G1 Y124.9 E392.8915
+G1 X174.9 E393.5068
+G1 Y110.1 E394.1221
+G1 X161.35 E394.6855
+G1 X160.1
+;layer #22
+G1 X161.1 E394.0855 F9000
+G1 X160.46 Y110.46
+G1 E394.69 F1800
+G1 Z5.5 F300
+G1 Y124.54 E395.2709 F3600
+G1 X174.54 E395.8562
+G1 Y110.46 E396.4416
+G1 X160.46 E397.027
+G1 X160.1 Y110.1
+G1 Y124.9 E397.6423
+G1 X174.9 E398.2576
+G1 Y110.1 E398.8729
+G1 X161.35 E399.4363
+G1 X160.1
+G1 X161.1 E398.8363 F9000
+G1 X74.54 Y110.46
+G1 E399.44 F1800
+G1 X60.46 E400.0217 F3600
+G1 Y124.54 E400.607
+G1 X74.54 E401.1924
+G1 Y110.46 E401.7778
+G1 X74.9 Y110.1
+G1 X60.1 E402.3931
+G1 Y124.9 E403.0084
+G1 X74.9 E403.6237
+G1 Y111.35 E404.1871
+G1 Y110.1
+;layer #23
+G1 Y111.1 E403.5871 F9000
+G1 X74.54 Y110.46
+G1 E404.19 F1800
+G1 Z5.75 F300
+G1 X60.46 E404.7725 F3600
+G1 Y124.54 E405.3578
+G1 X74.54 E405.9432
+G1 Y110.46 E406.5286
+G1 X74.9 Y110.1
+G1 X60.1 E407.1439
+G1 Y124.9 E407.7592
+G1 X74.9 E408.3745
+G1 Y111.35 E408.9379
+G1 Y110.1
+G1 Y111.1 E408.3379 F9000
+G1 X160.46 Y110.46
+G1 E408.94 F1800
+G1 Y124.54 E409.5232 F3600
+G1 X174.54 E410.1086
+G1 Y110.46 E410.694
+G1 X160.46 E411.2794
+G1 X160.1 Y110.1
+G1 Y124.9 E411.8947
+G1 X174.9 E412.51
+G1 Y110.1 E413.1253
+G1 X161.35 E413.6887
+G1 X160.1
+;layer #24
+G1 X161.1 E413.0887 F9000
+G1 X160.46 Y110.46
+G1 E413.69 F1800
+G1 Z6 F300
+G1 Y124.54 E414.274 F3600
+G1 X174.54 E414.8594
+G1 Y110.46 E415.4448
+G1 X160.46 E416.0302
+G1 X160.1 Y110.1
+G1 Y124.9 E416.6455
+G1 X174.9 E417.2608
+G1 Y110.1 E417.8761
+G1 X161.35 E418.4395
+G1 X160.1
+G1 X161.1 E417.8395 F9000
+G1 X74.54 Y110.46
+G1 E418.44 F1800
+G1 X60.46 E419.0248 F3600
+G1 Y124.54 E419.6102
+G1 X74.54 E420.1956
+G1 Y110.46 E420.781
+G1 X74.9 Y110.1
+G1 X60.1 E421.3963
+G1 Y124.9 E422.0116
+G1 X74.9 E422.6269
+G1 Y111.35 E423.1902
+G1 Y110.1
+;layer #25
+G1 Y111.1 E422.9902 F9000
+G1 X74.64 Y110.36
+G1 E423.19 F1800
+G1 Z6.25 F300
+G1 X60.36 E423.7839 F3600
+G1 Y124.64 E424.3776
+G1 X74.64 E424.9713
+G1 Y110.36 E425.565
+G1 X75 Y110
+G1 X60 E426.1887
+G1 Y125 E426.8123
+G1 X75 E427.4359
+G1 Y112 E427.9764
+G1 Y110
+G1 Y111 E427.7764 F9000
+G1 X160.36 Y110.36
+G1 E427.98 F1800
+G1 Y124.64 E428.5701 F3600
+G1 X174.64 E429.1638
+G1 Y110.36 E429.7575
+G1 X160.36 E430.3512
+G1 X160 Y110
+G1 Y125 E430.9748
+G1 X175 E431.5984
+G1 Y110 E432.222
+G1 X162 E432.7625
+G1 X160
+;layer #26
+G1 X161 E432.5625 F9000
+G1 X160.46 Y110.46
+G1 E432.76 F1800
+G1 Z6.5 F300
+G1 Y124.54 E433.3479 F3600
+G1 X174.54 E433.9333
+G1 Y110.46 E434.5187
+G1 X160.46 E435.104
+G1 X160.1 Y110.1
+G1 Y124.9 E435.7193
+G1 X174.9 E436.3347
+G1 Y110.1 E436.95
+G1 X162.1 E437.4821
+G1 X160.1
+G1 X161.1 E437.2821 F9000
+G1 X74.54 Y110.46
+G1 E437.48 F1800
+G1 X60.46 E438.0675 F3600
+G1 Y124.54 E438.6529
+G1 X74.54 E439.2383
+G1 Y110.46 E439.8236
+G1 X74.9 Y110.1
+G1 X60.1 E440.439
+G1 Y124.9 E441.0543
+G1 X74.9 E441.6696
+G1 Y112.1 E442.2017
+G1 Y110.1
+;layer #27
+G1 Y111.1 E442.0017 F9000
+G1 X74.54 Y110.46
+G1 E442.2 F1800
+G1 Z6.75 F300
+G1 X60.46 E442.7871 F3600
+G1 Y124.54 E443.3725
+G1 X74.54 E443.9579
+G1 Y110.46 E444.5433
+G1 X74.9 Y110.1
+G1 X60.1 E445.1586
+G1 Y124.9 E445.7739
+G1 X74.9 E446.3892
+G1 Y112.1 E446.9214
+G1 Y110.1
+G1 Y111.1 E446.7214 F9000
+G1 X160.46 Y110.46
+G1 E446.92 F1800
+G1 Y124.54 E447.5067 F3600
+G1 X174.54 E448.0921
+G1 Y110.46 E448.6775
+G1 X160.46 E449.2629
+G1 X160.1 Y110.1
+G1 Y124.9 E449.8782
+G1 X174.9 E450.4935
+G1 Y110.1 E451.1088
+G1 X162.1 E451.641
+G1 X160.1
+;layer #28
+G1 X161.1 E451.441 F9000
+G1 X160.46 Y110.46
+G1 E451.64 F1800
+G1 Z7 F300
+G1 Y124.54 E452.2263 F3600
+G1 X174.54 E452.8117
+G1 Y110.46 E453.3971
+G1 X160.46 E453.9825
+G1 X160.1 Y110.1
+G1 Y124.9 E454.5978
+G1 X174.9 E455.2131
+G1 Y110.1 E455.8284
+G1 X162.1 E456.3606
+G1 X160.1
+G1 X161.1 E456.1606 F9000
+G1 X74.54 Y110.46
+G1 E456.36 F1800
+G1 X60.46 E456.946 F3600
+G1 Y124.54 E457.5313
+G1 X74.54 E458.1167
+G1 Y110.46 E458.7021
+G1 X74.9 Y110.1
+G1 X60.1 E459.3174
+G1 Y124.9 E459.9327
+G1 X74.9 E460.548
+G1 Y112.1 E461.0802
+G1 Y110.1
+;layer #29
+G1 Y111.1 E460.8802 F9000
+G1 X74.54 Y110.46
+G1 E461.08 F1800
+G1 Z7.25 F300
+G1 X60.46 E461.6656 F3600
+G1 Y124.54 E462.251
+G1 X74.54 E462.8363
+G1 Y110.46 E463.4217
+G1 X74.9 Y110.1
+G1 X60.1 E464.037
+G1 Y124.9 E464.6523
+G1 X74.9 E465.2676
+G1 Y112.1 E465.7998
+G1 Y110.1
+G1 Y111.1 E465.5998 F9000
+G1 X160.46 Y110.46
+G1 E465.8 F1800
+G1 Y124.54 E466.3852 F3600
+G1 X174.54 E466.9706
+G1 Y110.46 E467.5559
+G1 X160.46 E468.1413
+G1 X160.1 Y110.1
+G1 Y124.9 E468.7566
+G1 X174.9 E469.3719
+G1 Y110.1 E469.9873
+G1 X162.1 E470.5194
+G1 X160.1
+;layer #30
+G1 X161.1 E470.3194 F9000
+G1 X160.46 Y110.46
+G1 E470.52 F1800
+G1 Z7.5 F300
+G1 Y124.54 E471.1048 F3600
+G1 X174.54 E471.6902
+G1 Y110.46 E472.2756
+G1 X160.46 E472.8609
+G1 X160.1 Y110.1
+G1 Y124.9 E473.4762
+G1 X174.9 E474.0916
+G1 Y110.1 E474.7069
+G1 X162.1 E475.239
+G1 X160.1
+G1 X161.1 E475.039 F9000
+G1 X74.54 Y110.46
+G1 E475.24 F1800
+G1 X60.46 E475.8244 F3600
+G1 Y124.54 E476.4098
+G1 X74.54 E476.9952
+G1 Y110.46 E477.5805
+G1 X74.9 Y110.1
+G1 X60.1 E478.1959
+G1 Y124.9 E478.8112
+G1 X74.9 E479.4265
+G1 Y112.1 E479.9586
+G1 Y110.1
+;layer #31
+G1 Y111.1 E479.7586 F9000
+G1 X74.54 Y110.46
+G1 E479.96 F1800
+G1 Z7.75 F300
+G1 X60.46 E480.544 F3600
+G1 Y124.54 E481.1294
+G1 X74.54 E481.7148
+G1 Y110.46 E482.3002
+G1 X74.9 Y110.1
+G1 X60.1 E482.9155
+G1 Y124.9 E483.5308
+G1 X74.9 E484.1461
+G1 Y112.1 E484.6783
+G1 Y110.1
+G1 Y111.1 E484.4783 F9000
+G1 X160.46 Y110.46
+G1 E484.68 F1800
+G1 Y124.54 E485.2636 F3600
+G1 X174.54 E485.849
+G1 Y110.46 E486.4344
+G1 X160.46 E487.0198
+G1 X160.1 Y110.1
+G1 Y124.9 E487.6351
+G1 X174.9 E488.2504
+G1 Y110.1 E488.8657
+G1 X162.1 E489.3979
+G1 X160.1
+;layer #32
+G1 X161.1 E489.1979 F9000
+G1 X160.46 Y110.46
+G1 E489.4 F1800
+G1 Z8 F300
+G1 Y124.54 E489.9833 F3600
+G1 X174.54 E490.5686
+G1 Y110.46 E491.154
+G1 X160.46 E491.7394
+G1 X160.1 Y110.1
+G1 Y124.9 E492.3547
+G1 X174.9 E492.97
+G1 Y110.1 E493.5853
+G1 X162.1 E494.1175
+G1 X160.1
+G1 X161.1 E493.9175 F9000
+G1 X74.54 Y110.46
+G1 E494.12 F1800
+G1 X60.46 E494.7029 F3600
+G1 Y124.54 E495.2882
+G1 X74.54 E495.8736
+G1 Y110.46 E496.459
+G1 X74.9 Y110.1
+G1 X60.1 E497.0743
+G1 Y124.9 E497.6896
+G1 X74.9 E498.3049
+G1 Y112.1 E498.8371
+G1 Y110.1
+;layer #33
+G1 Y111.1 E498.6371 F9000
+G1 X74.54 Y110.46
+G1 E498.84 F1800
+G1 Z8.25 F300
+G1 X60.46 E499.4225 F3600
+G1 Y124.54 E500.0079
+G1 X74.54 E500.5932
+G1 Y110.46 E501.1786
+G1 X74.9 Y110.1
+G1 X60.1 E501.7939
+G1 Y124.9 E502.4092
+G1 X74.9 E503.0246
+G1 Y112.1 E503.5567
+G1 Y110.1
+G1 Y111.1 E503.3567 F9000
+G1 X160.46 Y110.46
+G1 E503.56 F1800
+G1 Y124.54 E504.1421 F3600
+G1 X174.54 E504.7275
+G1 Y110.46 E505.3128
+G1 X160.46 E505.8982
+G1 X160.1 Y110.1
+G1 Y124.9 E506.5135
+G1 X174.9 E507.1289
+G1 Y110.1 E507.7442
+G1 X162.1 E508.2763
+G1 X160.1
+;layer #34
+G1 X161.1 E508.0763 F9000
+G1 X160.46 Y110.46
+G1 E508.28 F1800
+G1 Z8.5 F300
+G1 Y124.54 E508.8617 F3600
+G1 X174.54 E509.4471
+G1 Y110.46 E510.0325
+G1 X160.46 E510.6178
+G1 X160.1 Y110.1
+G1 Y124.9 E511.2332
+G1 X174.9 E511.8485
+G1 Y110.1 E512.4638
+G1 X162.1 E512.9959
+G1 X160.1
+G1 X161.1 E512.7959 F9000
+G1 X74.54 Y110.46
+G1 E513 F1800
+G1 X60.46 E513.5813 F3600
+G1 Y124.54 E514.1667
+G1 X74.54 E514.7521
+G1 Y110.46 E515.3375
+G1 X74.9 Y110.1
+G1 X60.1 E515.9528
+G1 Y124.9 E516.5681
+G1 X74.9 E517.1834
+G1 Y112.1 E517.7156
+G1 Y110.1
+;layer #35
+G1 Y111.1 E517.5156 F9000
+G1 X74.54 Y110.46
+G1 E517.72 F1800
+G1 Z8.75 F300
+G1 X60.46 E518.3009 F3600
+G1 Y124.54 E518.8863
+G1 X74.54 E519.4717
+G1 Y110.46 E520.0571
+G1 X74.9 Y110.1
+G1 X60.1 E520.6724
+G1 Y124.9 E521.2877
+G1 X74.9 E521.903
+G1 Y112.1 E522.4352
+G1 Y110.1
+G1 Y111.1 E522.2352 F9000
+G1 X160.46 Y110.46
+G1 E522.44 F1800
+G1 Y124.54 E523.0205 F3600
+G1 X174.54 E523.6059
+G1 Y110.46 E524.1913
+G1 X160.46 E524.7767
+G1 X160.1 Y110.1
+G1 Y124.9 E525.392
+G1 X174.9 E526.0073
+G1 Y110.1 E526.6226
+G1 X162.1 E527.1548
+G1 X160.1
+;layer #36
+G1 X161.1 E526.9548 F9000
+G1 X160.46 Y110.46
+G1 E527.15 F1800
+G1 Z9 F300
+G1 Y124.54 E527.7402 F3600
+G1 X174.54 E528.3255
+G1 Y110.46 E528.9109
+G1 X160.46 E529.4963
+G1 X160.1 Y110.1
+G1 Y124.9 E530.1116
+G1 X174.9 E530.7269
+G1 Y110.1 E531.3422
+G1 X162.1 E531.8744
+G1 X160.1
+G1 X161.1 E531.6744 F9000
+G1 X74.54 Y110.46
+G1 E531.87 F1800
+G1 X60.46 E532.4598 F3600
+G1 Y124.54 E533.0451
+G1 X74.54 E533.6305
+G1 Y110.46 E534.2159
+G1 X74.9 Y110.1
+G1 X60.1 E534.8312
+G1 Y124.9 E535.4465
+G1 X74.9 E536.0618
+G1 Y112.1 E536.594
+G1 Y110.1
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,491 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: true
+;Temp: 240/85 [°C]
+;Flow: 105
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Segment 2:   0.2mm @ 30mm/s
+;Segment 1:   1mm @ 30mm/s
+G28 ;home
+G29
+M140 S85
+M104 S240
+M190 S85
+M109 S240
+M900 K0
+M221 S105
+G92 E0
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 E202.83 F1800
+G1 X160.46 Y110.46 F9000
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 Y124.54 E204.42 F3600
+G1 X174.54 E205.0054
+G1 Y110.46 E205.5908
+G1 X160.46 E206.1762
+G1 X160.1 Y110.1
+G1 Y124.9 E206.7915
+G1 X174.9 E207.4068
+G1 Y110.1 E208.0221
+G1 X160.1 E208.6374
+G1 E207.64 F1800
+G1 X74.54 Y110.46 F9000
+G1 E208.64 F1800
+G1 X60.46 E209.2228 F3600
+G1 Y124.54 E209.8082
+G1 X74.54 E210.3936
+G1 Y110.46 E210.9789
+G1 X74.9 Y110.1
+G1 X60.1 E211.5943
+G1 Y124.9 E212.2096
+G1 X74.9 E212.8249
+G1 Y110.1 E213.4402
+;layer #3
+M106 S254
+G1 E212.44 F1800
+G1 X74.54 Y110.46 F9000
+G1 E213.44 F1800
+G1 Z0.75 F300
+G1 X60.46 E214.0256 F3600
+G1 Y124.54 E214.611
+G1 X74.54 E215.1963
+G1 Y110.46 E215.7817
+G1 X74.9 Y110.1
+G1 X60.1 E216.397
+G1 Y124.9 E217.0123
+G1 X74.9 E217.6276
+G1 Y110.1 E218.243
+G1 E217.24 F1800
+G1 X160.46 Y110.46 F9000
+G1 E218.24 F1800
+G1 Y124.54 E218.8283 F3600
+G1 X174.54 E219.4137
+G1 Y110.46 E219.9991
+G1 X160.46 E220.5845
+G1 X160.1 Y110.1
+G1 Y124.9 E221.1998
+G1 X174.9 E221.8151
+G1 Y110.1 E222.4304
+G1 X160.1 E223.0457
+;layer #4
+G1 E222.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E223.05 F1800
+G1 Z1 F300
+G1 Y124.54 E223.6311 F3600
+G1 X174.54 E224.2165
+G1 Y110.46 E224.8019
+G1 X160.46 E225.3872
+G1 X160.1 Y110.1
+G1 Y124.9 E226.0026
+G1 X174.9 E226.6179
+G1 Y110.1 E227.2332
+G1 X160.1 E227.8485
+G1 E226.85 F1800
+G1 X74.54 Y110.46 F9000
+G1 E227.85 F1800
+G1 X60.46 E228.4339 F3600
+G1 Y124.54 E229.0192
+G1 X74.54 E229.6046
+G1 Y110.46 E230.19
+G1 X74.9 Y110.1
+G1 X60.1 E230.8053
+G1 Y124.9 E231.4206
+G1 X74.9 E232.0359
+G1 Y110.1 E232.6513
+;layer #5
+G1 E232.45 F1800
+G1 X74.64 Y110.36 F9000
+G1 E232.65 F1800
+G1 Z1.25 F300
+G1 X60.36 E233.2449 F3600
+G1 Y124.64 E233.8386
+G1 X74.64 E234.4323
+G1 Y110.36 E235.026
+G1 X75 Y110
+G1 X60 E235.6497
+G1 Y125 E236.2733
+G1 X75 E236.8969
+G1 Y110 E237.5205
+G1 E237.32 F1800
+G1 X160.36 Y110.36 F9000
+G1 E237.52 F1800
+G1 Y124.64 E238.1142 F3600
+G1 X174.64 E238.7079
+G1 Y110.36 E239.3016
+G1 X160.36 E239.8953
+G1 X160 Y110
+G1 Y125 E240.5189
+G1 X175 E241.1426
+G1 Y110 E241.7662
+G1 X160 E242.3898
+;layer #6
+G1 E242.19 F1800
+G1 X160.46 Y110.46 F9000
+G1 E242.39 F1800
+G1 Z1.5 F300
+G1 Y124.54 E242.9752 F3600
+G1 X174.54 E243.5606
+G1 Y110.46 E244.146
+G1 X160.46 E244.7313
+G1 X160.1 Y110.1
+G1 Y124.9 E245.3466
+G1 X174.9 E245.962
+G1 Y110.1 E246.5773
+G1 X160.1 E247.1926
+G1 E246.99 F1800
+G1 X74.54 Y110.46 F9000
+G1 E247.19 F1800
+G1 X60.46 E247.778 F3600
+G1 Y124.54 E248.3633
+G1 X74.54 E248.9487
+G1 Y110.46 E249.5341
+G1 X74.9 Y110.1
+G1 X60.1 E250.1494
+G1 Y124.9 E250.7647
+G1 X74.9 E251.38
+G1 Y110.1 E251.9953
+;layer #7
+G1 E251.8 F1800
+G1 X74.54 Y110.46 F9000
+G1 E252 F1800
+G1 Z1.75 F300
+G1 X60.46 E252.5807 F3600
+G1 Y124.54 E253.1661
+G1 X74.54 E253.7515
+G1 Y110.46 E254.3369
+G1 X74.9 Y110.1
+G1 X60.1 E254.9522
+G1 Y124.9 E255.5675
+G1 X74.9 E256.1828
+G1 Y110.1 E256.7981
+G1 E256.6 F1800
+G1 X160.46 Y110.46 F9000
+G1 E256.8 F1800
+G1 Y124.54 E257.3835 F3600
+G1 X174.54 E257.9689
+G1 Y110.46 E258.5542
+G1 X160.46 E259.1396
+G1 X160.1 Y110.1
+G1 Y124.9 E259.7549
+G1 X174.9 E260.3702
+G1 Y110.1 E260.9856
+G1 X160.1 E261.6009
+;layer #8
+G1 E261.4 F1800
+G1 X160.46 Y110.46 F9000
+G1 E261.6 F1800
+G1 Z2 F300
+G1 Y124.54 E262.1863 F3600
+G1 X174.54 E262.7716
+G1 Y110.46 E263.357
+G1 X160.46 E263.9424
+G1 X160.1 Y110.1
+G1 Y124.9 E264.5577
+G1 X174.9 E265.173
+G1 Y110.1 E265.7883
+G1 X160.1 E266.4036
+G1 E266.2 F1800
+G1 X74.54 Y110.46 F9000
+G1 E266.4 F1800
+G1 X60.46 E266.989 F3600
+G1 Y124.54 E267.5744
+G1 X74.54 E268.1598
+G1 Y110.46 E268.7451
+G1 X74.9 Y110.1
+G1 X60.1 E269.3605
+G1 Y124.9 E269.9758
+G1 X74.9 E270.5911
+G1 Y110.1 E271.2064
+;end gcode
+G91
+G1 E-2 F2400
+G1 Z10 F600
+G90
+M84
\ No newline at end of file
@@ -0,0 +1,1535 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 1
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Extra prime: -0.2-0.4 [mm]
+;Segment 4:   0.2mm @ 30mm/s @ extra prime 0.4mm
+;Segment 3:   0.47mm @ 30mm/s @ extra prime 0.2mm
+;Segment 2:   0.73mm @ 30mm/s @ extra prime 0mm
+;Segment 1:   1mm @ 30mm/s @ extra prime -0.2mm
+SET_PRESSURE_ADVANCE ADVANCE=0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E-0.2 F1800
+G1 X182.5 E7.9072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.0143 F1800
+G1 E15.01 F1800
+G1 X52.8 Y132.2 F9000
+G1 E15.81 F1800
+G1 Y131.28 E15.8764 F1800
+G1 X53.72 Y132.2 E15.9641 F1800
+G1 X54.64 E16.0261 F1800
+G1 X52.8 Y130.36 E16.2016 F1800
+G1 Y129.44 E16.2636 F1800
+G1 X55.56 Y132.2 E16.5268 F1800
+G1 X56.47 E16.5889 F1800
+G1 X52.8 Y128.53 E16.9398 F1800
+G1 Y127.61 E17.0018 F1800
+G1 X57.39 Y132.2 E17.4405 F1800
+G1 X58.31 E17.5026 F1800
+G1 X52.8 Y126.69 E18.029 F1800
+G1 Y125.77 E18.091 F1800
+G1 X59.23 Y132.2 E18.7051 F1800
+G1 X60.15 E18.7672 F1800
+G1 X52.8 Y124.85 E19.469 F1800
+G1 Y123.93 E19.5311 F1800
+G1 X61.07 Y132.2 E20.3207 F1800
+G1 X61.99 E20.3827 F1800
+G1 X52.8 Y123.01 E21.2601 F1800
+G1 Y122.09 E21.3221 F1800
+G1 X62.91 Y132.2 E22.2872 F1800
+G1 X63.83 E22.3492 F1800
+G1 X52.8 Y121.18 E23.402 F1800
+G1 Y120.26 E23.4641 F1800
+G1 X64.74 Y132.2 E24.6046 F1800
+G1 X65.66 E24.6666 F1800
+G1 X52.8 Y119.34 E25.8949 F1800
+G1 Y118.42 E25.957 F1800
+G1 X66.58 Y132.2 E27.273 F1800
+G1 X67.5 E27.335 F1800
+G1 X52.8 Y117.5 E28.7388 F1800
+G1 Y116.58 E28.8008 F1800
+G1 X68.42 Y132.2 E30.2923 F1800
+G1 X69.34 E30.3543 F1800
+G1 X52.8 Y115.66 E31.9335 F1800
+G1 Y114.74 E31.9956 F1800
+G1 X70.26 Y132.2 E33.6625 F1800
+G1 X71.18 E33.7246 F1800
+G1 X52.8 Y113.82 E35.4792 F1800
+G1 Y112.91 E35.5413 F1800
+G1 X72.09 Y132.2 E37.3837 F1800
+G1 X73.01 E37.4457 F1800
+G1 X52.8 Y111.99 E39.3759 F1800
+G1 Y111.07 E39.4379 F1800
+G1 X73.93 Y132.2 E41.4558 F1800
+G1 X74.85 E41.5178 F1800
+G1 X52.8 Y110.15 E43.6235 F1800
+G1 Y109.23 E43.6855 F1800
+G1 X75.77 Y132.2 E45.8789 F1800
+G1 X76.69 E45.9409 F1800
+G1 X52.8 Y108.31 E48.222 F1800
+G1 Y107.39 E48.284 F1800
+G1 X77.61 Y132.2 E50.6528 F1800
+G1 X78.52 E50.7149 F1800
+G1 X52.8 Y106.48 E53.1714 F1800
+G1 Y105.56 E53.2335 F1800
+G1 X79.44 Y132.2 E55.7778 F1800
+G1 X80.36 E55.8398 F1800
+G1 X52.8 Y104.64 E58.4718 F1800
+G1 Y103.72 E58.5339 F1800
+G1 X81.28 Y132.2 E61.2536 F1800
+G1 X82.2 E61.3157 F1800
+G1 X52.8 Y102.8 E64.1232 F1800
+G1 X53.72 E64.1852 F1800
+G1 X82.2 Y131.28 E66.9049 F1800
+G1 Y130.36 E66.967 F1800
+G1 X54.64 Y102.8 E69.599 F1800
+G1 X55.56 E69.661 F1800
+G1 X82.2 Y129.44 E72.2053 F1800
+G1 Y128.52 E72.2674 F1800
+G1 X56.48 Y102.8 E74.7239 F1800
+G1 X57.39 E74.786 F1800
+G1 X82.2 Y127.61 E77.1548 F1800
+G1 Y126.69 E77.2168 F1800
+G1 X58.31 Y102.8 E79.4979 F1800
+G1 X59.23 E79.56 F1800
+G1 X82.2 Y125.77 E81.7533 F1800
+G1 Y124.85 E81.8153 F1800
+G1 X60.15 Y102.8 E83.921 F1800
+G1 X61.07 E83.983 F1800
+G1 X82.2 Y123.93 E86.0009 F1800
+G1 Y123.01 E86.0629 F1800
+G1 X61.99 Y102.8 E87.9931 F1800
+G1 X62.91 E88.0551 F1800
+G1 X82.2 Y122.09 E89.8975 F1800
+G1 Y121.17 E89.9596 F1800
+G1 X63.83 Y102.8 E91.7143 F1800
+G1 X64.74 E91.7763 F1800
+G1 X82.2 Y120.26 E93.4432 F1800
+G1 Y119.34 E93.5053 F1800
+G1 X65.66 Y102.8 E95.0845 F1800
+G1 X66.58 E95.1465 F1800
+G1 X82.2 Y118.42 E96.638 F1800
+G1 Y117.5 E96.7 F1800
+G1 X67.5 Y102.8 E98.1038 F1800
+G1 X68.42 E98.1658 F1800
+G1 X82.2 Y116.58 E99.4818 F1800
+G1 Y115.66 E99.5439 F1800
+G1 X69.34 Y102.8 E100.7722 F1800
+G1 X70.26 E100.8342 F1800
+G1 X82.2 Y114.74 E101.9747 F1800
+G1 Y113.82 E102.0368 F1800
+G1 X71.18 Y102.8 E103.0896 F1800
+G1 X72.09 E103.1516 F1800
+G1 X82.2 Y112.91 E104.1167 F1800
+G1 Y111.99 E104.1787 F1800
+G1 X73.01 Y102.8 E105.0561 F1800
+G1 X73.93 E105.1181 F1800
+G1 X82.2 Y111.07 E105.9077 F1800
+G1 Y110.15 E105.9698 F1800
+G1 X74.85 Y102.8 E106.6716 F1800
+G1 X75.77 E106.7337 F1800
+G1 X82.2 Y109.23 E107.3478 F1800
+G1 Y108.31 E107.4099 F1800
+G1 X76.69 Y102.8 E107.9363 F1800
+G1 X77.61 E107.9983 F1800
+G1 X82.2 Y107.39 E108.437 F1800
+G1 Y106.48 E108.499 F1800
+G1 X78.53 Y102.8 E108.8499 F1800
+G1 X79.44 E108.912 F1800
+G1 X82.2 Y105.56 E109.1752 F1800
+G1 Y104.64 E109.2372 F1800
+G1 X80.36 Y102.8 E109.4127 F1800
+G1 X81.28 E109.4747 F1800
+G1 X82.2 Y103.72 E109.5625 F1800
+G1 Y102.8 E109.6245 F1800
+G1 E108.62 F1800
+G1 X152.8 Y132.2 F9000
+G1 E109.42 F1800
+G1 Y131.28 E109.4865 F1800
+G1 X153.72 Y132.2 E109.5743 F1800
+G1 X154.64 E109.6363 F1800
+G1 X152.8 Y130.36 E109.8118 F1800
+G1 Y129.44 E109.8738 F1800
+G1 X155.56 Y132.2 E110.137 F1800
+G1 X156.48 E110.199 F1800
+G1 X152.8 Y128.53 E110.55 F1800
+G1 Y127.61 E110.612 F1800
+G1 X157.39 Y132.2 E111.0507 F1800
+G1 X158.31 E111.1127 F1800
+G1 X152.8 Y126.69 E111.6391 F1800
+G1 Y125.77 E111.7012 F1800
+G1 X159.23 Y132.2 E112.3153 F1800
+G1 X160.15 E112.3773 F1800
+G1 X152.8 Y124.85 E113.0792 F1800
+G1 Y123.93 E113.1413 F1800
+G1 X161.07 Y132.2 E113.9309 F1800
+G1 X161.99 E113.9929 F1800
+G1 X152.8 Y123.01 E114.8702 F1800
+G1 Y122.09 E114.9323 F1800
+G1 X162.91 Y132.2 E115.8974 F1800
+G1 X163.82 E115.9594 F1800
+G1 X152.8 Y121.18 E117.0122 F1800
+G1 Y120.26 E117.0742 F1800
+G1 X164.74 Y132.2 E118.2148 F1800
+G1 X165.66 E118.2768 F1800
+G1 X152.8 Y119.34 E119.5051 F1800
+G1 Y118.42 E119.5671 F1800
+G1 X166.58 Y132.2 E120.8832 F1800
+G1 X167.5 E120.9452 F1800
+G1 X152.8 Y117.5 E122.3489 F1800
+G1 Y116.58 E122.411 F1800
+G1 X168.42 Y132.2 E123.9025 F1800
+G1 X169.34 E123.9645 F1800
+G1 X152.8 Y115.66 E125.5437 F1800
+G1 Y114.74 E125.6057 F1800
+G1 X170.26 Y132.2 E127.2727 F1800
+G1 X171.18 E127.3347 F1800
+G1 X152.8 Y113.82 E129.0894 F1800
+G1 Y112.91 E129.1515 F1800
+G1 X172.09 Y132.2 E130.9939 F1800
+G1 X173.01 E131.0559 F1800
+G1 X152.8 Y111.99 E132.9861 F1800
+G1 Y111.07 E133.0481 F1800
+G1 X173.93 Y132.2 E135.066 F1800
+G1 X174.85 E135.128 F1800
+G1 X152.8 Y110.15 E137.2336 F1800
+G1 Y109.23 E137.2957 F1800
+G1 X175.77 Y132.2 E139.489 F1800
+G1 X176.69 E139.5511 F1800
+G1 X152.8 Y108.31 E141.8322 F1800
+G1 Y107.39 E141.8942 F1800
+G1 X177.61 Y132.2 E144.263 F1800
+G1 X178.52 E144.3251 F1800
+G1 X152.8 Y106.48 E146.7816 F1800
+G1 Y105.56 E146.8436 F1800
+G1 X179.44 Y132.2 E149.3879 F1800
+G1 X180.36 E149.45 F1800
+G1 X152.8 Y104.64 E152.082 F1800
+G1 Y103.72 E152.144 F1800
+G1 X181.28 Y132.2 E154.8638 F1800
+G1 X182.2 E154.9258 F1800
+G1 X152.8 Y102.8 E157.7333 F1800
+G1 X153.72 E157.7954 F1800
+G1 X182.2 Y131.28 E160.5151 F1800
+G1 Y130.36 E160.5772 F1800
+G1 X154.64 Y102.8 E163.2092 F1800
+G1 X155.56 E163.2712 F1800
+G1 X182.2 Y129.44 E165.8155 F1800
+G1 Y128.52 E165.8776 F1800
+G1 X156.48 Y102.8 E168.3341 F1800
+G1 X157.39 E168.3961 F1800
+G1 X182.2 Y127.61 E170.765 F1800
+G1 Y126.69 E170.827 F1800
+G1 X158.31 Y102.8 E173.1081 F1800
+G1 X159.23 E173.1701 F1800
+G1 X182.2 Y125.77 E175.3635 F1800
+G1 Y124.85 E175.4255 F1800
+G1 X160.15 Y102.8 E177.5311 F1800
+G1 X161.07 E177.5932 F1800
+G1 X182.2 Y123.93 E179.6111 F1800
+G1 Y123.01 E179.6731 F1800
+G1 X161.99 Y102.8 E181.6033 F1800
+G1 X162.91 E181.6653 F1800
+G1 X182.2 Y122.09 E183.5077 F1800
+G1 Y121.17 E183.5697 F1800
+G1 X163.82 Y102.8 E185.3244 F1800
+G1 X164.74 E185.3865 F1800
+G1 X182.2 Y120.26 E187.0534 F1800
+G1 Y119.34 E187.1155 F1800
+G1 X165.66 Y102.8 E188.6947 F1800
+G1 X166.58 E188.7567 F1800
+G1 X182.2 Y118.42 E190.2482 F1800
+G1 Y117.5 E190.3102 F1800
+G1 X167.5 Y102.8 E191.714 F1800
+G1 X168.42 E191.776 F1800
+G1 X182.2 Y116.58 E193.092 F1800
+G1 Y115.66 E193.1541 F1800
+G1 X169.34 Y102.8 E194.3823 F1800
+G1 X170.26 E194.4444 F1800
+G1 X182.2 Y114.74 E195.5849 F1800
+G1 Y113.82 E195.647 F1800
+G1 X171.18 Y102.8 E196.6998 F1800
+G1 X172.09 E196.7618 F1800
+G1 X182.2 Y112.91 E197.7269 F1800
+G1 Y111.99 E197.7889 F1800
+G1 X173.01 Y102.8 E198.6663 F1800
+G1 X173.93 E198.7283 F1800
+G1 X182.2 Y111.07 E199.5179 F1800
+G1 Y110.15 E199.5799 F1800
+G1 X174.85 Y102.8 E200.2818 F1800
+G1 X175.77 E200.3439 F1800
+G1 X182.2 Y109.23 E200.958 F1800
+G1 Y108.31 E201.02 F1800
+G1 X176.69 Y102.8 E201.5464 F1800
+G1 X177.61 E201.6085 F1800
+G1 X182.2 Y107.39 E202.0471 F1800
+G1 Y106.48 E202.1092 F1800
+G1 X178.53 Y102.8 E202.4601 F1800
+G1 X179.44 E202.5222 F1800
+G1 X182.2 Y105.56 E202.7854 F1800
+G1 Y104.64 E202.8474 F1800
+G1 X180.36 Y102.8 E203.0229 F1800
+G1 X181.28 E203.0849 F1800
+G1 X182.2 Y103.72 E203.1726 F1800
+G1 Y102.8 E203.2347 F1800
+;layer #2
+M106 S169
+G1 E202.23 F1800
+G1 X160.46 Y110.46 F9000
+G1 E203.03 F1800
+G1 Z0.5 F300
+G1 Y124.54 E203.62 F3600
+G1 X174.54 E204.2054
+G1 Y110.46 E204.7908
+G1 X160.46 E205.3762
+G1 X160.1 Y110.1
+G1 Y124.9 E205.9915
+G1 X174.9 E206.6068
+G1 Y110.1 E207.2221
+G1 X160.1 E207.8374
+G1 E206.84 F1800
+G1 X74.54 Y110.46 F9000
+G1 E207.64 F1800
+G1 X60.46 E208.2228 F3600
+G1 Y124.54 E208.8082
+G1 X74.54 E209.3936
+G1 Y110.46 E209.9789
+G1 X74.9 Y110.1
+G1 X60.1 E210.5943
+G1 Y124.9 E211.2096
+G1 X74.9 E211.8249
+G1 Y110.1 E212.4402
+;layer #3
+M106 S254
+G1 E211.44 F1800
+G1 X74.54 Y110.46 F9000
+G1 E212.24 F1800
+G1 Z0.75 F300
+G1 X60.46 E212.8256 F3600
+G1 Y124.54 E213.411
+G1 X74.54 E213.9963
+G1 Y110.46 E214.5817
+G1 X74.9 Y110.1
+G1 X60.1 E215.197
+G1 Y124.9 E215.8123
+G1 X74.9 E216.4276
+G1 Y110.1 E217.043
+G1 E216.04 F1800
+G1 X160.46 Y110.46 F9000
+G1 E216.84 F1800
+G1 Y124.54 E217.4283 F3600
+G1 X174.54 E218.0137
+G1 Y110.46 E218.5991
+G1 X160.46 E219.1845
+G1 X160.1 Y110.1
+G1 Y124.9 E219.7998
+G1 X174.9 E220.4151
+G1 Y110.1 E221.0304
+G1 X160.1 E221.6457
+;layer #4
+G1 E220.65 F1800
+G1 X160.46 Y110.46 F9000
+G1 E221.45 F1800
+G1 Z1 F300
+G1 Y124.54 E222.0311 F3600
+G1 X174.54 E222.6165
+G1 Y110.46 E223.2019
+G1 X160.46 E223.7872
+G1 X160.1 Y110.1
+G1 Y124.9 E224.4026
+G1 X174.9 E225.0179
+G1 Y110.1 E225.6332
+G1 X160.1 E226.2485
+G1 E225.25 F1800
+G1 X74.54 Y110.46 F9000
+G1 E226.05 F1800
+G1 X60.46 E226.6339 F3600
+G1 Y124.54 E227.2192
+G1 X74.54 E227.8046
+G1 Y110.46 E228.39
+G1 X74.9 Y110.1
+G1 X60.1 E229.0053
+G1 Y124.9 E229.6206
+G1 X74.9 E230.2359
+G1 Y110.1 E230.8513
+;layer #5
+G1 E229.85 F1800
+G1 X74.54 Y110.46 F9000
+G1 E230.65 F1800
+G1 Z1.25 F300
+G1 X60.46 E231.2366 F3600
+G1 Y124.54 E231.822
+G1 X74.54 E232.4074
+G1 Y110.46 E232.9928
+G1 X74.9 Y110.1
+G1 X60.1 E233.6081
+G1 Y124.9 E234.2234
+G1 X74.9 E234.8387
+G1 Y110.1 E235.454
+G1 E234.45 F1800
+G1 X160.46 Y110.46 F9000
+G1 E235.25 F1800
+G1 Y124.54 E235.8394 F3600
+G1 X174.54 E236.4248
+G1 Y110.46 E237.0101
+G1 X160.46 E237.5955
+G1 X160.1 Y110.1
+G1 Y124.9 E238.2108
+G1 X174.9 E238.8262
+G1 Y110.1 E239.4415
+G1 X160.1 E240.0568
+;layer #6
+G1 E239.06 F1800
+G1 X160.46 Y110.46 F9000
+G1 E239.86 F1800
+G1 Z1.5 F300
+G1 Y124.54 E240.4422 F3600
+G1 X174.54 E241.0275
+G1 Y110.46 E241.6129
+G1 X160.46 E242.1983
+G1 X160.1 Y110.1
+G1 Y124.9 E242.8136
+G1 X174.9 E243.4289
+G1 Y110.1 E244.0442
+G1 X160.1 E244.6595
+G1 E243.66 F1800
+G1 X74.54 Y110.46 F9000
+G1 E244.46 F1800
+G1 X60.46 E245.0449 F3600
+G1 Y124.54 E245.6303
+G1 X74.54 E246.2157
+G1 Y110.46 E246.8011
+G1 X74.9 Y110.1
+G1 X60.1 E247.4164
+G1 Y124.9 E248.0317
+G1 X74.9 E248.647
+G1 Y110.1 E249.2623
+;layer #7
+G1 E248.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E249.06 F1800
+G1 Z1.75 F300
+G1 X60.46 E249.6477 F3600
+G1 Y124.54 E250.2331
+G1 X74.54 E250.8184
+G1 Y110.46 E251.4038
+G1 X74.9 Y110.1
+G1 X60.1 E252.0191
+G1 Y124.9 E252.6344
+G1 X74.9 E253.2498
+G1 Y110.1 E253.8651
+G1 E252.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E253.67 F1800
+G1 Y124.54 E254.2504 F3600
+G1 X174.54 E254.8358
+G1 Y110.46 E255.4212
+G1 X160.46 E256.0066
+G1 X160.1 Y110.1
+G1 Y124.9 E256.6219
+G1 X174.9 E257.2372
+G1 Y110.1 E257.8525
+G1 X160.1 E258.4678
+;layer #8
+G1 E257.47 F1800
+G1 X160.46 Y110.46 F9000
+G1 E258.27 F1800
+G1 Z2 F300
+G1 Y124.54 E258.8532 F3600
+G1 X174.54 E259.4386
+G1 Y110.46 E260.024
+G1 X160.46 E260.6093
+G1 X160.1 Y110.1
+G1 Y124.9 E261.2247
+G1 X174.9 E261.84
+G1 Y110.1 E262.4553
+G1 X160.1 E263.0706
+G1 E262.07 F1800
+G1 X74.54 Y110.46 F9000
+G1 E262.87 F1800
+G1 X60.46 E263.456 F3600
+G1 Y124.54 E264.0414
+G1 X74.54 E264.6267
+G1 Y110.46 E265.2121
+G1 X74.9 Y110.1
+G1 X60.1 E265.8274
+G1 Y124.9 E266.4427
+G1 X74.9 E267.058
+G1 Y110.1 E267.6734
+;layer #9
+G1 E266.67 F1800
+G1 X74.54 Y110.46 F9000
+G1 E267.47 F1800
+G1 Z2.25 F300
+G1 X60.46 E268.0587 F3600
+G1 Y124.54 E268.6441
+G1 X74.54 E269.2295
+G1 Y110.46 E269.8149
+G1 X74.9 Y110.1
+G1 X60.1 E270.4302
+G1 Y124.9 E271.0455
+G1 X74.9 E271.6608
+G1 Y110.1 E272.2761
+G1 E271.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E272.08 F1800
+G1 Y124.54 E272.6615 F3600
+G1 X174.54 E273.2469
+G1 Y110.46 E273.8323
+G1 X160.46 E274.4176
+G1 X160.1 Y110.1
+G1 Y124.9 E275.0329
+G1 X174.9 E275.6483
+G1 Y110.1 E276.2636
+G1 X160.1 E276.8789
+;layer #10
+G1 E275.88 F1800
+G1 X160.46 Y110.46 F9000
+G1 E276.68 F1800
+G1 Z2.5 F300
+G1 Y124.54 E277.2643 F3600
+G1 X174.54 E277.8496
+G1 Y110.46 E278.435
+G1 X160.46 E279.0204
+G1 X160.1 Y110.1
+G1 Y124.9 E279.6357
+G1 X174.9 E280.251
+G1 Y110.1 E280.8663
+G1 X160.1 E281.4816
+G1 E280.48 F1800
+G1 X74.54 Y110.46 F9000
+G1 E281.28 F1800
+G1 X60.46 E281.867 F3600
+G1 Y124.54 E282.4524
+G1 X74.54 E283.0378
+G1 Y110.46 E283.6232
+G1 X74.9 Y110.1
+G1 X60.1 E284.2385
+G1 Y124.9 E284.8538
+G1 X74.9 E285.4691
+G1 Y110.1 E286.0844
+;layer #11
+G1 E285.08 F1800
+G1 X74.54 Y110.46 F9000
+G1 E285.88 F1800
+G1 Z2.75 F300
+G1 X60.46 E286.4698 F3600
+G1 Y124.54 E287.0552
+G1 X74.54 E287.6405
+G1 Y110.46 E288.2259
+G1 X74.9 Y110.1
+G1 X60.1 E288.8412
+G1 Y124.9 E289.4566
+G1 X74.9 E290.0719
+G1 Y110.1 E290.6872
+G1 E289.69 F1800
+G1 X160.46 Y110.46 F9000
+G1 E290.49 F1800
+G1 Y124.54 E291.0726 F3600
+G1 X174.54 E291.6579
+G1 Y110.46 E292.2433
+G1 X160.46 E292.8287
+G1 X160.1 Y110.1
+G1 Y124.9 E293.444
+G1 X174.9 E294.0593
+G1 Y110.1 E294.6746
+G1 X160.1 E295.2899
+;layer #12
+G1 E294.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E295.09 F1800
+G1 Z3 F300
+G1 Y124.54 E295.6753 F3600
+G1 X174.54 E296.2607
+G1 Y110.46 E296.8461
+G1 X160.46 E297.4315
+G1 X160.1 Y110.1
+G1 Y124.9 E298.0468
+G1 X174.9 E298.6621
+G1 Y110.1 E299.2774
+G1 X160.1 E299.8927
+G1 E298.89 F1800
+G1 X74.54 Y110.46 F9000
+G1 E299.69 F1800
+G1 X60.46 E300.2781 F3600
+G1 Y124.54 E300.8635
+G1 X74.54 E301.4488
+G1 Y110.46 E302.0342
+G1 X74.9 Y110.1
+G1 X60.1 E302.6495
+G1 Y124.9 E303.2648
+G1 X74.9 E303.8802
+G1 Y110.1 E304.4955
+;layer #13
+G1 E303.76 F1800
+G1 X74.64 Y110.36 F9000
+G1 E304.5 F1800
+G1 Z3.25 F300
+G1 X60.36 E305.0892 F3600
+G1 Y124.64 E305.6829
+G1 X74.64 E306.2765
+G1 Y110.36 E306.8702
+G1 X75 Y110
+G1 X60 E307.4939
+G1 Y125 E308.1175
+G1 X75 E308.7411
+G1 Y110 E309.3648
+G1 E308.63 F1800
+G1 X160.36 Y110.36 F9000
+G1 E309.36 F1800
+G1 Y124.64 E309.9584 F3600
+G1 X174.64 E310.5521
+G1 Y110.36 E311.1458
+G1 X160.36 E311.7395
+G1 X160 Y110
+G1 Y125 E312.3632
+G1 X175 E312.9868
+G1 Y110 E313.6104
+G1 X160 E314.234
+;layer #14
+G1 E313.5 F1800
+G1 X160.46 Y110.46 F9000
+G1 E314.23 F1800
+G1 Z3.5 F300
+G1 Y124.54 E314.8194 F3600
+G1 X174.54 E315.4048
+G1 Y110.46 E315.9902
+G1 X160.46 E316.5755
+G1 X160.1 Y110.1
+G1 Y124.9 E317.1909
+G1 X174.9 E317.8062
+G1 Y110.1 E318.4215
+G1 X160.1 E319.0368
+G1 E318.3 F1800
+G1 X74.54 Y110.46 F9000
+G1 E319.04 F1800
+G1 X60.46 E319.6222 F3600
+G1 Y124.54 E320.2076
+G1 X74.54 E320.7929
+G1 Y110.46 E321.3783
+G1 X74.9 Y110.1
+G1 X60.1 E321.9936
+G1 Y124.9 E322.6089
+G1 X74.9 E323.2242
+G1 Y110.1 E323.8396
+;layer #15
+G1 E323.11 F1800
+G1 X74.54 Y110.46 F9000
+G1 E323.84 F1800
+G1 Z3.75 F300
+G1 X60.46 E324.4249 F3600
+G1 Y124.54 E325.0103
+G1 X74.54 E325.5957
+G1 Y110.46 E326.1811
+G1 X74.9 Y110.1
+G1 X60.1 E326.7964
+G1 Y124.9 E327.4117
+G1 X74.9 E328.027
+G1 Y110.1 E328.6423
+G1 E327.91 F1800
+G1 X160.46 Y110.46 F9000
+G1 E328.64 F1800
+G1 Y124.54 E329.2277 F3600
+G1 X174.54 E329.8131
+G1 Y110.46 E330.3985
+G1 X160.46 E330.9838
+G1 X160.1 Y110.1
+G1 Y124.9 E331.5992
+G1 X174.9 E332.2145
+G1 Y110.1 E332.8298
+G1 X160.1 E333.4451
+;layer #16
+G1 E332.71 F1800
+G1 X160.46 Y110.46 F9000
+G1 E333.45 F1800
+G1 Z4 F300
+G1 Y124.54 E334.0305 F3600
+G1 X174.54 E334.6158
+G1 Y110.46 E335.2012
+G1 X160.46 E335.7866
+G1 X160.1 Y110.1
+G1 Y124.9 E336.4019
+G1 X174.9 E337.0172
+G1 Y110.1 E337.6325
+G1 X160.1 E338.2479
+G1 E337.51 F1800
+G1 X74.54 Y110.46 F9000
+G1 E338.25 F1800
+G1 X60.46 E338.8332 F3600
+G1 Y124.54 E339.4186
+G1 X74.54 E340.004
+G1 Y110.46 E340.5894
+G1 X74.9 Y110.1
+G1 X60.1 E341.2047
+G1 Y124.9 E341.82
+G1 X74.9 E342.4353
+G1 Y110.1 E343.0506
+;layer #17
+G1 E342.32 F1800
+G1 X74.54 Y110.46 F9000
+G1 E343.05 F1800
+G1 Z4.25 F300
+G1 X60.46 E343.636 F3600
+G1 Y124.54 E344.2214
+G1 X74.54 E344.8068
+G1 Y110.46 E345.3921
+G1 X74.9 Y110.1
+G1 X60.1 E346.0074
+G1 Y124.9 E346.6228
+G1 X74.9 E347.2381
+G1 Y110.1 E347.8534
+G1 E347.12 F1800
+G1 X160.46 Y110.46 F9000
+G1 E347.85 F1800
+G1 Y124.54 E348.4388 F3600
+G1 X174.54 E349.0241
+G1 Y110.46 E349.6095
+G1 X160.46 E350.1949
+G1 X160.1 Y110.1
+G1 Y124.9 E350.8102
+G1 X174.9 E351.4255
+G1 Y110.1 E352.0408
+G1 X160.1 E352.6561
+;layer #18
+G1 E351.92 F1800
+G1 X160.46 Y110.46 F9000
+G1 E352.66 F1800
+G1 Z4.5 F300
+G1 Y124.54 E353.2415 F3600
+G1 X174.54 E353.8269
+G1 Y110.46 E354.4123
+G1 X160.46 E354.9977
+G1 X160.1 Y110.1
+G1 Y124.9 E355.613
+G1 X174.9 E356.2283
+G1 Y110.1 E356.8436
+G1 X160.1 E357.4589
+G1 E356.73 F1800
+G1 X74.54 Y110.46 F9000
+G1 E357.46 F1800
+G1 X60.46 E358.0443 F3600
+G1 Y124.54 E358.6297
+G1 X74.54 E359.215
+G1 Y110.46 E359.8004
+G1 X74.9 Y110.1
+G1 X60.1 E360.4157
+G1 Y124.9 E361.031
+G1 X74.9 E361.6464
+G1 Y110.1 E362.2617
+;layer #19
+G1 E361.53 F1800
+G1 X74.54 Y110.46 F9000
+G1 E362.26 F1800
+G1 Z4.75 F300
+G1 X60.46 E362.847 F3600
+G1 Y124.54 E363.4324
+G1 X74.54 E364.0178
+G1 Y110.46 E364.6032
+G1 X74.9 Y110.1
+G1 X60.1 E365.2185
+G1 Y124.9 E365.8338
+G1 X74.9 E366.4491
+G1 Y110.1 E367.0644
+G1 E366.33 F1800
+G1 X160.46 Y110.46 F9000
+G1 E367.06 F1800
+G1 Y124.54 E367.6498 F3600
+G1 X174.54 E368.2352
+G1 Y110.46 E368.8206
+G1 X160.46 E369.4059
+G1 X160.1 Y110.1
+G1 Y124.9 E370.0213
+G1 X174.9 E370.6366
+G1 Y110.1 E371.2519
+G1 X160.1 E371.8672
+;layer #20
+G1 E371.13 F1800
+G1 X160.46 Y110.46 F9000
+G1 E371.87 F1800
+G1 Z5 F300
+G1 Y124.54 E372.4526 F3600
+G1 X174.54 E373.038
+G1 Y110.46 E373.6233
+G1 X160.46 E374.2087
+G1 X160.1 Y110.1
+G1 Y124.9 E374.824
+G1 X174.9 E375.4393
+G1 Y110.1 E376.0546
+G1 X160.1 E376.67
+G1 E375.94 F1800
+G1 X74.54 Y110.46 F9000
+G1 E376.67 F1800
+G1 X60.46 E377.2553 F3600
+G1 Y124.54 E377.8407
+G1 X74.54 E378.4261
+G1 Y110.46 E379.0115
+G1 X74.9 Y110.1
+G1 X60.1 E379.6268
+G1 Y124.9 E380.2421
+G1 X74.9 E380.8574
+G1 Y110.1 E381.4727
+;layer #21
+G1 E380.74 F1800
+G1 X74.54 Y110.46 F9000
+G1 E381.47 F1800
+G1 Z5.25 F300
+G1 X60.46 E382.0581 F3600
+G1 Y124.54 E382.6435
+G1 X74.54 E383.2289
+G1 Y110.46 E383.8142
+G1 X74.9 Y110.1
+G1 X60.1 E384.4295
+G1 Y124.9 E385.0449
+G1 X74.9 E385.6602
+G1 Y110.1 E386.2755
+G1 E385.54 F1800
+G1 X160.46 Y110.46 F9000
+G1 E386.28 F1800
+G1 Y124.54 E386.8609 F3600
+G1 X174.54 E387.4462
+G1 Y110.46 E388.0316
+G1 X160.46 E388.617
+G1 X160.1 Y110.1
+G1 Y124.9 E389.2323
+G1 X174.9 E389.8476
+G1 Y110.1 E390.4629
+G1 X160.1 E391.0783
+;layer #22
+G1 E390.34 F1800
+G1 X160.46 Y110.46 F9000
+G1 E391.08 F1800
+G1 Z5.5 F300
+G1 Y124.54 E391.6636 F3600
+G1 X174.54 E392.249
+G1 Y110.46 E392.8344
+G1 X160.46 E393.4198
+G1 X160.1 Y110.1
+G1 Y124.9 E394.0351
+G1 X174.9 E394.6504
+G1 Y110.1 E395.2657
+G1 X160.1 E395.881
+G1 E395.15 F1800
+G1 X74.54 Y110.46 F9000
+G1 E395.88 F1800
+G1 X60.46 E396.4664 F3600
+G1 Y124.54 E397.0518
+G1 X74.54 E397.6371
+G1 Y110.46 E398.2225
+G1 X74.9 Y110.1
+G1 X60.1 E398.8378
+G1 Y124.9 E399.4532
+G1 X74.9 E400.0685
+G1 Y110.1 E400.6838
+;layer #23
+G1 E399.95 F1800
+G1 X74.54 Y110.46 F9000
+G1 E400.68 F1800
+G1 Z5.75 F300
+G1 X60.46 E401.2692 F3600
+G1 Y124.54 E401.8545
+G1 X74.54 E402.4399
+G1 Y110.46 E403.0253
+G1 X74.9 Y110.1
+G1 X60.1 E403.6406
+G1 Y124.9 E404.2559
+G1 X74.9 E404.8712
+G1 Y110.1 E405.4865
+G1 E404.75 F1800
+G1 X160.46 Y110.46 F9000
+G1 E405.49 F1800
+G1 Y124.54 E406.0719 F3600
+G1 X174.54 E406.6573
+G1 Y110.46 E407.2427
+G1 X160.46 E407.8281
+G1 X160.1 Y110.1
+G1 Y124.9 E408.4434
+G1 X174.9 E409.0587
+G1 Y110.1 E409.674
+G1 X160.1 E410.2893
+;layer #24
+G1 E409.56 F1800
+G1 X160.46 Y110.46 F9000
+G1 E410.29 F1800
+G1 Z6 F300
+G1 Y124.54 E410.8747 F3600
+G1 X174.54 E411.4601
+G1 Y110.46 E412.0454
+G1 X160.46 E412.6308
+G1 X160.1 Y110.1
+G1 Y124.9 E413.2461
+G1 X174.9 E413.8614
+G1 Y110.1 E414.4768
+G1 X160.1 E415.0921
+G1 E414.36 F1800
+G1 X74.54 Y110.46 F9000
+G1 E415.09 F1800
+G1 X60.46 E415.6774 F3600
+G1 Y124.54 E416.2628
+G1 X74.54 E416.8482
+G1 Y110.46 E417.4336
+G1 X74.9 Y110.1
+G1 X60.1 E418.0489
+G1 Y124.9 E418.6642
+G1 X74.9 E419.2795
+G1 Y110.1 E419.8948
+;layer #25
+G1 E419.43 F1800
+G1 X74.64 Y110.36 F9000
+G1 E420.09 F1800
+G1 Z6.25 F300
+G1 X60.36 E420.6885 F3600
+G1 Y124.64 E421.2822
+G1 X74.64 E421.8759
+G1 Y110.36 E422.4696
+G1 X75 Y110
+G1 X60 E423.0932
+G1 Y125 E423.7169
+G1 X75 E424.3405
+G1 Y110 E424.9641
+G1 E424.5 F1800
+G1 X160.36 Y110.36 F9000
+G1 E425.16 F1800
+G1 Y124.64 E425.7578 F3600
+G1 X174.64 E426.3515
+G1 Y110.36 E426.9452
+G1 X160.36 E427.5389
+G1 X160 Y110
+G1 Y125 E428.1625
+G1 X175 E428.7861
+G1 Y110 E429.4098
+G1 X160 E430.0334
+;layer #26
+G1 E429.57 F1800
+G1 X160.46 Y110.46 F9000
+G1 E430.23 F1800
+G1 Z6.5 F300
+G1 Y124.54 E430.8188 F3600
+G1 X174.54 E431.4042
+G1 Y110.46 E431.9895
+G1 X160.46 E432.5749
+G1 X160.1 Y110.1
+G1 Y124.9 E433.1902
+G1 X174.9 E433.8055
+G1 Y110.1 E434.4209
+G1 X160.1 E435.0362
+G1 E434.57 F1800
+G1 X74.54 Y110.46 F9000
+G1 E435.24 F1800
+G1 X60.46 E435.8215 F3600
+G1 Y124.54 E436.4069
+G1 X74.54 E436.9923
+G1 Y110.46 E437.5777
+G1 X74.9 Y110.1
+G1 X60.1 E438.193
+G1 Y124.9 E438.8083
+G1 X74.9 E439.4236
+G1 Y110.1 E440.0389
+;layer #27
+G1 E439.57 F1800
+G1 X74.54 Y110.46 F9000
+G1 E440.24 F1800
+G1 Z6.75 F300
+G1 X60.46 E440.8243 F3600
+G1 Y124.54 E441.4097
+G1 X74.54 E441.9951
+G1 Y110.46 E442.5804
+G1 X74.9 Y110.1
+G1 X60.1 E443.1958
+G1 Y124.9 E443.8111
+G1 X74.9 E444.4264
+G1 Y110.1 E445.0417
+G1 E444.58 F1800
+G1 X160.46 Y110.46 F9000
+G1 E445.24 F1800
+G1 Y124.54 E445.8271 F3600
+G1 X174.54 E446.4124
+G1 Y110.46 E446.9978
+G1 X160.46 E447.5832
+G1 X160.1 Y110.1
+G1 Y124.9 E448.1985
+G1 X174.9 E448.8138
+G1 Y110.1 E449.4291
+G1 X160.1 E450.0445
+;layer #28
+G1 E449.58 F1800
+G1 X160.46 Y110.46 F9000
+G1 E450.24 F1800
+G1 Z7 F300
+G1 Y124.54 E450.8298 F3600
+G1 X174.54 E451.4152
+G1 Y110.46 E452.0006
+G1 X160.46 E452.586
+G1 X160.1 Y110.1
+G1 Y124.9 E453.2013
+G1 X174.9 E453.8166
+G1 Y110.1 E454.4319
+G1 X160.1 E455.0472
+G1 E454.58 F1800
+G1 X74.54 Y110.46 F9000
+G1 E455.25 F1800
+G1 X60.46 E455.8326 F3600
+G1 Y124.54 E456.418
+G1 X74.54 E457.0034
+G1 Y110.46 E457.5887
+G1 X74.9 Y110.1
+G1 X60.1 E458.204
+G1 Y124.9 E458.8194
+G1 X74.9 E459.4347
+G1 Y110.1 E460.05
+;layer #29
+G1 E459.58 F1800
+G1 X74.54 Y110.46 F9000
+G1 E460.25 F1800
+G1 Z7.25 F300
+G1 X60.46 E460.8354 F3600
+G1 Y124.54 E461.4207
+G1 X74.54 E462.0061
+G1 Y110.46 E462.5915
+G1 X74.9 Y110.1
+G1 X60.1 E463.2068
+G1 Y124.9 E463.8221
+G1 X74.9 E464.4374
+G1 Y110.1 E465.0527
+G1 E464.59 F1800
+G1 X160.46 Y110.46 F9000
+G1 E465.25 F1800
+G1 Y124.54 E465.8381 F3600
+G1 X174.54 E466.4235
+G1 Y110.46 E467.0089
+G1 X160.46 E467.5943
+G1 X160.1 Y110.1
+G1 Y124.9 E468.2096
+G1 X174.9 E468.8249
+G1 Y110.1 E469.4402
+G1 X160.1 E470.0555
+;layer #30
+G1 E469.59 F1800
+G1 X160.46 Y110.46 F9000
+G1 E470.26 F1800
+G1 Z7.5 F300
+G1 Y124.54 E470.8409 F3600
+G1 X174.54 E471.4263
+G1 Y110.46 E472.0116
+G1 X160.46 E472.597
+G1 X160.1 Y110.1
+G1 Y124.9 E473.2123
+G1 X174.9 E473.8276
+G1 Y110.1 E474.443
+G1 X160.1 E475.0583
+G1 E474.59 F1800
+G1 X74.54 Y110.46 F9000
+G1 E475.26 F1800
+G1 X60.46 E475.8436 F3600
+G1 Y124.54 E476.429
+G1 X74.54 E477.0144
+G1 Y110.46 E477.5998
+G1 X74.9 Y110.1
+G1 X60.1 E478.2151
+G1 Y124.9 E478.8304
+G1 X74.9 E479.4457
+G1 Y110.1 E480.061
+;layer #31
+G1 E479.59 F1800
+G1 X74.54 Y110.46 F9000
+G1 E480.26 F1800
+G1 Z7.75 F300
+G1 X60.46 E480.8464 F3600
+G1 Y124.54 E481.4318
+G1 X74.54 E482.0172
+G1 Y110.46 E482.6025
+G1 X74.9 Y110.1
+G1 X60.1 E483.2179
+G1 Y124.9 E483.8332
+G1 X74.9 E484.4485
+G1 Y110.1 E485.0638
+G1 E484.6 F1800
+G1 X160.46 Y110.46 F9000
+G1 E485.26 F1800
+G1 Y124.54 E485.8492 F3600
+G1 X174.54 E486.4346
+G1 Y110.46 E487.0199
+G1 X160.46 E487.6053
+G1 X160.1 Y110.1
+G1 Y124.9 E488.2206
+G1 X174.9 E488.8359
+G1 Y110.1 E489.4512
+G1 X160.1 E490.0666
+;layer #32
+G1 E489.6 F1800
+G1 X160.46 Y110.46 F9000
+G1 E490.27 F1800
+G1 Z8 F300
+G1 Y124.54 E490.8519 F3600
+G1 X174.54 E491.4373
+G1 Y110.46 E492.0227
+G1 X160.46 E492.6081
+G1 X160.1 Y110.1
+G1 Y124.9 E493.2234
+G1 X174.9 E493.8387
+G1 Y110.1 E494.454
+G1 X160.1 E495.0693
+G1 E494.6 F1800
+G1 X74.54 Y110.46 F9000
+G1 E495.27 F1800
+G1 X60.46 E495.8547 F3600
+G1 Y124.54 E496.4401
+G1 X74.54 E497.0255
+G1 Y110.46 E497.6108
+G1 X74.9 Y110.1
+G1 X60.1 E498.2262
+G1 Y124.9 E498.8415
+G1 X74.9 E499.4568
+G1 Y110.1 E500.0721
+;layer #33
+G1 E499.61 F1800
+G1 X74.54 Y110.46 F9000
+G1 E500.27 F1800
+G1 Z8.25 F300
+G1 X60.46 E500.8575 F3600
+G1 Y124.54 E501.4428
+G1 X74.54 E502.0282
+G1 Y110.46 E502.6136
+G1 X74.9 Y110.1
+G1 X60.1 E503.2289
+G1 Y124.9 E503.8442
+G1 X74.9 E504.4595
+G1 Y110.1 E505.0749
+G1 E504.61 F1800
+G1 X160.46 Y110.46 F9000
+G1 E505.27 F1800
+G1 Y124.54 E505.8602 F3600
+G1 X174.54 E506.4456
+G1 Y110.46 E507.031
+G1 X160.46 E507.6164
+G1 X160.1 Y110.1
+G1 Y124.9 E508.2317
+G1 X174.9 E508.847
+G1 Y110.1 E509.4623
+G1 X160.1 E510.0776
+;layer #34
+G1 E509.61 F1800
+G1 X160.46 Y110.46 F9000
+G1 E510.28 F1800
+G1 Z8.5 F300
+G1 Y124.54 E510.863 F3600
+G1 X174.54 E511.4484
+G1 Y110.46 E512.0338
+G1 X160.46 E512.6191
+G1 X160.1 Y110.1
+G1 Y124.9 E513.2344
+G1 X174.9 E513.8498
+G1 Y110.1 E514.4651
+G1 X160.1 E515.0804
+G1 E514.61 F1800
+G1 X74.54 Y110.46 F9000
+G1 E515.28 F1800
+G1 X60.46 E515.8658 F3600
+G1 Y124.54 E516.4511
+G1 X74.54 E517.0365
+G1 Y110.46 E517.6219
+G1 X74.9 Y110.1
+G1 X60.1 E518.2372
+G1 Y124.9 E518.8525
+G1 X74.9 E519.4678
+G1 Y110.1 E520.0831
+;layer #35
+G1 E519.62 F1800
+G1 X74.54 Y110.46 F9000
+G1 E520.28 F1800
+G1 Z8.75 F300
+G1 X60.46 E520.8685 F3600
+G1 Y124.54 E521.4539
+G1 X74.54 E522.0393
+G1 Y110.46 E522.6247
+G1 X74.9 Y110.1
+G1 X60.1 E523.24
+G1 Y124.9 E523.8553
+G1 X74.9 E524.4706
+G1 Y110.1 E525.0859
+G1 E524.62 F1800
+G1 X160.46 Y110.46 F9000
+G1 E525.29 F1800
+G1 Y124.54 E525.8713 F3600
+G1 X174.54 E526.4567
+G1 Y110.46 E527.042
+G1 X160.46 E527.6274
+G1 X160.1 Y110.1
+G1 Y124.9 E528.2427
+G1 X174.9 E528.858
+G1 Y110.1 E529.4734
+G1 X160.1 E530.0887
+;layer #36
+G1 E529.62 F1800
+G1 X160.46 Y110.46 F9000
+G1 E530.29 F1800
+G1 Z9 F300
+G1 Y124.54 E530.874 F3600
+G1 X174.54 E531.4594
+G1 Y110.46 E532.0448
+G1 X160.46 E532.6302
+G1 X160.1 Y110.1
+G1 Y124.9 E533.2455
+G1 X174.9 E533.8608
+G1 Y110.1 E534.4761
+G1 X160.1 E535.0914
+G1 E534.62 F1800
+G1 X74.54 Y110.46 F9000
+G1 E535.29 F1800
+G1 X60.46 E535.8768 F3600
+G1 Y124.54 E536.4622
+G1 X74.54 E537.0476
+G1 Y110.46 E537.6329
+G1 X74.9 Y110.1
+G1 X60.1 E538.2483
+G1 Y124.9 E538.8636
+G1 X74.9 E539.4789
+G1 Y110.1 E540.0942
+;layer #37
+G1 E539.89 F1800
+G1 X74.64 Y110.36 F9000
+G1 E540.49 F1800
+G1 Z9.25 F300
+G1 X60.36 E541.0879 F3600
+G1 Y124.64 E541.6816
+G1 X74.64 E542.2753
+G1 Y110.36 E542.869
+G1 X75 Y110
+G1 X60 E543.4926
+G1 Y125 E544.1162
+G1 X75 E544.7399
+G1 Y110 E545.3635
+G1 E545.16 F1800
+G1 X160.36 Y110.36 F9000
+G1 E545.76 F1800
+G1 Y124.64 E546.3572 F3600
+G1 X174.64 E546.9509
+G1 Y110.36 E547.5446
+G1 X160.36 E548.1383
+G1 X160 Y110
+G1 Y125 E548.7619
+G1 X175 E549.3855
+G1 Y110 E550.0091
+G1 X160 E550.6328
+;layer #38
+G1 E550.43 F1800
+G1 X160.46 Y110.46 F9000
+G1 E551.03 F1800
+G1 Z9.5 F300
+G1 Y124.54 E551.6181 F3600
+G1 X174.54 E552.2035
+G1 Y110.46 E552.7889
+G1 X160.46 E553.3743
+G1 X160.1 Y110.1
+G1 Y124.9 E553.9896
+G1 X174.9 E554.6049
+G1 Y110.1 E555.2202
+G1 X160.1 E555.8355
+G1 E555.64 F1800
+G1 X74.54 Y110.46 F9000
+G1 E556.24 F1800
+G1 X60.46 E556.8209 F3600
+G1 Y124.54 E557.4063
+G1 X74.54 E557.9917
+G1 Y110.46 E558.577
+G1 X74.9 Y110.1
+G1 X60.1 E559.1924
+G1 Y124.9 E559.8077
+G1 X74.9 E560.423
+G1 Y110.1 E561.0383
+;layer #39
+G1 E560.84 F1800
+G1 X74.54 Y110.46 F9000
+G1 E561.44 F1800
+G1 Z9.75 F300
+G1 X60.46 E562.0237 F3600
+G1 Y124.54 E562.609
+G1 X74.54 E563.1944
+G1 Y110.46 E563.7798
+G1 X74.9 Y110.1
+G1 X60.1 E564.3951
+G1 Y124.9 E565.0104
+G1 X74.9 E565.6257
+G1 Y110.1 E566.2411
+G1 E566.04 F1800
+G1 X160.46 Y110.46 F9000
+G1 E566.64 F1800
+G1 Y124.54 E567.2264 F3600
+G1 X174.54 E567.8118
+G1 Y110.46 E568.3972
+G1 X160.46 E568.9826
+G1 X160.1 Y110.1
+G1 Y124.9 E569.5979
+G1 X174.9 E570.2132
+G1 Y110.1 E570.8285
+G1 X160.1 E571.4438
+;layer #40
+G1 E571.24 F1800
+G1 X160.46 Y110.46 F9000
+G1 E571.84 F1800
+G1 Z10 F300
+G1 Y124.54 E572.4292 F3600
+G1 X174.54 E573.0146
+G1 Y110.46 E573.6
+G1 X160.46 E574.1853
+G1 X160.1 Y110.1
+G1 Y124.9 E574.8006
+G1 X174.9 E575.416
+G1 Y110.1 E576.0313
+G1 X160.1 E576.6466
+G1 E576.45 F1800
+G1 X74.54 Y110.46 F9000
+G1 E577.05 F1800
+G1 X60.46 E577.632 F3600
+G1 Y124.54 E578.2173
+G1 X74.54 E578.8027
+G1 Y110.46 E579.3881
+G1 X74.9 Y110.1
+G1 X60.1 E580.0034
+G1 Y124.9 E580.6187
+G1 X74.9 E581.234
+G1 Y110.1 E581.8493
+;layer #41
+G1 E581.65 F1800
+G1 X74.54 Y110.46 F9000
+G1 E582.25 F1800
+G1 Z10.25 F300
+G1 X60.46 E582.8347 F3600
+G1 Y124.54 E583.4201
+G1 X74.54 E584.0055
+G1 Y110.46 E584.5909
+G1 X74.9 Y110.1
+G1 X60.1 E585.2062
+G1 Y124.9 E585.8215
+G1 X74.9 E586.4368
+G1 Y110.1 E587.0521
+G1 E586.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E587.45 F1800
+G1 Y124.54 E588.0375 F3600
+G1 X174.54 E588.6229
+G1 Y110.46 E589.2082
+G1 X160.46 E589.7936
+G1 X160.1 Y110.1
+G1 Y124.9 E590.4089
+G1 X174.9 E591.0242
+G1 Y110.1 E591.6396
+G1 X160.1 E592.2549
+;layer #42
+G1 E592.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E592.65 F1800
+G1 Z10.5 F300
+G1 Y124.54 E593.2403 F3600
+G1 X174.54 E593.8256
+G1 Y110.46 E594.411
+G1 X160.46 E594.9964
+G1 X160.1 Y110.1
+G1 Y124.9 E595.6117
+G1 X174.9 E596.227
+G1 Y110.1 E596.8423
+G1 X160.1 E597.4576
+G1 E597.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E597.86 F1800
+G1 X60.46 E598.443 F3600
+G1 Y124.54 E599.0284
+G1 X74.54 E599.6138
+G1 Y110.46 E600.1991
+G1 X74.9 Y110.1
+G1 X60.1 E600.8145
+G1 Y124.9 E601.4298
+G1 X74.9 E602.0451
+G1 Y110.1 E602.6604
+;layer #43
+G1 E602.46 F1800
+G1 X74.54 Y110.46 F9000
+G1 E603.06 F1800
+G1 Z10.75 F300
+G1 X60.46 E603.6458 F3600
+G1 Y124.54 E604.2312
+G1 X74.54 E604.8165
+G1 Y110.46 E605.4019
+G1 X74.9 Y110.1
+G1 X60.1 E606.0172
+G1 Y124.9 E606.6325
+G1 X74.9 E607.2478
+G1 Y110.1 E607.8632
+G1 E607.66 F1800
+G1 X160.46 Y110.46 F9000
+G1 E608.26 F1800
+G1 Y124.54 E608.8485 F3600
+G1 X174.54 E609.4339
+G1 Y110.46 E610.0193
+G1 X160.46 E610.6047
+G1 X160.1 Y110.1
+G1 Y124.9 E611.22
+G1 X174.9 E611.8353
+G1 Y110.1 E612.4506
+G1 X160.1 E613.0659
+;layer #44
+G1 E612.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E613.47 F1800
+G1 Z11 F300
+G1 Y124.54 E614.0513 F3600
+G1 X174.54 E614.6367
+G1 Y110.46 E615.2221
+G1 X160.46 E615.8074
+G1 X160.1 Y110.1
+G1 Y124.9 E616.4228
+G1 X174.9 E617.0381
+G1 Y110.1 E617.6534
+G1 X160.1 E618.2687
+G1 E618.07 F1800
+G1 X74.54 Y110.46 F9000
+G1 E618.67 F1800
+G1 X60.46 E619.2541 F3600
+G1 Y124.54 E619.8394
+G1 X74.54 E620.4248
+G1 Y110.46 E621.0102
+G1 X74.9 Y110.1
+G1 X60.1 E621.6255
+G1 Y124.9 E622.2408
+G1 X74.9 E622.8561
+G1 Y110.1 E623.4715
+;layer #45
+G1 E623.27 F1800
+G1 X74.54 Y110.46 F9000
+G1 E623.87 F1800
+G1 Z11.25 F300
+G1 X60.46 E624.4568 F3600
+G1 Y124.54 E625.0422
+G1 X74.54 E625.6276
+G1 Y110.46 E626.213
+G1 X74.9 Y110.1
+G1 X60.1 E626.8283
+G1 Y124.9 E627.4436
+G1 X74.9 E628.0589
+G1 Y110.1 E628.6742
+G1 E628.47 F1800
+G1 X160.46 Y110.46 F9000
+G1 E629.07 F1800
+G1 Y124.54 E629.6596 F3600
+G1 X174.54 E630.245
+G1 Y110.46 E630.8304
+G1 X160.46 E631.4157
+G1 X160.1 Y110.1
+G1 Y124.9 E632.031
+G1 X174.9 E632.6464
+G1 Y110.1 E633.2617
+G1 X160.1 E633.877
+;layer #46
+G1 E633.68 F1800
+G1 X160.46 Y110.46 F9000
+G1 E634.28 F1800
+G1 Z11.5 F300
+G1 Y124.54 E634.8624 F3600
+G1 X174.54 E635.4477
+G1 Y110.46 E636.0331
+G1 X160.46 E636.6185
+G1 X160.1 Y110.1
+G1 Y124.9 E637.2338
+G1 X174.9 E637.8491
+G1 Y110.1 E638.4644
+G1 X160.1 E639.0797
+G1 E638.88 F1800
+G1 X74.54 Y110.46 F9000
+G1 E639.48 F1800
+G1 X60.46 E640.0651 F3600
+G1 Y124.54 E640.6505
+G1 X74.54 E641.2359
+G1 Y110.46 E641.8213
+G1 X74.9 Y110.1
+G1 X60.1 E642.4366
+G1 Y124.9 E643.0519
+G1 X74.9 E643.6672
+G1 Y110.1 E644.2825
+;layer #47
+G1 E644.08 F1800
+G1 X74.54 Y110.46 F9000
+G1 E644.68 F1800
+G1 Z11.75 F300
+G1 X60.46 E645.2679 F3600
+G1 Y124.54 E645.8533
+G1 X74.54 E646.4386
+G1 Y110.46 E647.024
+G1 X74.9 Y110.1
+G1 X60.1 E647.6393
+G1 Y124.9 E648.2546
+G1 X74.9 E648.87
+G1 Y110.1 E649.4853
+G1 E649.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E649.89 F1800
+G1 Y124.54 E650.4706 F3600
+G1 X174.54 E651.056
+G1 Y110.46 E651.6414
+G1 X160.46 E652.2268
+G1 X160.1 Y110.1
+G1 Y124.9 E652.8421
+G1 X174.9 E653.4574
+G1 Y110.1 E654.0727
+G1 X160.1 E654.688
+;layer #48
+G1 E654.49 F1800
+G1 X160.46 Y110.46 F9000
+G1 E655.09 F1800
+G1 Z12 F300
+G1 Y124.54 E655.6734 F3600
+G1 X174.54 E656.2588
+G1 Y110.46 E656.8442
+G1 X160.46 E657.4295
+G1 X160.1 Y110.1
+G1 Y124.9 E658.0449
+G1 X174.9 E658.6602
+G1 Y110.1 E659.2755
+G1 X160.1 E659.8908
+G1 E659.69 F1800
+G1 X74.54 Y110.46 F9000
+G1 E660.29 F1800
+G1 X60.46 E660.8762 F3600
+G1 Y124.54 E661.4616
+G1 X74.54 E662.0469
+G1 Y110.46 E662.6323
+G1 X74.9 Y110.1
+G1 X60.1 E663.2476
+G1 Y124.9 E663.8629
+G1 X74.9 E664.4782
+G1 Y110.1 E665.0936
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
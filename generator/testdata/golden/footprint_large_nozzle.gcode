@@ -0,0 +1,690 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 1 [mm]
+;First layer line width: 1 [mm]
+;Layer height: 0.5 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Tower size: 25 [mm], wall spacing: 85%, raft margin: 10 [mm]
+;Segment 3:   0.2mm @ 30mm/s
+;Segment 2:   0.6mm @ 30mm/s
+;Segment 1:   1mm @ 30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.5 F450
+G92 Z0.5
+G1 E-1 F1800
+G1 X45 Y85 F9000
+G1 E0 F1800
+G1 X190 E36.1704 F1800
+G1 Y86.2 E36.4697 F1800
+G1 X45 E72.6401 F1800
+G1 E71.64 F1800
+G1 X45.6 Y139.4 F9000
+G1 E72.64 F1800
+G1 Y137.57 E73.1297 F1800
+G1 X47.43 Y139.4 E73.8221 F1800
+G1 X49.25 E74.3116 F1800
+G1 X45.6 Y135.75 E75.6963 F1800
+G1 Y133.92 E76.1859 F1800
+G1 X51.08 Y139.4 E78.263 F1800
+G1 X52.9 E78.7526 F1800
+G1 X45.6 Y132.1 E81.522 F1800
+G1 Y130.27 E82.0115 F1800
+G1 X54.73 Y139.4 E85.4733 F1800
+G1 X56.55 E85.9629 F1800
+G1 X45.6 Y128.45 E90.117 F1800
+G1 Y126.63 E90.6066 F1800
+G1 X58.38 Y139.4 E95.4531 F1800
+G1 X60.2 E95.9427 F1800
+G1 X45.6 Y124.8 E101.4815 F1800
+G1 Y122.98 E101.9711 F1800
+G1 X62.03 Y139.4 E108.2023 F1800
+G1 X63.85 E108.6919 F1800
+G1 X45.6 Y121.15 E115.6154 F1800
+G1 Y119.32 E116.105 F1800
+G1 X65.68 Y139.4 E123.7209 F1800
+G1 X67.5 E124.2105 F1800
+G1 X45.6 Y117.5 E132.5188 F1800
+G1 Y115.68 E133.0084 F1800
+G1 X69.33 Y139.4 E142.009 F1800
+G1 X71.15 E142.4986 F1800
+G1 X45.6 Y113.85 E152.1915 F1800
+G1 Y112.03 E152.6811 F1800
+G1 X72.97 Y139.4 E163.0665 F1800
+G1 X74.8 E163.556 F1800
+G1 X45.6 Y110.2 E174.6337 F1800
+G1 Y108.38 E175.1233 F1800
+G1 X76.63 Y139.4 E186.8934 F1800
+G1 X78.45 E187.3829 F1800
+G1 X45.6 Y106.55 E199.8454 F1800
+G1 Y104.73 E200.3349 F1800
+G1 X80.28 Y139.4 E213.4897 F1800
+G1 X82.1 E213.9793 F1800
+G1 X45.6 Y102.9 E227.8264 F1800
+G1 Y101.07 E228.316 F1800
+G1 X83.93 Y139.4 E242.8555 F1800
+G1 X85.75 E243.345 F1800
+G1 X45.6 Y99.25 E258.5769 F1800
+G1 Y97.43 E259.0664 F1800
+G1 X87.58 Y139.4 E274.9906 F1800
+G1 X89.4 E275.4802 F1800
+G1 X45.6 Y95.6 E292.0968 F1800
+G1 X47.43 E292.5863 F1800
+G1 X89.4 Y137.58 E308.5105 F1800
+G1 Y135.75 E309.0001 F1800
+G1 X49.25 Y95.6 E324.2319 F1800
+G1 X51.08 E324.7215 F1800
+G1 X89.4 Y133.93 E339.261 F1800
+G1 Y132.1 E339.7506 F1800
+G1 X52.9 Y95.6 E353.5977 F1800
+G1 X54.73 E354.0873 F1800
+G1 X89.4 Y130.28 E367.242 F1800
+G1 Y128.45 E367.7316 F1800
+G1 X56.55 Y95.6 E380.194 F1800
+G1 X58.38 E380.6836 F1800
+G1 X89.4 Y126.63 E392.4537 F1800
+G1 Y124.8 E392.9432 F1800
+G1 X60.2 Y95.6 E404.0209 F1800
+G1 X62.03 E404.5105 F1800
+G1 X89.4 Y122.98 E414.8958 F1800
+G1 Y121.15 E415.3854 F1800
+G1 X63.85 Y95.6 E425.0784 F1800
+G1 X65.68 E425.568 F1800
+G1 X89.4 Y119.33 E434.5686 F1800
+G1 Y117.5 E435.0582 F1800
+G1 X67.5 Y95.6 E443.3665 F1800
+G1 X69.33 E443.856 F1800
+G1 X89.4 Y115.68 E451.472 F1800
+G1 Y113.85 E451.9615 F1800
+G1 X71.15 Y95.6 E458.8851 F1800
+G1 X72.98 E459.3747 F1800
+G1 X89.4 Y112.03 E465.6059 F1800
+G1 Y110.2 E466.0954 F1800
+G1 X74.8 Y95.6 E471.6343 F1800
+G1 X76.63 E472.1239 F1800
+G1 X89.4 Y108.38 E476.9704 F1800
+G1 Y106.55 E477.4599 F1800
+G1 X78.45 Y95.6 E481.6141 F1800
+G1 X80.28 E482.1036 F1800
+G1 X89.4 Y104.73 E485.5654 F1800
+G1 Y102.9 E486.055 F1800
+G1 X82.1 Y95.6 E488.8244 F1800
+G1 X83.93 E489.314 F1800
+G1 X89.4 Y101.08 E491.391 F1800
+G1 Y99.25 E491.8806 F1800
+G1 X85.75 Y95.6 E493.2653 F1800
+G1 X87.58 E493.7549 F1800
+G1 X89.4 Y97.43 E494.4473 F1800
+G1 Y95.6 E494.9368 F1800
+G1 E493.94 F1800
+G1 X145.6 Y139.4 F9000
+G1 E494.94 F1800
+G1 Y137.57 E495.4264 F1800
+G1 X147.43 Y139.4 E496.1188 F1800
+G1 X149.25 E496.6083 F1800
+G1 X145.6 Y135.75 E497.993 F1800
+G1 Y133.92 E498.4826 F1800
+G1 X151.07 Y139.4 E500.5597 F1800
+G1 X152.9 E501.0492 F1800
+G1 X145.6 Y132.1 E503.8187 F1800
+G1 Y130.27 E504.3082 F1800
+G1 X154.73 Y139.4 E507.77 F1800
+G1 X156.55 E508.2596 F1800
+G1 X145.6 Y128.45 E512.4137 F1800
+G1 Y126.63 E512.9033 F1800
+G1 X158.38 Y139.4 E517.7498 F1800
+G1 X160.2 E518.2394 F1800
+G1 X145.6 Y124.8 E523.7782 F1800
+G1 Y122.98 E524.2678 F1800
+G1 X162.03 Y139.4 E530.499 F1800
+G1 X163.85 E530.9886 F1800
+G1 X145.6 Y121.15 E537.9121 F1800
+G1 Y119.32 E538.4017 F1800
+G1 X165.68 Y139.4 E546.0176 F1800
+G1 X167.5 E546.5072 F1800
+G1 X145.6 Y117.5 E554.8155 F1800
+G1 Y115.68 E555.305 F1800
+G1 X169.33 Y139.4 E564.3057 F1800
+G1 X171.15 E564.7952 F1800
+G1 X145.6 Y113.85 E574.4882 F1800
+G1 Y112.03 E574.9778 F1800
+G1 X172.98 Y139.4 E585.3632 F1800
+G1 X174.8 E585.8527 F1800
+G1 X145.6 Y110.2 E596.9304 F1800
+G1 Y108.38 E597.42 F1800
+G1 X176.63 Y139.4 E609.1901 F1800
+G1 X178.45 E609.6796 F1800
+G1 X145.6 Y106.55 E622.142 F1800
+G1 Y104.73 E622.6316 F1800
+G1 X180.28 Y139.4 E635.7864 F1800
+G1 X182.1 E636.276 F1800
+G1 X145.6 Y102.9 E650.1231 F1800
+G1 Y101.07 E650.6127 F1800
+G1 X183.93 Y139.4 E665.1521 F1800
+G1 X185.75 E665.6417 F1800
+G1 X145.6 Y99.25 E680.8736 F1800
+G1 Y97.43 E681.3631 F1800
+G1 X187.58 Y139.4 E697.2873 F1800
+G1 X189.4 E697.7769 F1800
+G1 X145.6 Y95.6 E714.3935 F1800
+G1 X147.43 E714.883 F1800
+G1 X189.4 Y137.58 E730.8072 F1800
+G1 Y135.75 E731.2968 F1800
+G1 X149.25 Y95.6 E746.5286 F1800
+G1 X151.08 E747.0182 F1800
+G1 X189.4 Y133.93 E761.5577 F1800
+G1 Y132.1 E762.0473 F1800
+G1 X152.9 Y95.6 E775.8944 F1800
+G1 X154.73 E776.384 F1800
+G1 X189.4 Y130.28 E789.5387 F1800
+G1 Y128.45 E790.0283 F1800
+G1 X156.55 Y95.6 E802.4907 F1800
+G1 X158.38 E802.9803 F1800
+G1 X189.4 Y126.63 E814.7503 F1800
+G1 Y124.8 E815.2399 F1800
+G1 X160.2 Y95.6 E826.3176 F1800
+G1 X162.03 E826.8072 F1800
+G1 X189.4 Y122.98 E837.1925 F1800
+G1 Y121.15 E837.6821 F1800
+G1 X163.85 Y95.6 E847.3751 F1800
+G1 X165.68 E847.8647 F1800
+G1 X189.4 Y119.33 E856.8653 F1800
+G1 Y117.5 E857.3549 F1800
+G1 X167.5 Y95.6 E865.6632 F1800
+G1 X169.33 E866.1527 F1800
+G1 X189.4 Y115.68 E873.7686 F1800
+G1 Y113.85 E874.2582 F1800
+G1 X171.15 Y95.6 E881.1818 F1800
+G1 X172.98 E881.6713 F1800
+G1 X189.4 Y112.03 E887.9026 F1800
+G1 Y110.2 E888.3921 F1800
+G1 X174.8 Y95.6 E893.931 F1800
+G1 X176.63 E894.4205 F1800
+G1 X189.4 Y108.38 E899.267 F1800
+G1 Y106.55 E899.7566 F1800
+G1 X178.45 Y95.6 E903.9108 F1800
+G1 X180.28 E904.4003 F1800
+G1 X189.4 Y104.73 E907.8621 F1800
+G1 Y102.9 E908.3517 F1800
+G1 X182.1 Y95.6 E911.1211 F1800
+G1 X183.93 E911.6107 F1800
+G1 X189.4 Y101.08 E913.6877 F1800
+G1 Y99.25 E914.1773 F1800
+G1 X185.75 Y95.6 E915.562 F1800
+G1 X187.58 E916.0516 F1800
+G1 X189.4 Y97.43 E916.744 F1800
+G1 Y95.6 E917.2335 F1800
+;layer #2
+M106 S169
+G1 E916.23 F1800
+G1 X156.1 Y106.1 F9000
+G1 E917.23 F1800
+G1 Z1 F300
+G1 Y128.9 E921.9731 F3600
+G1 X178.9 E926.7127
+G1 Y106.1 E931.4522
+G1 X156.1 E936.1918
+G1 X155.25 Y105.25 E936.4417
+G1 Y129.75 E941.5346
+G1 X179.75 E946.6276
+G1 Y105.25 E951.7206
+G1 X155.25 E956.8135
+G1 E955.81 F1800
+G1 X78.9 Y106.1 F9000
+G1 E956.81 F1800
+G1 X56.1 E961.5531 F3600
+G1 Y128.9 E966.2927
+G1 X78.9 E971.0322
+G1 Y106.1 E975.7718
+G1 X79.75 Y105.25 E976.0217
+G1 X55.25 E981.1146
+G1 Y129.75 E986.2076
+G1 X79.75 E991.3005
+G1 Y105.25 E996.3935
+;layer #3
+M106 S254
+G1 E995.39 F1800
+G1 X78.9 Y106.1 F9000
+G1 E996.39 F1800
+G1 Z1.5 F300
+G1 X56.1 E1001.1331 F3600
+G1 Y128.9 E1005.8726
+G1 X78.9 E1010.6122
+G1 Y106.1 E1015.3518
+G1 X79.75 Y105.25 E1015.6017
+G1 X55.25 E1020.6946
+G1 Y129.75 E1025.7876
+G1 X79.75 E1030.8805
+G1 Y105.25 E1035.9735
+G1 E1034.97 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1035.97 F1800
+G1 Y128.9 E1040.7131 F3600
+G1 X178.9 E1045.4526
+G1 Y106.1 E1050.1922
+G1 X156.1 E1054.9318
+G1 X155.25 Y105.25 E1055.1817
+G1 Y129.75 E1060.2746
+G1 X179.75 E1065.3676
+G1 Y105.25 E1070.4605
+G1 X155.25 E1075.5535
+;layer #4
+G1 E1074.55 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1075.55 F1800
+G1 Z2 F300
+G1 Y128.9 E1080.2931 F3600
+G1 X178.9 E1085.0326
+G1 Y106.1 E1089.7722
+G1 X156.1 E1094.5118
+G1 X155.25 Y105.25 E1094.7617
+G1 Y129.75 E1099.8546
+G1 X179.75 E1104.9476
+G1 Y105.25 E1110.0405
+G1 X155.25 E1115.1335
+G1 E1114.13 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1115.13 F1800
+G1 X56.1 E1119.8731 F3600
+G1 Y128.9 E1124.6126
+G1 X78.9 E1129.3522
+G1 Y106.1 E1134.0918
+G1 X79.75 Y105.25 E1134.3416
+G1 X55.25 E1139.4346
+G1 Y129.75 E1144.5276
+G1 X79.75 E1149.6205
+G1 Y105.25 E1154.7135
+;layer #5
+G1 E1153.71 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1154.71 F1800
+G1 Z2.5 F300
+G1 X56.1 E1159.453 F3600
+G1 Y128.9 E1164.1926
+G1 X78.9 E1168.9322
+G1 Y106.1 E1173.6718
+G1 X79.75 Y105.25 E1173.9216
+G1 X55.25 E1179.0146
+G1 Y129.75 E1184.1076
+G1 X79.75 E1189.2005
+G1 Y105.25 E1194.2935
+G1 E1193.29 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1194.29 F1800
+G1 Y128.9 E1199.033 F3600
+G1 X178.9 E1203.7726
+G1 Y106.1 E1208.5122
+G1 X156.1 E1213.2518
+G1 X155.25 Y105.25 E1213.5016
+G1 Y129.75 E1218.5946
+G1 X179.75 E1223.6876
+G1 Y105.25 E1228.7805
+G1 X155.25 E1233.8735
+;layer #6
+G1 E1232.87 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1233.87 F1800
+G1 Z3 F300
+G1 Y128.9 E1238.613 F3600
+G1 X178.9 E1243.3526
+G1 Y106.1 E1248.0922
+G1 X156.1 E1252.8317
+G1 X155.25 Y105.25 E1253.0816
+G1 Y129.75 E1258.1746
+G1 X179.75 E1263.2675
+G1 Y105.25 E1268.3605
+G1 X155.25 E1273.4535
+G1 E1272.45 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1273.45 F1800
+G1 X56.1 E1278.193 F3600
+G1 Y128.9 E1282.9326
+G1 X78.9 E1287.6722
+G1 Y106.1 E1292.4117
+G1 X79.75 Y105.25 E1292.6616
+G1 X55.25 E1297.7546
+G1 Y129.75 E1302.8475
+G1 X79.75 E1307.9405
+G1 Y105.25 E1313.0335
+;layer #7
+G1 E1312.43 F1800
+G1 X79.15 Y105.85 F9000
+G1 E1313.03 F1800
+G1 Z3.5 F300
+G1 X55.85 E1317.877 F3600
+G1 Y129.15 E1322.7205
+G1 X79.15 E1327.564
+G1 Y105.85 E1332.4075
+G1 X80 Y105 E1332.6574
+G1 X55 E1337.8543
+G1 Y130 E1343.0512
+G1 X80 E1348.2481
+G1 Y105 E1353.445
+G1 E1352.84 F1800
+G1 X155.85 Y105.85 F9000
+G1 E1353.44 F1800
+G1 Y129.15 E1358.2885 F3600
+G1 X179.15 E1363.132
+G1 Y105.85 E1367.9755
+G1 X155.85 E1372.819
+G1 X155 Y105 E1373.0689
+G1 Y130 E1378.2658
+G1 X180 E1383.4627
+G1 Y105 E1388.6596
+G1 X155 E1393.8564
+;layer #8
+G1 E1393.26 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1393.86 F1800
+G1 Z4 F300
+G1 Y128.9 E1398.596 F3600
+G1 X178.9 E1403.3356
+G1 Y106.1 E1408.0752
+G1 X156.1 E1412.8147
+G1 X155.25 Y105.25 E1413.0646
+G1 Y129.75 E1418.1576
+G1 X179.75 E1423.2505
+G1 Y105.25 E1428.3435
+G1 X155.25 E1433.4364
+G1 E1432.84 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1433.44 F1800
+G1 X56.1 E1438.176 F3600
+G1 Y128.9 E1442.9156
+G1 X78.9 E1447.6551
+G1 Y106.1 E1452.3947
+G1 X79.75 Y105.25 E1452.6446
+G1 X55.25 E1457.7376
+G1 Y129.75 E1462.8305
+G1 X79.75 E1467.9235
+G1 Y105.25 E1473.0164
+;layer #9
+G1 E1472.42 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1473.02 F1800
+G1 Z4.5 F300
+G1 X56.1 E1477.756 F3600
+G1 Y128.9 E1482.4956
+G1 X78.9 E1487.2351
+G1 Y106.1 E1491.9747
+G1 X79.75 Y105.25 E1492.2246
+G1 X55.25 E1497.3176
+G1 Y129.75 E1502.4105
+G1 X79.75 E1507.5035
+G1 Y105.25 E1512.5964
+G1 E1512 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1512.6 F1800
+G1 Y128.9 E1517.336 F3600
+G1 X178.9 E1522.0756
+G1 Y106.1 E1526.8151
+G1 X156.1 E1531.5547
+G1 X155.25 Y105.25 E1531.8046
+G1 Y129.75 E1536.8975
+G1 X179.75 E1541.9905
+G1 Y105.25 E1547.0835
+G1 X155.25 E1552.1764
+;layer #10
+G1 E1551.58 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1552.18 F1800
+G1 Z5 F300
+G1 Y128.9 E1556.916 F3600
+G1 X178.9 E1561.6556
+G1 Y106.1 E1566.3951
+G1 X156.1 E1571.1347
+G1 X155.25 Y105.25 E1571.3846
+G1 Y129.75 E1576.4775
+G1 X179.75 E1581.5705
+G1 Y105.25 E1586.6635
+G1 X155.25 E1591.7564
+G1 E1591.16 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1591.76 F1800
+G1 X56.1 E1596.496 F3600
+G1 Y128.9 E1601.2356
+G1 X78.9 E1605.9751
+G1 Y106.1 E1610.7147
+G1 X79.75 Y105.25 E1610.9646
+G1 X55.25 E1616.0575
+G1 Y129.75 E1621.1505
+G1 X79.75 E1626.2434
+G1 Y105.25 E1631.3364
+;layer #11
+G1 E1630.74 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1631.34 F1800
+G1 Z5.5 F300
+G1 X56.1 E1636.076 F3600
+G1 Y128.9 E1640.8155
+G1 X78.9 E1645.5551
+G1 Y106.1 E1650.2947
+G1 X79.75 Y105.25 E1650.5446
+G1 X55.25 E1655.6375
+G1 Y129.75 E1660.7305
+G1 X79.75 E1665.8234
+G1 Y105.25 E1670.9164
+G1 E1670.32 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1670.92 F1800
+G1 Y128.9 E1675.656 F3600
+G1 X178.9 E1680.3955
+G1 Y106.1 E1685.1351
+G1 X156.1 E1689.8747
+G1 X155.25 Y105.25 E1690.1246
+G1 Y129.75 E1695.2175
+G1 X179.75 E1700.3105
+G1 Y105.25 E1705.4034
+G1 X155.25 E1710.4964
+;layer #12
+G1 E1709.9 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1710.5 F1800
+G1 Z6 F300
+G1 Y128.9 E1715.236 F3600
+G1 X178.9 E1719.9755
+G1 Y106.1 E1724.7151
+G1 X156.1 E1729.4547
+G1 X155.25 Y105.25 E1729.7046
+G1 Y129.75 E1734.7975
+G1 X179.75 E1739.8905
+G1 Y105.25 E1744.9834
+G1 X155.25 E1750.0764
+G1 E1749.48 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1750.08 F1800
+G1 X56.1 E1754.816 F3600
+G1 Y128.9 E1759.5555
+G1 X78.9 E1764.2951
+G1 Y106.1 E1769.0347
+G1 X79.75 Y105.25 E1769.2845
+G1 X55.25 E1774.3775
+G1 Y129.75 E1779.4705
+G1 X79.75 E1784.5634
+G1 Y105.25 E1789.6564
+;layer #13
+G1 E1789.46 F1800
+G1 X79.15 Y105.85 F9000
+G1 E1789.66 F1800
+G1 Z6.5 F300
+G1 X55.85 E1794.4999 F3600
+G1 Y129.15 E1799.3434
+G1 X79.15 E1804.1869
+G1 Y105.85 E1809.0304
+G1 X80 Y105 E1809.2803
+G1 X55 E1814.4772
+G1 Y130 E1819.6741
+G1 X80 E1824.871
+G1 Y105 E1830.0679
+G1 E1829.87 F1800
+G1 X155.85 Y105.85 F9000
+G1 E1830.07 F1800
+G1 Y129.15 E1834.9114 F3600
+G1 X179.15 E1839.7549
+G1 Y105.85 E1844.5984
+G1 X155.85 E1849.4419
+G1 X155 Y105 E1849.6918
+G1 Y130 E1854.8887
+G1 X180 E1860.0856
+G1 Y105 E1865.2825
+G1 X155 E1870.4794
+;layer #14
+G1 E1870.28 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1870.48 F1800
+G1 Z7 F300
+G1 Y128.9 E1875.2189 F3600
+G1 X178.9 E1879.9585
+G1 Y106.1 E1884.6981
+G1 X156.1 E1889.4377
+G1 X155.25 Y105.25 E1889.6875
+G1 Y129.75 E1894.7805
+G1 X179.75 E1899.8735
+G1 Y105.25 E1904.9664
+G1 X155.25 E1910.0594
+G1 E1909.86 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1910.06 F1800
+G1 X56.1 E1914.7989 F3600
+G1 Y128.9 E1919.5385
+G1 X78.9 E1924.2781
+G1 Y106.1 E1929.0176
+G1 X79.75 Y105.25 E1929.2675
+G1 X55.25 E1934.3605
+G1 Y129.75 E1939.4534
+G1 X79.75 E1944.5464
+G1 Y105.25 E1949.6394
+;layer #15
+G1 E1949.44 F1800
+G1 X78.9 Y106.1 F9000
+G1 E1949.64 F1800
+G1 Z7.5 F300
+G1 X56.1 E1954.3789 F3600
+G1 Y128.9 E1959.1185
+G1 X78.9 E1963.8581
+G1 Y106.1 E1968.5976
+G1 X79.75 Y105.25 E1968.8475
+G1 X55.25 E1973.9405
+G1 Y129.75 E1979.0334
+G1 X79.75 E1984.1264
+G1 Y105.25 E1989.2194
+G1 E1989.02 F1800
+G1 X156.1 Y106.1 F9000
+G1 E1989.22 F1800
+G1 Y128.9 E1993.9589 F3600
+G1 X178.9 E1998.6985
+G1 Y106.1 E2003.4381
+G1 X156.1 E2008.1776
+G1 X155.25 Y105.25 E2008.4275
+G1 Y129.75 E2013.5205
+G1 X179.75 E2018.6134
+G1 Y105.25 E2023.7064
+G1 X155.25 E2028.7993
+;layer #16
+G1 E2028.6 F1800
+G1 X156.1 Y106.1 F9000
+G1 E2028.8 F1800
+G1 Z8 F300
+G1 Y128.9 E2033.5389 F3600
+G1 X178.9 E2038.2785
+G1 Y106.1 E2043.0181
+G1 X156.1 E2047.7576
+G1 X155.25 Y105.25 E2048.0075
+G1 Y129.75 E2053.1005
+G1 X179.75 E2058.1934
+G1 Y105.25 E2063.2864
+G1 X155.25 E2068.3793
+G1 E2068.18 F1800
+G1 X78.9 Y106.1 F9000
+G1 E2068.38 F1800
+G1 X56.1 E2073.1189 F3600
+G1 Y128.9 E2077.8585
+G1 X78.9 E2082.598
+G1 Y106.1 E2087.3376
+G1 X79.75 Y105.25 E2087.5875
+G1 X55.25 E2092.6805
+G1 Y129.75 E2097.7734
+G1 X79.75 E2102.8664
+G1 Y105.25 E2107.9593
+;layer #17
+G1 E2107.76 F1800
+G1 X78.9 Y106.1 F9000
+G1 E2107.96 F1800
+G1 Z8.5 F300
+G1 X56.1 E2112.6989 F3600
+G1 Y128.9 E2117.4385
+G1 X78.9 E2122.178
+G1 Y106.1 E2126.9176
+G1 X79.75 Y105.25 E2127.1675
+G1 X55.25 E2132.2605
+G1 Y129.75 E2137.3534
+G1 X79.75 E2142.4464
+G1 Y105.25 E2147.5393
+G1 E2147.34 F1800
+G1 X156.1 Y106.1 F9000
+G1 E2147.54 F1800
+G1 Y128.9 E2152.2789 F3600
+G1 X178.9 E2157.0185
+G1 Y106.1 E2161.758
+G1 X156.1 E2166.4976
+G1 X155.25 Y105.25 E2166.7475
+G1 Y129.75 E2171.8404
+G1 X179.75 E2176.9334
+G1 Y105.25 E2182.0264
+G1 X155.25 E2187.1193
+;layer #18
+G1 E2186.92 F1800
+G1 X156.1 Y106.1 F9000
+G1 E2187.12 F1800
+G1 Z9 F300
+G1 Y128.9 E2191.8589 F3600
+G1 X178.9 E2196.5985
+G1 Y106.1 E2201.338
+G1 X156.1 E2206.0776
+G1 X155.25 Y105.25 E2206.3275
+G1 Y129.75 E2211.4204
+G1 X179.75 E2216.5134
+G1 Y105.25 E2221.6064
+G1 X155.25 E2226.6993
+G1 E2226.5 F1800
+G1 X78.9 Y106.1 F9000
+G1 E2226.7 F1800
+G1 X56.1 E2231.4389 F3600
+G1 Y128.9 E2236.1785
+G1 X78.9 E2240.918
+G1 Y106.1 E2245.6576
+G1 X79.75 Y105.25 E2245.9075
+G1 X55.25 E2251.0004
+G1 Y129.75 E2256.0934
+G1 X79.75 E2261.1864
+G1 Y105.25 E2266.2793
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
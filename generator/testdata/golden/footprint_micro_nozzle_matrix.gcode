@@ -0,0 +1,3779 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.25 [mm]
+;First layer line width: 0.25 [mm]
+;Layer height: 0.1 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 40 [mm]
+;Hardmode: false
+;Tower size: 8 [mm], wall spacing: 100%, raft margin: 3 [mm]
+;Matrix: 4 pairs, retraction length from bottom to top, speed from front to back
+;Segment 3:   0.2mm @ 30-30mm/s
+;Segment 2:   0.6mm @ 30-30mm/s
+;Segment 1:   1mm @ 30-30mm/s
+;Pair 1:   30mm/s
+;Pair 2:   30mm/s
+;Pair 3:   30mm/s
+;Pair 4:   30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.1 F450
+G92 Z0.1
+G1 E-1 F1800
+G1 X90.5 Y64.5 F9000
+G1 E0 F1800
+G1 X144.5 E0.6735 F1800
+G1 Y64.8 F1800
+G1 X90.5 E1.347 F1800
+G1 E0.35 F1800
+G1 X90.65 Y88.35 F9000
+G1 E1.35 F1800
+G1 Y87.89 F1800
+G1 X91.11 Y88.35 F1800
+G1 X91.56 F1800
+G1 X90.65 Y87.44 E1.3644 F1800
+G1 Y86.98 F1800
+G1 X92.02 Y88.35 E1.3904 F1800
+G1 X92.48 F1800
+G1 X90.65 Y86.52 E1.4251 F1800
+G1 Y86.07 F1800
+G1 X92.93 Y88.35 E1.4684 F1800
+G1 X93.39 F1800
+G1 X90.65 Y85.61 E1.5204 F1800
+G1 Y85.15 F1800
+G1 X93.85 Y88.35 E1.5811 F1800
+G1 X94.3 F1800
+G1 X90.65 Y84.7 E1.6505 F1800
+G1 Y84.24 F1800
+G1 X94.76 Y88.35 E1.7285 F1800
+G1 X95.22 F1800
+G1 X90.65 Y83.78 E1.8152 F1800
+G1 Y83.33 F1800
+G1 X95.67 Y88.35 E1.9106 F1800
+G1 X96.13 F1800
+G1 X90.65 Y82.87 E2.0146 F1800
+G1 Y82.41 F1800
+G1 X96.59 Y88.35 E2.1274 F1800
+G1 X97.04 F1800
+G1 X90.65 Y81.96 E2.2487 F1800
+G1 Y81.5 F1800
+G1 X97.5 Y88.35 E2.3788 F1800
+G1 X97.96 F1800
+G1 X90.65 Y81.04 E2.5175 F1800
+G1 Y80.59 F1800
+G1 X98.41 Y88.35 E2.6649 F1800
+G1 X98.87 F1800
+G1 X90.65 Y80.13 E2.821 F1800
+G1 Y79.67 F1800
+G1 X99.33 Y88.35 E2.9857 F1800
+G1 X99.78 F1800
+G1 X90.65 Y79.22 E3.1591 F1800
+G1 Y78.76 F1800
+G1 X100.24 Y88.35 E3.3412 F1800
+G1 X100.7 F1800
+G1 X90.65 Y78.3 E3.5319 F1800
+G1 Y77.85 F1800
+G1 X101.15 Y88.35 E3.7314 F1800
+G1 X101.61 F1800
+G1 X90.65 Y77.39 E3.9394 F1800
+G1 Y76.93 F1800
+G1 X102.07 Y88.35 E4.1562 F1800
+G1 X102.52 F1800
+G1 X90.65 Y76.48 E4.3816 F1800
+G1 Y76.02 F1800
+G1 X102.98 Y88.35 E4.6157 F1800
+G1 X103.44 F1800
+G1 X90.65 Y75.56 E4.8585 F1800
+G1 Y75.11 F1800
+G1 X103.89 Y88.35 E5.1099 F1800
+G1 X104.35 F1800
+G1 X90.65 Y74.65 E5.37 F1800
+G1 X91.11 F1800
+G1 X104.35 Y87.89 E5.6215 F1800
+G1 Y87.44 F1800
+G1 X91.56 Y74.65 E5.8642 F1800
+G1 X92.02 F1800
+G1 X104.35 Y86.98 E6.0983 F1800
+G1 Y86.52 F1800
+G1 X92.48 Y74.65 E6.3238 F1800
+G1 X92.93 F1800
+G1 X104.35 Y86.07 E6.5405 F1800
+G1 Y85.61 F1800
+G1 X93.39 Y74.65 E6.7486 F1800
+G1 X93.85 F1800
+G1 X104.35 Y85.15 E6.948 F1800
+G1 Y84.7 F1800
+G1 X94.3 Y74.65 E7.1388 F1800
+G1 X94.76 F1800
+G1 X104.35 Y84.24 E7.3209 F1800
+G1 Y83.78 F1800
+G1 X95.22 Y74.65 E7.4943 F1800
+G1 X95.67 F1800
+G1 X104.35 Y83.33 E7.659 F1800
+G1 Y82.87 F1800
+G1 X96.13 Y74.65 E7.8151 F1800
+G1 X96.59 F1800
+G1 X104.35 Y82.41 E7.9625 F1800
+G1 Y81.96 F1800
+G1 X97.04 Y74.65 E8.1012 F1800
+G1 X97.5 F1800
+G1 X104.35 Y81.5 E8.2312 F1800
+G1 Y81.04 F1800
+G1 X97.96 Y74.65 E8.3526 F1800
+G1 X98.41 F1800
+G1 X104.35 Y80.59 E8.4653 F1800
+G1 Y80.13 F1800
+G1 X98.87 Y74.65 E8.5694 F1800
+G1 X99.33 F1800
+G1 X104.35 Y79.67 E8.6647 F1800
+G1 Y79.22 F1800
+G1 X99.78 Y74.65 E8.7514 F1800
+G1 X100.24 F1800
+G1 X104.35 Y78.76 E8.8295 F1800
+G1 Y78.3 F1800
+G1 X100.7 Y74.65 E8.8988 F1800
+G1 X101.15 F1800
+G1 X104.35 Y77.85 E8.9595 F1800
+G1 Y77.39 F1800
+G1 X101.61 Y74.65 E9.0116 F1800
+G1 X102.07 F1800
+G1 X104.35 Y76.93 E9.0549 F1800
+G1 Y76.48 F1800
+G1 X102.52 Y74.65 E9.0896 F1800
+G1 X102.98 F1800
+G1 X104.35 Y76.02 E9.1156 F1800
+G1 Y75.56 F1800
+G1 X103.44 Y74.65 E9.1329 F1800
+G1 X103.89 F1800
+G1 X104.35 Y75.11 F1800
+G1 Y74.65 F1800
+G1 E8.13 F1800
+G1 X130.65 Y88.35 F9000
+G1 E9.13 F1800
+G1 Y87.89 F1800
+G1 X131.11 Y88.35 F1800
+G1 X131.56 F1800
+G1 X130.65 Y87.44 E9.1503 F1800
+G1 Y86.98 F1800
+G1 X132.02 Y88.35 E9.1763 F1800
+G1 X132.48 F1800
+G1 X130.65 Y86.52 E9.211 F1800
+G1 Y86.07 F1800
+G1 X132.93 Y88.35 E9.2543 F1800
+G1 X133.39 F1800
+G1 X130.65 Y85.61 E9.3063 F1800
+G1 Y85.15 F1800
+G1 X133.85 Y88.35 E9.367 F1800
+G1 X134.3 F1800
+G1 X130.65 Y84.7 E9.4364 F1800
+G1 Y84.24 F1800
+G1 X134.76 Y88.35 E9.5144 F1800
+G1 X135.22 F1800
+G1 X130.65 Y83.78 E9.6011 F1800
+G1 Y83.33 F1800
+G1 X135.67 Y88.35 E9.6965 F1800
+G1 X136.13 F1800
+G1 X130.65 Y82.87 E9.8005 F1800
+G1 Y82.41 F1800
+G1 X136.59 Y88.35 E9.9133 F1800
+G1 X137.04 F1800
+G1 X130.65 Y81.96 E10.0346 F1800
+G1 Y81.5 F1800
+G1 X137.5 Y88.35 E10.1647 F1800
+G1 X137.96 F1800
+G1 X130.65 Y81.04 E10.3034 F1800
+G1 Y80.59 F1800
+G1 X138.41 Y88.35 E10.4508 F1800
+G1 X138.87 F1800
+G1 X130.65 Y80.13 E10.6069 F1800
+G1 Y79.67 F1800
+G1 X139.33 Y88.35 E10.7716 F1800
+G1 X139.78 F1800
+G1 X130.65 Y79.22 E10.945 F1800
+G1 Y78.76 F1800
+G1 X140.24 Y88.35 E11.1271 F1800
+G1 X140.7 F1800
+G1 X130.65 Y78.3 E11.3178 F1800
+G1 Y77.85 F1800
+G1 X141.15 Y88.35 E11.5173 F1800
+G1 X141.61 F1800
+G1 X130.65 Y77.39 E11.7253 F1800
+G1 Y76.93 F1800
+G1 X142.07 Y88.35 E11.9421 F1800
+G1 X142.52 F1800
+G1 X130.65 Y76.48 E12.1675 F1800
+G1 Y76.02 F1800
+G1 X142.98 Y88.35 E12.4016 F1800
+G1 X143.44 F1800
+G1 X130.65 Y75.56 E12.6444 F1800
+G1 Y75.11 F1800
+G1 X143.89 Y88.35 E12.8958 F1800
+G1 X144.35 F1800
+G1 X130.65 Y74.65 E13.1559 F1800
+G1 X131.11 F1800
+G1 X144.35 Y87.89 E13.4074 F1800
+G1 Y87.44 F1800
+G1 X131.56 Y74.65 E13.6501 F1800
+G1 X132.02 F1800
+G1 X144.35 Y86.98 E13.8842 F1800
+G1 Y86.52 F1800
+G1 X132.48 Y74.65 E14.1097 F1800
+G1 X132.93 F1800
+G1 X144.35 Y86.07 E14.3264 F1800
+G1 Y85.61 F1800
+G1 X133.39 Y74.65 E14.5345 F1800
+G1 X133.85 F1800
+G1 X144.35 Y85.15 E14.7339 F1800
+G1 Y84.7 F1800
+G1 X134.3 Y74.65 E14.9247 F1800
+G1 X134.76 F1800
+G1 X144.35 Y84.24 E15.1068 F1800
+G1 Y83.78 F1800
+G1 X135.22 Y74.65 E15.2802 F1800
+G1 X135.67 F1800
+G1 X144.35 Y83.33 E15.4449 F1800
+G1 Y82.87 F1800
+G1 X136.13 Y74.65 E15.601 F1800
+G1 X136.59 F1800
+G1 X144.35 Y82.41 E15.7484 F1800
+G1 Y81.96 F1800
+G1 X137.04 Y74.65 E15.8871 F1800
+G1 X137.5 F1800
+G1 X144.35 Y81.5 E16.0171 F1800
+G1 Y81.04 F1800
+G1 X137.96 Y74.65 E16.1385 F1800
+G1 X138.41 F1800
+G1 X144.35 Y80.59 E16.2512 F1800
+G1 Y80.13 F1800
+G1 X138.87 Y74.65 E16.3553 F1800
+G1 X139.33 F1800
+G1 X144.35 Y79.67 E16.4506 F1800
+G1 Y79.22 F1800
+G1 X139.78 Y74.65 E16.5373 F1800
+G1 X140.24 F1800
+G1 X144.35 Y78.76 E16.6154 F1800
+G1 Y78.3 F1800
+G1 X140.7 Y74.65 E16.6847 F1800
+G1 X141.15 F1800
+G1 X144.35 Y77.85 E16.7454 F1800
+G1 Y77.39 F1800
+G1 X141.61 Y74.65 E16.7975 F1800
+G1 X142.07 F1800
+G1 X144.35 Y76.93 E16.8408 F1800
+G1 Y76.48 F1800
+G1 X142.52 Y74.65 E16.8755 F1800
+G1 X142.98 F1800
+G1 X144.35 Y76.02 E16.9015 F1800
+G1 Y75.56 F1800
+G1 X143.44 Y74.65 E16.9188 F1800
+G1 X143.89 F1800
+G1 X144.35 Y75.11 F1800
+G1 Y74.65 F1800
+G1 E15.92 F1800
+G1 X90.66 Y112.34 F9000
+G1 E16.92 F1800
+G1 Y111.85 F1800
+G1 X91.15 Y112.34 F1800
+G1 X91.64 F1800
+G1 X90.66 Y111.36 E16.9387 F1800
+G1 Y110.87 F1800
+G1 X92.13 Y112.34 E16.9684 F1800
+G1 X92.62 F1800
+G1 X90.66 Y110.38 E17.0081 F1800
+G1 Y109.9 F1800
+G1 X93.1 Y112.34 E17.0577 F1800
+G1 X93.59 F1800
+G1 X90.66 Y109.41 E17.1172 F1800
+G1 Y108.92 F1800
+G1 X94.08 Y112.34 E17.1867 F1800
+G1 X94.57 F1800
+G1 X90.66 Y108.43 E17.266 F1800
+G1 Y107.94 F1800
+G1 X95.06 Y112.34 E17.3553 F1800
+G1 X95.55 F1800
+G1 X90.66 Y107.45 E17.4545 F1800
+G1 Y106.97 F1800
+G1 X96.03 Y112.34 E17.5636 F1800
+G1 X96.52 F1800
+G1 X90.66 Y106.48 E17.6827 F1800
+G1 Y105.99 F1800
+G1 X97.01 Y112.34 E17.8116 F1800
+G1 X97.5 F1800
+G1 X90.66 Y105.5 E17.9505 F1800
+G1 Y105.01 F1800
+G1 X97.99 Y112.34 E18.0993 F1800
+G1 X98.48 F1800
+G1 X90.66 Y104.52 E18.258 F1800
+G1 Y104.03 F1800
+G1 X98.97 Y112.34 E18.4267 F1800
+G1 X99.45 F1800
+G1 X90.66 Y103.55 E18.6052 F1800
+G1 Y103.06 F1800
+G1 X99.94 Y112.34 E18.7937 F1800
+G1 X100.43 F1800
+G1 X90.66 Y102.57 E18.9921 F1800
+G1 Y102.08 F1800
+G1 X100.92 Y112.34 E19.2004 F1800
+G1 X101.41 F1800
+G1 X90.66 Y101.59 E19.4186 F1800
+G1 Y101.1 F1800
+G1 X101.9 Y112.34 E19.6468 F1800
+G1 X102.38 F1800
+G1 X90.66 Y100.62 E19.8849 F1800
+G1 Y100.13 F1800
+G1 X102.87 Y112.34 E20.1329 F1800
+G1 X103.36 F1800
+G1 X90.66 Y99.64 E20.3908 F1800
+G1 Y99.15 F1800
+G1 X103.85 Y112.34 E20.6586 F1800
+G1 X104.34 F1800
+G1 X90.66 Y98.66 E20.9364 F1800
+G1 X91.15 F1800
+G1 X104.34 Y111.85 E21.2042 F1800
+G1 Y111.36 F1800
+G1 X91.64 Y98.66 E21.4621 F1800
+G1 X92.13 F1800
+G1 X104.34 Y110.87 E21.7101 F1800
+G1 Y110.38 F1800
+G1 X92.62 Y98.66 E21.9482 F1800
+G1 X93.1 F1800
+G1 X104.34 Y109.9 E22.1764 F1800
+G1 Y109.41 F1800
+G1 X93.59 Y98.66 E22.3946 F1800
+G1 X94.08 F1800
+G1 X104.34 Y108.92 E22.6029 F1800
+G1 Y108.43 F1800
+G1 X94.57 Y98.66 E22.8013 F1800
+G1 X95.06 F1800
+G1 X104.34 Y107.94 E22.9898 F1800
+G1 Y107.45 F1800
+G1 X95.55 Y98.66 E23.1683 F1800
+G1 X96.03 F1800
+G1 X104.34 Y106.97 E23.337 F1800
+G1 Y106.48 F1800
+G1 X96.52 Y98.66 E23.4957 F1800
+G1 X97.01 F1800
+G1 X104.34 Y105.99 E23.6445 F1800
+G1 Y105.5 F1800
+G1 X97.5 Y98.66 E23.7834 F1800
+G1 X97.99 F1800
+G1 X104.34 Y105.01 E23.9123 F1800
+G1 Y104.52 F1800
+G1 X98.48 Y98.66 E24.0314 F1800
+G1 X98.97 F1800
+G1 X104.34 Y104.03 E24.1405 F1800
+G1 Y103.55 F1800
+G1 X99.45 Y98.66 E24.2397 F1800
+G1 X99.94 F1800
+G1 X104.34 Y103.06 E24.329 F1800
+G1 Y102.57 F1800
+G1 X100.43 Y98.66 E24.4083 F1800
+G1 X100.92 F1800
+G1 X104.34 Y102.08 E24.4778 F1800
+G1 Y101.59 F1800
+G1 X101.41 Y98.66 E24.5373 F1800
+G1 X101.9 F1800
+G1 X104.34 Y101.1 E24.5869 F1800
+G1 Y100.62 F1800
+G1 X102.38 Y98.66 E24.6266 F1800
+G1 X102.87 F1800
+G1 X104.34 Y100.13 E24.6563 F1800
+G1 Y99.64 F1800
+G1 X103.36 Y98.66 E24.6762 F1800
+G1 X103.85 F1800
+G1 X104.34 Y99.15 F1800
+G1 Y98.66 F1800
+G1 E23.68 F1800
+G1 X130.66 Y112.34 F9000
+G1 E24.68 F1800
+G1 Y111.85 F1800
+G1 X131.15 Y112.34 F1800
+G1 X131.64 F1800
+G1 X130.66 Y111.36 E24.696 F1800
+G1 Y110.87 F1800
+G1 X132.13 Y112.34 E24.7258 F1800
+G1 X132.62 F1800
+G1 X130.66 Y110.38 E24.7654 F1800
+G1 Y109.9 F1800
+G1 X133.1 Y112.34 E24.815 F1800
+G1 X133.59 F1800
+G1 X130.66 Y109.41 E24.8746 F1800
+G1 Y108.92 F1800
+G1 X134.08 Y112.34 E24.944 F1800
+G1 X134.57 F1800
+G1 X130.66 Y108.43 E25.0234 F1800
+G1 Y107.94 F1800
+G1 X135.06 Y112.34 E25.1126 F1800
+G1 X135.55 F1800
+G1 X130.66 Y107.45 E25.2118 F1800
+G1 Y106.97 F1800
+G1 X136.03 Y112.34 E25.321 F1800
+G1 X136.52 F1800
+G1 X130.66 Y106.48 E25.44 F1800
+G1 Y105.99 F1800
+G1 X137.01 Y112.34 E25.569 F1800
+G1 X137.5 F1800
+G1 X130.66 Y105.5 E25.7078 F1800
+G1 Y105.01 F1800
+G1 X137.99 Y112.34 E25.8566 F1800
+G1 X138.48 F1800
+G1 X130.66 Y104.52 E26.0153 F1800
+G1 Y104.03 F1800
+G1 X138.97 Y112.34 E26.184 F1800
+G1 X139.45 F1800
+G1 X130.66 Y103.55 E26.3625 F1800
+G1 Y103.06 F1800
+G1 X139.94 Y112.34 E26.551 F1800
+G1 X140.43 F1800
+G1 X130.66 Y102.57 E26.7494 F1800
+G1 Y102.08 F1800
+G1 X140.92 Y112.34 E26.9577 F1800
+G1 X141.41 F1800
+G1 X130.66 Y101.59 E27.176 F1800
+G1 Y101.1 F1800
+G1 X141.9 Y112.34 E27.4041 F1800
+G1 X142.38 F1800
+G1 X130.66 Y100.62 E27.6422 F1800
+G1 Y100.13 F1800
+G1 X142.87 Y112.34 E27.8902 F1800
+G1 X143.36 F1800
+G1 X130.66 Y99.64 E28.1481 F1800
+G1 Y99.15 F1800
+G1 X143.85 Y112.34 E28.416 F1800
+G1 X144.34 F1800
+G1 X130.66 Y98.66 E28.6937 F1800
+G1 X131.15 F1800
+G1 X144.34 Y111.85 E28.9615 F1800
+G1 Y111.36 F1800
+G1 X131.64 Y98.66 E29.2195 F1800
+G1 X132.13 F1800
+G1 X144.34 Y110.87 E29.4675 F1800
+G1 Y110.38 F1800
+G1 X132.62 Y98.66 E29.7055 F1800
+G1 X133.1 F1800
+G1 X144.34 Y109.9 E29.9337 F1800
+G1 Y109.41 F1800
+G1 X133.59 Y98.66 E30.1519 F1800
+G1 X134.08 F1800
+G1 X144.34 Y108.92 E30.3602 F1800
+G1 Y108.43 F1800
+G1 X134.57 Y98.66 E30.5586 F1800
+G1 X135.06 F1800
+G1 X144.34 Y107.94 E30.7471 F1800
+G1 Y107.45 F1800
+G1 X135.55 Y98.66 E30.9257 F1800
+G1 X136.03 F1800
+G1 X144.34 Y106.97 E31.0943 F1800
+G1 Y106.48 F1800
+G1 X136.52 Y98.66 E31.253 F1800
+G1 X137.01 F1800
+G1 X144.34 Y105.99 E31.4018 F1800
+G1 Y105.5 F1800
+G1 X137.5 Y98.66 E31.5407 F1800
+G1 X137.99 F1800
+G1 X144.34 Y105.01 E31.6697 F1800
+G1 Y104.52 F1800
+G1 X138.48 Y98.66 E31.7887 F1800
+G1 X138.97 F1800
+G1 X144.34 Y104.03 E31.8978 F1800
+G1 Y103.55 F1800
+G1 X139.45 Y98.66 E31.997 F1800
+G1 X139.94 F1800
+G1 X144.34 Y103.06 E32.0863 F1800
+G1 Y102.57 F1800
+G1 X140.43 Y98.66 E32.1657 F1800
+G1 X140.92 F1800
+G1 X144.34 Y102.08 E32.2351 F1800
+G1 Y101.59 F1800
+G1 X141.41 Y98.66 E32.2946 F1800
+G1 X141.9 F1800
+G1 X144.34 Y101.1 E32.3442 F1800
+G1 Y100.62 F1800
+G1 X142.38 Y98.66 E32.3839 F1800
+G1 X142.87 F1800
+G1 X144.34 Y100.13 E32.4137 F1800
+G1 Y99.64 F1800
+G1 X143.36 Y98.66 E32.4335 F1800
+G1 X143.85 F1800
+G1 X144.34 Y99.15 F1800
+G1 Y98.66 F1800
+G1 E31.43 F1800
+G1 X90.67 Y136.33 F9000
+G1 E32.43 F1800
+G1 Y135.8 F1800
+G1 X91.2 Y136.33 F1800
+G1 X91.72 F1800
+G1 X90.67 Y135.28 E32.4564 F1800
+G1 Y134.75 F1800
+G1 X92.25 Y136.33 E32.4908 F1800
+G1 X92.77 F1800
+G1 X90.67 Y134.23 E32.5367 F1800
+G1 Y133.7 F1800
+G1 X93.3 Y136.33 E32.594 F1800
+G1 X93.82 F1800
+G1 X90.67 Y133.18 E32.6628 F1800
+G1 Y132.65 F1800
+G1 X94.35 Y136.33 E32.7431 F1800
+G1 X94.87 F1800
+G1 X90.67 Y132.13 E32.8348 F1800
+G1 Y131.6 F1800
+G1 X95.4 Y136.33 E32.938 F1800
+G1 X95.92 F1800
+G1 X90.67 Y131.08 E33.0527 F1800
+G1 Y130.55 F1800
+G1 X96.45 Y136.33 E33.1788 F1800
+G1 X96.97 F1800
+G1 X90.67 Y130.03 E33.3164 F1800
+G1 Y129.5 F1800
+G1 X97.5 Y136.33 E33.4655 F1800
+G1 X98.03 F1800
+G1 X90.67 Y128.97 E33.626 F1800
+G1 Y128.45 F1800
+G1 X98.55 Y136.33 E33.7981 F1800
+G1 X99.08 F1800
+G1 X90.67 Y127.92 E33.9815 F1800
+G1 Y127.4 F1800
+G1 X99.6 Y136.33 E34.1765 F1800
+G1 X100.13 F1800
+G1 X90.67 Y126.87 E34.3829 F1800
+G1 Y126.35 F1800
+G1 X100.65 Y136.33 E34.6007 F1800
+G1 X101.18 F1800
+G1 X90.67 Y125.82 E34.8301 F1800
+G1 Y125.3 F1800
+G1 X101.7 Y136.33 E35.0709 F1800
+G1 X102.23 F1800
+G1 X90.67 Y124.77 E35.3231 F1800
+G1 Y124.25 F1800
+G1 X102.75 Y136.33 E35.5869 F1800
+G1 X103.28 F1800
+G1 X90.67 Y123.72 E35.8621 F1800
+G1 Y123.2 F1800
+G1 X103.8 Y136.33 E36.1488 F1800
+G1 X104.33 F1800
+G1 X90.67 Y122.67 E36.4469 F1800
+G1 X91.2 F1800
+G1 X104.33 Y135.8 E36.7336 F1800
+G1 Y135.28 F1800
+G1 X91.72 Y122.67 E37.0088 F1800
+G1 X92.25 F1800
+G1 X104.33 Y134.75 E37.2725 F1800
+G1 Y134.23 F1800
+G1 X92.77 Y122.67 E37.5248 F1800
+G1 X93.3 F1800
+G1 X104.33 Y133.7 E37.7656 F1800
+G1 Y133.18 F1800
+G1 X93.82 Y122.67 E37.9949 F1800
+G1 X94.35 F1800
+G1 X104.33 Y132.65 E38.2128 F1800
+G1 Y132.13 F1800
+G1 X94.87 Y122.67 E38.4192 F1800
+G1 X95.4 F1800
+G1 X104.33 Y131.6 E38.6141 F1800
+G1 Y131.08 F1800
+G1 X95.92 Y122.67 E38.7976 F1800
+G1 X96.45 F1800
+G1 X104.33 Y130.55 E38.9696 F1800
+G1 Y130.03 F1800
+G1 X96.97 Y122.67 E39.1302 F1800
+G1 X97.5 F1800
+G1 X104.33 Y129.5 E39.2792 F1800
+G1 Y128.97 F1800
+G1 X98.03 Y122.67 E39.4168 F1800
+G1 X98.55 F1800
+G1 X104.33 Y128.45 E39.543 F1800
+G1 Y127.92 F1800
+G1 X99.08 Y122.67 E39.6576 F1800
+G1 X99.6 F1800
+G1 X104.33 Y127.4 E39.7608 F1800
+G1 Y126.87 F1800
+G1 X100.13 Y122.67 E39.8526 F1800
+G1 X100.65 F1800
+G1 X104.33 Y126.35 E39.9328 F1800
+G1 Y125.82 F1800
+G1 X101.18 Y122.67 E40.0016 F1800
+G1 X101.7 F1800
+G1 X104.33 Y125.3 E40.059 F1800
+G1 Y124.77 F1800
+G1 X102.23 Y122.67 E40.1048 F1800
+G1 X102.75 F1800
+G1 X104.33 Y124.25 E40.1392 F1800
+G1 Y123.72 F1800
+G1 X103.28 Y122.67 E40.1622 F1800
+G1 X103.8 F1800
+G1 X104.33 Y123.2 F1800
+G1 Y122.67 F1800
+G1 E39.16 F1800
+G1 X130.67 Y136.33 F9000
+G1 E40.16 F1800
+G1 Y135.8 F1800
+G1 X131.2 Y136.33 F1800
+G1 X131.72 F1800
+G1 X130.67 Y135.28 E40.1851 F1800
+G1 Y134.75 F1800
+G1 X132.25 Y136.33 E40.2195 F1800
+G1 X132.77 F1800
+G1 X130.67 Y134.23 E40.2654 F1800
+G1 Y133.7 F1800
+G1 X133.3 Y136.33 E40.3227 F1800
+G1 X133.82 F1800
+G1 X130.67 Y133.18 E40.3915 F1800
+G1 Y132.65 F1800
+G1 X134.35 Y136.33 E40.4718 F1800
+G1 X134.87 F1800
+G1 X130.67 Y132.13 E40.5635 F1800
+G1 Y131.6 F1800
+G1 X135.4 Y136.33 E40.6667 F1800
+G1 X135.92 F1800
+G1 X130.67 Y131.08 E40.7814 F1800
+G1 Y130.55 F1800
+G1 X136.45 Y136.33 E40.9075 F1800
+G1 X136.97 F1800
+G1 X130.67 Y130.03 E41.0451 F1800
+G1 Y129.5 F1800
+G1 X137.5 Y136.33 E41.1942 F1800
+G1 X138.03 F1800
+G1 X130.67 Y128.97 E41.3547 F1800
+G1 Y128.45 F1800
+G1 X138.55 Y136.33 E41.5267 F1800
+G1 X139.08 F1800
+G1 X130.67 Y127.92 E41.7102 F1800
+G1 Y127.4 F1800
+G1 X139.6 Y136.33 E41.9051 F1800
+G1 X140.13 F1800
+G1 X130.67 Y126.87 E42.1115 F1800
+G1 Y126.35 F1800
+G1 X140.65 Y136.33 E42.3294 F1800
+G1 X141.18 F1800
+G1 X130.67 Y125.82 E42.5587 F1800
+G1 Y125.3 F1800
+G1 X141.7 Y136.33 E42.7996 F1800
+G1 X142.23 F1800
+G1 X130.67 Y124.77 E43.0518 F1800
+G1 Y124.25 F1800
+G1 X142.75 Y136.33 E43.3156 F1800
+G1 X143.28 F1800
+G1 X130.67 Y123.72 E43.5908 F1800
+G1 Y123.2 F1800
+G1 X143.8 Y136.33 E43.8774 F1800
+G1 X144.33 F1800
+G1 X130.67 Y122.67 E44.1756 F1800
+G1 X131.2 F1800
+G1 X144.33 Y135.8 E44.4623 F1800
+G1 Y135.28 F1800
+G1 X131.72 Y122.67 E44.7375 F1800
+G1 X132.25 F1800
+G1 X144.33 Y134.75 E45.0012 F1800
+G1 Y134.23 F1800
+G1 X132.77 Y122.67 E45.2535 F1800
+G1 X133.3 F1800
+G1 X144.33 Y133.7 E45.4943 F1800
+G1 Y133.18 F1800
+G1 X133.82 Y122.67 E45.7236 F1800
+G1 X134.35 F1800
+G1 X144.33 Y132.65 E45.9415 F1800
+G1 Y132.13 F1800
+G1 X134.87 Y122.67 E46.1479 F1800
+G1 X135.4 F1800
+G1 X144.33 Y131.6 E46.3428 F1800
+G1 Y131.08 F1800
+G1 X135.92 Y122.67 E46.5263 F1800
+G1 X136.45 F1800
+G1 X144.33 Y130.55 E46.6983 F1800
+G1 Y130.03 F1800
+G1 X136.97 Y122.67 E46.8588 F1800
+G1 X137.5 F1800
+G1 X144.33 Y129.5 E47.0079 F1800
+G1 Y128.97 F1800
+G1 X138.03 Y122.67 E47.1455 F1800
+G1 X138.55 F1800
+G1 X144.33 Y128.45 E47.2716 F1800
+G1 Y127.92 F1800
+G1 X139.08 Y122.67 E47.3863 F1800
+G1 X139.6 F1800
+G1 X144.33 Y127.4 E47.4895 F1800
+G1 Y126.87 F1800
+G1 X140.13 Y122.67 E47.5812 F1800
+G1 X140.65 F1800
+G1 X144.33 Y126.35 E47.6615 F1800
+G1 Y125.82 F1800
+G1 X141.18 Y122.67 E47.7303 F1800
+G1 X141.7 F1800
+G1 X144.33 Y125.3 E47.7876 F1800
+G1 Y124.77 F1800
+G1 X142.23 Y122.67 E47.8335 F1800
+G1 X142.75 F1800
+G1 X144.33 Y124.25 E47.8679 F1800
+G1 Y123.72 F1800
+G1 X143.28 Y122.67 E47.8908 F1800
+G1 X143.8 F1800
+G1 X144.33 Y123.2 F1800
+G1 Y122.67 F1800
+G1 E46.89 F1800
+G1 X90.69 Y160.31 F9000
+G1 E47.89 F1800
+G1 Y159.75 F1800
+G1 X91.25 Y160.31 E47.9043 F1800
+G1 X91.82 F1800
+G1 X90.69 Y159.18 E47.9311 F1800
+G1 Y158.61 F1800
+G1 X92.39 Y160.31 E47.9713 F1800
+G1 X92.96 F1800
+G1 X90.69 Y158.04 E48.0249 F1800
+G1 Y157.48 F1800
+G1 X93.52 Y160.31 E48.0919 F1800
+G1 X94.09 F1800
+G1 X90.69 Y156.91 E48.1724 F1800
+G1 Y156.34 F1800
+G1 X94.66 Y160.31 E48.2662 F1800
+G1 X95.23 F1800
+G1 X90.69 Y155.77 E48.3735 F1800
+G1 Y155.2 F1800
+G1 X95.8 Y160.31 E48.4941 F1800
+G1 X96.36 F1800
+G1 X90.69 Y154.64 E48.6282 F1800
+G1 Y154.07 F1800
+G1 X96.93 Y160.31 E48.7757 F1800
+G1 X97.5 F1800
+G1 X90.69 Y153.5 E48.9366 F1800
+G1 Y152.93 F1800
+G1 X98.07 Y160.31 E49.1108 F1800
+G1 X98.64 F1800
+G1 X90.69 Y152.36 E49.2985 F1800
+G1 Y151.8 F1800
+G1 X99.2 Y160.31 E49.4996 F1800
+G1 X99.77 F1800
+G1 X90.69 Y151.23 E49.7141 F1800
+G1 Y150.66 F1800
+G1 X100.34 Y160.31 E49.9421 F1800
+G1 X100.91 F1800
+G1 X90.69 Y150.09 E50.1834 F1800
+G1 Y149.52 F1800
+G1 X101.48 Y160.31 E50.4381 F1800
+G1 X102.04 F1800
+G1 X90.69 Y148.96 E50.7062 F1800
+G1 Y148.39 F1800
+G1 X102.61 Y160.31 E50.9878 F1800
+G1 X103.18 F1800
+G1 X90.69 Y147.82 E51.2827 F1800
+G1 Y147.25 F1800
+G1 X103.75 Y160.31 E51.5911 F1800
+G1 X104.31 F1800
+G1 X90.69 Y146.69 E51.9128 F1800
+G1 X91.25 F1800
+G1 X104.31 Y159.75 E52.2212 F1800
+G1 Y159.18 F1800
+G1 X91.82 Y146.69 E52.5161 F1800
+G1 X92.39 F1800
+G1 X104.31 Y158.61 E52.7976 F1800
+G1 Y158.04 F1800
+G1 X92.96 Y146.69 E53.0658 F1800
+G1 X93.52 F1800
+G1 X104.31 Y157.48 E53.3205 F1800
+G1 Y156.91 F1800
+G1 X94.09 Y146.69 E53.5618 F1800
+G1 X94.66 F1800
+G1 X104.31 Y156.34 E53.7897 F1800
+G1 Y155.77 F1800
+G1 X95.23 Y146.69 E54.0042 F1800
+G1 X95.8 F1800
+G1 X104.31 Y155.2 E54.2053 F1800
+G1 Y154.64 F1800
+G1 X96.36 Y146.69 E54.393 F1800
+G1 X96.93 F1800
+G1 X104.31 Y154.07 E54.5673 F1800
+G1 Y153.5 F1800
+G1 X97.5 Y146.69 E54.7282 F1800
+G1 X98.07 F1800
+G1 X104.31 Y152.93 E54.8757 F1800
+G1 Y152.36 F1800
+G1 X98.64 Y146.69 E55.0097 F1800
+G1 X99.2 F1800
+G1 X104.31 Y151.8 E55.1304 F1800
+G1 Y151.23 F1800
+G1 X99.77 Y146.69 E55.2376 F1800
+G1 X100.34 F1800
+G1 X104.31 Y150.66 E55.3315 F1800
+G1 Y150.09 F1800
+G1 X100.91 Y146.69 E55.4119 F1800
+G1 X101.48 F1800
+G1 X104.31 Y149.52 E55.479 F1800
+G1 Y148.96 F1800
+G1 X102.04 Y146.69 E55.5326 F1800
+G1 X102.61 F1800
+G1 X104.31 Y148.39 E55.5728 F1800
+G1 Y147.82 F1800
+G1 X103.18 Y146.69 E55.5996 F1800
+G1 X103.75 F1800
+G1 X104.31 Y147.25 E55.613 F1800
+G1 Y146.69 F1800
+G1 E54.61 F1800
+G1 X130.69 Y160.31 F9000
+G1 E55.61 F1800
+G1 Y159.75 F1800
+G1 X131.25 Y160.31 E55.6264 F1800
+G1 X131.82 F1800
+G1 X130.69 Y159.18 E55.6532 F1800
+G1 Y158.61 F1800
+G1 X132.39 Y160.31 E55.6935 F1800
+G1 X132.96 F1800
+G1 X130.69 Y158.04 E55.7471 F1800
+G1 Y157.48 F1800
+G1 X133.52 Y160.31 E55.8141 F1800
+G1 X134.09 F1800
+G1 X130.69 Y156.91 E55.8946 F1800
+G1 Y156.34 F1800
+G1 X134.66 Y160.31 E55.9884 F1800
+G1 X135.23 F1800
+G1 X130.69 Y155.77 E56.0957 F1800
+G1 Y155.2 F1800
+G1 X135.8 Y160.31 E56.2163 F1800
+G1 X136.36 F1800
+G1 X130.69 Y154.64 E56.3504 F1800
+G1 Y154.07 F1800
+G1 X136.93 Y160.31 E56.4979 F1800
+G1 X137.5 F1800
+G1 X130.69 Y153.5 E56.6587 F1800
+G1 Y152.93 F1800
+G1 X138.07 Y160.31 E56.833 F1800
+G1 X138.64 F1800
+G1 X130.69 Y152.36 E57.0207 F1800
+G1 Y151.8 F1800
+G1 X139.2 Y160.31 E57.2218 F1800
+G1 X139.77 F1800
+G1 X130.69 Y151.23 E57.4363 F1800
+G1 Y150.66 F1800
+G1 X140.34 Y160.31 E57.6642 F1800
+G1 X140.91 F1800
+G1 X130.69 Y150.09 E57.9055 F1800
+G1 Y149.52 F1800
+G1 X141.48 Y160.31 E58.1603 F1800
+G1 X142.04 F1800
+G1 X130.69 Y148.96 E58.4284 F1800
+G1 Y148.39 F1800
+G1 X142.61 Y160.31 E58.7099 F1800
+G1 X143.18 F1800
+G1 X130.69 Y147.82 E59.0049 F1800
+G1 Y147.25 F1800
+G1 X143.75 Y160.31 E59.3132 F1800
+G1 X144.31 F1800
+G1 X130.69 Y146.69 E59.635 F1800
+G1 X131.25 F1800
+G1 X144.31 Y159.75 E59.9433 F1800
+G1 Y159.18 F1800
+G1 X131.82 Y146.69 E60.2383 F1800
+G1 X132.39 F1800
+G1 X144.31 Y158.61 E60.5198 F1800
+G1 Y158.04 F1800
+G1 X132.96 Y146.69 E60.7879 F1800
+G1 X133.52 F1800
+G1 X144.31 Y157.48 E61.0427 F1800
+G1 Y156.91 F1800
+G1 X134.09 Y146.69 E61.284 F1800
+G1 X134.66 F1800
+G1 X144.31 Y156.34 E61.5119 F1800
+G1 Y155.77 F1800
+G1 X135.23 Y146.69 E61.7264 F1800
+G1 X135.8 F1800
+G1 X144.31 Y155.2 E61.9275 F1800
+G1 Y154.64 F1800
+G1 X136.36 Y146.69 E62.1152 F1800
+G1 X136.93 F1800
+G1 X144.31 Y154.07 E62.2895 F1800
+G1 Y153.5 F1800
+G1 X137.5 Y146.69 E62.4504 F1800
+G1 X138.07 F1800
+G1 X144.31 Y152.93 E62.5978 F1800
+G1 Y152.36 F1800
+G1 X138.64 Y146.69 E62.7319 F1800
+G1 X139.2 F1800
+G1 X144.31 Y151.8 E62.8526 F1800
+G1 Y151.23 F1800
+G1 X139.77 Y146.69 E62.9598 F1800
+G1 X140.34 F1800
+G1 X144.31 Y150.66 E63.0537 F1800
+G1 Y150.09 F1800
+G1 X140.91 Y146.69 E63.1341 F1800
+G1 X141.48 F1800
+G1 X144.31 Y149.52 E63.2011 F1800
+G1 Y148.96 F1800
+G1 X142.04 Y146.69 E63.2547 F1800
+G1 X142.61 F1800
+G1 X144.31 Y148.39 E63.295 F1800
+G1 Y147.82 F1800
+G1 X143.18 Y146.69 E63.3218 F1800
+G1 X143.75 F1800
+G1 X144.31 Y147.25 E63.3352 F1800
+G1 Y146.69 F1800
+;layer #2
+M106 S169
+G1 E62.34 F1800
+G1 X133.81 Y149.81 F9000
+G1 E63.34 F1800
+G1 Z0.2 F300
+G1 Y157.19 E63.4118 F3600
+G1 X141.19 E63.4885
+G1 Y149.81 E63.5652
+G1 X133.81 E63.6418
+G1 X133.56 Y149.56
+G1 Y157.44 E63.7237
+G1 X141.44 E63.8055
+G1 Y149.56 E63.8874
+G1 X133.56 E63.9692
+G1 E62.97 F1800
+G1 X101.19 Y149.81 F9000
+G1 E63.97 F1800
+G1 X93.81 E64.0459 F3600
+G1 Y157.19 E64.1225
+G1 X101.19 E64.1992
+G1 Y149.81 E64.2758
+G1 X101.44 Y149.56
+G1 X93.56 E64.3577
+G1 Y157.44 E64.4395
+G1 X101.44 E64.5214
+G1 Y149.56 E64.6032
+G1 E63.6 F1800
+G1 X133.81 Y125.81 F9000
+G1 E64.6 F1800
+G1 Y133.19 E64.6799 F3600
+G1 X141.19 E64.7565
+G1 Y125.81 E64.8332
+G1 X133.81 E64.9098
+G1 X133.56 Y125.56
+G1 Y133.44 E64.9917
+G1 X141.44 E65.0735
+G1 Y125.56 E65.1554
+G1 X133.56 E65.2373
+G1 E64.24 F1800
+G1 X101.19 Y125.81 F9000
+G1 E65.24 F1800
+G1 X93.81 E65.3139 F3600
+G1 Y133.19 E65.3906
+G1 X101.19 E65.4672
+G1 Y125.81 E65.5439
+G1 X101.44 Y125.56
+G1 X93.56 E65.6257
+G1 Y133.44 E65.7076
+G1 X101.44 E65.7894
+G1 Y125.56 E65.8713
+G1 E64.87 F1800
+G1 X133.81 Y101.81 F9000
+G1 E65.87 F1800
+G1 Y109.19 E65.9479 F3600
+G1 X141.19 E66.0246
+G1 Y101.81 E66.1012
+G1 X133.81 E66.1779
+G1 X133.56 Y101.56
+G1 Y109.44 E66.2597
+G1 X141.44 E66.3416
+G1 Y101.56 E66.4234
+G1 X133.56 E66.5053
+G1 E65.51 F1800
+G1 X101.19 Y101.81 F9000
+G1 E66.51 F1800
+G1 X93.81 E66.5819 F3600
+G1 Y109.19 E66.6586
+G1 X101.19 E66.7353
+G1 Y101.81 E66.8119
+G1 X101.44 Y101.56
+G1 X93.56 E66.8938
+G1 Y109.44 E66.9756
+G1 X101.44 E67.0575
+G1 Y101.56 E67.1393
+G1 E66.14 F1800
+G1 X133.81 Y77.81 F9000
+G1 E67.14 F1800
+G1 Y85.19 E67.216 F3600
+G1 X141.19 E67.2926
+G1 Y77.81 E67.3693
+G1 X133.81 E67.4459
+G1 X133.56 Y77.56
+G1 Y85.44 E67.5278
+G1 X141.44 E67.6096
+G1 Y77.56 E67.6915
+G1 X133.56 E67.7733
+G1 E66.77 F1800
+G1 X101.19 Y77.81 F9000
+G1 E67.77 F1800
+G1 X93.81 E67.85 F3600
+G1 Y85.19 E67.9266
+G1 X101.19 E68.0033
+G1 Y77.81 E68.08
+G1 X101.44 Y77.56
+G1 X93.56 E68.1618
+G1 Y85.44 E68.2437
+G1 X101.44 E68.3255
+G1 Y77.56 E68.4074
+;layer #3
+M106 S254
+G1 E67.41 F1800
+G1 X101.19 Y77.81 F9000
+G1 E68.41 F1800
+G1 Z0.3 F300
+G1 X93.81 E68.484 F3600
+G1 Y85.19 E68.5607
+G1 X101.19 E68.6373
+G1 Y77.81 E68.714
+G1 X101.44 Y77.56
+G1 X93.56 E68.7958
+G1 Y85.44 E68.8777
+G1 X101.44 E68.9595
+G1 Y77.56 E69.0414
+G1 E68.04 F1800
+G1 X133.81 Y77.81 F9000
+G1 E69.04 F1800
+G1 Y85.19 E69.118 F3600
+G1 X141.19 E69.1947
+G1 Y77.81 E69.2713
+G1 X133.81 E69.348
+G1 X133.56 Y77.56
+G1 Y85.44 E69.4298
+G1 X141.44 E69.5117
+G1 Y77.56 E69.5935
+G1 X133.56 E69.6754
+G1 E68.68 F1800
+G1 X101.19 Y101.81 F9000
+G1 E69.68 F1800
+G1 X93.81 E69.7521 F3600
+G1 Y109.19 E69.8287
+G1 X101.19 E69.9054
+G1 Y101.81 E69.982
+G1 X101.44 Y101.56
+G1 X93.56 E70.0639
+G1 Y109.44 E70.1457
+G1 X101.44 E70.2276
+G1 Y101.56 E70.3094
+G1 E69.31 F1800
+G1 X133.81 Y101.81 F9000
+G1 E70.31 F1800
+G1 Y109.19 E70.3861 F3600
+G1 X141.19 E70.4627
+G1 Y101.81 E70.5394
+G1 X133.81 E70.616
+G1 X133.56 Y101.56
+G1 Y109.44 E70.6979
+G1 X141.44 E70.7797
+G1 Y101.56 E70.8616
+G1 X133.56 E70.9434
+G1 E69.94 F1800
+G1 X101.19 Y125.81 F9000
+G1 E70.94 F1800
+G1 X93.81 E71.0201 F3600
+G1 Y133.19 E71.0968
+G1 X101.19 E71.1734
+G1 Y125.81 E71.2501
+G1 X101.44 Y125.56
+G1 X93.56 E71.3319
+G1 Y133.44 E71.4138
+G1 X101.44 E71.4956
+G1 Y125.56 E71.5775
+G1 E70.58 F1800
+G1 X133.81 Y125.81 F9000
+G1 E71.58 F1800
+G1 Y133.19 E71.6541 F3600
+G1 X141.19 E71.7308
+G1 Y125.81 E71.8074
+G1 X133.81 E71.8841
+G1 X133.56 Y125.56
+G1 Y133.44 E71.9659
+G1 X141.44 E72.0478
+G1 Y125.56 E72.1296
+G1 X133.56 E72.2115
+G1 E71.21 F1800
+G1 X101.19 Y149.81 F9000
+G1 E72.21 F1800
+G1 X93.81 E72.2881 F3600
+G1 Y157.19 E72.3648
+G1 X101.19 E72.4414
+G1 Y149.81 E72.5181
+G1 X101.44 Y149.56
+G1 X93.56 E72.6
+G1 Y157.44 E72.6818
+G1 X101.44 E72.7637
+G1 Y149.56 E72.8455
+G1 E71.85 F1800
+G1 X133.81 Y149.81 F9000
+G1 E72.85 F1800
+G1 Y157.19 E72.9222 F3600
+G1 X141.19 E72.9988
+G1 Y149.81 E73.0755
+G1 X133.81 E73.1521
+G1 X133.56 Y149.56
+G1 Y157.44 E73.234
+G1 X141.44 E73.3158
+G1 Y149.56 E73.3977
+G1 X133.56 E73.4795
+;layer #4
+G1 E72.48 F1800
+G1 X133.81 Y149.81 F9000
+G1 E73.48 F1800
+G1 Z0.4 F300
+G1 Y157.19 E73.5562 F3600
+G1 X141.19 E73.6328
+G1 Y149.81 E73.7095
+G1 X133.81 E73.7861
+G1 X133.56 Y149.56
+G1 Y157.44 E73.868
+G1 X141.44 E73.9498
+G1 Y149.56 E74.0317
+G1 X133.56 E74.1135
+G1 E73.11 F1800
+G1 X101.19 Y149.81 F9000
+G1 E74.11 F1800
+G1 X93.81 E74.1902 F3600
+G1 Y157.19 E74.2669
+G1 X101.19 E74.3435
+G1 Y149.81 E74.4202
+G1 X101.44 Y149.56
+G1 X93.56 E74.502
+G1 Y157.44 E74.5839
+G1 X101.44 E74.6657
+G1 Y149.56 E74.7476
+G1 E73.75 F1800
+G1 X133.81 Y125.81 F9000
+G1 E74.75 F1800
+G1 Y133.19 E74.8242 F3600
+G1 X141.19 E74.9009
+G1 Y125.81 E74.9775
+G1 X133.81 E75.0542
+G1 X133.56 Y125.56
+G1 Y133.44 E75.136
+G1 X141.44 E75.2179
+G1 Y125.56 E75.2997
+G1 X133.56 E75.3816
+G1 E74.38 F1800
+G1 X101.19 Y125.81 F9000
+G1 E75.38 F1800
+G1 X93.81 E75.4582 F3600
+G1 Y133.19 E75.5349
+G1 X101.19 E75.6116
+G1 Y125.81 E75.6882
+G1 X101.44 Y125.56
+G1 X93.56 E75.7701
+G1 Y133.44 E75.8519
+G1 X101.44 E75.9338
+G1 Y125.56 E76.0156
+G1 E75.02 F1800
+G1 X133.81 Y101.81 F9000
+G1 E76.02 F1800
+G1 Y109.19 E76.0923 F3600
+G1 X141.19 E76.1689
+G1 Y101.81 E76.2456
+G1 X133.81 E76.3222
+G1 X133.56 Y101.56
+G1 Y109.44 E76.4041
+G1 X141.44 E76.4859
+G1 Y101.56 E76.5678
+G1 X133.56 E76.6496
+G1 E75.65 F1800
+G1 X101.19 Y101.81 F9000
+G1 E76.65 F1800
+G1 X93.81 E76.7263 F3600
+G1 Y109.19 E76.8029
+G1 X101.19 E76.8796
+G1 Y101.81 E76.9563
+G1 X101.44 Y101.56
+G1 X93.56 E77.0381
+G1 Y109.44 E77.12
+G1 X101.44 E77.2018
+G1 Y101.56 E77.2837
+G1 E76.28 F1800
+G1 X133.81 Y77.81 F9000
+G1 E77.28 F1800
+G1 Y85.19 E77.3603 F3600
+G1 X141.19 E77.437
+G1 Y77.81 E77.5136
+G1 X133.81 E77.5903
+G1 X133.56 Y77.56
+G1 Y85.44 E77.6721
+G1 X141.44 E77.754
+G1 Y77.56 E77.8358
+G1 X133.56 E77.9177
+G1 E76.92 F1800
+G1 X101.19 Y77.81 F9000
+G1 E77.92 F1800
+G1 X93.81 E77.9943 F3600
+G1 Y85.19 E78.071
+G1 X101.19 E78.1476
+G1 Y77.81 E78.2243
+G1 X101.44 Y77.56
+G1 X93.56 E78.3061
+G1 Y85.44 E78.388
+G1 X101.44 E78.4698
+G1 Y77.56 E78.5517
+;layer #5
+G1 E77.55 F1800
+G1 X101.19 Y77.81 F9000
+G1 E78.55 F1800
+G1 Z0.5 F300
+G1 X93.81 E78.6284 F3600
+G1 Y85.19 E78.705
+G1 X101.19 E78.7817
+G1 Y77.81 E78.8583
+G1 X101.44 Y77.56
+G1 X93.56 E78.9402
+G1 Y85.44 E79.022
+G1 X101.44 E79.1039
+G1 Y77.56 E79.1857
+G1 E78.19 F1800
+G1 X133.81 Y77.81 F9000
+G1 E79.19 F1800
+G1 Y85.19 E79.2624 F3600
+G1 X141.19 E79.339
+G1 Y77.81 E79.4157
+G1 X133.81 E79.4923
+G1 X133.56 Y77.56
+G1 Y85.44 E79.5742
+G1 X141.44 E79.656
+G1 Y77.56 E79.7379
+G1 X133.56 E79.8197
+G1 E78.82 F1800
+G1 X101.19 Y101.81 F9000
+G1 E79.82 F1800
+G1 X93.81 E79.8964 F3600
+G1 Y109.19 E79.9731
+G1 X101.19 E80.0497
+G1 Y101.81 E80.1264
+G1 X101.44 Y101.56
+G1 X93.56 E80.2082
+G1 Y109.44 E80.2901
+G1 X101.44 E80.3719
+G1 Y101.56 E80.4538
+G1 E79.45 F1800
+G1 X133.81 Y101.81 F9000
+G1 E80.45 F1800
+G1 Y109.19 E80.5304 F3600
+G1 X141.19 E80.6071
+G1 Y101.81 E80.6837
+G1 X133.81 E80.7604
+G1 X133.56 Y101.56
+G1 Y109.44 E80.8422
+G1 X141.44 E80.9241
+G1 Y101.56 E81.0059
+G1 X133.56 E81.0878
+G1 E80.09 F1800
+G1 X101.19 Y125.81 F9000
+G1 E81.09 F1800
+G1 X93.81 E81.1644 F3600
+G1 Y133.19 E81.2411
+G1 X101.19 E81.3177
+G1 Y125.81 E81.3944
+G1 X101.44 Y125.56
+G1 X93.56 E81.4763
+G1 Y133.44 E81.5581
+G1 X101.44 E81.64
+G1 Y125.56 E81.7218
+G1 E80.72 F1800
+G1 X133.81 Y125.81 F9000
+G1 E81.72 F1800
+G1 Y133.19 E81.7985 F3600
+G1 X141.19 E81.8751
+G1 Y125.81 E81.9518
+G1 X133.81 E82.0284
+G1 X133.56 Y125.56
+G1 Y133.44 E82.1103
+G1 X141.44 E82.1921
+G1 Y125.56 E82.274
+G1 X133.56 E82.3558
+G1 E81.36 F1800
+G1 X101.19 Y149.81 F9000
+G1 E82.36 F1800
+G1 X93.81 E82.4325 F3600
+G1 Y157.19 E82.5091
+G1 X101.19 E82.5858
+G1 Y149.81 E82.6624
+G1 X101.44 Y149.56
+G1 X93.56 E82.7443
+G1 Y157.44 E82.8261
+G1 X101.44 E82.908
+G1 Y149.56 E82.9898
+G1 E81.99 F1800
+G1 X133.81 Y149.81 F9000
+G1 E82.99 F1800
+G1 Y157.19 E83.0665 F3600
+G1 X141.19 E83.1432
+G1 Y149.81 E83.2198
+G1 X133.81 E83.2965
+G1 X133.56 Y149.56
+G1 Y157.44 E83.3783
+G1 X141.44 E83.4602
+G1 Y149.56 E83.542
+G1 X133.56 E83.6239
+;layer #6
+G1 E82.62 F1800
+G1 X133.81 Y149.81 F9000
+G1 E83.62 F1800
+G1 Z0.6 F300
+G1 Y157.19 E83.7005 F3600
+G1 X141.19 E83.7772
+G1 Y149.81 E83.8538
+G1 X133.81 E83.9305
+G1 X133.56 Y149.56
+G1 Y157.44 E84.0123
+G1 X141.44 E84.0942
+G1 Y149.56 E84.176
+G1 X133.56 E84.2579
+G1 E83.26 F1800
+G1 X101.19 Y149.81 F9000
+G1 E84.26 F1800
+G1 X93.81 E84.3345 F3600
+G1 Y157.19 E84.4112
+G1 X101.19 E84.4879
+G1 Y149.81 E84.5645
+G1 X101.44 Y149.56
+G1 X93.56 E84.6464
+G1 Y157.44 E84.7282
+G1 X101.44 E84.8101
+G1 Y149.56 E84.8919
+G1 E83.89 F1800
+G1 X133.81 Y125.81 F9000
+G1 E84.89 F1800
+G1 Y133.19 E84.9686 F3600
+G1 X141.19 E85.0452
+G1 Y125.81 E85.1219
+G1 X133.81 E85.1985
+G1 X133.56 Y125.56
+G1 Y133.44 E85.2804
+G1 X141.44 E85.3622
+G1 Y125.56 E85.4441
+G1 X133.56 E85.5259
+G1 E84.53 F1800
+G1 X101.19 Y125.81 F9000
+G1 E85.53 F1800
+G1 X93.81 E85.6026 F3600
+G1 Y133.19 E85.6792
+G1 X101.19 E85.7559
+G1 Y125.81 E85.8326
+G1 X101.44 Y125.56
+G1 X93.56 E85.9144
+G1 Y133.44 E85.9963
+G1 X101.44 E86.0781
+G1 Y125.56 E86.16
+G1 E85.16 F1800
+G1 X133.81 Y101.81 F9000
+G1 E86.16 F1800
+G1 Y109.19 E86.2366 F3600
+G1 X141.19 E86.3133
+G1 Y101.81 E86.3899
+G1 X133.81 E86.4666
+G1 X133.56 Y101.56
+G1 Y109.44 E86.5484
+G1 X141.44 E86.6303
+G1 Y101.56 E86.7121
+G1 X133.56 E86.794
+G1 E85.79 F1800
+G1 X101.19 Y101.81 F9000
+G1 E86.79 F1800
+G1 X93.81 E86.8706 F3600
+G1 Y109.19 E86.9473
+G1 X101.19 E87.0239
+G1 Y101.81 E87.1006
+G1 X101.44 Y101.56
+G1 X93.56 E87.1824
+G1 Y109.44 E87.2643
+G1 X101.44 E87.3461
+G1 Y101.56 E87.428
+G1 E86.43 F1800
+G1 X133.81 Y77.81 F9000
+G1 E87.43 F1800
+G1 Y85.19 E87.5047 F3600
+G1 X141.19 E87.5813
+G1 Y77.81 E87.658
+G1 X133.81 E87.7346
+G1 X133.56 Y77.56
+G1 Y85.44 E87.8165
+G1 X141.44 E87.8983
+G1 Y77.56 E87.9802
+G1 X133.56 E88.062
+G1 E87.06 F1800
+G1 X101.19 Y77.81 F9000
+G1 E88.06 F1800
+G1 X93.81 E88.1387 F3600
+G1 Y85.19 E88.2153
+G1 X101.19 E88.292
+G1 Y77.81 E88.3686
+G1 X101.44 Y77.56
+G1 X93.56 E88.4505
+G1 Y85.44 E88.5323
+G1 X101.44 E88.6142
+G1 Y77.56 E88.696
+;layer #7
+G1 E87.7 F1800
+G1 X101.19 Y77.81 F9000
+G1 E88.7 F1800
+G1 Z0.7 F300
+G1 X93.81 E88.7727 F3600
+G1 Y85.19 E88.8493
+G1 X101.19 E88.926
+G1 Y77.81 E89.0027
+G1 X101.44 Y77.56
+G1 X93.56 E89.0845
+G1 Y85.44 E89.1664
+G1 X101.44 E89.2482
+G1 Y77.56 E89.3301
+G1 E88.33 F1800
+G1 X133.81 Y77.81 F9000
+G1 E89.33 F1800
+G1 Y85.19 E89.4067 F3600
+G1 X141.19 E89.4834
+G1 Y77.81 E89.56
+G1 X133.81 E89.6367
+G1 X133.56 Y77.56
+G1 Y85.44 E89.7185
+G1 X141.44 E89.8004
+G1 Y77.56 E89.8822
+G1 X133.56 E89.9641
+G1 E88.96 F1800
+G1 X101.19 Y101.81 F9000
+G1 E89.96 F1800
+G1 X93.81 E90.0407 F3600
+G1 Y109.19 E90.1174
+G1 X101.19 E90.194
+G1 Y101.81 E90.2707
+G1 X101.44 Y101.56
+G1 X93.56 E90.3526
+G1 Y109.44 E90.4344
+G1 X101.44 E90.5163
+G1 Y101.56 E90.5981
+G1 E89.6 F1800
+G1 X133.81 Y101.81 F9000
+G1 E90.6 F1800
+G1 Y109.19 E90.6748 F3600
+G1 X141.19 E90.7514
+G1 Y101.81 E90.8281
+G1 X133.81 E90.9047
+G1 X133.56 Y101.56
+G1 Y109.44 E90.9866
+G1 X141.44 E91.0684
+G1 Y101.56 E91.1503
+G1 X133.56 E91.2321
+G1 E90.23 F1800
+G1 X101.19 Y125.81 F9000
+G1 E91.23 F1800
+G1 X93.81 E91.3088 F3600
+G1 Y133.19 E91.3854
+G1 X101.19 E91.4621
+G1 Y125.81 E91.5387
+G1 X101.44 Y125.56
+G1 X93.56 E91.6206
+G1 Y133.44 E91.7024
+G1 X101.44 E91.7843
+G1 Y125.56 E91.8661
+G1 E90.87 F1800
+G1 X133.81 Y125.81 F9000
+G1 E91.87 F1800
+G1 Y133.19 E91.9428 F3600
+G1 X141.19 E92.0195
+G1 Y125.81 E92.0961
+G1 X133.81 E92.1728
+G1 X133.56 Y125.56
+G1 Y133.44 E92.2546
+G1 X141.44 E92.3365
+G1 Y125.56 E92.4183
+G1 X133.56 E92.5002
+G1 E91.5 F1800
+G1 X101.19 Y149.81 F9000
+G1 E92.5 F1800
+G1 X93.81 E92.5768 F3600
+G1 Y157.19 E92.6535
+G1 X101.19 E92.7301
+G1 Y149.81 E92.8068
+G1 X101.44 Y149.56
+G1 X93.56 E92.8886
+G1 Y157.44 E92.9705
+G1 X101.44 E93.0523
+G1 Y149.56 E93.1342
+G1 E92.13 F1800
+G1 X133.81 Y149.81 F9000
+G1 E93.13 F1800
+G1 Y157.19 E93.2108 F3600
+G1 X141.19 E93.2875
+G1 Y149.81 E93.3642
+G1 X133.81 E93.4408
+G1 X133.56 Y149.56
+G1 Y157.44 E93.5227
+G1 X141.44 E93.6045
+G1 Y149.56 E93.6864
+G1 X133.56 E93.7682
+;layer #8
+G1 E92.77 F1800
+G1 X133.81 Y149.81 F9000
+G1 E93.77 F1800
+G1 Z0.8 F300
+G1 Y157.19 E93.8449 F3600
+G1 X141.19 E93.9215
+G1 Y149.81 E93.9982
+G1 X133.81 E94.0748
+G1 X133.56 Y149.56
+G1 Y157.44 E94.1567
+G1 X141.44 E94.2385
+G1 Y149.56 E94.3204
+G1 X133.56 E94.4022
+G1 E93.4 F1800
+G1 X101.19 Y149.81 F9000
+G1 E94.4 F1800
+G1 X93.81 E94.4789 F3600
+G1 Y157.19 E94.5555
+G1 X101.19 E94.6322
+G1 Y149.81 E94.7088
+G1 X101.44 Y149.56
+G1 X93.56 E94.7907
+G1 Y157.44 E94.8726
+G1 X101.44 E94.9544
+G1 Y149.56 E95.0363
+G1 E94.04 F1800
+G1 X133.81 Y125.81 F9000
+G1 E95.04 F1800
+G1 Y133.19 E95.1129 F3600
+G1 X141.19 E95.1896
+G1 Y125.81 E95.2662
+G1 X133.81 E95.3429
+G1 X133.56 Y125.56
+G1 Y133.44 E95.4247
+G1 X141.44 E95.5066
+G1 Y125.56 E95.5884
+G1 X133.56 E95.6703
+G1 E94.67 F1800
+G1 X101.19 Y125.81 F9000
+G1 E95.67 F1800
+G1 X93.81 E95.7469 F3600
+G1 Y133.19 E95.8236
+G1 X101.19 E95.9002
+G1 Y125.81 E95.9769
+G1 X101.44 Y125.56
+G1 X93.56 E96.0587
+G1 Y133.44 E96.1406
+G1 X101.44 E96.2224
+G1 Y125.56 E96.3043
+G1 E95.3 F1800
+G1 X133.81 Y101.81 F9000
+G1 E96.3 F1800
+G1 Y109.19 E96.381 F3600
+G1 X141.19 E96.4576
+G1 Y101.81 E96.5343
+G1 X133.81 E96.6109
+G1 X133.56 Y101.56
+G1 Y109.44 E96.6928
+G1 X141.44 E96.7746
+G1 Y101.56 E96.8565
+G1 X133.56 E96.9383
+G1 E95.94 F1800
+G1 X101.19 Y101.81 F9000
+G1 E96.94 F1800
+G1 X93.81 E97.015 F3600
+G1 Y109.19 E97.0916
+G1 X101.19 E97.1683
+G1 Y101.81 E97.2449
+G1 X101.44 Y101.56
+G1 X93.56 E97.3268
+G1 Y109.44 E97.4086
+G1 X101.44 E97.4905
+G1 Y101.56 E97.5723
+G1 E96.57 F1800
+G1 X133.81 Y77.81 F9000
+G1 E97.57 F1800
+G1 Y85.19 E97.649 F3600
+G1 X141.19 E97.7256
+G1 Y77.81 E97.8023
+G1 X133.81 E97.879
+G1 X133.56 Y77.56
+G1 Y85.44 E97.9608
+G1 X141.44 E98.0427
+G1 Y77.56 E98.1245
+G1 X133.56 E98.2064
+G1 E97.21 F1800
+G1 X101.19 Y77.81 F9000
+G1 E98.21 F1800
+G1 X93.81 E98.283 F3600
+G1 Y85.19 E98.3597
+G1 X101.19 E98.4363
+G1 Y77.81 E98.513
+G1 X101.44 Y77.56
+G1 X93.56 E98.5948
+G1 Y85.44 E98.6767
+G1 X101.44 E98.7585
+G1 Y77.56 E98.8404
+;layer #9
+G1 E97.84 F1800
+G1 X101.19 Y77.81 F9000
+G1 E98.84 F1800
+G1 Z0.9 F300
+G1 X93.81 E98.917 F3600
+G1 Y85.19 E98.9937
+G1 X101.19 E99.0703
+G1 Y77.81 E99.147
+G1 X101.44 Y77.56
+G1 X93.56 E99.2288
+G1 Y85.44 E99.3107
+G1 X101.44 E99.3926
+G1 Y77.56 E99.4744
+G1 E98.47 F1800
+G1 X133.81 Y77.81 F9000
+G1 E99.47 F1800
+G1 Y85.19 E99.5511 F3600
+G1 X141.19 E99.6277
+G1 Y77.81 E99.7044
+G1 X133.81 E99.781
+G1 X133.56 Y77.56
+G1 Y85.44 E99.8629
+G1 X141.44 E99.9447
+G1 Y77.56 E100.0266
+G1 X133.56 E100.1084
+G1 E99.11 F1800
+G1 X101.19 Y101.81 F9000
+G1 E100.11 F1800
+G1 X93.81 E100.1851 F3600
+G1 Y109.19 E100.2617
+G1 X101.19 E100.3384
+G1 Y101.81 E100.415
+G1 X101.44 Y101.56
+G1 X93.56 E100.4969
+G1 Y109.44 E100.5787
+G1 X101.44 E100.6606
+G1 Y101.56 E100.7424
+G1 E99.74 F1800
+G1 X133.81 Y101.81 F9000
+G1 E100.74 F1800
+G1 Y109.19 E100.8191 F3600
+G1 X141.19 E100.8958
+G1 Y101.81 E100.9724
+G1 X133.81 E101.0491
+G1 X133.56 Y101.56
+G1 Y109.44 E101.1309
+G1 X141.44 E101.2128
+G1 Y101.56 E101.2946
+G1 X133.56 E101.3765
+G1 E100.38 F1800
+G1 X101.19 Y125.81 F9000
+G1 E101.38 F1800
+G1 X93.81 E101.4531 F3600
+G1 Y133.19 E101.5298
+G1 X101.19 E101.6064
+G1 Y125.81 E101.6831
+G1 X101.44 Y125.56
+G1 X93.56 E101.7649
+G1 Y133.44 E101.8468
+G1 X101.44 E101.9286
+G1 Y125.56 E102.0105
+G1 E101.01 F1800
+G1 X133.81 Y125.81 F9000
+G1 E102.01 F1800
+G1 Y133.19 E102.0871 F3600
+G1 X141.19 E102.1638
+G1 Y125.81 E102.2405
+G1 X133.81 E102.3171
+G1 X133.56 Y125.56
+G1 Y133.44 E102.399
+G1 X141.44 E102.4808
+G1 Y125.56 E102.5627
+G1 X133.56 E102.6445
+G1 E101.64 F1800
+G1 X101.19 Y149.81 F9000
+G1 E102.64 F1800
+G1 X93.81 E102.7212 F3600
+G1 Y157.19 E102.7978
+G1 X101.19 E102.8745
+G1 Y149.81 E102.9511
+G1 X101.44 Y149.56
+G1 X93.56 E103.033
+G1 Y157.44 E103.1148
+G1 X101.44 E103.1967
+G1 Y149.56 E103.2785
+G1 E102.28 F1800
+G1 X133.81 Y149.81 F9000
+G1 E103.28 F1800
+G1 Y157.19 E103.3552 F3600
+G1 X141.19 E103.4318
+G1 Y149.81 E103.5085
+G1 X133.81 E103.5851
+G1 X133.56 Y149.56
+G1 Y157.44 E103.667
+G1 X141.44 E103.7488
+G1 Y149.56 E103.8307
+G1 X133.56 E103.9126
+;layer #10
+G1 E102.91 F1800
+G1 X133.81 Y149.81 F9000
+G1 E103.91 F1800
+G1 Z1 F300
+G1 Y157.19 E103.9892 F3600
+G1 X141.19 E104.0659
+G1 Y149.81 E104.1425
+G1 X133.81 E104.2192
+G1 X133.56 Y149.56
+G1 Y157.44 E104.301
+G1 X141.44 E104.3829
+G1 Y149.56 E104.4647
+G1 X133.56 E104.5466
+G1 E103.55 F1800
+G1 X101.19 Y149.81 F9000
+G1 E104.55 F1800
+G1 X93.81 E104.6232 F3600
+G1 Y157.19 E104.6999
+G1 X101.19 E104.7765
+G1 Y149.81 E104.8532
+G1 X101.44 Y149.56
+G1 X93.56 E104.935
+G1 Y157.44 E105.0169
+G1 X101.44 E105.0987
+G1 Y149.56 E105.1806
+G1 E104.18 F1800
+G1 X133.81 Y125.81 F9000
+G1 E105.18 F1800
+G1 Y133.19 E105.2572 F3600
+G1 X141.19 E105.3339
+G1 Y125.81 E105.4106
+G1 X133.81 E105.4872
+G1 X133.56 Y125.56
+G1 Y133.44 E105.5691
+G1 X141.44 E105.6509
+G1 Y125.56 E105.7328
+G1 X133.56 E105.8146
+G1 E104.81 F1800
+G1 X101.19 Y125.81 F9000
+G1 E105.81 F1800
+G1 X93.81 E105.8913 F3600
+G1 Y133.19 E105.9679
+G1 X101.19 E106.0446
+G1 Y125.81 E106.1212
+G1 X101.44 Y125.56
+G1 X93.56 E106.2031
+G1 Y133.44 E106.2849
+G1 X101.44 E106.3668
+G1 Y125.56 E106.4486
+G1 E105.45 F1800
+G1 X133.81 Y101.81 F9000
+G1 E106.45 F1800
+G1 Y109.19 E106.5253 F3600
+G1 X141.19 E106.6019
+G1 Y101.81 E106.6786
+G1 X133.81 E106.7553
+G1 X133.56 Y101.56
+G1 Y109.44 E106.8371
+G1 X141.44 E106.919
+G1 Y101.56 E107.0008
+G1 X133.56 E107.0827
+G1 E106.08 F1800
+G1 X101.19 Y101.81 F9000
+G1 E107.08 F1800
+G1 X93.81 E107.1593 F3600
+G1 Y109.19 E107.236
+G1 X101.19 E107.3126
+G1 Y101.81 E107.3893
+G1 X101.44 Y101.56
+G1 X93.56 E107.4711
+G1 Y109.44 E107.553
+G1 X101.44 E107.6348
+G1 Y101.56 E107.7167
+G1 E106.72 F1800
+G1 X133.81 Y77.81 F9000
+G1 E107.72 F1800
+G1 Y85.19 E107.7933 F3600
+G1 X141.19 E107.87
+G1 Y77.81 E107.9466
+G1 X133.81 E108.0233
+G1 X133.56 Y77.56
+G1 Y85.44 E108.1051
+G1 X141.44 E108.187
+G1 Y77.56 E108.2689
+G1 X133.56 E108.3507
+G1 E107.35 F1800
+G1 X101.19 Y77.81 F9000
+G1 E108.35 F1800
+G1 X93.81 E108.4274 F3600
+G1 Y85.19 E108.504
+G1 X101.19 E108.5807
+G1 Y77.81 E108.6573
+G1 X101.44 Y77.56
+G1 X93.56 E108.7392
+G1 Y85.44 E108.821
+G1 X101.44 E108.9029
+G1 Y77.56 E108.9847
+;layer #11
+G1 E108.38 F1800
+G1 X101.25 Y77.75 F9000
+G1 E108.98 F1800
+G1 Z1.1 F300
+G1 X93.75 E109.0627 F3600
+G1 Y85.25 E109.1406
+G1 X101.25 E109.2186
+G1 Y77.75 E109.2965
+G1 X101.5 Y77.5
+G1 X93.5 E109.3797
+G1 Y85.5 E109.4628
+G1 X101.5 E109.546
+G1 Y77.5 E109.6291
+G1 E109.03 F1800
+G1 X133.75 Y77.75 F9000
+G1 E109.63 F1800
+G1 Y85.25 E109.7071 F3600
+G1 X141.25 E109.785
+G1 Y77.75 E109.863
+G1 X133.75 E109.941
+G1 X133.5 Y77.5
+G1 Y85.5 E110.0241
+G1 X141.5 E110.1073
+G1 Y77.5 E110.1904
+G1 X133.5 E110.2736
+G1 E109.67 F1800
+G1 X101.25 Y101.75 F9000
+G1 E110.27 F1800
+G1 X93.75 E110.3515 F3600
+G1 Y109.25 E110.4295
+G1 X101.25 E110.5074
+G1 Y101.75 E110.5854
+G1 X101.5 Y101.5
+G1 X93.5 E110.6685
+G1 Y109.5 E110.7517
+G1 X101.5 E110.8348
+G1 Y101.5 E110.918
+G1 E110.32 F1800
+G1 X133.75 Y101.75 F9000
+G1 E110.92 F1800
+G1 Y109.25 E110.9959 F3600
+G1 X141.25 E111.0739
+G1 Y101.75 E111.1518
+G1 X133.75 E111.2298
+G1 X133.5 Y101.5
+G1 Y109.5 E111.3129
+G1 X141.5 E111.3961
+G1 Y101.5 E111.4792
+G1 X133.5 E111.5624
+G1 E110.96 F1800
+G1 X101.25 Y125.75 F9000
+G1 E111.56 F1800
+G1 X93.75 E111.6403 F3600
+G1 Y133.25 E111.7183
+G1 X101.25 E111.7962
+G1 Y125.75 E111.8742
+G1 X101.5 Y125.5
+G1 X93.5 E111.9573
+G1 Y133.5 E112.0405
+G1 X101.5 E112.1236
+G1 Y125.5 E112.2068
+G1 E111.61 F1800
+G1 X133.75 Y125.75 F9000
+G1 E112.21 F1800
+G1 Y133.25 E112.2848 F3600
+G1 X141.25 E112.3627
+G1 Y125.75 E112.4407
+G1 X133.75 E112.5186
+G1 X133.5 Y125.5
+G1 Y133.5 E112.6018
+G1 X141.5 E112.6849
+G1 Y125.5 E112.7681
+G1 X133.5 E112.8512
+G1 E112.25 F1800
+G1 X101.25 Y149.75 F9000
+G1 E112.85 F1800
+G1 X93.75 E112.9292 F3600
+G1 Y157.25 E113.0071
+G1 X101.25 E113.0851
+G1 Y149.75 E113.163
+G1 X101.5 Y149.5
+G1 X93.5 E113.2462
+G1 Y157.5 E113.3293
+G1 X101.5 E113.4125
+G1 Y149.5 E113.4956
+G1 E112.9 F1800
+G1 X133.75 Y149.75 F9000
+G1 E113.5 F1800
+G1 Y157.25 E113.5736 F3600
+G1 X141.25 E113.6515
+G1 Y149.75 E113.7295
+G1 X133.75 E113.8074
+G1 X133.5 Y149.5
+G1 Y157.5 E113.8906
+G1 X141.5 E113.9737
+G1 Y149.5 E114.0569
+G1 X133.5 E114.14
+;layer #12
+G1 E113.54 F1800
+G1 X133.81 Y149.81 F9000
+G1 E114.14 F1800
+G1 Z1.2 F300
+G1 Y157.19 E114.2167 F3600
+G1 X141.19 E114.2934
+G1 Y149.81 E114.37
+G1 X133.81 E114.4467
+G1 X133.56 Y149.56
+G1 Y157.44 E114.5285
+G1 X141.44 E114.6104
+G1 Y149.56 E114.6922
+G1 X133.56 E114.7741
+G1 E114.17 F1800
+G1 X101.19 Y149.81 F9000
+G1 E114.77 F1800
+G1 X93.81 E114.8507 F3600
+G1 Y157.19 E114.9274
+G1 X101.19 E115.004
+G1 Y149.81 E115.0807
+G1 X101.44 Y149.56
+G1 X93.56 E115.1625
+G1 Y157.44 E115.2444
+G1 X101.44 E115.3262
+G1 Y149.56 E115.4081
+G1 E114.81 F1800
+G1 X133.81 Y125.81 F9000
+G1 E115.41 F1800
+G1 Y133.19 E115.4847 F3600
+G1 X141.19 E115.5614
+G1 Y125.81 E115.638
+G1 X133.81 E115.7147
+G1 X133.56 Y125.56
+G1 Y133.44 E115.7966
+G1 X141.44 E115.8784
+G1 Y125.56 E115.9603
+G1 X133.56 E116.0421
+G1 E115.44 F1800
+G1 X101.19 Y125.81 F9000
+G1 E116.04 F1800
+G1 X93.81 E116.1188 F3600
+G1 Y133.19 E116.1954
+G1 X101.19 E116.2721
+G1 Y125.81 E116.3487
+G1 X101.44 Y125.56
+G1 X93.56 E116.4306
+G1 Y133.44 E116.5124
+G1 X101.44 E116.5943
+G1 Y125.56 E116.6761
+G1 E116.08 F1800
+G1 X133.81 Y101.81 F9000
+G1 E116.68 F1800
+G1 Y109.19 E116.7528 F3600
+G1 X141.19 E116.8294
+G1 Y101.81 E116.9061
+G1 X133.81 E116.9827
+G1 X133.56 Y101.56
+G1 Y109.44 E117.0646
+G1 X141.44 E117.1464
+G1 Y101.56 E117.2283
+G1 X133.56 E117.3102
+G1 E116.71 F1800
+G1 X101.19 Y101.81 F9000
+G1 E117.31 F1800
+G1 X93.81 E117.3868 F3600
+G1 Y109.19 E117.4635
+G1 X101.19 E117.5401
+G1 Y101.81 E117.6168
+G1 X101.44 Y101.56
+G1 X93.56 E117.6986
+G1 Y109.44 E117.7805
+G1 X101.44 E117.8623
+G1 Y101.56 E117.9442
+G1 E117.34 F1800
+G1 X133.81 Y77.81 F9000
+G1 E117.94 F1800
+G1 Y85.19 E118.0208 F3600
+G1 X141.19 E118.0975
+G1 Y77.81 E118.1741
+G1 X133.81 E118.2508
+G1 X133.56 Y77.56
+G1 Y85.44 E118.3326
+G1 X141.44 E118.4145
+G1 Y77.56 E118.4963
+G1 X133.56 E118.5782
+G1 E117.98 F1800
+G1 X101.19 Y77.81 F9000
+G1 E118.58 F1800
+G1 X93.81 E118.6548 F3600
+G1 Y85.19 E118.7315
+G1 X101.19 E118.8082
+G1 Y77.81 E118.8848
+G1 X101.44 Y77.56
+G1 X93.56 E118.9667
+G1 Y85.44 E119.0485
+G1 X101.44 E119.1304
+G1 Y77.56 E119.2122
+;layer #13
+G1 E118.61 F1800
+G1 X101.19 Y77.81 F9000
+G1 E119.21 F1800
+G1 Z1.3 F300
+G1 X93.81 E119.2889 F3600
+G1 Y85.19 E119.3655
+G1 X101.19 E119.4422
+G1 Y77.81 E119.5188
+G1 X101.44 Y77.56
+G1 X93.56 E119.6007
+G1 Y85.44 E119.6825
+G1 X101.44 E119.7644
+G1 Y77.56 E119.8462
+G1 E119.25 F1800
+G1 X133.81 Y77.81 F9000
+G1 E119.85 F1800
+G1 Y85.19 E119.9229 F3600
+G1 X141.19 E119.9995
+G1 Y77.81 E120.0762
+G1 X133.81 E120.1529
+G1 X133.56 Y77.56
+G1 Y85.44 E120.2347
+G1 X141.44 E120.3166
+G1 Y77.56 E120.3984
+G1 X133.56 E120.4803
+G1 E119.88 F1800
+G1 X101.19 Y101.81 F9000
+G1 E120.48 F1800
+G1 X93.81 E120.5569 F3600
+G1 Y109.19 E120.6336
+G1 X101.19 E120.7102
+G1 Y101.81 E120.7869
+G1 X101.44 Y101.56
+G1 X93.56 E120.8687
+G1 Y109.44 E120.9506
+G1 X101.44 E121.0324
+G1 Y101.56 E121.1143
+G1 E120.51 F1800
+G1 X133.81 Y101.81 F9000
+G1 E121.11 F1800
+G1 Y109.19 E121.1909 F3600
+G1 X141.19 E121.2676
+G1 Y101.81 E121.3442
+G1 X133.81 E121.4209
+G1 X133.56 Y101.56
+G1 Y109.44 E121.5027
+G1 X141.44 E121.5846
+G1 Y101.56 E121.6664
+G1 X133.56 E121.7483
+G1 E121.15 F1800
+G1 X101.19 Y125.81 F9000
+G1 E121.75 F1800
+G1 X93.81 E121.825 F3600
+G1 Y133.19 E121.9016
+G1 X101.19 E121.9783
+G1 Y125.81 E122.0549
+G1 X101.44 Y125.56
+G1 X93.56 E122.1368
+G1 Y133.44 E122.2186
+G1 X101.44 E122.3005
+G1 Y125.56 E122.3823
+G1 E121.78 F1800
+G1 X133.81 Y125.81 F9000
+G1 E122.38 F1800
+G1 Y133.19 E122.459 F3600
+G1 X141.19 E122.5356
+G1 Y125.81 E122.6123
+G1 X133.81 E122.6889
+G1 X133.56 Y125.56
+G1 Y133.44 E122.7708
+G1 X141.44 E122.8526
+G1 Y125.56 E122.9345
+G1 X133.56 E123.0163
+G1 E122.42 F1800
+G1 X101.19 Y149.81 F9000
+G1 E123.02 F1800
+G1 X93.81 E123.093 F3600
+G1 Y157.19 E123.1697
+G1 X101.19 E123.2463
+G1 Y149.81 E123.323
+G1 X101.44 Y149.56
+G1 X93.56 E123.4048
+G1 Y157.44 E123.4867
+G1 X101.44 E123.5685
+G1 Y149.56 E123.6504
+G1 E123.05 F1800
+G1 X133.81 Y149.81 F9000
+G1 E123.65 F1800
+G1 Y157.19 E123.727 F3600
+G1 X141.19 E123.8037
+G1 Y149.81 E123.8803
+G1 X133.81 E123.957
+G1 X133.56 Y149.56
+G1 Y157.44 E124.0388
+G1 X141.44 E124.1207
+G1 Y149.56 E124.2025
+G1 X133.56 E124.2844
+;layer #14
+G1 E123.68 F1800
+G1 X133.81 Y149.81 F9000
+G1 E124.28 F1800
+G1 Z1.4 F300
+G1 Y157.19 E124.361 F3600
+G1 X141.19 E124.4377
+G1 Y149.81 E124.5143
+G1 X133.81 E124.591
+G1 X133.56 Y149.56
+G1 Y157.44 E124.6729
+G1 X141.44 E124.7547
+G1 Y149.56 E124.8366
+G1 X133.56 E124.9184
+G1 E124.32 F1800
+G1 X101.19 Y149.81 F9000
+G1 E124.92 F1800
+G1 X93.81 E124.9951 F3600
+G1 Y157.19 E125.0717
+G1 X101.19 E125.1484
+G1 Y149.81 E125.225
+G1 X101.44 Y149.56
+G1 X93.56 E125.3069
+G1 Y157.44 E125.3887
+G1 X101.44 E125.4706
+G1 Y149.56 E125.5524
+G1 E124.95 F1800
+G1 X133.81 Y125.81 F9000
+G1 E125.55 F1800
+G1 Y133.19 E125.6291 F3600
+G1 X141.19 E125.7057
+G1 Y125.81 E125.7824
+G1 X133.81 E125.859
+G1 X133.56 Y125.56
+G1 Y133.44 E125.9409
+G1 X141.44 E126.0227
+G1 Y125.56 E126.1046
+G1 X133.56 E126.1864
+G1 E125.59 F1800
+G1 X101.19 Y125.81 F9000
+G1 E126.19 F1800
+G1 X93.81 E126.2631 F3600
+G1 Y133.19 E126.3398
+G1 X101.19 E126.4164
+G1 Y125.81 E126.4931
+G1 X101.44 Y125.56
+G1 X93.56 E126.5749
+G1 Y133.44 E126.6568
+G1 X101.44 E126.7386
+G1 Y125.56 E126.8205
+G1 E126.22 F1800
+G1 X133.81 Y101.81 F9000
+G1 E126.82 F1800
+G1 Y109.19 E126.8971 F3600
+G1 X141.19 E126.9738
+G1 Y101.81 E127.0504
+G1 X133.81 E127.1271
+G1 X133.56 Y101.56
+G1 Y109.44 E127.2089
+G1 X141.44 E127.2908
+G1 Y101.56 E127.3726
+G1 X133.56 E127.4545
+G1 E126.85 F1800
+G1 X101.19 Y101.81 F9000
+G1 E127.45 F1800
+G1 X93.81 E127.5311 F3600
+G1 Y109.19 E127.6078
+G1 X101.19 E127.6845
+G1 Y101.81 E127.7611
+G1 X101.44 Y101.56
+G1 X93.56 E127.843
+G1 Y109.44 E127.9248
+G1 X101.44 E128.0067
+G1 Y101.56 E128.0885
+G1 E127.49 F1800
+G1 X133.81 Y77.81 F9000
+G1 E128.09 F1800
+G1 Y85.19 E128.1652 F3600
+G1 X141.19 E128.2418
+G1 Y77.81 E128.3185
+G1 X133.81 E128.3951
+G1 X133.56 Y77.56
+G1 Y85.44 E128.477
+G1 X141.44 E128.5588
+G1 Y77.56 E128.6407
+G1 X133.56 E128.7225
+G1 E128.12 F1800
+G1 X101.19 Y77.81 F9000
+G1 E128.72 F1800
+G1 X93.81 E128.7992 F3600
+G1 Y85.19 E128.8758
+G1 X101.19 E128.9525
+G1 Y77.81 E129.0292
+G1 X101.44 Y77.56
+G1 X93.56 E129.111
+G1 Y85.44 E129.1929
+G1 X101.44 E129.2747
+G1 Y77.56 E129.3566
+;layer #15
+G1 E128.76 F1800
+G1 X101.19 Y77.81 F9000
+G1 E129.36 F1800
+G1 Z1.5 F300
+G1 X93.81 E129.4332 F3600
+G1 Y85.19 E129.5099
+G1 X101.19 E129.5865
+G1 Y77.81 E129.6632
+G1 X101.44 Y77.56
+G1 X93.56 E129.745
+G1 Y85.44 E129.8269
+G1 X101.44 E129.9087
+G1 Y77.56 E129.9906
+G1 E129.39 F1800
+G1 X133.81 Y77.81 F9000
+G1 E129.99 F1800
+G1 Y85.19 E130.0672 F3600
+G1 X141.19 E130.1439
+G1 Y77.81 E130.2205
+G1 X133.81 E130.2972
+G1 X133.56 Y77.56
+G1 Y85.44 E130.379
+G1 X141.44 E130.4609
+G1 Y77.56 E130.5427
+G1 X133.56 E130.6246
+G1 E130.02 F1800
+G1 X101.19 Y101.81 F9000
+G1 E130.62 F1800
+G1 X93.81 E130.7013 F3600
+G1 Y109.19 E130.7779
+G1 X101.19 E130.8546
+G1 Y101.81 E130.9312
+G1 X101.44 Y101.56
+G1 X93.56 E131.0131
+G1 Y109.44 E131.0949
+G1 X101.44 E131.1768
+G1 Y101.56 E131.2586
+G1 E130.66 F1800
+G1 X133.81 Y101.81 F9000
+G1 E131.26 F1800
+G1 Y109.19 E131.3353 F3600
+G1 X141.19 E131.4119
+G1 Y101.81 E131.4886
+G1 X133.81 E131.5652
+G1 X133.56 Y101.56
+G1 Y109.44 E131.6471
+G1 X141.44 E131.7289
+G1 Y101.56 E131.8108
+G1 X133.56 E131.8926
+G1 E131.29 F1800
+G1 X101.19 Y125.81 F9000
+G1 E131.89 F1800
+G1 X93.81 E131.9693 F3600
+G1 Y133.19 E132.0459
+G1 X101.19 E132.1226
+G1 Y125.81 E132.1993
+G1 X101.44 Y125.56
+G1 X93.56 E132.2811
+G1 Y133.44 E132.363
+G1 X101.44 E132.4448
+G1 Y125.56 E132.5267
+G1 E131.93 F1800
+G1 X133.81 Y125.81 F9000
+G1 E132.53 F1800
+G1 Y133.19 E132.6033 F3600
+G1 X141.19 E132.68
+G1 Y125.81 E132.7566
+G1 X133.81 E132.8333
+G1 X133.56 Y125.56
+G1 Y133.44 E132.9151
+G1 X141.44 E132.997
+G1 Y125.56 E133.0788
+G1 X133.56 E133.1607
+G1 E132.56 F1800
+G1 X101.19 Y149.81 F9000
+G1 E133.16 F1800
+G1 X93.81 E133.2373 F3600
+G1 Y157.19 E133.314
+G1 X101.19 E133.3906
+G1 Y149.81 E133.4673
+G1 X101.44 Y149.56
+G1 X93.56 E133.5492
+G1 Y157.44 E133.631
+G1 X101.44 E133.7129
+G1 Y149.56 E133.7947
+G1 E133.19 F1800
+G1 X133.81 Y149.81 F9000
+G1 E133.79 F1800
+G1 Y157.19 E133.8714 F3600
+G1 X141.19 E133.948
+G1 Y149.81 E134.0247
+G1 X133.81 E134.1013
+G1 X133.56 Y149.56
+G1 Y157.44 E134.1832
+G1 X141.44 E134.265
+G1 Y149.56 E134.3469
+G1 X133.56 E134.4287
+;layer #16
+G1 E133.83 F1800
+G1 X133.81 Y149.81 F9000
+G1 E134.43 F1800
+G1 Z1.6 F300
+G1 Y157.19 E134.5054 F3600
+G1 X141.19 E134.582
+G1 Y149.81 E134.6587
+G1 X133.81 E134.7353
+G1 X133.56 Y149.56
+G1 Y157.44 E134.8172
+G1 X141.44 E134.899
+G1 Y149.56 E134.9809
+G1 X133.56 E135.0627
+G1 E134.46 F1800
+G1 X101.19 Y149.81 F9000
+G1 E135.06 F1800
+G1 X93.81 E135.1394 F3600
+G1 Y157.19 E135.2161
+G1 X101.19 E135.2927
+G1 Y149.81 E135.3694
+G1 X101.44 Y149.56
+G1 X93.56 E135.4512
+G1 Y157.44 E135.5331
+G1 X101.44 E135.6149
+G1 Y149.56 E135.6968
+G1 E135.1 F1800
+G1 X133.81 Y125.81 F9000
+G1 E135.7 F1800
+G1 Y133.19 E135.7734 F3600
+G1 X141.19 E135.8501
+G1 Y125.81 E135.9267
+G1 X133.81 E136.0034
+G1 X133.56 Y125.56
+G1 Y133.44 E136.0852
+G1 X141.44 E136.1671
+G1 Y125.56 E136.2489
+G1 X133.56 E136.3308
+G1 E135.73 F1800
+G1 X101.19 Y125.81 F9000
+G1 E136.33 F1800
+G1 X93.81 E136.4074 F3600
+G1 Y133.19 E136.4841
+G1 X101.19 E136.5608
+G1 Y125.81 E136.6374
+G1 X101.44 Y125.56
+G1 X93.56 E136.7193
+G1 Y133.44 E136.8011
+G1 X101.44 E136.883
+G1 Y125.56 E136.9648
+G1 E136.36 F1800
+G1 X133.81 Y101.81 F9000
+G1 E136.96 F1800
+G1 Y109.19 E137.0415 F3600
+G1 X141.19 E137.1181
+G1 Y101.81 E137.1948
+G1 X133.81 E137.2714
+G1 X133.56 Y101.56
+G1 Y109.44 E137.3533
+G1 X141.44 E137.4351
+G1 Y101.56 E137.517
+G1 X133.56 E137.5988
+G1 E137 F1800
+G1 X101.19 Y101.81 F9000
+G1 E137.6 F1800
+G1 X93.81 E137.6755 F3600
+G1 Y109.19 E137.7521
+G1 X101.19 E137.8288
+G1 Y101.81 E137.9054
+G1 X101.44 Y101.56
+G1 X93.56 E137.9873
+G1 Y109.44 E138.0692
+G1 X101.44 E138.151
+G1 Y101.56 E138.2329
+G1 E137.63 F1800
+G1 X133.81 Y77.81 F9000
+G1 E138.23 F1800
+G1 Y85.19 E138.3095 F3600
+G1 X141.19 E138.3862
+G1 Y77.81 E138.4628
+G1 X133.81 E138.5395
+G1 X133.56 Y77.56
+G1 Y85.44 E138.6213
+G1 X141.44 E138.7032
+G1 Y77.56 E138.785
+G1 X133.56 E138.8669
+G1 E138.27 F1800
+G1 X101.19 Y77.81 F9000
+G1 E138.87 F1800
+G1 X93.81 E138.9435 F3600
+G1 Y85.19 E139.0202
+G1 X101.19 E139.0968
+G1 Y77.81 E139.1735
+G1 X101.44 Y77.56
+G1 X93.56 E139.2553
+G1 Y85.44 E139.3372
+G1 X101.44 E139.419
+G1 Y77.56 E139.5009
+;layer #17
+G1 E138.9 F1800
+G1 X101.19 Y77.81 F9000
+G1 E139.5 F1800
+G1 Z1.7 F300
+G1 X93.81 E139.5776 F3600
+G1 Y85.19 E139.6542
+G1 X101.19 E139.7309
+G1 Y77.81 E139.8075
+G1 X101.44 Y77.56
+G1 X93.56 E139.8894
+G1 Y85.44 E139.9712
+G1 X101.44 E140.0531
+G1 Y77.56 E140.1349
+G1 E139.53 F1800
+G1 X133.81 Y77.81 F9000
+G1 E140.13 F1800
+G1 Y85.19 E140.2116 F3600
+G1 X141.19 E140.2882
+G1 Y77.81 E140.3649
+G1 X133.81 E140.4415
+G1 X133.56 Y77.56
+G1 Y85.44 E140.5234
+G1 X141.44 E140.6052
+G1 Y77.56 E140.6871
+G1 X133.56 E140.7689
+G1 E140.17 F1800
+G1 X101.19 Y101.81 F9000
+G1 E140.77 F1800
+G1 X93.81 E140.8456 F3600
+G1 Y109.19 E140.9222
+G1 X101.19 E140.9989
+G1 Y101.81 E141.0756
+G1 X101.44 Y101.56
+G1 X93.56 E141.1574
+G1 Y109.44 E141.2393
+G1 X101.44 E141.3211
+G1 Y101.56 E141.403
+G1 E140.8 F1800
+G1 X133.81 Y101.81 F9000
+G1 E141.4 F1800
+G1 Y109.19 E141.4796 F3600
+G1 X141.19 E141.5563
+G1 Y101.81 E141.6329
+G1 X133.81 E141.7096
+G1 X133.56 Y101.56
+G1 Y109.44 E141.7914
+G1 X141.44 E141.8733
+G1 Y101.56 E141.9551
+G1 X133.56 E142.037
+G1 E141.44 F1800
+G1 X101.19 Y125.81 F9000
+G1 E142.04 F1800
+G1 X93.81 E142.1136 F3600
+G1 Y133.19 E142.1903
+G1 X101.19 E142.2669
+G1 Y125.81 E142.3436
+G1 X101.44 Y125.56
+G1 X93.56 E142.4255
+G1 Y133.44 E142.5073
+G1 X101.44 E142.5892
+G1 Y125.56 E142.671
+G1 E142.07 F1800
+G1 X133.81 Y125.81 F9000
+G1 E142.67 F1800
+G1 Y133.19 E142.7477 F3600
+G1 X141.19 E142.8243
+G1 Y125.81 E142.901
+G1 X133.81 E142.9776
+G1 X133.56 Y125.56
+G1 Y133.44 E143.0595
+G1 X141.44 E143.1413
+G1 Y125.56 E143.2232
+G1 X133.56 E143.305
+G1 E142.71 F1800
+G1 X101.19 Y149.81 F9000
+G1 E143.31 F1800
+G1 X93.81 E143.3817 F3600
+G1 Y157.19 E143.4583
+G1 X101.19 E143.535
+G1 Y149.81 E143.6116
+G1 X101.44 Y149.56
+G1 X93.56 E143.6935
+G1 Y157.44 E143.7753
+G1 X101.44 E143.8572
+G1 Y149.56 E143.939
+G1 E143.34 F1800
+G1 X133.81 Y149.81 F9000
+G1 E143.94 F1800
+G1 Y157.19 E144.0157 F3600
+G1 X141.19 E144.0924
+G1 Y149.81 E144.169
+G1 X133.81 E144.2457
+G1 X133.56 Y149.56
+G1 Y157.44 E144.3275
+G1 X141.44 E144.4094
+G1 Y149.56 E144.4912
+G1 X133.56 E144.5731
+;layer #18
+G1 E143.97 F1800
+G1 X133.81 Y149.81 F9000
+G1 E144.57 F1800
+G1 Z1.8 F300
+G1 Y157.19 E144.6497 F3600
+G1 X141.19 E144.7264
+G1 Y149.81 E144.803
+G1 X133.81 E144.8797
+G1 X133.56 Y149.56
+G1 Y157.44 E144.9615
+G1 X141.44 E145.0434
+G1 Y149.56 E145.1252
+G1 X133.56 E145.2071
+G1 E144.61 F1800
+G1 X101.19 Y149.81 F9000
+G1 E145.21 F1800
+G1 X93.81 E145.2837 F3600
+G1 Y157.19 E145.3604
+G1 X101.19 E145.4371
+G1 Y149.81 E145.5137
+G1 X101.44 Y149.56
+G1 X93.56 E145.5956
+G1 Y157.44 E145.6774
+G1 X101.44 E145.7593
+G1 Y149.56 E145.8411
+G1 E145.24 F1800
+G1 X133.81 Y125.81 F9000
+G1 E145.84 F1800
+G1 Y133.19 E145.9178 F3600
+G1 X141.19 E145.9944
+G1 Y125.81 E146.0711
+G1 X133.81 E146.1477
+G1 X133.56 Y125.56
+G1 Y133.44 E146.2296
+G1 X141.44 E146.3114
+G1 Y125.56 E146.3933
+G1 X133.56 E146.4751
+G1 E145.88 F1800
+G1 X101.19 Y125.81 F9000
+G1 E146.48 F1800
+G1 X93.81 E146.5518 F3600
+G1 Y133.19 E146.6284
+G1 X101.19 E146.7051
+G1 Y125.81 E146.7817
+G1 X101.44 Y125.56
+G1 X93.56 E146.8636
+G1 Y133.44 E146.9455
+G1 X101.44 E147.0273
+G1 Y125.56 E147.1092
+G1 E146.51 F1800
+G1 X133.81 Y101.81 F9000
+G1 E147.11 F1800
+G1 Y109.19 E147.1858 F3600
+G1 X141.19 E147.2625
+G1 Y101.81 E147.3391
+G1 X133.81 E147.4158
+G1 X133.56 Y101.56
+G1 Y109.44 E147.4976
+G1 X141.44 E147.5795
+G1 Y101.56 E147.6613
+G1 X133.56 E147.7432
+G1 E147.14 F1800
+G1 X101.19 Y101.81 F9000
+G1 E147.74 F1800
+G1 X93.81 E147.8198 F3600
+G1 Y109.19 E147.8965
+G1 X101.19 E147.9731
+G1 Y101.81 E148.0498
+G1 X101.44 Y101.56
+G1 X93.56 E148.1316
+G1 Y109.44 E148.2135
+G1 X101.44 E148.2953
+G1 Y101.56 E148.3772
+G1 E147.78 F1800
+G1 X133.81 Y77.81 F9000
+G1 E148.38 F1800
+G1 Y85.19 E148.4538 F3600
+G1 X141.19 E148.5305
+G1 Y77.81 E148.6072
+G1 X133.81 E148.6838
+G1 X133.56 Y77.56
+G1 Y85.44 E148.7657
+G1 X141.44 E148.8475
+G1 Y77.56 E148.9294
+G1 X133.56 E149.0112
+G1 E148.41 F1800
+G1 X101.19 Y77.81 F9000
+G1 E149.01 F1800
+G1 X93.81 E149.0879 F3600
+G1 Y85.19 E149.1645
+G1 X101.19 E149.2412
+G1 Y77.81 E149.3178
+G1 X101.44 Y77.56
+G1 X93.56 E149.3997
+G1 Y85.44 E149.4815
+G1 X101.44 E149.5634
+G1 Y77.56 E149.6452
+;layer #19
+G1 E149.05 F1800
+G1 X101.19 Y77.81 F9000
+G1 E149.65 F1800
+G1 Z1.9 F300
+G1 X93.81 E149.7219 F3600
+G1 Y85.19 E149.7985
+G1 X101.19 E149.8752
+G1 Y77.81 E149.9519
+G1 X101.44 Y77.56
+G1 X93.56 E150.0337
+G1 Y85.44 E150.1156
+G1 X101.44 E150.1974
+G1 Y77.56 E150.2793
+G1 E149.68 F1800
+G1 X133.81 Y77.81 F9000
+G1 E150.28 F1800
+G1 Y85.19 E150.3559 F3600
+G1 X141.19 E150.4326
+G1 Y77.81 E150.5092
+G1 X133.81 E150.5859
+G1 X133.56 Y77.56
+G1 Y85.44 E150.6677
+G1 X141.44 E150.7496
+G1 Y77.56 E150.8314
+G1 X133.56 E150.9133
+G1 E150.31 F1800
+G1 X101.19 Y101.81 F9000
+G1 E150.91 F1800
+G1 X93.81 E150.9899 F3600
+G1 Y109.19 E151.0666
+G1 X101.19 E151.1432
+G1 Y101.81 E151.2199
+G1 X101.44 Y101.56
+G1 X93.56 E151.3017
+G1 Y109.44 E151.3836
+G1 X101.44 E151.4655
+G1 Y101.56 E151.5473
+G1 E150.95 F1800
+G1 X133.81 Y101.81 F9000
+G1 E151.55 F1800
+G1 Y109.19 E151.624 F3600
+G1 X141.19 E151.7006
+G1 Y101.81 E151.7773
+G1 X133.81 E151.8539
+G1 X133.56 Y101.56
+G1 Y109.44 E151.9358
+G1 X141.44 E152.0176
+G1 Y101.56 E152.0995
+G1 X133.56 E152.1813
+G1 E151.58 F1800
+G1 X101.19 Y125.81 F9000
+G1 E152.18 F1800
+G1 X93.81 E152.258 F3600
+G1 Y133.19 E152.3346
+G1 X101.19 E152.4113
+G1 Y125.81 E152.4879
+G1 X101.44 Y125.56
+G1 X93.56 E152.5698
+G1 Y133.44 E152.6516
+G1 X101.44 E152.7335
+G1 Y125.56 E152.8153
+G1 E152.22 F1800
+G1 X133.81 Y125.81 F9000
+G1 E152.82 F1800
+G1 Y133.19 E152.892 F3600
+G1 X141.19 E152.9687
+G1 Y125.81 E153.0453
+G1 X133.81 E153.122
+G1 X133.56 Y125.56
+G1 Y133.44 E153.2038
+G1 X141.44 E153.2857
+G1 Y125.56 E153.3675
+G1 X133.56 E153.4494
+G1 E152.85 F1800
+G1 X101.19 Y149.81 F9000
+G1 E153.45 F1800
+G1 X93.81 E153.526 F3600
+G1 Y157.19 E153.6027
+G1 X101.19 E153.6793
+G1 Y149.81 E153.756
+G1 X101.44 Y149.56
+G1 X93.56 E153.8378
+G1 Y157.44 E153.9197
+G1 X101.44 E154.0015
+G1 Y149.56 E154.0834
+G1 E153.48 F1800
+G1 X133.81 Y149.81 F9000
+G1 E154.08 F1800
+G1 Y157.19 E154.16 F3600
+G1 X141.19 E154.2367
+G1 Y149.81 E154.3133
+G1 X133.81 E154.39
+G1 X133.56 Y149.56
+G1 Y157.44 E154.4719
+G1 X141.44 E154.5537
+G1 Y149.56 E154.6356
+G1 X133.56 E154.7174
+;layer #20
+G1 E154.12 F1800
+G1 X133.81 Y149.81 F9000
+G1 E154.72 F1800
+G1 Z2 F300
+G1 Y157.19 E154.7941 F3600
+G1 X141.19 E154.8707
+G1 Y149.81 E154.9474
+G1 X133.81 E155.024
+G1 X133.56 Y149.56
+G1 Y157.44 E155.1059
+G1 X141.44 E155.1877
+G1 Y149.56 E155.2696
+G1 X133.56 E155.3514
+G1 E154.75 F1800
+G1 X101.19 Y149.81 F9000
+G1 E155.35 F1800
+G1 X93.81 E155.4281 F3600
+G1 Y157.19 E155.5047
+G1 X101.19 E155.5814
+G1 Y149.81 E155.658
+G1 X101.44 Y149.56
+G1 X93.56 E155.7399
+G1 Y157.44 E155.8217
+G1 X101.44 E155.9036
+G1 Y149.56 E155.9855
+G1 E155.39 F1800
+G1 X133.81 Y125.81 F9000
+G1 E155.99 F1800
+G1 Y133.19 E156.0621 F3600
+G1 X141.19 E156.1388
+G1 Y125.81 E156.2154
+G1 X133.81 E156.2921
+G1 X133.56 Y125.56
+G1 Y133.44 E156.3739
+G1 X141.44 E156.4558
+G1 Y125.56 E156.5376
+G1 X133.56 E156.6195
+G1 E156.02 F1800
+G1 X101.19 Y125.81 F9000
+G1 E156.62 F1800
+G1 X93.81 E156.6961 F3600
+G1 Y133.19 E156.7728
+G1 X101.19 E156.8494
+G1 Y125.81 E156.9261
+G1 X101.44 Y125.56
+G1 X93.56 E157.0079
+G1 Y133.44 E157.0898
+G1 X101.44 E157.1716
+G1 Y125.56 E157.2535
+G1 E156.65 F1800
+G1 X133.81 Y101.81 F9000
+G1 E157.25 F1800
+G1 Y109.19 E157.3301 F3600
+G1 X141.19 E157.4068
+G1 Y101.81 E157.4835
+G1 X133.81 E157.5601
+G1 X133.56 Y101.56
+G1 Y109.44 E157.642
+G1 X141.44 E157.7238
+G1 Y101.56 E157.8057
+G1 X133.56 E157.8875
+G1 E157.29 F1800
+G1 X101.19 Y101.81 F9000
+G1 E157.89 F1800
+G1 X93.81 E157.9642 F3600
+G1 Y109.19 E158.0408
+G1 X101.19 E158.1175
+G1 Y101.81 E158.1941
+G1 X101.44 Y101.56
+G1 X93.56 E158.276
+G1 Y109.44 E158.3578
+G1 X101.44 E158.4397
+G1 Y101.56 E158.5215
+G1 E157.92 F1800
+G1 X133.81 Y77.81 F9000
+G1 E158.52 F1800
+G1 Y85.19 E158.5982 F3600
+G1 X141.19 E158.6748
+G1 Y77.81 E158.7515
+G1 X133.81 E158.8282
+G1 X133.56 Y77.56
+G1 Y85.44 E158.91
+G1 X141.44 E158.9919
+G1 Y77.56 E159.0737
+G1 X133.56 E159.1556
+G1 E158.56 F1800
+G1 X101.19 Y77.81 F9000
+G1 E159.16 F1800
+G1 X93.81 E159.2322 F3600
+G1 Y85.19 E159.3089
+G1 X101.19 E159.3855
+G1 Y77.81 E159.4622
+G1 X101.44 Y77.56
+G1 X93.56 E159.544
+G1 Y85.44 E159.6259
+G1 X101.44 E159.7077
+G1 Y77.56 E159.7896
+;layer #21
+G1 E159.59 F1800
+G1 X101.25 Y77.75 F9000
+G1 E159.79 F1800
+G1 Z2.1 F300
+G1 X93.75 E159.8675 F3600
+G1 Y85.25 E159.9455
+G1 X101.25 E160.0234
+G1 Y77.75 E160.1014
+G1 X101.5 Y77.5
+G1 X93.5 E160.1845
+G1 Y85.5 E160.2677
+G1 X101.5 E160.3508
+G1 Y77.5 E160.434
+G1 E160.23 F1800
+G1 X133.75 Y77.75 F9000
+G1 E160.43 F1800
+G1 Y85.25 E160.5119 F3600
+G1 X141.25 E160.5899
+G1 Y77.75 E160.6679
+G1 X133.75 E160.7458
+G1 X133.5 Y77.5
+G1 Y85.5 E160.829
+G1 X141.5 E160.9121
+G1 Y77.5 E160.9953
+G1 X133.5 E161.0784
+G1 E160.88 F1800
+G1 X101.25 Y101.75 F9000
+G1 E161.08 F1800
+G1 X93.75 E161.1564 F3600
+G1 Y109.25 E161.2343
+G1 X101.25 E161.3123
+G1 Y101.75 E161.3902
+G1 X101.5 Y101.5
+G1 X93.5 E161.4734
+G1 Y109.5 E161.5565
+G1 X101.5 E161.6397
+G1 Y101.5 E161.7228
+G1 E161.52 F1800
+G1 X133.75 Y101.75 F9000
+G1 E161.72 F1800
+G1 Y109.25 E161.8008 F3600
+G1 X141.25 E161.8787
+G1 Y101.75 E161.9567
+G1 X133.75 E162.0346
+G1 X133.5 Y101.5
+G1 Y109.5 E162.1178
+G1 X141.5 E162.2009
+G1 Y101.5 E162.2841
+G1 X133.5 E162.3672
+G1 E162.17 F1800
+G1 X101.25 Y125.75 F9000
+G1 E162.37 F1800
+G1 X93.75 E162.4452 F3600
+G1 Y133.25 E162.5231
+G1 X101.25 E162.6011
+G1 Y125.75 E162.6791
+G1 X101.5 Y125.5
+G1 X93.5 E162.7622
+G1 Y133.5 E162.8454
+G1 X101.5 E162.9285
+G1 Y125.5 E163.0117
+G1 E162.81 F1800
+G1 X133.75 Y125.75 F9000
+G1 E163.01 F1800
+G1 Y133.25 E163.0896 F3600
+G1 X141.25 E163.1676
+G1 Y125.75 E163.2455
+G1 X133.75 E163.3235
+G1 X133.5 Y125.5
+G1 Y133.5 E163.4066
+G1 X141.5 E163.4898
+G1 Y125.5 E163.5729
+G1 X133.5 E163.6561
+G1 E163.46 F1800
+G1 X101.25 Y149.75 F9000
+G1 E163.66 F1800
+G1 X93.75 E163.734 F3600
+G1 Y157.25 E163.812
+G1 X101.25 E163.8899
+G1 Y149.75 E163.9679
+G1 X101.5 Y149.5
+G1 X93.5 E164.051
+G1 Y157.5 E164.1342
+G1 X101.5 E164.2173
+G1 Y149.5 E164.3005
+G1 E164.1 F1800
+G1 X133.75 Y149.75 F9000
+G1 E164.3 F1800
+G1 Y157.25 E164.3784 F3600
+G1 X141.25 E164.4564
+G1 Y149.75 E164.5343
+G1 X133.75 E164.6123
+G1 X133.5 Y149.5
+G1 Y157.5 E164.6954
+G1 X141.5 E164.7786
+G1 Y149.5 E164.8617
+G1 X133.5 E164.9449
+;layer #22
+G1 E164.74 F1800
+G1 X133.81 Y149.81 F9000
+G1 E164.94 F1800
+G1 Z2.2 F300
+G1 Y157.19 E165.0216 F3600
+G1 X141.19 E165.0982
+G1 Y149.81 E165.1749
+G1 X133.81 E165.2515
+G1 X133.56 Y149.56
+G1 Y157.44 E165.3334
+G1 X141.44 E165.4152
+G1 Y149.56 E165.4971
+G1 X133.56 E165.5789
+G1 E165.38 F1800
+G1 X101.19 Y149.81 F9000
+G1 E165.58 F1800
+G1 X93.81 E165.6556 F3600
+G1 Y157.19 E165.7322
+G1 X101.19 E165.8089
+G1 Y149.81 E165.8855
+G1 X101.44 Y149.56
+G1 X93.56 E165.9674
+G1 Y157.44 E166.0492
+G1 X101.44 E166.1311
+G1 Y149.56 E166.2129
+G1 E166.01 F1800
+G1 X133.81 Y125.81 F9000
+G1 E166.21 F1800
+G1 Y133.19 E166.2896 F3600
+G1 X141.19 E166.3663
+G1 Y125.81 E166.4429
+G1 X133.81 E166.5196
+G1 X133.56 Y125.56
+G1 Y133.44 E166.6014
+G1 X141.44 E166.6833
+G1 Y125.56 E166.7651
+G1 X133.56 E166.847
+G1 E166.65 F1800
+G1 X101.19 Y125.81 F9000
+G1 E166.85 F1800
+G1 X93.81 E166.9236 F3600
+G1 Y133.19 E167.0003
+G1 X101.19 E167.0769
+G1 Y125.81 E167.1536
+G1 X101.44 Y125.56
+G1 X93.56 E167.2354
+G1 Y133.44 E167.3173
+G1 X101.44 E167.3991
+G1 Y125.56 E167.481
+G1 E167.28 F1800
+G1 X133.81 Y101.81 F9000
+G1 E167.48 F1800
+G1 Y109.19 E167.5576 F3600
+G1 X141.19 E167.6343
+G1 Y101.81 E167.7109
+G1 X133.81 E167.7876
+G1 X133.56 Y101.56
+G1 Y109.44 E167.8695
+G1 X141.44 E167.9513
+G1 Y101.56 E168.0332
+G1 X133.56 E168.115
+G1 E167.92 F1800
+G1 X101.19 Y101.81 F9000
+G1 E168.12 F1800
+G1 X93.81 E168.1917 F3600
+G1 Y109.19 E168.2683
+G1 X101.19 E168.345
+G1 Y101.81 E168.4216
+G1 X101.44 Y101.56
+G1 X93.56 E168.5035
+G1 Y109.44 E168.5853
+G1 X101.44 E168.6672
+G1 Y101.56 E168.749
+G1 E168.55 F1800
+G1 X133.81 Y77.81 F9000
+G1 E168.75 F1800
+G1 Y85.19 E168.8257 F3600
+G1 X141.19 E168.9023
+G1 Y77.81 E168.979
+G1 X133.81 E169.0556
+G1 X133.56 Y77.56
+G1 Y85.44 E169.1375
+G1 X141.44 E169.2193
+G1 Y77.56 E169.3012
+G1 X133.56 E169.383
+G1 E169.18 F1800
+G1 X101.19 Y77.81 F9000
+G1 E169.38 F1800
+G1 X93.81 E169.4597 F3600
+G1 Y85.19 E169.5364
+G1 X101.19 E169.613
+G1 Y77.81 E169.6897
+G1 X101.44 Y77.56
+G1 X93.56 E169.7715
+G1 Y85.44 E169.8534
+G1 X101.44 E169.9352
+G1 Y77.56 E170.0171
+;layer #23
+G1 E169.82 F1800
+G1 X101.19 Y77.81 F9000
+G1 E170.02 F1800
+G1 Z2.3 F300
+G1 X93.81 E170.0937 F3600
+G1 Y85.19 E170.1704
+G1 X101.19 E170.247
+G1 Y77.81 E170.3237
+G1 X101.44 Y77.56
+G1 X93.56 E170.4055
+G1 Y85.44 E170.4874
+G1 X101.44 E170.5692
+G1 Y77.56 E170.6511
+G1 E170.45 F1800
+G1 X133.81 Y77.81 F9000
+G1 E170.65 F1800
+G1 Y85.19 E170.7277 F3600
+G1 X141.19 E170.8044
+G1 Y77.81 E170.8811
+G1 X133.81 E170.9577
+G1 X133.56 Y77.56
+G1 Y85.44 E171.0396
+G1 X141.44 E171.1214
+G1 Y77.56 E171.2033
+G1 X133.56 E171.2851
+G1 E171.09 F1800
+G1 X101.19 Y101.81 F9000
+G1 E171.29 F1800
+G1 X93.81 E171.3618 F3600
+G1 Y109.19 E171.4384
+G1 X101.19 E171.5151
+G1 Y101.81 E171.5917
+G1 X101.44 Y101.56
+G1 X93.56 E171.6736
+G1 Y109.44 E171.7554
+G1 X101.44 E171.8373
+G1 Y101.56 E171.9191
+G1 E171.72 F1800
+G1 X133.81 Y101.81 F9000
+G1 E171.92 F1800
+G1 Y109.19 E171.9958 F3600
+G1 X141.19 E172.0724
+G1 Y101.81 E172.1491
+G1 X133.81 E172.2258
+G1 X133.56 Y101.56
+G1 Y109.44 E172.3076
+G1 X141.44 E172.3895
+G1 Y101.56 E172.4713
+G1 X133.56 E172.5532
+G1 E172.35 F1800
+G1 X101.19 Y125.81 F9000
+G1 E172.55 F1800
+G1 X93.81 E172.6298 F3600
+G1 Y133.19 E172.7065
+G1 X101.19 E172.7831
+G1 Y125.81 E172.8598
+G1 X101.44 Y125.56
+G1 X93.56 E172.9416
+G1 Y133.44 E173.0235
+G1 X101.44 E173.1053
+G1 Y125.56 E173.1872
+G1 E172.99 F1800
+G1 X133.81 Y125.81 F9000
+G1 E173.19 F1800
+G1 Y133.19 E173.2638 F3600
+G1 X141.19 E173.3405
+G1 Y125.81 E173.4171
+G1 X133.81 E173.4938
+G1 X133.56 Y125.56
+G1 Y133.44 E173.5756
+G1 X141.44 E173.6575
+G1 Y125.56 E173.7393
+G1 X133.56 E173.8212
+G1 E173.62 F1800
+G1 X101.19 Y149.81 F9000
+G1 E173.82 F1800
+G1 X93.81 E173.8979 F3600
+G1 Y157.19 E173.9745
+G1 X101.19 E174.0512
+G1 Y149.81 E174.1278
+G1 X101.44 Y149.56
+G1 X93.56 E174.2097
+G1 Y157.44 E174.2915
+G1 X101.44 E174.3734
+G1 Y149.56 E174.4552
+G1 E174.26 F1800
+G1 X133.81 Y149.81 F9000
+G1 E174.46 F1800
+G1 Y157.19 E174.5319 F3600
+G1 X141.19 E174.6085
+G1 Y149.81 E174.6852
+G1 X133.81 E174.7618
+G1 X133.56 Y149.56
+G1 Y157.44 E174.8437
+G1 X141.44 E174.9255
+G1 Y149.56 E175.0074
+G1 X133.56 E175.0892
+;layer #24
+G1 E174.89 F1800
+G1 X133.81 Y149.81 F9000
+G1 E175.09 F1800
+G1 Z2.4 F300
+G1 Y157.19 E175.1659 F3600
+G1 X141.19 E175.2425
+G1 Y149.81 E175.3192
+G1 X133.81 E175.3959
+G1 X133.56 Y149.56
+G1 Y157.44 E175.4777
+G1 X141.44 E175.5596
+G1 Y149.56 E175.6414
+G1 X133.56 E175.7233
+G1 E175.52 F1800
+G1 X101.19 Y149.81 F9000
+G1 E175.72 F1800
+G1 X93.81 E175.7999 F3600
+G1 Y157.19 E175.8766
+G1 X101.19 E175.9532
+G1 Y149.81 E176.0299
+G1 X101.44 Y149.56
+G1 X93.56 E176.1117
+G1 Y157.44 E176.1936
+G1 X101.44 E176.2754
+G1 Y149.56 E176.3573
+G1 E176.16 F1800
+G1 X133.81 Y125.81 F9000
+G1 E176.36 F1800
+G1 Y133.19 E176.4339 F3600
+G1 X141.19 E176.5106
+G1 Y125.81 E176.5872
+G1 X133.81 E176.6639
+G1 X133.56 Y125.56
+G1 Y133.44 E176.7458
+G1 X141.44 E176.8276
+G1 Y125.56 E176.9095
+G1 X133.56 E176.9913
+G1 E176.79 F1800
+G1 X101.19 Y125.81 F9000
+G1 E176.99 F1800
+G1 X93.81 E177.068 F3600
+G1 Y133.19 E177.1446
+G1 X101.19 E177.2213
+G1 Y125.81 E177.2979
+G1 X101.44 Y125.56
+G1 X93.56 E177.3798
+G1 Y133.44 E177.4616
+G1 X101.44 E177.5435
+G1 Y125.56 E177.6253
+G1 E177.43 F1800
+G1 X133.81 Y101.81 F9000
+G1 E177.63 F1800
+G1 Y109.19 E177.702 F3600
+G1 X141.19 E177.7786
+G1 Y101.81 E177.8553
+G1 X133.81 E177.9319
+G1 X133.56 Y101.56
+G1 Y109.44 E178.0138
+G1 X141.44 E178.0956
+G1 Y101.56 E178.1775
+G1 X133.56 E178.2593
+G1 E178.06 F1800
+G1 X101.19 Y101.81 F9000
+G1 E178.26 F1800
+G1 X93.81 E178.336 F3600
+G1 Y109.19 E178.4127
+G1 X101.19 E178.4893
+G1 Y101.81 E178.566
+G1 X101.44 Y101.56
+G1 X93.56 E178.6478
+G1 Y109.44 E178.7297
+G1 X101.44 E178.8115
+G1 Y101.56 E178.8934
+G1 E178.69 F1800
+G1 X133.81 Y77.81 F9000
+G1 E178.89 F1800
+G1 Y85.19 E178.97 F3600
+G1 X141.19 E179.0467
+G1 Y77.81 E179.1233
+G1 X133.81 E179.2
+G1 X133.56 Y77.56
+G1 Y85.44 E179.2818
+G1 X141.44 E179.3637
+G1 Y77.56 E179.4455
+G1 X133.56 E179.5274
+G1 E179.33 F1800
+G1 X101.19 Y77.81 F9000
+G1 E179.53 F1800
+G1 X93.81 E179.604 F3600
+G1 Y85.19 E179.6807
+G1 X101.19 E179.7574
+G1 Y77.81 E179.834
+G1 X101.44 Y77.56
+G1 X93.56 E179.9159
+G1 Y85.44 E179.9977
+G1 X101.44 E180.0796
+G1 Y77.56 E180.1614
+;layer #25
+G1 E179.96 F1800
+G1 X101.19 Y77.81 F9000
+G1 E180.16 F1800
+G1 Z2.5 F300
+G1 X93.81 E180.2381 F3600
+G1 Y85.19 E180.3147
+G1 X101.19 E180.3914
+G1 Y77.81 E180.468
+G1 X101.44 Y77.56
+G1 X93.56 E180.5499
+G1 Y85.44 E180.6317
+G1 X101.44 E180.7136
+G1 Y77.56 E180.7954
+G1 E180.6 F1800
+G1 X133.81 Y77.81 F9000
+G1 E180.8 F1800
+G1 Y85.19 E180.8721 F3600
+G1 X141.19 E180.9487
+G1 Y77.81 E181.0254
+G1 X133.81 E181.1021
+G1 X133.56 Y77.56
+G1 Y85.44 E181.1839
+G1 X141.44 E181.2658
+G1 Y77.56 E181.3476
+G1 X133.56 E181.4295
+G1 E181.23 F1800
+G1 X101.19 Y101.81 F9000
+G1 E181.43 F1800
+G1 X93.81 E181.5061 F3600
+G1 Y109.19 E181.5828
+G1 X101.19 E181.6594
+G1 Y101.81 E181.7361
+G1 X101.44 Y101.56
+G1 X93.56 E181.8179
+G1 Y109.44 E181.8998
+G1 X101.44 E181.9816
+G1 Y101.56 E182.0635
+G1 E181.86 F1800
+G1 X133.81 Y101.81 F9000
+G1 E182.06 F1800
+G1 Y109.19 E182.1401 F3600
+G1 X141.19 E182.2168
+G1 Y101.81 E182.2934
+G1 X133.81 E182.3701
+G1 X133.56 Y101.56
+G1 Y109.44 E182.4519
+G1 X141.44 E182.5338
+G1 Y101.56 E182.6156
+G1 X133.56 E182.6975
+G1 E182.5 F1800
+G1 X101.19 Y125.81 F9000
+G1 E182.7 F1800
+G1 X93.81 E182.7742 F3600
+G1 Y133.19 E182.8508
+G1 X101.19 E182.9275
+G1 Y125.81 E183.0041
+G1 X101.44 Y125.56
+G1 X93.56 E183.086
+G1 Y133.44 E183.1678
+G1 X101.44 E183.2497
+G1 Y125.56 E183.3315
+G1 E183.13 F1800
+G1 X133.81 Y125.81 F9000
+G1 E183.33 F1800
+G1 Y133.19 E183.4082 F3600
+G1 X141.19 E183.4848
+G1 Y125.81 E183.5615
+G1 X133.81 E183.6381
+G1 X133.56 Y125.56
+G1 Y133.44 E183.72
+G1 X141.44 E183.8018
+G1 Y125.56 E183.8837
+G1 X133.56 E183.9655
+G1 E183.77 F1800
+G1 X101.19 Y149.81 F9000
+G1 E183.97 F1800
+G1 X93.81 E184.0422 F3600
+G1 Y157.19 E184.1188
+G1 X101.19 E184.1955
+G1 Y149.81 E184.2722
+G1 X101.44 Y149.56
+G1 X93.56 E184.354
+G1 Y157.44 E184.4359
+G1 X101.44 E184.5177
+G1 Y149.56 E184.5996
+G1 E184.4 F1800
+G1 X133.81 Y149.81 F9000
+G1 E184.6 F1800
+G1 Y157.19 E184.6762 F3600
+G1 X141.19 E184.7529
+G1 Y149.81 E184.8295
+G1 X133.81 E184.9062
+G1 X133.56 Y149.56
+G1 Y157.44 E184.988
+G1 X141.44 E185.0699
+G1 Y149.56 E185.1517
+G1 X133.56 E185.2336
+;layer #26
+G1 E185.03 F1800
+G1 X133.81 Y149.81 F9000
+G1 E185.23 F1800
+G1 Z2.6 F300
+G1 Y157.19 E185.3102 F3600
+G1 X141.19 E185.3869
+G1 Y149.81 E185.4635
+G1 X133.81 E185.5402
+G1 X133.56 Y149.56
+G1 Y157.44 E185.6221
+G1 X141.44 E185.7039
+G1 Y149.56 E185.7858
+G1 X133.56 E185.8676
+G1 E185.67 F1800
+G1 X101.19 Y149.81 F9000
+G1 E185.87 F1800
+G1 X93.81 E185.9443 F3600
+G1 Y157.19 E186.0209
+G1 X101.19 E186.0976
+G1 Y149.81 E186.1742
+G1 X101.44 Y149.56
+G1 X93.56 E186.2561
+G1 Y157.44 E186.3379
+G1 X101.44 E186.4198
+G1 Y149.56 E186.5016
+G1 E186.3 F1800
+G1 X133.81 Y125.81 F9000
+G1 E186.5 F1800
+G1 Y133.19 E186.5783 F3600
+G1 X141.19 E186.6549
+G1 Y125.81 E186.7316
+G1 X133.81 E186.8082
+G1 X133.56 Y125.56
+G1 Y133.44 E186.8901
+G1 X141.44 E186.9719
+G1 Y125.56 E187.0538
+G1 X133.56 E187.1356
+G1 E186.94 F1800
+G1 X101.19 Y125.81 F9000
+G1 E187.14 F1800
+G1 X93.81 E187.2123 F3600
+G1 Y133.19 E187.289
+G1 X101.19 E187.3656
+G1 Y125.81 E187.4423
+G1 X101.44 Y125.56
+G1 X93.56 E187.5241
+G1 Y133.44 E187.606
+G1 X101.44 E187.6878
+G1 Y125.56 E187.7697
+G1 E187.57 F1800
+G1 X133.81 Y101.81 F9000
+G1 E187.77 F1800
+G1 Y109.19 E187.8463 F3600
+G1 X141.19 E187.923
+G1 Y101.81 E187.9996
+G1 X133.81 E188.0763
+G1 X133.56 Y101.56
+G1 Y109.44 E188.1581
+G1 X141.44 E188.24
+G1 Y101.56 E188.3218
+G1 X133.56 E188.4037
+G1 E188.2 F1800
+G1 X101.19 Y101.81 F9000
+G1 E188.4 F1800
+G1 X93.81 E188.4803 F3600
+G1 Y109.19 E188.557
+G1 X101.19 E188.6337
+G1 Y101.81 E188.7103
+G1 X101.44 Y101.56
+G1 X93.56 E188.7922
+G1 Y109.44 E188.874
+G1 X101.44 E188.9559
+G1 Y101.56 E189.0377
+G1 E188.84 F1800
+G1 X133.81 Y77.81 F9000
+G1 E189.04 F1800
+G1 Y85.19 E189.1144 F3600
+G1 X141.19 E189.191
+G1 Y77.81 E189.2677
+G1 X133.81 E189.3443
+G1 X133.56 Y77.56
+G1 Y85.44 E189.4262
+G1 X141.44 E189.508
+G1 Y77.56 E189.5899
+G1 X133.56 E189.6717
+G1 E189.47 F1800
+G1 X101.19 Y77.81 F9000
+G1 E189.67 F1800
+G1 X93.81 E189.7484 F3600
+G1 Y85.19 E189.825
+G1 X101.19 E189.9017
+G1 Y77.81 E189.9783
+G1 X101.44 Y77.56
+G1 X93.56 E190.0602
+G1 Y85.44 E190.1421
+G1 X101.44 E190.2239
+G1 Y77.56 E190.3058
+;layer #27
+G1 E190.11 F1800
+G1 X101.19 Y77.81 F9000
+G1 E190.31 F1800
+G1 Z2.7 F300
+G1 X93.81 E190.3824 F3600
+G1 Y85.19 E190.4591
+G1 X101.19 E190.5357
+G1 Y77.81 E190.6124
+G1 X101.44 Y77.56
+G1 X93.56 E190.6942
+G1 Y85.44 E190.7761
+G1 X101.44 E190.8579
+G1 Y77.56 E190.9398
+G1 E190.74 F1800
+G1 X133.81 Y77.81 F9000
+G1 E190.94 F1800
+G1 Y85.19 E191.0164 F3600
+G1 X141.19 E191.0931
+G1 Y77.81 E191.1697
+G1 X133.81 E191.2464
+G1 X133.56 Y77.56
+G1 Y85.44 E191.3282
+G1 X141.44 E191.4101
+G1 Y77.56 E191.4919
+G1 X133.56 E191.5738
+G1 E191.37 F1800
+G1 X101.19 Y101.81 F9000
+G1 E191.57 F1800
+G1 X93.81 E191.6504 F3600
+G1 Y109.19 E191.7271
+G1 X101.19 E191.8038
+G1 Y101.81 E191.8804
+G1 X101.44 Y101.56
+G1 X93.56 E191.9623
+G1 Y109.44 E192.0441
+G1 X101.44 E192.126
+G1 Y101.56 E192.2078
+G1 E192.01 F1800
+G1 X133.81 Y101.81 F9000
+G1 E192.21 F1800
+G1 Y109.19 E192.2845 F3600
+G1 X141.19 E192.3611
+G1 Y101.81 E192.4378
+G1 X133.81 E192.5144
+G1 X133.56 Y101.56
+G1 Y109.44 E192.5963
+G1 X141.44 E192.6781
+G1 Y101.56 E192.76
+G1 X133.56 E192.8418
+G1 E192.64 F1800
+G1 X101.19 Y125.81 F9000
+G1 E192.84 F1800
+G1 X93.81 E192.9185 F3600
+G1 Y133.19 E192.9951
+G1 X101.19 E193.0718
+G1 Y125.81 E193.1485
+G1 X101.44 Y125.56
+G1 X93.56 E193.2303
+G1 Y133.44 E193.3122
+G1 X101.44 E193.394
+G1 Y125.56 E193.4759
+G1 E193.28 F1800
+G1 X133.81 Y125.81 F9000
+G1 E193.48 F1800
+G1 Y133.19 E193.5525 F3600
+G1 X141.19 E193.6292
+G1 Y125.81 E193.7058
+G1 X133.81 E193.7825
+G1 X133.56 Y125.56
+G1 Y133.44 E193.8643
+G1 X141.44 E193.9462
+G1 Y125.56 E194.028
+G1 X133.56 E194.1099
+G1 E193.91 F1800
+G1 X101.19 Y149.81 F9000
+G1 E194.11 F1800
+G1 X93.81 E194.1865 F3600
+G1 Y157.19 E194.2632
+G1 X101.19 E194.3398
+G1 Y149.81 E194.4165
+G1 X101.44 Y149.56
+G1 X93.56 E194.4983
+G1 Y157.44 E194.5802
+G1 X101.44 E194.6621
+G1 Y149.56 E194.7439
+G1 E194.54 F1800
+G1 X133.81 Y149.81 F9000
+G1 E194.74 F1800
+G1 Y157.19 E194.8206 F3600
+G1 X141.19 E194.8972
+G1 Y149.81 E194.9739
+G1 X133.81 E195.0505
+G1 X133.56 Y149.56
+G1 Y157.44 E195.1324
+G1 X141.44 E195.2142
+G1 Y149.56 E195.2961
+G1 X133.56 E195.3779
+;layer #28
+G1 E195.18 F1800
+G1 X133.81 Y149.81 F9000
+G1 E195.38 F1800
+G1 Z2.8 F300
+G1 Y157.19 E195.4546 F3600
+G1 X141.19 E195.5312
+G1 Y149.81 E195.6079
+G1 X133.81 E195.6845
+G1 X133.56 Y149.56
+G1 Y157.44 E195.7664
+G1 X141.44 E195.8482
+G1 Y149.56 E195.9301
+G1 X133.56 E196.0119
+G1 E195.81 F1800
+G1 X101.19 Y149.81 F9000
+G1 E196.01 F1800
+G1 X93.81 E196.0886 F3600
+G1 Y157.19 E196.1653
+G1 X101.19 E196.2419
+G1 Y149.81 E196.3186
+G1 X101.44 Y149.56
+G1 X93.56 E196.4004
+G1 Y157.44 E196.4823
+G1 X101.44 E196.5641
+G1 Y149.56 E196.646
+G1 E196.45 F1800
+G1 X133.81 Y125.81 F9000
+G1 E196.65 F1800
+G1 Y133.19 E196.7226 F3600
+G1 X141.19 E196.7993
+G1 Y125.81 E196.8759
+G1 X133.81 E196.9526
+G1 X133.56 Y125.56
+G1 Y133.44 E197.0344
+G1 X141.44 E197.1163
+G1 Y125.56 E197.1981
+G1 X133.56 E197.28
+G1 E197.08 F1800
+G1 X101.19 Y125.81 F9000
+G1 E197.28 F1800
+G1 X93.81 E197.3566 F3600
+G1 Y133.19 E197.4333
+G1 X101.19 E197.51
+G1 Y125.81 E197.5866
+G1 X101.44 Y125.56
+G1 X93.56 E197.6685
+G1 Y133.44 E197.7503
+G1 X101.44 E197.8322
+G1 Y125.56 E197.914
+G1 E197.71 F1800
+G1 X133.81 Y101.81 F9000
+G1 E197.91 F1800
+G1 Y109.19 E197.9907 F3600
+G1 X141.19 E198.0673
+G1 Y101.81 E198.144
+G1 X133.81 E198.2206
+G1 X133.56 Y101.56
+G1 Y109.44 E198.3025
+G1 X141.44 E198.3843
+G1 Y101.56 E198.4662
+G1 X133.56 E198.548
+G1 E198.35 F1800
+G1 X101.19 Y101.81 F9000
+G1 E198.55 F1800
+G1 X93.81 E198.6247 F3600
+G1 Y109.19 E198.7013
+G1 X101.19 E198.778
+G1 Y101.81 E198.8546
+G1 X101.44 Y101.56
+G1 X93.56 E198.9365
+G1 Y109.44 E199.0183
+G1 X101.44 E199.1002
+G1 Y101.56 E199.1821
+G1 E198.98 F1800
+G1 X133.81 Y77.81 F9000
+G1 E199.18 F1800
+G1 Y85.19 E199.2587 F3600
+G1 X141.19 E199.3354
+G1 Y77.81 E199.412
+G1 X133.81 E199.4887
+G1 X133.56 Y77.56
+G1 Y85.44 E199.5705
+G1 X141.44 E199.6524
+G1 Y77.56 E199.7342
+G1 X133.56 E199.8161
+G1 E199.62 F1800
+G1 X101.19 Y77.81 F9000
+G1 E199.82 F1800
+G1 X93.81 E199.8927 F3600
+G1 Y85.19 E199.9694
+G1 X101.19 E200.046
+G1 Y77.81 E200.1227
+G1 X101.44 Y77.56
+G1 X93.56 E200.2045
+G1 Y85.44 E200.2864
+G1 X101.44 E200.3682
+G1 Y77.56 E200.4501
+;layer #29
+G1 E200.25 F1800
+G1 X101.19 Y77.81 F9000
+G1 E200.45 F1800
+G1 Z2.9 F300
+G1 X93.81 E200.5267 F3600
+G1 Y85.19 E200.6034
+G1 X101.19 E200.6801
+G1 Y77.81 E200.7567
+G1 X101.44 Y77.56
+G1 X93.56 E200.8386
+G1 Y85.44 E200.9204
+G1 X101.44 E201.0023
+G1 Y77.56 E201.0841
+G1 E200.88 F1800
+G1 X133.81 Y77.81 F9000
+G1 E201.08 F1800
+G1 Y85.19 E201.1608 F3600
+G1 X141.19 E201.2374
+G1 Y77.81 E201.3141
+G1 X133.81 E201.3907
+G1 X133.56 Y77.56
+G1 Y85.44 E201.4726
+G1 X141.44 E201.5544
+G1 Y77.56 E201.6363
+G1 X133.56 E201.7181
+G1 E201.52 F1800
+G1 X101.19 Y101.81 F9000
+G1 E201.72 F1800
+G1 X93.81 E201.7948 F3600
+G1 Y109.19 E201.8714
+G1 X101.19 E201.9481
+G1 Y101.81 E202.0248
+G1 X101.44 Y101.56
+G1 X93.56 E202.1066
+G1 Y109.44 E202.1885
+G1 X101.44 E202.2703
+G1 Y101.56 E202.3522
+G1 E202.15 F1800
+G1 X133.81 Y101.81 F9000
+G1 E202.35 F1800
+G1 Y109.19 E202.4288 F3600
+G1 X141.19 E202.5055
+G1 Y101.81 E202.5821
+G1 X133.81 E202.6588
+G1 X133.56 Y101.56
+G1 Y109.44 E202.7406
+G1 X141.44 E202.8225
+G1 Y101.56 E202.9043
+G1 X133.56 E202.9862
+G1 E202.79 F1800
+G1 X101.19 Y125.81 F9000
+G1 E202.99 F1800
+G1 X93.81 E203.0628 F3600
+G1 Y133.19 E203.1395
+G1 X101.19 E203.2161
+G1 Y125.81 E203.2928
+G1 X101.44 Y125.56
+G1 X93.56 E203.3746
+G1 Y133.44 E203.4565
+G1 X101.44 E203.5383
+G1 Y125.56 E203.6202
+G1 E203.42 F1800
+G1 X133.81 Y125.81 F9000
+G1 E203.62 F1800
+G1 Y133.19 E203.6969 F3600
+G1 X141.19 E203.7735
+G1 Y125.81 E203.8502
+G1 X133.81 E203.9268
+G1 X133.56 Y125.56
+G1 Y133.44 E204.0087
+G1 X141.44 E204.0905
+G1 Y125.56 E204.1724
+G1 X133.56 E204.2542
+G1 E204.05 F1800
+G1 X101.19 Y149.81 F9000
+G1 E204.25 F1800
+G1 X93.81 E204.3309 F3600
+G1 Y157.19 E204.4075
+G1 X101.19 E204.4842
+G1 Y149.81 E204.5608
+G1 X101.44 Y149.56
+G1 X93.56 E204.6427
+G1 Y157.44 E204.7245
+G1 X101.44 E204.8064
+G1 Y149.56 E204.8882
+G1 E204.69 F1800
+G1 X133.81 Y149.81 F9000
+G1 E204.89 F1800
+G1 Y157.19 E204.9649 F3600
+G1 X141.19 E205.0416
+G1 Y149.81 E205.1182
+G1 X133.81 E205.1949
+G1 X133.56 Y149.56
+G1 Y157.44 E205.2767
+G1 X141.44 E205.3586
+G1 Y149.56 E205.4404
+G1 X133.56 E205.5223
+;layer #30
+G1 E205.32 F1800
+G1 X133.81 Y149.81 F9000
+G1 E205.52 F1800
+G1 Z3 F300
+G1 Y157.19 E205.5989 F3600
+G1 X141.19 E205.6756
+G1 Y149.81 E205.7522
+G1 X133.81 E205.8289
+G1 X133.56 Y149.56
+G1 Y157.44 E205.9107
+G1 X141.44 E205.9926
+G1 Y149.56 E206.0744
+G1 X133.56 E206.1563
+G1 E205.96 F1800
+G1 X101.19 Y149.81 F9000
+G1 E206.16 F1800
+G1 X93.81 E206.2329 F3600
+G1 Y157.19 E206.3096
+G1 X101.19 E206.3862
+G1 Y149.81 E206.4629
+G1 X101.44 Y149.56
+G1 X93.56 E206.5448
+G1 Y157.44 E206.6266
+G1 X101.44 E206.7085
+G1 Y149.56 E206.7903
+G1 E206.59 F1800
+G1 X133.81 Y125.81 F9000
+G1 E206.79 F1800
+G1 Y133.19 E206.867 F3600
+G1 X141.19 E206.9436
+G1 Y125.81 E207.0203
+G1 X133.81 E207.0969
+G1 X133.56 Y125.56
+G1 Y133.44 E207.1788
+G1 X141.44 E207.2606
+G1 Y125.56 E207.3425
+G1 X133.56 E207.4243
+G1 E207.22 F1800
+G1 X101.19 Y125.81 F9000
+G1 E207.42 F1800
+G1 X93.81 E207.501 F3600
+G1 Y133.19 E207.5776
+G1 X101.19 E207.6543
+G1 Y125.81 E207.7309
+G1 X101.44 Y125.56
+G1 X93.56 E207.8128
+G1 Y133.44 E207.8946
+G1 X101.44 E207.9765
+G1 Y125.56 E208.0584
+G1 E207.86 F1800
+G1 X133.81 Y101.81 F9000
+G1 E208.06 F1800
+G1 Y109.19 E208.135 F3600
+G1 X141.19 E208.2117
+G1 Y101.81 E208.2883
+G1 X133.81 E208.365
+G1 X133.56 Y101.56
+G1 Y109.44 E208.4468
+G1 X141.44 E208.5287
+G1 Y101.56 E208.6105
+G1 X133.56 E208.6924
+G1 E208.49 F1800
+G1 X101.19 Y101.81 F9000
+G1 E208.69 F1800
+G1 X93.81 E208.769 F3600
+G1 Y109.19 E208.8457
+G1 X101.19 E208.9223
+G1 Y101.81 E208.999
+G1 X101.44 Y101.56
+G1 X93.56 E209.0808
+G1 Y109.44 E209.1627
+G1 X101.44 E209.2445
+G1 Y101.56 E209.3264
+G1 E209.13 F1800
+G1 X133.81 Y77.81 F9000
+G1 E209.33 F1800
+G1 Y85.19 E209.403 F3600
+G1 X141.19 E209.4797
+G1 Y77.81 E209.5564
+G1 X133.81 E209.633
+G1 X133.56 Y77.56
+G1 Y85.44 E209.7149
+G1 X141.44 E209.7967
+G1 Y77.56 E209.8786
+G1 X133.56 E209.9604
+G1 E209.76 F1800
+G1 X101.19 Y77.81 F9000
+G1 E209.96 F1800
+G1 X93.81 E210.0371 F3600
+G1 Y85.19 E210.1137
+G1 X101.19 E210.1904
+G1 Y77.81 E210.267
+G1 X101.44 Y77.56
+G1 X93.56 E210.3489
+G1 Y85.44 E210.4307
+G1 X101.44 E210.5126
+G1 Y77.56 E210.5944
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
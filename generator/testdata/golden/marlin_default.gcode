@@ -0,0 +1,3412 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Segment 10:   0.2mm @ 30mm/s
+;Segment 9:   0.29mm @ 30mm/s
+;Segment 8:   0.38mm @ 30mm/s
+;Segment 7:   0.47mm @ 30mm/s
+;Segment 6:   0.56mm @ 30mm/s
+;Segment 5:   0.64mm @ 30mm/s
+;Segment 4:   0.73mm @ 30mm/s
+;Segment 3:   0.82mm @ 30mm/s
+;Segment 2:   0.91mm @ 30mm/s
+;Segment 1:   1mm @ 30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 E202.83 F1800
+G1 X160.46 Y110.46 F9000
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 Y124.54 E204.42 F3600
+G1 X174.54 E205.0054
+G1 Y110.46 E205.5908
+G1 X160.46 E206.1762
+G1 X160.1 Y110.1
+G1 Y124.9 E206.7915
+G1 X174.9 E207.4068
+G1 Y110.1 E208.0221
+G1 X160.1 E208.6374
+G1 E207.64 F1800
+G1 X74.54 Y110.46 F9000
+G1 E208.64 F1800
+G1 X60.46 E209.2228 F3600
+G1 Y124.54 E209.8082
+G1 X74.54 E210.3936
+G1 Y110.46 E210.9789
+G1 X74.9 Y110.1
+G1 X60.1 E211.5943
+G1 Y124.9 E212.2096
+G1 X74.9 E212.8249
+G1 Y110.1 E213.4402
+;layer #3
+M106 S254
+G1 E212.44 F1800
+G1 X74.54 Y110.46 F9000
+G1 E213.44 F1800
+G1 Z0.75 F300
+G1 X60.46 E214.0256 F3600
+G1 Y124.54 E214.611
+G1 X74.54 E215.1963
+G1 Y110.46 E215.7817
+G1 X74.9 Y110.1
+G1 X60.1 E216.397
+G1 Y124.9 E217.0123
+G1 X74.9 E217.6276
+G1 Y110.1 E218.243
+G1 E217.24 F1800
+G1 X160.46 Y110.46 F9000
+G1 E218.24 F1800
+G1 Y124.54 E218.8283 F3600
+G1 X174.54 E219.4137
+G1 Y110.46 E219.9991
+G1 X160.46 E220.5845
+G1 X160.1 Y110.1
+G1 Y124.9 E221.1998
+G1 X174.9 E221.8151
+G1 Y110.1 E222.4304
+G1 X160.1 E223.0457
+;layer #4
+G1 E222.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E223.05 F1800
+G1 Z1 F300
+G1 Y124.54 E223.6311 F3600
+G1 X174.54 E224.2165
+G1 Y110.46 E224.8019
+G1 X160.46 E225.3872
+G1 X160.1 Y110.1
+G1 Y124.9 E226.0026
+G1 X174.9 E226.6179
+G1 Y110.1 E227.2332
+G1 X160.1 E227.8485
+G1 E226.85 F1800
+G1 X74.54 Y110.46 F9000
+G1 E227.85 F1800
+G1 X60.46 E228.4339 F3600
+G1 Y124.54 E229.0192
+G1 X74.54 E229.6046
+G1 Y110.46 E230.19
+G1 X74.9 Y110.1
+G1 X60.1 E230.8053
+G1 Y124.9 E231.4206
+G1 X74.9 E232.0359
+G1 Y110.1 E232.6513
+;layer #5
+G1 E231.65 F1800
+G1 X74.54 Y110.46 F9000
+G1 E232.65 F1800
+G1 Z1.25 F300
+G1 X60.46 E233.2366 F3600
+G1 Y124.54 E233.822
+G1 X74.54 E234.4074
+G1 Y110.46 E234.9928
+G1 X74.9 Y110.1
+G1 X60.1 E235.6081
+G1 Y124.9 E236.2234
+G1 X74.9 E236.8387
+G1 Y110.1 E237.454
+G1 E236.45 F1800
+G1 X160.46 Y110.46 F9000
+G1 E237.45 F1800
+G1 Y124.54 E238.0394 F3600
+G1 X174.54 E238.6248
+G1 Y110.46 E239.2101
+G1 X160.46 E239.7955
+G1 X160.1 Y110.1
+G1 Y124.9 E240.4108
+G1 X174.9 E241.0262
+G1 Y110.1 E241.6415
+G1 X160.1 E242.2568
+;layer #6
+G1 E241.26 F1800
+G1 X160.46 Y110.46 F9000
+G1 E242.26 F1800
+G1 Z1.5 F300
+G1 Y124.54 E242.8422 F3600
+G1 X174.54 E243.4275
+G1 Y110.46 E244.0129
+G1 X160.46 E244.5983
+G1 X160.1 Y110.1
+G1 Y124.9 E245.2136
+G1 X174.9 E245.8289
+G1 Y110.1 E246.4442
+G1 X160.1 E247.0595
+G1 E246.06 F1800
+G1 X74.54 Y110.46 F9000
+G1 E247.06 F1800
+G1 X60.46 E247.6449 F3600
+G1 Y124.54 E248.2303
+G1 X74.54 E248.8157
+G1 Y110.46 E249.4011
+G1 X74.9 Y110.1
+G1 X60.1 E250.0164
+G1 Y124.9 E250.6317
+G1 X74.9 E251.247
+G1 Y110.1 E251.8623
+;layer #7
+G1 E250.86 F1800
+G1 X74.54 Y110.46 F9000
+G1 E251.86 F1800
+G1 Z1.75 F300
+G1 X60.46 E252.4477 F3600
+G1 Y124.54 E253.0331
+G1 X74.54 E253.6184
+G1 Y110.46 E254.2038
+G1 X74.9 Y110.1
+G1 X60.1 E254.8191
+G1 Y124.9 E255.4344
+G1 X74.9 E256.0498
+G1 Y110.1 E256.6651
+G1 E255.67 F1800
+G1 X160.46 Y110.46 F9000
+G1 E256.67 F1800
+G1 Y124.54 E257.2504 F3600
+G1 X174.54 E257.8358
+G1 Y110.46 E258.4212
+G1 X160.46 E259.0066
+G1 X160.1 Y110.1
+G1 Y124.9 E259.6219
+G1 X174.9 E260.2372
+G1 Y110.1 E260.8525
+G1 X160.1 E261.4678
+;layer #8
+G1 E260.47 F1800
+G1 X160.46 Y110.46 F9000
+G1 E261.47 F1800
+G1 Z2 F300
+G1 Y124.54 E262.0532 F3600
+G1 X174.54 E262.6386
+G1 Y110.46 E263.224
+G1 X160.46 E263.8093
+G1 X160.1 Y110.1
+G1 Y124.9 E264.4247
+G1 X174.9 E265.04
+G1 Y110.1 E265.6553
+G1 X160.1 E266.2706
+G1 E265.27 F1800
+G1 X74.54 Y110.46 F9000
+G1 E266.27 F1800
+G1 X60.46 E266.856 F3600
+G1 Y124.54 E267.4414
+G1 X74.54 E268.0267
+G1 Y110.46 E268.6121
+G1 X74.9 Y110.1
+G1 X60.1 E269.2274
+G1 Y124.9 E269.8427
+G1 X74.9 E270.458
+G1 Y110.1 E271.0734
+;layer #9
+G1 E270.07 F1800
+G1 X74.54 Y110.46 F9000
+G1 E271.07 F1800
+G1 Z2.25 F300
+G1 X60.46 E271.6587 F3600
+G1 Y124.54 E272.2441
+G1 X74.54 E272.8295
+G1 Y110.46 E273.4149
+G1 X74.9 Y110.1
+G1 X60.1 E274.0302
+G1 Y124.9 E274.6455
+G1 X74.9 E275.2608
+G1 Y110.1 E275.8761
+G1 E274.88 F1800
+G1 X160.46 Y110.46 F9000
+G1 E275.88 F1800
+G1 Y124.54 E276.4615 F3600
+G1 X174.54 E277.0469
+G1 Y110.46 E277.6323
+G1 X160.46 E278.2176
+G1 X160.1 Y110.1
+G1 Y124.9 E278.8329
+G1 X174.9 E279.4483
+G1 Y110.1 E280.0636
+G1 X160.1 E280.6789
+;layer #10
+G1 E279.68 F1800
+G1 X160.46 Y110.46 F9000
+G1 E280.68 F1800
+G1 Z2.5 F300
+G1 Y124.54 E281.2643 F3600
+G1 X174.54 E281.8496
+G1 Y110.46 E282.435
+G1 X160.46 E283.0204
+G1 X160.1 Y110.1
+G1 Y124.9 E283.6357
+G1 X174.9 E284.251
+G1 Y110.1 E284.8663
+G1 X160.1 E285.4816
+G1 E284.48 F1800
+G1 X74.54 Y110.46 F9000
+G1 E285.48 F1800
+G1 X60.46 E286.067 F3600
+G1 Y124.54 E286.6524
+G1 X74.54 E287.2378
+G1 Y110.46 E287.8232
+G1 X74.9 Y110.1
+G1 X60.1 E288.4385
+G1 Y124.9 E289.0538
+G1 X74.9 E289.6691
+G1 Y110.1 E290.2844
+;layer #11
+G1 E289.28 F1800
+G1 X74.54 Y110.46 F9000
+G1 E290.28 F1800
+G1 Z2.75 F300
+G1 X60.46 E290.8698 F3600
+G1 Y124.54 E291.4552
+G1 X74.54 E292.0405
+G1 Y110.46 E292.6259
+G1 X74.9 Y110.1
+G1 X60.1 E293.2412
+G1 Y124.9 E293.8566
+G1 X74.9 E294.4719
+G1 Y110.1 E295.0872
+G1 E294.09 F1800
+G1 X160.46 Y110.46 F9000
+G1 E295.09 F1800
+G1 Y124.54 E295.6726 F3600
+G1 X174.54 E296.2579
+G1 Y110.46 E296.8433
+G1 X160.46 E297.4287
+G1 X160.1 Y110.1
+G1 Y124.9 E298.044
+G1 X174.9 E298.6593
+G1 Y110.1 E299.2746
+G1 X160.1 E299.8899
+;layer #12
+G1 E298.89 F1800
+G1 X160.46 Y110.46 F9000
+G1 E299.89 F1800
+G1 Z3 F300
+G1 Y124.54 E300.4753 F3600
+G1 X174.54 E301.0607
+G1 Y110.46 E301.6461
+G1 X160.46 E302.2315
+G1 X160.1 Y110.1
+G1 Y124.9 E302.8468
+G1 X174.9 E303.4621
+G1 Y110.1 E304.0774
+G1 X160.1 E304.6927
+G1 E303.69 F1800
+G1 X74.54 Y110.46 F9000
+G1 E304.69 F1800
+G1 X60.46 E305.2781 F3600
+G1 Y124.54 E305.8635
+G1 X74.54 E306.4488
+G1 Y110.46 E307.0342
+G1 X74.9 Y110.1
+G1 X60.1 E307.6495
+G1 Y124.9 E308.2648
+G1 X74.9 E308.8802
+G1 Y110.1 E309.4955
+;layer #13
+G1 E308.58 F1800
+G1 X74.64 Y110.36 F9000
+G1 E309.5 F1800
+G1 Z3.25 F300
+G1 X60.36 E310.0892 F3600
+G1 Y124.64 E310.6829
+G1 X74.64 E311.2765
+G1 Y110.36 E311.8702
+G1 X75 Y110
+G1 X60 E312.4939
+G1 Y125 E313.1175
+G1 X75 E313.7411
+G1 Y110 E314.3648
+G1 E313.45 F1800
+G1 X160.36 Y110.36 F9000
+G1 E314.36 F1800
+G1 Y124.64 E314.9584 F3600
+G1 X174.64 E315.5521
+G1 Y110.36 E316.1458
+G1 X160.36 E316.7395
+G1 X160 Y110
+G1 Y125 E317.3632
+G1 X175 E317.9868
+G1 Y110 E318.6104
+G1 X160 E319.234
+;layer #14
+G1 E318.32 F1800
+G1 X160.46 Y110.46 F9000
+G1 E319.23 F1800
+G1 Z3.5 F300
+G1 Y124.54 E319.8194 F3600
+G1 X174.54 E320.4048
+G1 Y110.46 E320.9902
+G1 X160.46 E321.5755
+G1 X160.1 Y110.1
+G1 Y124.9 E322.1909
+G1 X174.9 E322.8062
+G1 Y110.1 E323.4215
+G1 X160.1 E324.0368
+G1 E323.13 F1800
+G1 X74.54 Y110.46 F9000
+G1 E324.04 F1800
+G1 X60.46 E324.6222 F3600
+G1 Y124.54 E325.2076
+G1 X74.54 E325.7929
+G1 Y110.46 E326.3783
+G1 X74.9 Y110.1
+G1 X60.1 E326.9936
+G1 Y124.9 E327.6089
+G1 X74.9 E328.2242
+G1 Y110.1 E328.8396
+;layer #15
+G1 E327.93 F1800
+G1 X74.54 Y110.46 F9000
+G1 E328.84 F1800
+G1 Z3.75 F300
+G1 X60.46 E329.4249 F3600
+G1 Y124.54 E330.0103
+G1 X74.54 E330.5957
+G1 Y110.46 E331.1811
+G1 X74.9 Y110.1
+G1 X60.1 E331.7964
+G1 Y124.9 E332.4117
+G1 X74.9 E333.027
+G1 Y110.1 E333.6423
+G1 E332.73 F1800
+G1 X160.46 Y110.46 F9000
+G1 E333.64 F1800
+G1 Y124.54 E334.2277 F3600
+G1 X174.54 E334.8131
+G1 Y110.46 E335.3985
+G1 X160.46 E335.9838
+G1 X160.1 Y110.1
+G1 Y124.9 E336.5992
+G1 X174.9 E337.2145
+G1 Y110.1 E337.8298
+G1 X160.1 E338.4451
+;layer #16
+G1 E337.53 F1800
+G1 X160.46 Y110.46 F9000
+G1 E338.45 F1800
+G1 Z4 F300
+G1 Y124.54 E339.0305 F3600
+G1 X174.54 E339.6158
+G1 Y110.46 E340.2012
+G1 X160.46 E340.7866
+G1 X160.1 Y110.1
+G1 Y124.9 E341.4019
+G1 X174.9 E342.0172
+G1 Y110.1 E342.6325
+G1 X160.1 E343.2479
+G1 E342.34 F1800
+G1 X74.54 Y110.46 F9000
+G1 E343.25 F1800
+G1 X60.46 E343.8332 F3600
+G1 Y124.54 E344.4186
+G1 X74.54 E345.004
+G1 Y110.46 E345.5894
+G1 X74.9 Y110.1
+G1 X60.1 E346.2047
+G1 Y124.9 E346.82
+G1 X74.9 E347.4353
+G1 Y110.1 E348.0506
+;layer #17
+G1 E347.14 F1800
+G1 X74.54 Y110.46 F9000
+G1 E348.05 F1800
+G1 Z4.25 F300
+G1 X60.46 E348.636 F3600
+G1 Y124.54 E349.2214
+G1 X74.54 E349.8068
+G1 Y110.46 E350.3921
+G1 X74.9 Y110.1
+G1 X60.1 E351.0074
+G1 Y124.9 E351.6228
+G1 X74.9 E352.2381
+G1 Y110.1 E352.8534
+G1 E351.94 F1800
+G1 X160.46 Y110.46 F9000
+G1 E352.85 F1800
+G1 Y124.54 E353.4388 F3600
+G1 X174.54 E354.0241
+G1 Y110.46 E354.6095
+G1 X160.46 E355.1949
+G1 X160.1 Y110.1
+G1 Y124.9 E355.8102
+G1 X174.9 E356.4255
+G1 Y110.1 E357.0408
+G1 X160.1 E357.6561
+;layer #18
+G1 E356.75 F1800
+G1 X160.46 Y110.46 F9000
+G1 E357.66 F1800
+G1 Z4.5 F300
+G1 Y124.54 E358.2415 F3600
+G1 X174.54 E358.8269
+G1 Y110.46 E359.4123
+G1 X160.46 E359.9977
+G1 X160.1 Y110.1
+G1 Y124.9 E360.613
+G1 X174.9 E361.2283
+G1 Y110.1 E361.8436
+G1 X160.1 E362.4589
+G1 E361.55 F1800
+G1 X74.54 Y110.46 F9000
+G1 E362.46 F1800
+G1 X60.46 E363.0443 F3600
+G1 Y124.54 E363.6297
+G1 X74.54 E364.215
+G1 Y110.46 E364.8004
+G1 X74.9 Y110.1
+G1 X60.1 E365.4157
+G1 Y124.9 E366.031
+G1 X74.9 E366.6464
+G1 Y110.1 E367.2617
+;layer #19
+G1 E366.35 F1800
+G1 X74.54 Y110.46 F9000
+G1 E367.26 F1800
+G1 Z4.75 F300
+G1 X60.46 E367.847 F3600
+G1 Y124.54 E368.4324
+G1 X74.54 E369.0178
+G1 Y110.46 E369.6032
+G1 X74.9 Y110.1
+G1 X60.1 E370.2185
+G1 Y124.9 E370.8338
+G1 X74.9 E371.4491
+G1 Y110.1 E372.0644
+G1 E371.15 F1800
+G1 X160.46 Y110.46 F9000
+G1 E372.06 F1800
+G1 Y124.54 E372.6498 F3600
+G1 X174.54 E373.2352
+G1 Y110.46 E373.8206
+G1 X160.46 E374.4059
+G1 X160.1 Y110.1
+G1 Y124.9 E375.0213
+G1 X174.9 E375.6366
+G1 Y110.1 E376.2519
+G1 X160.1 E376.8672
+;layer #20
+G1 E375.96 F1800
+G1 X160.46 Y110.46 F9000
+G1 E376.87 F1800
+G1 Z5 F300
+G1 Y124.54 E377.4526 F3600
+G1 X174.54 E378.038
+G1 Y110.46 E378.6233
+G1 X160.46 E379.2087
+G1 X160.1 Y110.1
+G1 Y124.9 E379.824
+G1 X174.9 E380.4393
+G1 Y110.1 E381.0546
+G1 X160.1 E381.67
+G1 E380.76 F1800
+G1 X74.54 Y110.46 F9000
+G1 E381.67 F1800
+G1 X60.46 E382.2553 F3600
+G1 Y124.54 E382.8407
+G1 X74.54 E383.4261
+G1 Y110.46 E384.0115
+G1 X74.9 Y110.1
+G1 X60.1 E384.6268
+G1 Y124.9 E385.2421
+G1 X74.9 E385.8574
+G1 Y110.1 E386.4727
+;layer #21
+G1 E385.56 F1800
+G1 X74.54 Y110.46 F9000
+G1 E386.47 F1800
+G1 Z5.25 F300
+G1 X60.46 E387.0581 F3600
+G1 Y124.54 E387.6435
+G1 X74.54 E388.2289
+G1 Y110.46 E388.8142
+G1 X74.9 Y110.1
+G1 X60.1 E389.4295
+G1 Y124.9 E390.0449
+G1 X74.9 E390.6602
+G1 Y110.1 E391.2755
+G1 E390.36 F1800
+G1 X160.46 Y110.46 F9000
+G1 E391.28 F1800
+G1 Y124.54 E391.8609 F3600
+G1 X174.54 E392.4462
+G1 Y110.46 E393.0316
+G1 X160.46 E393.617
+G1 X160.1 Y110.1
+G1 Y124.9 E394.2323
+G1 X174.9 E394.8476
+G1 Y110.1 E395.4629
+G1 X160.1 E396.0783
+;layer #22
+G1 E395.17 F1800
+G1 X160.46 Y110.46 F9000
+G1 E396.08 F1800
+G1 Z5.5 F300
+G1 Y124.54 E396.6636 F3600
+G1 X174.54 E397.249
+G1 Y110.46 E397.8344
+G1 X160.46 E398.4198
+G1 X160.1 Y110.1
+G1 Y124.9 E399.0351
+G1 X174.9 E399.6504
+G1 Y110.1 E400.2657
+G1 X160.1 E400.881
+G1 E399.97 F1800
+G1 X74.54 Y110.46 F9000
+G1 E400.88 F1800
+G1 X60.46 E401.4664 F3600
+G1 Y124.54 E402.0518
+G1 X74.54 E402.6371
+G1 Y110.46 E403.2225
+G1 X74.9 Y110.1
+G1 X60.1 E403.8378
+G1 Y124.9 E404.4532
+G1 X74.9 E405.0685
+G1 Y110.1 E405.6838
+;layer #23
+G1 E404.77 F1800
+G1 X74.54 Y110.46 F9000
+G1 E405.68 F1800
+G1 Z5.75 F300
+G1 X60.46 E406.2692 F3600
+G1 Y124.54 E406.8545
+G1 X74.54 E407.4399
+G1 Y110.46 E408.0253
+G1 X74.9 Y110.1
+G1 X60.1 E408.6406
+G1 Y124.9 E409.2559
+G1 X74.9 E409.8712
+G1 Y110.1 E410.4865
+G1 E409.58 F1800
+G1 X160.46 Y110.46 F9000
+G1 E410.49 F1800
+G1 Y124.54 E411.0719 F3600
+G1 X174.54 E411.6573
+G1 Y110.46 E412.2427
+G1 X160.46 E412.8281
+G1 X160.1 Y110.1
+G1 Y124.9 E413.4434
+G1 X174.9 E414.0587
+G1 Y110.1 E414.674
+G1 X160.1 E415.2893
+;layer #24
+G1 E414.38 F1800
+G1 X160.46 Y110.46 F9000
+G1 E415.29 F1800
+G1 Z6 F300
+G1 Y124.54 E415.8747 F3600
+G1 X174.54 E416.4601
+G1 Y110.46 E417.0454
+G1 X160.46 E417.6308
+G1 X160.1 Y110.1
+G1 Y124.9 E418.2461
+G1 X174.9 E418.8614
+G1 Y110.1 E419.4768
+G1 X160.1 E420.0921
+G1 E419.18 F1800
+G1 X74.54 Y110.46 F9000
+G1 E420.09 F1800
+G1 X60.46 E420.6774 F3600
+G1 Y124.54 E421.2628
+G1 X74.54 E421.8482
+G1 Y110.46 E422.4336
+G1 X74.9 Y110.1
+G1 X60.1 E423.0489
+G1 Y124.9 E423.6642
+G1 X74.9 E424.2795
+G1 Y110.1 E424.8948
+;layer #25
+G1 E424.07 F1800
+G1 X74.64 Y110.36 F9000
+G1 E424.89 F1800
+G1 Z6.25 F300
+G1 X60.36 E425.4885 F3600
+G1 Y124.64 E426.0822
+G1 X74.64 E426.6759
+G1 Y110.36 E427.2696
+G1 X75 Y110
+G1 X60 E427.8932
+G1 Y125 E428.5169
+G1 X75 E429.1405
+G1 Y110 E429.7641
+G1 E428.94 F1800
+G1 X160.36 Y110.36 F9000
+G1 E429.76 F1800
+G1 Y124.64 E430.3578 F3600
+G1 X174.64 E430.9515
+G1 Y110.36 E431.5452
+G1 X160.36 E432.1389
+G1 X160 Y110
+G1 Y125 E432.7625
+G1 X175 E433.3861
+G1 Y110 E434.0098
+G1 X160 E434.6334
+;layer #26
+G1 E433.81 F1800
+G1 X160.46 Y110.46 F9000
+G1 E434.63 F1800
+G1 Z6.5 F300
+G1 Y124.54 E435.2188 F3600
+G1 X174.54 E435.8042
+G1 Y110.46 E436.3895
+G1 X160.46 E436.9749
+G1 X160.1 Y110.1
+G1 Y124.9 E437.5902
+G1 X174.9 E438.2055
+G1 Y110.1 E438.8209
+G1 X160.1 E439.4362
+G1 E438.61 F1800
+G1 X74.54 Y110.46 F9000
+G1 E439.44 F1800
+G1 X60.46 E440.0215 F3600
+G1 Y124.54 E440.6069
+G1 X74.54 E441.1923
+G1 Y110.46 E441.7777
+G1 X74.9 Y110.1
+G1 X60.1 E442.393
+G1 Y124.9 E443.0083
+G1 X74.9 E443.6236
+G1 Y110.1 E444.2389
+;layer #27
+G1 E443.42 F1800
+G1 X74.54 Y110.46 F9000
+G1 E444.24 F1800
+G1 Z6.75 F300
+G1 X60.46 E444.8243 F3600
+G1 Y124.54 E445.4097
+G1 X74.54 E445.9951
+G1 Y110.46 E446.5804
+G1 X74.9 Y110.1
+G1 X60.1 E447.1958
+G1 Y124.9 E447.8111
+G1 X74.9 E448.4264
+G1 Y110.1 E449.0417
+G1 E448.22 F1800
+G1 X160.46 Y110.46 F9000
+G1 E449.04 F1800
+G1 Y124.54 E449.6271 F3600
+G1 X174.54 E450.2124
+G1 Y110.46 E450.7978
+G1 X160.46 E451.3832
+G1 X160.1 Y110.1
+G1 Y124.9 E451.9985
+G1 X174.9 E452.6138
+G1 Y110.1 E453.2291
+G1 X160.1 E453.8445
+;layer #28
+G1 E453.02 F1800
+G1 X160.46 Y110.46 F9000
+G1 E453.84 F1800
+G1 Z7 F300
+G1 Y124.54 E454.4298 F3600
+G1 X174.54 E455.0152
+G1 Y110.46 E455.6006
+G1 X160.46 E456.186
+G1 X160.1 Y110.1
+G1 Y124.9 E456.8013
+G1 X174.9 E457.4166
+G1 Y110.1 E458.0319
+G1 X160.1 E458.6472
+G1 E457.82 F1800
+G1 X74.54 Y110.46 F9000
+G1 E458.65 F1800
+G1 X60.46 E459.2326 F3600
+G1 Y124.54 E459.818
+G1 X74.54 E460.4034
+G1 Y110.46 E460.9887
+G1 X74.9 Y110.1
+G1 X60.1 E461.604
+G1 Y124.9 E462.2194
+G1 X74.9 E462.8347
+G1 Y110.1 E463.45
+;layer #29
+G1 E462.63 F1800
+G1 X74.54 Y110.46 F9000
+G1 E463.45 F1800
+G1 Z7.25 F300
+G1 X60.46 E464.0354 F3600
+G1 Y124.54 E464.6207
+G1 X74.54 E465.2061
+G1 Y110.46 E465.7915
+G1 X74.9 Y110.1
+G1 X60.1 E466.4068
+G1 Y124.9 E467.0221
+G1 X74.9 E467.6374
+G1 Y110.1 E468.2527
+G1 E467.43 F1800
+G1 X160.46 Y110.46 F9000
+G1 E468.25 F1800
+G1 Y124.54 E468.8381 F3600
+G1 X174.54 E469.4235
+G1 Y110.46 E470.0089
+G1 X160.46 E470.5943
+G1 X160.1 Y110.1
+G1 Y124.9 E471.2096
+G1 X174.9 E471.8249
+G1 Y110.1 E472.4402
+G1 X160.1 E473.0555
+;layer #30
+G1 E472.23 F1800
+G1 X160.46 Y110.46 F9000
+G1 E473.06 F1800
+G1 Z7.5 F300
+G1 Y124.54 E473.6409 F3600
+G1 X174.54 E474.2263
+G1 Y110.46 E474.8116
+G1 X160.46 E475.397
+G1 X160.1 Y110.1
+G1 Y124.9 E476.0123
+G1 X174.9 E476.6276
+G1 Y110.1 E477.243
+G1 X160.1 E477.8583
+G1 E477.04 F1800
+G1 X74.54 Y110.46 F9000
+G1 E477.86 F1800
+G1 X60.46 E478.4436 F3600
+G1 Y124.54 E479.029
+G1 X74.54 E479.6144
+G1 Y110.46 E480.1998
+G1 X74.9 Y110.1
+G1 X60.1 E480.8151
+G1 Y124.9 E481.4304
+G1 X74.9 E482.0457
+G1 Y110.1 E482.661
+;layer #31
+G1 E481.84 F1800
+G1 X74.54 Y110.46 F9000
+G1 E482.66 F1800
+G1 Z7.75 F300
+G1 X60.46 E483.2464 F3600
+G1 Y124.54 E483.8318
+G1 X74.54 E484.4172
+G1 Y110.46 E485.0025
+G1 X74.9 Y110.1
+G1 X60.1 E485.6179
+G1 Y124.9 E486.2332
+G1 X74.9 E486.8485
+G1 Y110.1 E487.4638
+G1 E486.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E487.46 F1800
+G1 Y124.54 E488.0492 F3600
+G1 X174.54 E488.6346
+G1 Y110.46 E489.2199
+G1 X160.46 E489.8053
+G1 X160.1 Y110.1
+G1 Y124.9 E490.4206
+G1 X174.9 E491.0359
+G1 Y110.1 E491.6512
+G1 X160.1 E492.2666
+;layer #32
+G1 E491.44 F1800
+G1 X160.46 Y110.46 F9000
+G1 E492.27 F1800
+G1 Z8 F300
+G1 Y124.54 E492.8519 F3600
+G1 X174.54 E493.4373
+G1 Y110.46 E494.0227
+G1 X160.46 E494.6081
+G1 X160.1 Y110.1
+G1 Y124.9 E495.2234
+G1 X174.9 E495.8387
+G1 Y110.1 E496.454
+G1 X160.1 E497.0693
+G1 E496.25 F1800
+G1 X74.54 Y110.46 F9000
+G1 E497.07 F1800
+G1 X60.46 E497.6547 F3600
+G1 Y124.54 E498.2401
+G1 X74.54 E498.8255
+G1 Y110.46 E499.4108
+G1 X74.9 Y110.1
+G1 X60.1 E500.0262
+G1 Y124.9 E500.6415
+G1 X74.9 E501.2568
+G1 Y110.1 E501.8721
+;layer #33
+G1 E501.05 F1800
+G1 X74.54 Y110.46 F9000
+G1 E501.87 F1800
+G1 Z8.25 F300
+G1 X60.46 E502.4575 F3600
+G1 Y124.54 E503.0428
+G1 X74.54 E503.6282
+G1 Y110.46 E504.2136
+G1 X74.9 Y110.1
+G1 X60.1 E504.8289
+G1 Y124.9 E505.4442
+G1 X74.9 E506.0595
+G1 Y110.1 E506.6749
+G1 E505.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E506.67 F1800
+G1 Y124.54 E507.2602 F3600
+G1 X174.54 E507.8456
+G1 Y110.46 E508.431
+G1 X160.46 E509.0164
+G1 X160.1 Y110.1
+G1 Y124.9 E509.6317
+G1 X174.9 E510.247
+G1 Y110.1 E510.8623
+G1 X160.1 E511.4776
+;layer #34
+G1 E510.66 F1800
+G1 X160.46 Y110.46 F9000
+G1 E511.48 F1800
+G1 Z8.5 F300
+G1 Y124.54 E512.063 F3600
+G1 X174.54 E512.6484
+G1 Y110.46 E513.2338
+G1 X160.46 E513.8191
+G1 X160.1 Y110.1
+G1 Y124.9 E514.4344
+G1 X174.9 E515.0498
+G1 Y110.1 E515.6651
+G1 X160.1 E516.2804
+G1 E515.46 F1800
+G1 X74.54 Y110.46 F9000
+G1 E516.28 F1800
+G1 X60.46 E516.8658 F3600
+G1 Y124.54 E517.4511
+G1 X74.54 E518.0365
+G1 Y110.46 E518.6219
+G1 X74.9 Y110.1
+G1 X60.1 E519.2372
+G1 Y124.9 E519.8525
+G1 X74.9 E520.4678
+G1 Y110.1 E521.0831
+;layer #35
+G1 E520.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E521.08 F1800
+G1 Z8.75 F300
+G1 X60.46 E521.6685 F3600
+G1 Y124.54 E522.2539
+G1 X74.54 E522.8393
+G1 Y110.46 E523.4247
+G1 X74.9 Y110.1
+G1 X60.1 E524.04
+G1 Y124.9 E524.6553
+G1 X74.9 E525.2706
+G1 Y110.1 E525.8859
+G1 E525.06 F1800
+G1 X160.46 Y110.46 F9000
+G1 E525.89 F1800
+G1 Y124.54 E526.4713 F3600
+G1 X174.54 E527.0567
+G1 Y110.46 E527.642
+G1 X160.46 E528.2274
+G1 X160.1 Y110.1
+G1 Y124.9 E528.8427
+G1 X174.9 E529.458
+G1 Y110.1 E530.0734
+G1 X160.1 E530.6887
+;layer #36
+G1 E529.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E530.69 F1800
+G1 Z9 F300
+G1 Y124.54 E531.274 F3600
+G1 X174.54 E531.8594
+G1 Y110.46 E532.4448
+G1 X160.46 E533.0302
+G1 X160.1 Y110.1
+G1 Y124.9 E533.6455
+G1 X174.9 E534.2608
+G1 Y110.1 E534.8761
+G1 X160.1 E535.4914
+G1 E534.67 F1800
+G1 X74.54 Y110.46 F9000
+G1 E535.49 F1800
+G1 X60.46 E536.0768 F3600
+G1 Y124.54 E536.6622
+G1 X74.54 E537.2476
+G1 Y110.46 E537.8329
+G1 X74.9 Y110.1
+G1 X60.1 E538.4483
+G1 Y124.9 E539.0636
+G1 X74.9 E539.6789
+G1 Y110.1 E540.2942
+;layer #37
+G1 E539.56 F1800
+G1 X74.64 Y110.36 F9000
+G1 E540.29 F1800
+G1 Z9.25 F300
+G1 X60.36 E540.8879 F3600
+G1 Y124.64 E541.4816
+G1 X74.64 E542.0753
+G1 Y110.36 E542.669
+G1 X75 Y110
+G1 X60 E543.2926
+G1 Y125 E543.9162
+G1 X75 E544.5399
+G1 Y110 E545.1635
+G1 E544.43 F1800
+G1 X160.36 Y110.36 F9000
+G1 E545.16 F1800
+G1 Y124.64 E545.7572 F3600
+G1 X174.64 E546.3509
+G1 Y110.36 E546.9446
+G1 X160.36 E547.5383
+G1 X160 Y110
+G1 Y125 E548.1619
+G1 X175 E548.7855
+G1 Y110 E549.4091
+G1 X160 E550.0328
+;layer #38
+G1 E549.3 F1800
+G1 X160.46 Y110.46 F9000
+G1 E550.03 F1800
+G1 Z9.5 F300
+G1 Y124.54 E550.6181 F3600
+G1 X174.54 E551.2035
+G1 Y110.46 E551.7889
+G1 X160.46 E552.3743
+G1 X160.1 Y110.1
+G1 Y124.9 E552.9896
+G1 X174.9 E553.6049
+G1 Y110.1 E554.2202
+G1 X160.1 E554.8355
+G1 E554.1 F1800
+G1 X74.54 Y110.46 F9000
+G1 E554.84 F1800
+G1 X60.46 E555.4209 F3600
+G1 Y124.54 E556.0063
+G1 X74.54 E556.5917
+G1 Y110.46 E557.177
+G1 X74.9 Y110.1
+G1 X60.1 E557.7924
+G1 Y124.9 E558.4077
+G1 X74.9 E559.023
+G1 Y110.1 E559.6383
+;layer #39
+G1 E558.9 F1800
+G1 X74.54 Y110.46 F9000
+G1 E559.64 F1800
+G1 Z9.75 F300
+G1 X60.46 E560.2237 F3600
+G1 Y124.54 E560.809
+G1 X74.54 E561.3944
+G1 Y110.46 E561.9798
+G1 X74.9 Y110.1
+G1 X60.1 E562.5951
+G1 Y124.9 E563.2104
+G1 X74.9 E563.8257
+G1 Y110.1 E564.4411
+G1 E563.71 F1800
+G1 X160.46 Y110.46 F9000
+G1 E564.44 F1800
+G1 Y124.54 E565.0264 F3600
+G1 X174.54 E565.6118
+G1 Y110.46 E566.1972
+G1 X160.46 E566.7826
+G1 X160.1 Y110.1
+G1 Y124.9 E567.3979
+G1 X174.9 E568.0132
+G1 Y110.1 E568.6285
+G1 X160.1 E569.2438
+;layer #40
+G1 E568.51 F1800
+G1 X160.46 Y110.46 F9000
+G1 E569.24 F1800
+G1 Z10 F300
+G1 Y124.54 E569.8292 F3600
+G1 X174.54 E570.4146
+G1 Y110.46 E571
+G1 X160.46 E571.5853
+G1 X160.1 Y110.1
+G1 Y124.9 E572.2006
+G1 X174.9 E572.816
+G1 Y110.1 E573.4313
+G1 X160.1 E574.0466
+G1 E573.31 F1800
+G1 X74.54 Y110.46 F9000
+G1 E574.05 F1800
+G1 X60.46 E574.632 F3600
+G1 Y124.54 E575.2173
+G1 X74.54 E575.8027
+G1 Y110.46 E576.3881
+G1 X74.9 Y110.1
+G1 X60.1 E577.0034
+G1 Y124.9 E577.6187
+G1 X74.9 E578.234
+G1 Y110.1 E578.8493
+;layer #41
+G1 E578.12 F1800
+G1 X74.54 Y110.46 F9000
+G1 E578.85 F1800
+G1 Z10.25 F300
+G1 X60.46 E579.4347 F3600
+G1 Y124.54 E580.0201
+G1 X74.54 E580.6055
+G1 Y110.46 E581.1909
+G1 X74.9 Y110.1
+G1 X60.1 E581.8062
+G1 Y124.9 E582.4215
+G1 X74.9 E583.0368
+G1 Y110.1 E583.6521
+G1 E582.92 F1800
+G1 X160.46 Y110.46 F9000
+G1 E583.65 F1800
+G1 Y124.54 E584.2375 F3600
+G1 X174.54 E584.8229
+G1 Y110.46 E585.4082
+G1 X160.46 E585.9936
+G1 X160.1 Y110.1
+G1 Y124.9 E586.6089
+G1 X174.9 E587.2242
+G1 Y110.1 E587.8396
+G1 X160.1 E588.4549
+;layer #42
+G1 E587.72 F1800
+G1 X160.46 Y110.46 F9000
+G1 E588.45 F1800
+G1 Z10.5 F300
+G1 Y124.54 E589.0403 F3600
+G1 X174.54 E589.6256
+G1 Y110.46 E590.211
+G1 X160.46 E590.7964
+G1 X160.1 Y110.1
+G1 Y124.9 E591.4117
+G1 X174.9 E592.027
+G1 Y110.1 E592.6423
+G1 X160.1 E593.2576
+G1 E592.52 F1800
+G1 X74.54 Y110.46 F9000
+G1 E593.26 F1800
+G1 X60.46 E593.843 F3600
+G1 Y124.54 E594.4284
+G1 X74.54 E595.0138
+G1 Y110.46 E595.5991
+G1 X74.9 Y110.1
+G1 X60.1 E596.2145
+G1 Y124.9 E596.8298
+G1 X74.9 E597.4451
+G1 Y110.1 E598.0604
+;layer #43
+G1 E597.33 F1800
+G1 X74.54 Y110.46 F9000
+G1 E598.06 F1800
+G1 Z10.75 F300
+G1 X60.46 E598.6458 F3600
+G1 Y124.54 E599.2312
+G1 X74.54 E599.8165
+G1 Y110.46 E600.4019
+G1 X74.9 Y110.1
+G1 X60.1 E601.0172
+G1 Y124.9 E601.6325
+G1 X74.9 E602.2478
+G1 Y110.1 E602.8632
+G1 E602.13 F1800
+G1 X160.46 Y110.46 F9000
+G1 E602.86 F1800
+G1 Y124.54 E603.4485 F3600
+G1 X174.54 E604.0339
+G1 Y110.46 E604.6193
+G1 X160.46 E605.2047
+G1 X160.1 Y110.1
+G1 Y124.9 E605.82
+G1 X174.9 E606.4353
+G1 Y110.1 E607.0506
+G1 X160.1 E607.6659
+;layer #44
+G1 E606.93 F1800
+G1 X160.46 Y110.46 F9000
+G1 E607.67 F1800
+G1 Z11 F300
+G1 Y124.54 E608.2513 F3600
+G1 X174.54 E608.8367
+G1 Y110.46 E609.4221
+G1 X160.46 E610.0074
+G1 X160.1 Y110.1
+G1 Y124.9 E610.6228
+G1 X174.9 E611.2381
+G1 Y110.1 E611.8534
+G1 X160.1 E612.4687
+G1 E611.74 F1800
+G1 X74.54 Y110.46 F9000
+G1 E612.47 F1800
+G1 X60.46 E613.0541 F3600
+G1 Y124.54 E613.6394
+G1 X74.54 E614.2248
+G1 Y110.46 E614.8102
+G1 X74.9 Y110.1
+G1 X60.1 E615.4255
+G1 Y124.9 E616.0408
+G1 X74.9 E616.6561
+G1 Y110.1 E617.2715
+;layer #45
+G1 E616.54 F1800
+G1 X74.54 Y110.46 F9000
+G1 E617.27 F1800
+G1 Z11.25 F300
+G1 X60.46 E617.8568 F3600
+G1 Y124.54 E618.4422
+G1 X74.54 E619.0276
+G1 Y110.46 E619.613
+G1 X74.9 Y110.1
+G1 X60.1 E620.2283
+G1 Y124.9 E620.8436
+G1 X74.9 E621.4589
+G1 Y110.1 E622.0742
+G1 E621.34 F1800
+G1 X160.46 Y110.46 F9000
+G1 E622.07 F1800
+G1 Y124.54 E622.6596 F3600
+G1 X174.54 E623.245
+G1 Y110.46 E623.8304
+G1 X160.46 E624.4157
+G1 X160.1 Y110.1
+G1 Y124.9 E625.031
+G1 X174.9 E625.6464
+G1 Y110.1 E626.2617
+G1 X160.1 E626.877
+;layer #46
+G1 E626.14 F1800
+G1 X160.46 Y110.46 F9000
+G1 E626.88 F1800
+G1 Z11.5 F300
+G1 Y124.54 E627.4624 F3600
+G1 X174.54 E628.0477
+G1 Y110.46 E628.6331
+G1 X160.46 E629.2185
+G1 X160.1 Y110.1
+G1 Y124.9 E629.8338
+G1 X174.9 E630.4491
+G1 Y110.1 E631.0644
+G1 X160.1 E631.6797
+G1 E630.95 F1800
+G1 X74.54 Y110.46 F9000
+G1 E631.68 F1800
+G1 X60.46 E632.2651 F3600
+G1 Y124.54 E632.8505
+G1 X74.54 E633.4359
+G1 Y110.46 E634.0213
+G1 X74.9 Y110.1
+G1 X60.1 E634.6366
+G1 Y124.9 E635.2519
+G1 X74.9 E635.8672
+G1 Y110.1 E636.4825
+;layer #47
+G1 E635.75 F1800
+G1 X74.54 Y110.46 F9000
+G1 E636.48 F1800
+G1 Z11.75 F300
+G1 X60.46 E637.0679 F3600
+G1 Y124.54 E637.6533
+G1 X74.54 E638.2386
+G1 Y110.46 E638.824
+G1 X74.9 Y110.1
+G1 X60.1 E639.4393
+G1 Y124.9 E640.0546
+G1 X74.9 E640.67
+G1 Y110.1 E641.2853
+G1 E640.55 F1800
+G1 X160.46 Y110.46 F9000
+G1 E641.29 F1800
+G1 Y124.54 E641.8706 F3600
+G1 X174.54 E642.456
+G1 Y110.46 E643.0414
+G1 X160.46 E643.6268
+G1 X160.1 Y110.1
+G1 Y124.9 E644.2421
+G1 X174.9 E644.8574
+G1 Y110.1 E645.4727
+G1 X160.1 E646.088
+;layer #48
+G1 E645.35 F1800
+G1 X160.46 Y110.46 F9000
+G1 E646.09 F1800
+G1 Z12 F300
+G1 Y124.54 E646.6734 F3600
+G1 X174.54 E647.2588
+G1 Y110.46 E647.8442
+G1 X160.46 E648.4295
+G1 X160.1 Y110.1
+G1 Y124.9 E649.0449
+G1 X174.9 E649.6602
+G1 Y110.1 E650.2755
+G1 X160.1 E650.8908
+G1 E650.16 F1800
+G1 X74.54 Y110.46 F9000
+G1 E650.89 F1800
+G1 X60.46 E651.4762 F3600
+G1 Y124.54 E652.0616
+G1 X74.54 E652.6469
+G1 Y110.46 E653.2323
+G1 X74.9 Y110.1
+G1 X60.1 E653.8476
+G1 Y124.9 E654.4629
+G1 X74.9 E655.0782
+G1 Y110.1 E655.6936
+;layer #49
+G1 E655.05 F1800
+G1 X74.64 Y110.36 F9000
+G1 E655.69 F1800
+G1 Z12.25 F300
+G1 X60.36 E656.2873 F3600
+G1 Y124.64 E656.8809
+G1 X74.64 E657.4746
+G1 Y110.36 E658.0683
+G1 X75 Y110
+G1 X60 E658.692
+G1 Y125 E659.3156
+G1 X75 E659.9392
+G1 Y110 E660.5628
+G1 E659.92 F1800
+G1 X160.36 Y110.36 F9000
+G1 E660.56 F1800
+G1 Y124.64 E661.1565 F3600
+G1 X174.64 E661.7502
+G1 Y110.36 E662.3439
+G1 X160.36 E662.9376
+G1 X160 Y110
+G1 Y125 E663.5612
+G1 X175 E664.1849
+G1 Y110 E664.8085
+G1 X160 E665.4321
+;layer #50
+G1 E664.79 F1800
+G1 X160.46 Y110.46 F9000
+G1 E665.43 F1800
+G1 Z12.5 F300
+G1 Y124.54 E666.0175 F3600
+G1 X174.54 E666.6029
+G1 Y110.46 E667.1883
+G1 X160.46 E667.7736
+G1 X160.1 Y110.1
+G1 Y124.9 E668.389
+G1 X174.9 E669.0043
+G1 Y110.1 E669.6196
+G1 X160.1 E670.2349
+G1 E669.59 F1800
+G1 X74.54 Y110.46 F9000
+G1 E670.23 F1800
+G1 X60.46 E670.8203 F3600
+G1 Y124.54 E671.4056
+G1 X74.54 E671.991
+G1 Y110.46 E672.5764
+G1 X74.9 Y110.1
+G1 X60.1 E673.1917
+G1 Y124.9 E673.807
+G1 X74.9 E674.4223
+G1 Y110.1 E675.0377
+;layer #51
+G1 E674.39 F1800
+G1 X74.54 Y110.46 F9000
+G1 E675.04 F1800
+G1 Z12.75 F300
+G1 X60.46 E675.623 F3600
+G1 Y124.54 E676.2084
+G1 X74.54 E676.7938
+G1 Y110.46 E677.3792
+G1 X74.9 Y110.1
+G1 X60.1 E677.9945
+G1 Y124.9 E678.6098
+G1 X74.9 E679.2251
+G1 Y110.1 E679.8404
+G1 E679.2 F1800
+G1 X160.46 Y110.46 F9000
+G1 E679.84 F1800
+G1 Y124.54 E680.4258 F3600
+G1 X174.54 E681.0112
+G1 Y110.46 E681.5966
+G1 X160.46 E682.1819
+G1 X160.1 Y110.1
+G1 Y124.9 E682.7972
+G1 X174.9 E683.4126
+G1 Y110.1 E684.0279
+G1 X160.1 E684.6432
+;layer #52
+G1 E684 F1800
+G1 X160.46 Y110.46 F9000
+G1 E684.64 F1800
+G1 Z13 F300
+G1 Y124.54 E685.2286 F3600
+G1 X174.54 E685.8139
+G1 Y110.46 E686.3993
+G1 X160.46 E686.9847
+G1 X160.1 Y110.1
+G1 Y124.9 E687.6
+G1 X174.9 E688.2153
+G1 Y110.1 E688.8306
+G1 X160.1 E689.4459
+G1 E688.8 F1800
+G1 X74.54 Y110.46 F9000
+G1 E689.45 F1800
+G1 X60.46 E690.0313 F3600
+G1 Y124.54 E690.6167
+G1 X74.54 E691.2021
+G1 Y110.46 E691.7875
+G1 X74.9 Y110.1
+G1 X60.1 E692.4028
+G1 Y124.9 E693.0181
+G1 X74.9 E693.6334
+G1 Y110.1 E694.2487
+;layer #53
+G1 E693.6 F1800
+G1 X74.54 Y110.46 F9000
+G1 E694.25 F1800
+G1 Z13.25 F300
+G1 X60.46 E694.8341 F3600
+G1 Y124.54 E695.4195
+G1 X74.54 E696.0048
+G1 Y110.46 E696.5902
+G1 X74.9 Y110.1
+G1 X60.1 E697.2055
+G1 Y124.9 E697.8208
+G1 X74.9 E698.4362
+G1 Y110.1 E699.0515
+G1 E698.41 F1800
+G1 X160.46 Y110.46 F9000
+G1 E699.05 F1800
+G1 Y124.54 E699.6369 F3600
+G1 X174.54 E700.2222
+G1 Y110.46 E700.8076
+G1 X160.46 E701.393
+G1 X160.1 Y110.1
+G1 Y124.9 E702.0083
+G1 X174.9 E702.6236
+G1 Y110.1 E703.2389
+G1 X160.1 E703.8542
+;layer #54
+G1 E703.21 F1800
+G1 X160.46 Y110.46 F9000
+G1 E703.85 F1800
+G1 Z13.5 F300
+G1 Y124.54 E704.4396 F3600
+G1 X174.54 E705.025
+G1 Y110.46 E705.6104
+G1 X160.46 E706.1958
+G1 X160.1 Y110.1
+G1 Y124.9 E706.8111
+G1 X174.9 E707.4264
+G1 Y110.1 E708.0417
+G1 X160.1 E708.657
+G1 E708.01 F1800
+G1 X74.54 Y110.46 F9000
+G1 E708.66 F1800
+G1 X60.46 E709.2424 F3600
+G1 Y124.54 E709.8278
+G1 X74.54 E710.4131
+G1 Y110.46 E710.9985
+G1 X74.9 Y110.1
+G1 X60.1 E711.6138
+G1 Y124.9 E712.2291
+G1 X74.9 E712.8445
+G1 Y110.1 E713.4598
+;layer #55
+G1 E712.82 F1800
+G1 X74.54 Y110.46 F9000
+G1 E713.46 F1800
+G1 Z13.75 F300
+G1 X60.46 E714.0451 F3600
+G1 Y124.54 E714.6305
+G1 X74.54 E715.2159
+G1 Y110.46 E715.8013
+G1 X74.9 Y110.1
+G1 X60.1 E716.4166
+G1 Y124.9 E717.0319
+G1 X74.9 E717.6472
+G1 Y110.1 E718.2625
+G1 E717.62 F1800
+G1 X160.46 Y110.46 F9000
+G1 E718.26 F1800
+G1 Y124.54 E718.8479 F3600
+G1 X174.54 E719.4333
+G1 Y110.46 E720.0187
+G1 X160.46 E720.604
+G1 X160.1 Y110.1
+G1 Y124.9 E721.2194
+G1 X174.9 E721.8347
+G1 Y110.1 E722.45
+G1 X160.1 E723.0653
+;layer #56
+G1 E722.42 F1800
+G1 X160.46 Y110.46 F9000
+G1 E723.07 F1800
+G1 Z14 F300
+G1 Y124.54 E723.6507 F3600
+G1 X174.54 E724.236
+G1 Y110.46 E724.8214
+G1 X160.46 E725.4068
+G1 X160.1 Y110.1
+G1 Y124.9 E726.0221
+G1 X174.9 E726.6374
+G1 Y110.1 E727.2527
+G1 X160.1 E727.8681
+G1 E727.22 F1800
+G1 X74.54 Y110.46 F9000
+G1 E727.87 F1800
+G1 X60.46 E728.4534 F3600
+G1 Y124.54 E729.0388
+G1 X74.54 E729.6242
+G1 Y110.46 E730.2096
+G1 X74.9 Y110.1
+G1 X60.1 E730.8249
+G1 Y124.9 E731.4402
+G1 X74.9 E732.0555
+G1 Y110.1 E732.6708
+;layer #57
+G1 E732.03 F1800
+G1 X74.54 Y110.46 F9000
+G1 E732.67 F1800
+G1 Z14.25 F300
+G1 X60.46 E733.2562 F3600
+G1 Y124.54 E733.8416
+G1 X74.54 E734.427
+G1 Y110.46 E735.0123
+G1 X74.9 Y110.1
+G1 X60.1 E735.6276
+G1 Y124.9 E736.243
+G1 X74.9 E736.8583
+G1 Y110.1 E737.4736
+G1 E736.83 F1800
+G1 X160.46 Y110.46 F9000
+G1 E737.47 F1800
+G1 Y124.54 E738.059 F3600
+G1 X174.54 E738.6443
+G1 Y110.46 E739.2297
+G1 X160.46 E739.8151
+G1 X160.1 Y110.1
+G1 Y124.9 E740.4304
+G1 X174.9 E741.0457
+G1 Y110.1 E741.661
+G1 X160.1 E742.2763
+;layer #58
+G1 E741.63 F1800
+G1 X160.46 Y110.46 F9000
+G1 E742.28 F1800
+G1 Z14.5 F300
+G1 Y124.54 E742.8617 F3600
+G1 X174.54 E743.4471
+G1 Y110.46 E744.0325
+G1 X160.46 E744.6179
+G1 X160.1 Y110.1
+G1 Y124.9 E745.2332
+G1 X174.9 E745.8485
+G1 Y110.1 E746.4638
+G1 X160.1 E747.0791
+G1 E746.43 F1800
+G1 X74.54 Y110.46 F9000
+G1 E747.08 F1800
+G1 X60.46 E747.6645 F3600
+G1 Y124.54 E748.2499
+G1 X74.54 E748.8352
+G1 Y110.46 E749.4206
+G1 X74.9 Y110.1
+G1 X60.1 E750.0359
+G1 Y124.9 E750.6512
+G1 X74.9 E751.2666
+G1 Y110.1 E751.8819
+;layer #59
+G1 E751.24 F1800
+G1 X74.54 Y110.46 F9000
+G1 E751.88 F1800
+G1 Z14.75 F300
+G1 X60.46 E752.4672 F3600
+G1 Y124.54 E753.0526
+G1 X74.54 E753.638
+G1 Y110.46 E754.2234
+G1 X74.9 Y110.1
+G1 X60.1 E754.8387
+G1 Y124.9 E755.454
+G1 X74.9 E756.0693
+G1 Y110.1 E756.6846
+G1 E756.04 F1800
+G1 X160.46 Y110.46 F9000
+G1 E756.68 F1800
+G1 Y124.54 E757.27 F3600
+G1 X174.54 E757.8554
+G1 Y110.46 E758.4408
+G1 X160.46 E759.0261
+G1 X160.1 Y110.1
+G1 Y124.9 E759.6415
+G1 X174.9 E760.2568
+G1 Y110.1 E760.8721
+G1 X160.1 E761.4874
+;layer #60
+G1 E760.84 F1800
+G1 X160.46 Y110.46 F9000
+G1 E761.49 F1800
+G1 Z15 F300
+G1 Y124.54 E762.0728 F3600
+G1 X174.54 E762.6582
+G1 Y110.46 E763.2435
+G1 X160.46 E763.8289
+G1 X160.1 Y110.1
+G1 Y124.9 E764.4442
+G1 X174.9 E765.0595
+G1 Y110.1 E765.6748
+G1 X160.1 E766.2902
+G1 E765.65 F1800
+G1 X74.54 Y110.46 F9000
+G1 E766.29 F1800
+G1 X60.46 E766.8755 F3600
+G1 Y124.54 E767.4609
+G1 X74.54 E768.0463
+G1 Y110.46 E768.6317
+G1 X74.9 Y110.1
+G1 X60.1 E769.247
+G1 Y124.9 E769.8623
+G1 X74.9 E770.4776
+G1 Y110.1 E771.0929
+;layer #61
+G1 E770.54 F1800
+G1 X74.64 Y110.36 F9000
+G1 E771.09 F1800
+G1 Z15.25 F300
+G1 X60.36 E771.6866 F3600
+G1 Y124.64 E772.2803
+G1 X74.64 E772.874
+G1 Y110.36 E773.4677
+G1 X75 Y110
+G1 X60 E774.0913
+G1 Y125 E774.715
+G1 X75 E775.3386
+G1 Y110 E775.9622
+G1 E775.41 F1800
+G1 X160.36 Y110.36 F9000
+G1 E775.96 F1800
+G1 Y124.64 E776.5559 F3600
+G1 X174.64 E777.1496
+G1 Y110.36 E777.7433
+G1 X160.36 E778.337
+G1 X160 Y110
+G1 Y125 E778.9606
+G1 X175 E779.5842
+G1 Y110 E780.2079
+G1 X160 E780.8315
+;layer #62
+G1 E780.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E780.83 F1800
+G1 Z15.5 F300
+G1 Y124.54 E781.4169 F3600
+G1 X174.54 E782.0022
+G1 Y110.46 E782.5876
+G1 X160.46 E783.173
+G1 X160.1 Y110.1
+G1 Y124.9 E783.7883
+G1 X174.9 E784.4036
+G1 Y110.1 E785.0189
+G1 X160.1 E785.6343
+G1 E785.08 F1800
+G1 X74.54 Y110.46 F9000
+G1 E785.63 F1800
+G1 X60.46 E786.2196 F3600
+G1 Y124.54 E786.805
+G1 X74.54 E787.3904
+G1 Y110.46 E787.9758
+G1 X74.9 Y110.1
+G1 X60.1 E788.5911
+G1 Y124.9 E789.2064
+G1 X74.9 E789.8217
+G1 Y110.1 E790.437
+;layer #63
+G1 E789.88 F1800
+G1 X74.54 Y110.46 F9000
+G1 E790.44 F1800
+G1 Z15.75 F300
+G1 X60.46 E791.0224 F3600
+G1 Y124.54 E791.6078
+G1 X74.54 E792.1932
+G1 Y110.46 E792.7785
+G1 X74.9 Y110.1
+G1 X60.1 E793.3938
+G1 Y124.9 E794.0092
+G1 X74.9 E794.6245
+G1 Y110.1 E795.2398
+G1 E794.68 F1800
+G1 X160.46 Y110.46 F9000
+G1 E795.24 F1800
+G1 Y124.54 E795.8252 F3600
+G1 X174.54 E796.4105
+G1 Y110.46 E796.9959
+G1 X160.46 E797.5813
+G1 X160.1 Y110.1
+G1 Y124.9 E798.1966
+G1 X174.9 E798.8119
+G1 Y110.1 E799.4272
+G1 X160.1 E800.0425
+;layer #64
+G1 E799.49 F1800
+G1 X160.46 Y110.46 F9000
+G1 E800.04 F1800
+G1 Z16 F300
+G1 Y124.54 E800.6279 F3600
+G1 X174.54 E801.2133
+G1 Y110.46 E801.7987
+G1 X160.46 E802.3841
+G1 X160.1 Y110.1
+G1 Y124.9 E802.9994
+G1 X174.9 E803.6147
+G1 Y110.1 E804.23
+G1 X160.1 E804.8453
+G1 E804.29 F1800
+G1 X74.54 Y110.46 F9000
+G1 E804.85 F1800
+G1 X60.46 E805.4307 F3600
+G1 Y124.54 E806.0161
+G1 X74.54 E806.6014
+G1 Y110.46 E807.1868
+G1 X74.9 Y110.1
+G1 X60.1 E807.8021
+G1 Y124.9 E808.4174
+G1 X74.9 E809.0328
+G1 Y110.1 E809.6481
+;layer #65
+G1 E809.09 F1800
+G1 X74.54 Y110.46 F9000
+G1 E809.65 F1800
+G1 Z16.25 F300
+G1 X60.46 E810.2335 F3600
+G1 Y124.54 E810.8188
+G1 X74.54 E811.4042
+G1 Y110.46 E811.9896
+G1 X74.9 Y110.1
+G1 X60.1 E812.6049
+G1 Y124.9 E813.2202
+G1 X74.9 E813.8355
+G1 Y110.1 E814.4508
+G1 E813.9 F1800
+G1 X160.46 Y110.46 F9000
+G1 E814.45 F1800
+G1 Y124.54 E815.0362 F3600
+G1 X174.54 E815.6216
+G1 Y110.46 E816.207
+G1 X160.46 E816.7924
+G1 X160.1 Y110.1
+G1 Y124.9 E817.4077
+G1 X174.9 E818.023
+G1 Y110.1 E818.6383
+G1 X160.1 E819.2536
+;layer #66
+G1 E818.7 F1800
+G1 X160.46 Y110.46 F9000
+G1 E819.25 F1800
+G1 Z16.5 F300
+G1 Y124.54 E819.839 F3600
+G1 X174.54 E820.4244
+G1 Y110.46 E821.0097
+G1 X160.46 E821.5951
+G1 X160.1 Y110.1
+G1 Y124.9 E822.2104
+G1 X174.9 E822.8257
+G1 Y110.1 E823.4411
+G1 X160.1 E824.0564
+G1 E823.5 F1800
+G1 X74.54 Y110.46 F9000
+G1 E824.06 F1800
+G1 X60.46 E824.6417 F3600
+G1 Y124.54 E825.2271
+G1 X74.54 E825.8125
+G1 Y110.46 E826.3979
+G1 X74.9 Y110.1
+G1 X60.1 E827.0132
+G1 Y124.9 E827.6285
+G1 X74.9 E828.2438
+G1 Y110.1 E828.8591
+;layer #67
+G1 E828.3 F1800
+G1 X74.54 Y110.46 F9000
+G1 E828.86 F1800
+G1 Z16.75 F300
+G1 X60.46 E829.4445 F3600
+G1 Y124.54 E830.0299
+G1 X74.54 E830.6153
+G1 Y110.46 E831.2006
+G1 X74.9 Y110.1
+G1 X60.1 E831.816
+G1 Y124.9 E832.4313
+G1 X74.9 E833.0466
+G1 Y110.1 E833.6619
+G1 E833.11 F1800
+G1 X160.46 Y110.46 F9000
+G1 E833.66 F1800
+G1 Y124.54 E834.2473 F3600
+G1 X174.54 E834.8326
+G1 Y110.46 E835.418
+G1 X160.46 E836.0034
+G1 X160.1 Y110.1
+G1 Y124.9 E836.6187
+G1 X174.9 E837.234
+G1 Y110.1 E837.8493
+G1 X160.1 E838.4647
+;layer #68
+G1 E837.91 F1800
+G1 X160.46 Y110.46 F9000
+G1 E838.46 F1800
+G1 Z17 F300
+G1 Y124.54 E839.05 F3600
+G1 X174.54 E839.6354
+G1 Y110.46 E840.2208
+G1 X160.46 E840.8062
+G1 X160.1 Y110.1
+G1 Y124.9 E841.4215
+G1 X174.9 E842.0368
+G1 Y110.1 E842.6521
+G1 X160.1 E843.2674
+G1 E842.71 F1800
+G1 X74.54 Y110.46 F9000
+G1 E843.27 F1800
+G1 X60.46 E843.8528 F3600
+G1 Y124.54 E844.4382
+G1 X74.54 E845.0236
+G1 Y110.46 E845.6089
+G1 X74.9 Y110.1
+G1 X60.1 E846.2242
+G1 Y124.9 E846.8396
+G1 X74.9 E847.4549
+G1 Y110.1 E848.0702
+;layer #69
+G1 E847.51 F1800
+G1 X74.54 Y110.46 F9000
+G1 E848.07 F1800
+G1 Z17.25 F300
+G1 X60.46 E848.6556 F3600
+G1 Y124.54 E849.2409
+G1 X74.54 E849.8263
+G1 Y110.46 E850.4117
+G1 X74.9 Y110.1
+G1 X60.1 E851.027
+G1 Y124.9 E851.6423
+G1 X74.9 E852.2576
+G1 Y110.1 E852.8729
+G1 E852.32 F1800
+G1 X160.46 Y110.46 F9000
+G1 E852.87 F1800
+G1 Y124.54 E853.4583 F3600
+G1 X174.54 E854.0437
+G1 Y110.46 E854.6291
+G1 X160.46 E855.2145
+G1 X160.1 Y110.1
+G1 Y124.9 E855.8298
+G1 X174.9 E856.4451
+G1 Y110.1 E857.0604
+G1 X160.1 E857.6757
+;layer #70
+G1 E857.12 F1800
+G1 X160.46 Y110.46 F9000
+G1 E857.68 F1800
+G1 Z17.5 F300
+G1 Y124.54 E858.2611 F3600
+G1 X174.54 E858.8465
+G1 Y110.46 E859.4318
+G1 X160.46 E860.0172
+G1 X160.1 Y110.1
+G1 Y124.9 E860.6325
+G1 X174.9 E861.2478
+G1 Y110.1 E861.8632
+G1 X160.1 E862.4785
+G1 E861.92 F1800
+G1 X74.54 Y110.46 F9000
+G1 E862.48 F1800
+G1 X60.46 E863.0639 F3600
+G1 Y124.54 E863.6492
+G1 X74.54 E864.2346
+G1 Y110.46 E864.82
+G1 X74.9 Y110.1
+G1 X60.1 E865.4353
+G1 Y124.9 E866.0506
+G1 X74.9 E866.6659
+G1 Y110.1 E867.2812
+;layer #71
+G1 E866.73 F1800
+G1 X74.54 Y110.46 F9000
+G1 E867.28 F1800
+G1 Z17.75 F300
+G1 X60.46 E867.8666 F3600
+G1 Y124.54 E868.452
+G1 X74.54 E869.0374
+G1 Y110.46 E869.6227
+G1 X74.9 Y110.1
+G1 X60.1 E870.2381
+G1 Y124.9 E870.8534
+G1 X74.9 E871.4687
+G1 Y110.1 E872.084
+G1 E871.53 F1800
+G1 X160.46 Y110.46 F9000
+G1 E872.08 F1800
+G1 Y124.54 E872.6694 F3600
+G1 X174.54 E873.2548
+G1 Y110.46 E873.8401
+G1 X160.46 E874.4255
+G1 X160.1 Y110.1
+G1 Y124.9 E875.0408
+G1 X174.9 E875.6561
+G1 Y110.1 E876.2715
+G1 X160.1 E876.8868
+;layer #72
+G1 E876.33 F1800
+G1 X160.46 Y110.46 F9000
+G1 E876.89 F1800
+G1 Z18 F300
+G1 Y124.54 E877.4721 F3600
+G1 X174.54 E878.0575
+G1 Y110.46 E878.6429
+G1 X160.46 E879.2283
+G1 X160.1 Y110.1
+G1 Y124.9 E879.8436
+G1 X174.9 E880.4589
+G1 Y110.1 E881.0742
+G1 X160.1 E881.6895
+G1 E881.13 F1800
+G1 X74.54 Y110.46 F9000
+G1 E881.69 F1800
+G1 X60.46 E882.2749 F3600
+G1 Y124.54 E882.8603
+G1 X74.54 E883.4457
+G1 Y110.46 E884.031
+G1 X74.9 Y110.1
+G1 X60.1 E884.6464
+G1 Y124.9 E885.2617
+G1 X74.9 E885.877
+G1 Y110.1 E886.4923
+;layer #73
+G1 E886.03 F1800
+G1 X74.64 Y110.36 F9000
+G1 E886.49 F1800
+G1 Z18.25 F300
+G1 X60.36 E887.086 F3600
+G1 Y124.64 E887.6797
+G1 X74.64 E888.2734
+G1 Y110.36 E888.8671
+G1 X75 Y110
+G1 X60 E889.4907
+G1 Y125 E890.1143
+G1 X75 E890.7379
+G1 Y110 E891.3616
+G1 E890.89 F1800
+G1 X160.36 Y110.36 F9000
+G1 E891.36 F1800
+G1 Y124.64 E891.9553 F3600
+G1 X174.64 E892.549
+G1 Y110.36 E893.1427
+G1 X160.36 E893.7363
+G1 X160 Y110
+G1 Y125 E894.36
+G1 X175 E894.9836
+G1 Y110 E895.6072
+G1 X160 E896.2309
+;layer #74
+G1 E895.76 F1800
+G1 X160.46 Y110.46 F9000
+G1 E896.23 F1800
+G1 Z18.5 F300
+G1 Y124.54 E896.8162 F3600
+G1 X174.54 E897.4016
+G1 Y110.46 E897.987
+G1 X160.46 E898.5724
+G1 X160.1 Y110.1
+G1 Y124.9 E899.1877
+G1 X174.9 E899.803
+G1 Y110.1 E900.4183
+G1 X160.1 E901.0336
+G1 E900.57 F1800
+G1 X74.54 Y110.46 F9000
+G1 E901.03 F1800
+G1 X60.46 E901.619 F3600
+G1 Y124.54 E902.2044
+G1 X74.54 E902.7898
+G1 Y110.46 E903.3751
+G1 X74.9 Y110.1
+G1 X60.1 E903.9904
+G1 Y124.9 E904.6058
+G1 X74.9 E905.2211
+G1 Y110.1 E905.8364
+;layer #75
+G1 E905.37 F1800
+G1 X74.54 Y110.46 F9000
+G1 E905.84 F1800
+G1 Z18.75 F300
+G1 X60.46 E906.4218 F3600
+G1 Y124.54 E907.0071
+G1 X74.54 E907.5925
+G1 Y110.46 E908.1779
+G1 X74.9 Y110.1
+G1 X60.1 E908.7932
+G1 Y124.9 E909.4085
+G1 X74.9 E910.0238
+G1 Y110.1 E910.6391
+G1 E910.17 F1800
+G1 X160.46 Y110.46 F9000
+G1 E910.64 F1800
+G1 Y124.54 E911.2245 F3600
+G1 X174.54 E911.8099
+G1 Y110.46 E912.3953
+G1 X160.46 E912.9807
+G1 X160.1 Y110.1
+G1 Y124.9 E913.596
+G1 X174.9 E914.2113
+G1 Y110.1 E914.8266
+G1 X160.1 E915.4419
+;layer #76
+G1 E914.98 F1800
+G1 X160.46 Y110.46 F9000
+G1 E915.44 F1800
+G1 Z19 F300
+G1 Y124.54 E916.0273 F3600
+G1 X174.54 E916.6127
+G1 Y110.46 E917.198
+G1 X160.46 E917.7834
+G1 X160.1 Y110.1
+G1 Y124.9 E918.3987
+G1 X174.9 E919.0141
+G1 Y110.1 E919.6294
+G1 X160.1 E920.2447
+G1 E919.78 F1800
+G1 X74.54 Y110.46 F9000
+G1 E920.24 F1800
+G1 X60.46 E920.8301 F3600
+G1 Y124.54 E921.4154
+G1 X74.54 E922.0008
+G1 Y110.46 E922.5862
+G1 X74.9 Y110.1
+G1 X60.1 E923.2015
+G1 Y124.9 E923.8168
+G1 X74.9 E924.4321
+G1 Y110.1 E925.0474
+;layer #77
+G1 E924.58 F1800
+G1 X74.54 Y110.46 F9000
+G1 E925.05 F1800
+G1 Z19.25 F300
+G1 X60.46 E925.6328 F3600
+G1 Y124.54 E926.2182
+G1 X74.54 E926.8036
+G1 Y110.46 E927.389
+G1 X74.9 Y110.1
+G1 X60.1 E928.0043
+G1 Y124.9 E928.6196
+G1 X74.9 E929.2349
+G1 Y110.1 E929.8502
+G1 E929.38 F1800
+G1 X160.46 Y110.46 F9000
+G1 E929.85 F1800
+G1 Y124.54 E930.4356 F3600
+G1 X174.54 E931.021
+G1 Y110.46 E931.6063
+G1 X160.46 E932.1917
+G1 X160.1 Y110.1
+G1 Y124.9 E932.807
+G1 X174.9 E933.4223
+G1 Y110.1 E934.0377
+G1 X160.1 E934.653
+;layer #78
+G1 E934.19 F1800
+G1 X160.46 Y110.46 F9000
+G1 E934.65 F1800
+G1 Z19.5 F300
+G1 Y124.54 E935.2383 F3600
+G1 X174.54 E935.8237
+G1 Y110.46 E936.4091
+G1 X160.46 E936.9945
+G1 X160.1 Y110.1
+G1 Y124.9 E937.6098
+G1 X174.9 E938.2251
+G1 Y110.1 E938.8404
+G1 X160.1 E939.4557
+G1 E938.99 F1800
+G1 X74.54 Y110.46 F9000
+G1 E939.46 F1800
+G1 X60.46 E940.0411 F3600
+G1 Y124.54 E940.6265
+G1 X74.54 E941.2119
+G1 Y110.46 E941.7972
+G1 X74.9 Y110.1
+G1 X60.1 E942.4126
+G1 Y124.9 E943.0279
+G1 X74.9 E943.6432
+G1 Y110.1 E944.2585
+;layer #79
+G1 E943.79 F1800
+G1 X74.54 Y110.46 F9000
+G1 E944.26 F1800
+G1 Z19.75 F300
+G1 X60.46 E944.8439 F3600
+G1 Y124.54 E945.4292
+G1 X74.54 E946.0146
+G1 Y110.46 E946.6
+G1 X74.9 Y110.1
+G1 X60.1 E947.2153
+G1 Y124.9 E947.8306
+G1 X74.9 E948.4459
+G1 Y110.1 E949.0613
+G1 E948.59 F1800
+G1 X160.46 Y110.46 F9000
+G1 E949.06 F1800
+G1 Y124.54 E949.6466 F3600
+G1 X174.54 E950.232
+G1 Y110.46 E950.8174
+G1 X160.46 E951.4028
+G1 X160.1 Y110.1
+G1 Y124.9 E952.0181
+G1 X174.9 E952.6334
+G1 Y110.1 E953.2487
+G1 X160.1 E953.864
+;layer #80
+G1 E953.4 F1800
+G1 X160.46 Y110.46 F9000
+G1 E953.86 F1800
+G1 Z20 F300
+G1 Y124.54 E954.4494 F3600
+G1 X174.54 E955.0348
+G1 Y110.46 E955.6202
+G1 X160.46 E956.2055
+G1 X160.1 Y110.1
+G1 Y124.9 E956.8208
+G1 X174.9 E957.4362
+G1 Y110.1 E958.0515
+G1 X160.1 E958.6668
+G1 E958.2 F1800
+G1 X74.54 Y110.46 F9000
+G1 E958.67 F1800
+G1 X60.46 E959.2522 F3600
+G1 Y124.54 E959.8375
+G1 X74.54 E960.4229
+G1 Y110.46 E961.0083
+G1 X74.9 Y110.1
+G1 X60.1 E961.6236
+G1 Y124.9 E962.2389
+G1 X74.9 E962.8542
+G1 Y110.1 E963.4695
+;layer #81
+G1 E963 F1800
+G1 X74.54 Y110.46 F9000
+G1 E963.47 F1800
+G1 Z20.25 F300
+G1 X60.46 E964.0549 F3600
+G1 Y124.54 E964.6403
+G1 X74.54 E965.2257
+G1 Y110.46 E965.8111
+G1 X74.9 Y110.1
+G1 X60.1 E966.4264
+G1 Y124.9 E967.0417
+G1 X74.9 E967.657
+G1 Y110.1 E968.2723
+G1 E967.81 F1800
+G1 X160.46 Y110.46 F9000
+G1 E968.27 F1800
+G1 Y124.54 E968.8577 F3600
+G1 X174.54 E969.4431
+G1 Y110.46 E970.0284
+G1 X160.46 E970.6138
+G1 X160.1 Y110.1
+G1 Y124.9 E971.2291
+G1 X174.9 E971.8444
+G1 Y110.1 E972.4598
+G1 X160.1 E973.0751
+;layer #82
+G1 E972.61 F1800
+G1 X160.46 Y110.46 F9000
+G1 E973.08 F1800
+G1 Z20.5 F300
+G1 Y124.54 E973.6605 F3600
+G1 X174.54 E974.2458
+G1 Y110.46 E974.8312
+G1 X160.46 E975.4166
+G1 X160.1 Y110.1
+G1 Y124.9 E976.0319
+G1 X174.9 E976.6472
+G1 Y110.1 E977.2625
+G1 X160.1 E977.8778
+G1 E977.41 F1800
+G1 X74.54 Y110.46 F9000
+G1 E977.88 F1800
+G1 X60.46 E978.4632 F3600
+G1 Y124.54 E979.0486
+G1 X74.54 E979.634
+G1 Y110.46 E980.2194
+G1 X74.9 Y110.1
+G1 X60.1 E980.8347
+G1 Y124.9 E981.45
+G1 X74.9 E982.0653
+G1 Y110.1 E982.6806
+;layer #83
+G1 E982.21 F1800
+G1 X74.54 Y110.46 F9000
+G1 E982.68 F1800
+G1 Z20.75 F300
+G1 X60.46 E983.266 F3600
+G1 Y124.54 E983.8514
+G1 X74.54 E984.4367
+G1 Y110.46 E985.0221
+G1 X74.9 Y110.1
+G1 X60.1 E985.6374
+G1 Y124.9 E986.2527
+G1 X74.9 E986.8681
+G1 Y110.1 E987.4834
+G1 E987.02 F1800
+G1 X160.46 Y110.46 F9000
+G1 E987.48 F1800
+G1 Y124.54 E988.0687 F3600
+G1 X174.54 E988.6541
+G1 Y110.46 E989.2395
+G1 X160.46 E989.8249
+G1 X160.1 Y110.1
+G1 Y124.9 E990.4402
+G1 X174.9 E991.0555
+G1 Y110.1 E991.6708
+G1 X160.1 E992.2861
+;layer #84
+G1 E991.82 F1800
+G1 X160.46 Y110.46 F9000
+G1 E992.29 F1800
+G1 Z21 F300
+G1 Y124.54 E992.8715 F3600
+G1 X174.54 E993.4569
+G1 Y110.46 E994.0423
+G1 X160.46 E994.6276
+G1 X160.1 Y110.1
+G1 Y124.9 E995.243
+G1 X174.9 E995.8583
+G1 Y110.1 E996.4736
+G1 X160.1 E997.0889
+G1 E996.62 F1800
+G1 X74.54 Y110.46 F9000
+G1 E997.09 F1800
+G1 X60.46 E997.6743 F3600
+G1 Y124.54 E998.2596
+G1 X74.54 E998.845
+G1 Y110.46 E999.4304
+G1 X74.9 Y110.1
+G1 X60.1 E1000.0457
+G1 Y124.9 E1000.661
+G1 X74.9 E1001.2763
+G1 Y110.1 E1001.8917
+;layer #85
+G1 E1001.51 F1800
+G1 X74.64 Y110.36 F9000
+G1 E1001.89 F1800
+G1 Z21.25 F300
+G1 X60.36 E1002.4853 F3600
+G1 Y124.64 E1003.079
+G1 X74.64 E1003.6727
+G1 Y110.36 E1004.2664
+G1 X75 Y110
+G1 X60 E1004.8901
+G1 Y125 E1005.5137
+G1 X75 E1006.1373
+G1 Y110 E1006.7609
+G1 E1006.38 F1800
+G1 X160.36 Y110.36 F9000
+G1 E1006.76 F1800
+G1 Y124.64 E1007.3546 F3600
+G1 X174.64 E1007.9483
+G1 Y110.36 E1008.542
+G1 X160.36 E1009.1357
+G1 X160 Y110
+G1 Y125 E1009.7593
+G1 X175 E1010.383
+G1 Y110 E1011.0066
+G1 X160 E1011.6302
+;layer #86
+G1 E1011.25 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1011.63 F1800
+G1 Z21.5 F300
+G1 Y124.54 E1012.2156 F3600
+G1 X174.54 E1012.801
+G1 Y110.46 E1013.3864
+G1 X160.46 E1013.9717
+G1 X160.1 Y110.1
+G1 Y124.9 E1014.587
+G1 X174.9 E1015.2024
+G1 Y110.1 E1015.8177
+G1 X160.1 E1016.433
+G1 E1016.06 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1016.43 F1800
+G1 X60.46 E1017.0184 F3600
+G1 Y124.54 E1017.6037
+G1 X74.54 E1018.1891
+G1 Y110.46 E1018.7745
+G1 X74.9 Y110.1
+G1 X60.1 E1019.3898
+G1 Y124.9 E1020.0051
+G1 X74.9 E1020.6204
+G1 Y110.1 E1021.2357
+;layer #87
+G1 E1020.86 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1021.24 F1800
+G1 Z21.75 F300
+G1 X60.46 E1021.8211 F3600
+G1 Y124.54 E1022.4065
+G1 X74.54 E1022.9919
+G1 Y110.46 E1023.5773
+G1 X74.9 Y110.1
+G1 X60.1 E1024.1926
+G1 Y124.9 E1024.8079
+G1 X74.9 E1025.4232
+G1 Y110.1 E1026.0385
+G1 E1025.66 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1026.04 F1800
+G1 Y124.54 E1026.6239 F3600
+G1 X174.54 E1027.2093
+G1 Y110.46 E1027.7946
+G1 X160.46 E1028.38
+G1 X160.1 Y110.1
+G1 Y124.9 E1028.9953
+G1 X174.9 E1029.6107
+G1 Y110.1 E1030.226
+G1 X160.1 E1030.8413
+;layer #88
+G1 E1030.46 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1030.84 F1800
+G1 Z22 F300
+G1 Y124.54 E1031.4267 F3600
+G1 X174.54 E1032.012
+G1 Y110.46 E1032.5974
+G1 X160.46 E1033.1828
+G1 X160.1 Y110.1
+G1 Y124.9 E1033.7981
+G1 X174.9 E1034.4134
+G1 Y110.1 E1035.0287
+G1 X160.1 E1035.644
+G1 E1035.27 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1035.64 F1800
+G1 X60.46 E1036.2294 F3600
+G1 Y124.54 E1036.8148
+G1 X74.54 E1037.4002
+G1 Y110.46 E1037.9856
+G1 X74.9 Y110.1
+G1 X60.1 E1038.6009
+G1 Y124.9 E1039.2162
+G1 X74.9 E1039.8315
+G1 Y110.1 E1040.4468
+;layer #89
+G1 E1040.07 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1040.45 F1800
+G1 Z22.25 F300
+G1 X60.46 E1041.0322 F3600
+G1 Y124.54 E1041.6176
+G1 X74.54 E1042.2029
+G1 Y110.46 E1042.7883
+G1 X74.9 Y110.1
+G1 X60.1 E1043.4036
+G1 Y124.9 E1044.0189
+G1 X74.9 E1044.6343
+G1 Y110.1 E1045.2496
+G1 E1044.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1045.25 F1800
+G1 Y124.54 E1045.8349 F3600
+G1 X174.54 E1046.4203
+G1 Y110.46 E1047.0057
+G1 X160.46 E1047.5911
+G1 X160.1 Y110.1
+G1 Y124.9 E1048.2064
+G1 X174.9 E1048.8217
+G1 Y110.1 E1049.437
+G1 X160.1 E1050.0523
+;layer #90
+G1 E1049.67 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1050.05 F1800
+G1 Z22.5 F300
+G1 Y124.54 E1050.6377 F3600
+G1 X174.54 E1051.2231
+G1 Y110.46 E1051.8085
+G1 X160.46 E1052.3938
+G1 X160.1 Y110.1
+G1 Y124.9 E1053.0092
+G1 X174.9 E1053.6245
+G1 Y110.1 E1054.2398
+G1 X160.1 E1054.8551
+G1 E1054.48 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1054.86 F1800
+G1 X60.46 E1055.4405 F3600
+G1 Y124.54 E1056.0259
+G1 X74.54 E1056.6112
+G1 Y110.46 E1057.1966
+G1 X74.9 Y110.1
+G1 X60.1 E1057.8119
+G1 Y124.9 E1058.4272
+G1 X74.9 E1059.0425
+G1 Y110.1 E1059.6579
+;layer #91
+G1 E1059.28 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1059.66 F1800
+G1 Z22.75 F300
+G1 X60.46 E1060.2432 F3600
+G1 Y124.54 E1060.8286
+G1 X74.54 E1061.414
+G1 Y110.46 E1061.9994
+G1 X74.9 Y110.1
+G1 X60.1 E1062.6147
+G1 Y124.9 E1063.23
+G1 X74.9 E1063.8453
+G1 Y110.1 E1064.4606
+G1 E1064.08 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1064.46 F1800
+G1 Y124.54 E1065.046 F3600
+G1 X174.54 E1065.6314
+G1 Y110.46 E1066.2168
+G1 X160.46 E1066.8021
+G1 X160.1 Y110.1
+G1 Y124.9 E1067.4174
+G1 X174.9 E1068.0328
+G1 Y110.1 E1068.6481
+G1 X160.1 E1069.2634
+;layer #92
+G1 E1068.89 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1069.26 F1800
+G1 Z23 F300
+G1 Y124.54 E1069.8488 F3600
+G1 X174.54 E1070.4341
+G1 Y110.46 E1071.0195
+G1 X160.46 E1071.6049
+G1 X160.1 Y110.1
+G1 Y124.9 E1072.2202
+G1 X174.9 E1072.8355
+G1 Y110.1 E1073.4508
+G1 X160.1 E1074.0661
+G1 E1073.69 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1074.07 F1800
+G1 X60.46 E1074.6515 F3600
+G1 Y124.54 E1075.2369
+G1 X74.54 E1075.8223
+G1 Y110.46 E1076.4077
+G1 X74.9 Y110.1
+G1 X60.1 E1077.023
+G1 Y124.9 E1077.6383
+G1 X74.9 E1078.2536
+G1 Y110.1 E1078.8689
+;layer #93
+G1 E1078.49 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1078.87 F1800
+G1 Z23.25 F300
+G1 X60.46 E1079.4543 F3600
+G1 Y124.54 E1080.0397
+G1 X74.54 E1080.625
+G1 Y110.46 E1081.2104
+G1 X74.9 Y110.1
+G1 X60.1 E1081.8257
+G1 Y124.9 E1082.441
+G1 X74.9 E1083.0564
+G1 Y110.1 E1083.6717
+G1 E1083.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1083.67 F1800
+G1 Y124.54 E1084.2571 F3600
+G1 X174.54 E1084.8424
+G1 Y110.46 E1085.4278
+G1 X160.46 E1086.0132
+G1 X160.1 Y110.1
+G1 Y124.9 E1086.6285
+G1 X174.9 E1087.2438
+G1 Y110.1 E1087.8591
+G1 X160.1 E1088.4744
+;layer #94
+G1 E1088.1 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1088.47 F1800
+G1 Z23.5 F300
+G1 Y124.54 E1089.0598 F3600
+G1 X174.54 E1089.6452
+G1 Y110.46 E1090.2306
+G1 X160.46 E1090.816
+G1 X160.1 Y110.1
+G1 Y124.9 E1091.4313
+G1 X174.9 E1092.0466
+G1 Y110.1 E1092.6619
+G1 X160.1 E1093.2772
+G1 E1092.9 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1093.28 F1800
+G1 X60.46 E1093.8626 F3600
+G1 Y124.54 E1094.448
+G1 X74.54 E1095.0333
+G1 Y110.46 E1095.6187
+G1 X74.9 Y110.1
+G1 X60.1 E1096.234
+G1 Y124.9 E1096.8493
+G1 X74.9 E1097.4647
+G1 Y110.1 E1098.08
+;layer #95
+G1 E1097.7 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1098.08 F1800
+G1 Z23.75 F300
+G1 X60.46 E1098.6653 F3600
+G1 Y124.54 E1099.2507
+G1 X74.54 E1099.8361
+G1 Y110.46 E1100.4215
+G1 X74.9 Y110.1
+G1 X60.1 E1101.0368
+G1 Y124.9 E1101.6521
+G1 X74.9 E1102.2674
+G1 Y110.1 E1102.8827
+G1 E1102.5 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1102.88 F1800
+G1 Y124.54 E1103.4681 F3600
+G1 X174.54 E1104.0535
+G1 Y110.46 E1104.6389
+G1 X160.46 E1105.2242
+G1 X160.1 Y110.1
+G1 Y124.9 E1105.8396
+G1 X174.9 E1106.4549
+G1 Y110.1 E1107.0702
+G1 X160.1 E1107.6855
+;layer #96
+G1 E1107.31 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1107.69 F1800
+G1 Z24 F300
+G1 Y124.54 E1108.2709 F3600
+G1 X174.54 E1108.8562
+G1 Y110.46 E1109.4416
+G1 X160.46 E1110.027
+G1 X160.1 Y110.1
+G1 Y124.9 E1110.6423
+G1 X174.9 E1111.2576
+G1 Y110.1 E1111.8729
+G1 X160.1 E1112.4883
+G1 E1112.11 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1112.49 F1800
+G1 X60.46 E1113.0736 F3600
+G1 Y124.54 E1113.659
+G1 X74.54 E1114.2444
+G1 Y110.46 E1114.8298
+G1 X74.9 Y110.1
+G1 X60.1 E1115.4451
+G1 Y124.9 E1116.0604
+G1 X74.9 E1116.6757
+G1 Y110.1 E1117.291
+;layer #97
+G1 E1117 F1800
+G1 X74.64 Y110.36 F9000
+G1 E1117.29 F1800
+G1 Z24.25 F300
+G1 X60.36 E1117.8847 F3600
+G1 Y124.64 E1118.4784
+G1 X74.64 E1119.0721
+G1 Y110.36 E1119.6658
+G1 X75 Y110
+G1 X60 E1120.2894
+G1 Y125 E1120.913
+G1 X75 E1121.5367
+G1 Y110 E1122.1603
+G1 E1121.87 F1800
+G1 X160.36 Y110.36 F9000
+G1 E1122.16 F1800
+G1 Y124.64 E1122.754 F3600
+G1 X174.64 E1123.3477
+G1 Y110.36 E1123.9414
+G1 X160.36 E1124.5351
+G1 X160 Y110
+G1 Y125 E1125.1587
+G1 X175 E1125.7823
+G1 Y110 E1126.406
+G1 X160 E1127.0296
+;layer #98
+G1 E1126.74 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1127.03 F1800
+G1 Z24.5 F300
+G1 Y124.54 E1127.615 F3600
+G1 X174.54 E1128.2003
+G1 Y110.46 E1128.7857
+G1 X160.46 E1129.3711
+G1 X160.1 Y110.1
+G1 Y124.9 E1129.9864
+G1 X174.9 E1130.6017
+G1 Y110.1 E1131.217
+G1 X160.1 E1131.8324
+G1 E1131.54 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1131.83 F1800
+G1 X60.46 E1132.4177 F3600
+G1 Y124.54 E1133.0031
+G1 X74.54 E1133.5885
+G1 Y110.46 E1134.1739
+G1 X74.9 Y110.1
+G1 X60.1 E1134.7892
+G1 Y124.9 E1135.4045
+G1 X74.9 E1136.0198
+G1 Y110.1 E1136.6351
+;layer #99
+G1 E1136.35 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1136.64 F1800
+G1 Z24.75 F300
+G1 X60.46 E1137.2205 F3600
+G1 Y124.54 E1137.8059
+G1 X74.54 E1138.3912
+G1 Y110.46 E1138.9766
+G1 X74.9 Y110.1
+G1 X60.1 E1139.5919
+G1 Y124.9 E1140.2073
+G1 X74.9 E1140.8226
+G1 Y110.1 E1141.4379
+G1 E1141.15 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1141.44 F1800
+G1 Y124.54 E1142.0233 F3600
+G1 X174.54 E1142.6086
+G1 Y110.46 E1143.194
+G1 X160.46 E1143.7794
+G1 X160.1 Y110.1
+G1 Y124.9 E1144.3947
+G1 X174.9 E1145.01
+G1 Y110.1 E1145.6253
+G1 X160.1 E1146.2406
+;layer #100
+G1 E1145.95 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1146.24 F1800
+G1 Z25 F300
+G1 Y124.54 E1146.826 F3600
+G1 X174.54 E1147.4114
+G1 Y110.46 E1147.9968
+G1 X160.46 E1148.5822
+G1 X160.1 Y110.1
+G1 Y124.9 E1149.1975
+G1 X174.9 E1149.8128
+G1 Y110.1 E1150.4281
+G1 X160.1 E1151.0434
+G1 E1150.75 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1151.04 F1800
+G1 X60.46 E1151.6288 F3600
+G1 Y124.54 E1152.2142
+G1 X74.54 E1152.7995
+G1 Y110.46 E1153.3849
+G1 X74.9 Y110.1
+G1 X60.1 E1154.0002
+G1 Y124.9 E1154.6155
+G1 X74.9 E1155.2309
+G1 Y110.1 E1155.8462
+;layer #101
+G1 E1155.56 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1155.85 F1800
+G1 Z25.25 F300
+G1 X60.46 E1156.4315 F3600
+G1 Y124.54 E1157.0169
+G1 X74.54 E1157.6023
+G1 Y110.46 E1158.1877
+G1 X74.9 Y110.1
+G1 X60.1 E1158.803
+G1 Y124.9 E1159.4183
+G1 X74.9 E1160.0336
+G1 Y110.1 E1160.6489
+G1 E1160.36 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1160.65 F1800
+G1 Y124.54 E1161.2343 F3600
+G1 X174.54 E1161.8197
+G1 Y110.46 E1162.4051
+G1 X160.46 E1162.9904
+G1 X160.1 Y110.1
+G1 Y124.9 E1163.6058
+G1 X174.9 E1164.2211
+G1 Y110.1 E1164.8364
+G1 X160.1 E1165.4517
+;layer #102
+G1 E1165.16 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1165.45 F1800
+G1 Z25.5 F300
+G1 Y124.54 E1166.0371 F3600
+G1 X174.54 E1166.6225
+G1 Y110.46 E1167.2078
+G1 X160.46 E1167.7932
+G1 X160.1 Y110.1
+G1 Y124.9 E1168.4085
+G1 X174.9 E1169.0238
+G1 Y110.1 E1169.6391
+G1 X160.1 E1170.2545
+G1 E1169.97 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1170.25 F1800
+G1 X60.46 E1170.8398 F3600
+G1 Y124.54 E1171.4252
+G1 X74.54 E1172.0106
+G1 Y110.46 E1172.596
+G1 X74.9 Y110.1
+G1 X60.1 E1173.2113
+G1 Y124.9 E1173.8266
+G1 X74.9 E1174.4419
+G1 Y110.1 E1175.0572
+;layer #103
+G1 E1174.77 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1175.06 F1800
+G1 Z25.75 F300
+G1 X60.46 E1175.6426 F3600
+G1 Y124.54 E1176.228
+G1 X74.54 E1176.8134
+G1 Y110.46 E1177.3987
+G1 X74.9 Y110.1
+G1 X60.1 E1178.014
+G1 Y124.9 E1178.6294
+G1 X74.9 E1179.2447
+G1 Y110.1 E1179.86
+G1 E1179.57 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1179.86 F1800
+G1 Y124.54 E1180.4454 F3600
+G1 X174.54 E1181.0307
+G1 Y110.46 E1181.6161
+G1 X160.46 E1182.2015
+G1 X160.1 Y110.1
+G1 Y124.9 E1182.8168
+G1 X174.9 E1183.4321
+G1 Y110.1 E1184.0474
+G1 X160.1 E1184.6627
+;layer #104
+G1 E1184.37 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1184.66 F1800
+G1 Z26 F300
+G1 Y124.54 E1185.2481 F3600
+G1 X174.54 E1185.8335
+G1 Y110.46 E1186.4189
+G1 X160.46 E1187.0043
+G1 X160.1 Y110.1
+G1 Y124.9 E1187.6196
+G1 X174.9 E1188.2349
+G1 Y110.1 E1188.8502
+G1 X160.1 E1189.4655
+G1 E1189.18 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1189.47 F1800
+G1 X60.46 E1190.0509 F3600
+G1 Y124.54 E1190.6363
+G1 X74.54 E1191.2216
+G1 Y110.46 E1191.807
+G1 X74.9 Y110.1
+G1 X60.1 E1192.4223
+G1 Y124.9 E1193.0377
+G1 X74.9 E1193.653
+G1 Y110.1 E1194.2683
+;layer #105
+G1 E1193.98 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1194.27 F1800
+G1 Z26.25 F300
+G1 X60.46 E1194.8537 F3600
+G1 Y124.54 E1195.439
+G1 X74.54 E1196.0244
+G1 Y110.46 E1196.6098
+G1 X74.9 Y110.1
+G1 X60.1 E1197.2251
+G1 Y124.9 E1197.8404
+G1 X74.9 E1198.4557
+G1 Y110.1 E1199.071
+G1 E1198.78 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1199.07 F1800
+G1 Y124.54 E1199.6564 F3600
+G1 X174.54 E1200.2418
+G1 Y110.46 E1200.8272
+G1 X160.46 E1201.4126
+G1 X160.1 Y110.1
+G1 Y124.9 E1202.0279
+G1 X174.9 E1202.6432
+G1 Y110.1 E1203.2585
+G1 X160.1 E1203.8738
+;layer #106
+G1 E1203.58 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1203.87 F1800
+G1 Z26.5 F300
+G1 Y124.54 E1204.4592 F3600
+G1 X174.54 E1205.0446
+G1 Y110.46 E1205.6299
+G1 X160.46 E1206.2153
+G1 X160.1 Y110.1
+G1 Y124.9 E1206.8306
+G1 X174.9 E1207.4459
+G1 Y110.1 E1208.0613
+G1 X160.1 E1208.6766
+G1 E1208.39 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1208.68 F1800
+G1 X60.46 E1209.2619 F3600
+G1 Y124.54 E1209.8473
+G1 X74.54 E1210.4327
+G1 Y110.46 E1211.0181
+G1 X74.9 Y110.1
+G1 X60.1 E1211.6334
+G1 Y124.9 E1212.2487
+G1 X74.9 E1212.864
+G1 Y110.1 E1213.4793
+;layer #107
+G1 E1213.19 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1213.48 F1800
+G1 Z26.75 F300
+G1 X60.46 E1214.0647 F3600
+G1 Y124.54 E1214.6501
+G1 X74.54 E1215.2355
+G1 Y110.46 E1215.8208
+G1 X74.9 Y110.1
+G1 X60.1 E1216.4362
+G1 Y124.9 E1217.0515
+G1 X74.9 E1217.6668
+G1 Y110.1 E1218.2821
+G1 E1217.99 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1218.28 F1800
+G1 Y124.54 E1218.8675 F3600
+G1 X174.54 E1219.4529
+G1 Y110.46 E1220.0382
+G1 X160.46 E1220.6236
+G1 X160.1 Y110.1
+G1 Y124.9 E1221.2389
+G1 X174.9 E1221.8542
+G1 Y110.1 E1222.4695
+G1 X160.1 E1223.0849
+;layer #108
+G1 E1222.8 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1223.08 F1800
+G1 Z27 F300
+G1 Y124.54 E1223.6702 F3600
+G1 X174.54 E1224.2556
+G1 Y110.46 E1224.841
+G1 X160.46 E1225.4264
+G1 X160.1 Y110.1
+G1 Y124.9 E1226.0417
+G1 X174.9 E1226.657
+G1 Y110.1 E1227.2723
+G1 X160.1 E1227.8876
+G1 E1227.6 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1227.89 F1800
+G1 X60.46 E1228.473 F3600
+G1 Y124.54 E1229.0584
+G1 X74.54 E1229.6438
+G1 Y110.46 E1230.2291
+G1 X74.9 Y110.1
+G1 X60.1 E1230.8444
+G1 Y124.9 E1231.4598
+G1 X74.9 E1232.0751
+G1 Y110.1 E1232.6904
+;layer #109
+G1 E1232.49 F1800
+G1 X74.64 Y110.36 F9000
+G1 E1232.69 F1800
+G1 Z27.25 F300
+G1 X60.36 E1233.2841 F3600
+G1 Y124.64 E1233.8778
+G1 X74.64 E1234.4715
+G1 Y110.36 E1235.0652
+G1 X75 Y110
+G1 X60 E1235.6888
+G1 Y125 E1236.3124
+G1 X75 E1236.936
+G1 Y110 E1237.5597
+G1 E1237.36 F1800
+G1 X160.36 Y110.36 F9000
+G1 E1237.56 F1800
+G1 Y124.64 E1238.1534 F3600
+G1 X174.64 E1238.7471
+G1 Y110.36 E1239.3407
+G1 X160.36 E1239.9344
+G1 X160 Y110
+G1 Y125 E1240.5581
+G1 X175 E1241.1817
+G1 Y110 E1241.8053
+G1 X160 E1242.429
+;layer #110
+G1 E1242.23 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1242.43 F1800
+G1 Z27.5 F300
+G1 Y124.54 E1243.0143 F3600
+G1 X174.54 E1243.5997
+G1 Y110.46 E1244.1851
+G1 X160.46 E1244.7705
+G1 X160.1 Y110.1
+G1 Y124.9 E1245.3858
+G1 X174.9 E1246.0011
+G1 Y110.1 E1246.6164
+G1 X160.1 E1247.2317
+G1 E1247.03 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1247.23 F1800
+G1 X60.46 E1247.8171 F3600
+G1 Y124.54 E1248.4025
+G1 X74.54 E1248.9878
+G1 Y110.46 E1249.5732
+G1 X74.9 Y110.1
+G1 X60.1 E1250.1885
+G1 Y124.9 E1250.8039
+G1 X74.9 E1251.4192
+G1 Y110.1 E1252.0345
+;layer #111
+G1 E1251.83 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1252.03 F1800
+G1 Z27.75 F300
+G1 X60.46 E1252.6199 F3600
+G1 Y124.54 E1253.2052
+G1 X74.54 E1253.7906
+G1 Y110.46 E1254.376
+G1 X74.9 Y110.1
+G1 X60.1 E1254.9913
+G1 Y124.9 E1255.6066
+G1 X74.9 E1256.2219
+G1 Y110.1 E1256.8372
+G1 E1256.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1256.84 F1800
+G1 Y124.54 E1257.4226 F3600
+G1 X174.54 E1258.008
+G1 Y110.46 E1258.5934
+G1 X160.46 E1259.1788
+G1 X160.1 Y110.1
+G1 Y124.9 E1259.7941
+G1 X174.9 E1260.4094
+G1 Y110.1 E1261.0247
+G1 X160.1 E1261.64
+;layer #112
+G1 E1261.44 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1261.64 F1800
+G1 Z28 F300
+G1 Y124.54 E1262.2254 F3600
+G1 X174.54 E1262.8108
+G1 Y110.46 E1263.3961
+G1 X160.46 E1263.9815
+G1 X160.1 Y110.1
+G1 Y124.9 E1264.5968
+G1 X174.9 E1265.2121
+G1 Y110.1 E1265.8275
+G1 X160.1 E1266.4428
+G1 E1266.24 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1266.44 F1800
+G1 X60.46 E1267.0281 F3600
+G1 Y124.54 E1267.6135
+G1 X74.54 E1268.1989
+G1 Y110.46 E1268.7843
+G1 X74.9 Y110.1
+G1 X60.1 E1269.3996
+G1 Y124.9 E1270.0149
+G1 X74.9 E1270.6302
+G1 Y110.1 E1271.2455
+;layer #113
+G1 E1271.05 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1271.25 F1800
+G1 Z28.25 F300
+G1 X60.46 E1271.8309 F3600
+G1 Y124.54 E1272.4163
+G1 X74.54 E1273.0017
+G1 Y110.46 E1273.587
+G1 X74.9 Y110.1
+G1 X60.1 E1274.2024
+G1 Y124.9 E1274.8177
+G1 X74.9 E1275.433
+G1 Y110.1 E1276.0483
+G1 E1275.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1276.05 F1800
+G1 Y124.54 E1276.6337 F3600
+G1 X174.54 E1277.2191
+G1 Y110.46 E1277.8044
+G1 X160.46 E1278.3898
+G1 X160.1 Y110.1
+G1 Y124.9 E1279.0051
+G1 X174.9 E1279.6204
+G1 Y110.1 E1280.2357
+G1 X160.1 E1280.8511
+;layer #114
+G1 E1280.65 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1280.85 F1800
+G1 Z28.5 F300
+G1 Y124.54 E1281.4364 F3600
+G1 X174.54 E1282.0218
+G1 Y110.46 E1282.6072
+G1 X160.46 E1283.1926
+G1 X160.1 Y110.1
+G1 Y124.9 E1283.8079
+G1 X174.9 E1284.4232
+G1 Y110.1 E1285.0385
+G1 X160.1 E1285.6538
+G1 E1285.45 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1285.65 F1800
+G1 X60.46 E1286.2392 F3600
+G1 Y124.54 E1286.8246
+G1 X74.54 E1287.41
+G1 Y110.46 E1287.9953
+G1 X74.9 Y110.1
+G1 X60.1 E1288.6106
+G1 Y124.9 E1289.226
+G1 X74.9 E1289.8413
+G1 Y110.1 E1290.4566
+;layer #115
+G1 E1290.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1290.46 F1800
+G1 Z28.75 F300
+G1 X60.46 E1291.042 F3600
+G1 Y124.54 E1291.6273
+G1 X74.54 E1292.2127
+G1 Y110.46 E1292.7981
+G1 X74.9 Y110.1
+G1 X60.1 E1293.4134
+G1 Y124.9 E1294.0287
+G1 X74.9 E1294.644
+G1 Y110.1 E1295.2593
+G1 E1295.06 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1295.26 F1800
+G1 Y124.54 E1295.8447 F3600
+G1 X174.54 E1296.4301
+G1 Y110.46 E1297.0155
+G1 X160.46 E1297.6009
+G1 X160.1 Y110.1
+G1 Y124.9 E1298.2162
+G1 X174.9 E1298.8315
+G1 Y110.1 E1299.4468
+G1 X160.1 E1300.0621
+;layer #116
+G1 E1299.86 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1300.06 F1800
+G1 Z29 F300
+G1 Y124.54 E1300.6475 F3600
+G1 X174.54 E1301.2329
+G1 Y110.46 E1301.8182
+G1 X160.46 E1302.4036
+G1 X160.1 Y110.1
+G1 Y124.9 E1303.0189
+G1 X174.9 E1303.6343
+G1 Y110.1 E1304.2496
+G1 X160.1 E1304.8649
+G1 E1304.66 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1304.86 F1800
+G1 X60.46 E1305.4503 F3600
+G1 Y124.54 E1306.0356
+G1 X74.54 E1306.621
+G1 Y110.46 E1307.2064
+G1 X74.9 Y110.1
+G1 X60.1 E1307.8217
+G1 Y124.9 E1308.437
+G1 X74.9 E1309.0523
+G1 Y110.1 E1309.6676
+;layer #117
+G1 E1309.47 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1309.67 F1800
+G1 Z29.25 F300
+G1 X60.46 E1310.253 F3600
+G1 Y124.54 E1310.8384
+G1 X74.54 E1311.4238
+G1 Y110.46 E1312.0092
+G1 X74.9 Y110.1
+G1 X60.1 E1312.6245
+G1 Y124.9 E1313.2398
+G1 X74.9 E1313.8551
+G1 Y110.1 E1314.4704
+G1 E1314.27 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1314.47 F1800
+G1 Y124.54 E1315.0558 F3600
+G1 X174.54 E1315.6412
+G1 Y110.46 E1316.2265
+G1 X160.46 E1316.8119
+G1 X160.1 Y110.1
+G1 Y124.9 E1317.4272
+G1 X174.9 E1318.0425
+G1 Y110.1 E1318.6579
+G1 X160.1 E1319.2732
+;layer #118
+G1 E1319.07 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1319.27 F1800
+G1 Z29.5 F300
+G1 Y124.54 E1319.8585 F3600
+G1 X174.54 E1320.4439
+G1 Y110.46 E1321.0293
+G1 X160.46 E1321.6147
+G1 X160.1 Y110.1
+G1 Y124.9 E1322.23
+G1 X174.9 E1322.8453
+G1 Y110.1 E1323.4606
+G1 X160.1 E1324.0759
+G1 E1323.88 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1324.08 F1800
+G1 X60.46 E1324.6613 F3600
+G1 Y124.54 E1325.2467
+G1 X74.54 E1325.8321
+G1 Y110.46 E1326.4174
+G1 X74.9 Y110.1
+G1 X60.1 E1327.0328
+G1 Y124.9 E1327.6481
+G1 X74.9 E1328.2634
+G1 Y110.1 E1328.8787
+;layer #119
+G1 E1328.68 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1328.88 F1800
+G1 Z29.75 F300
+G1 X60.46 E1329.4641 F3600
+G1 Y124.54 E1330.0495
+G1 X74.54 E1330.6348
+G1 Y110.46 E1331.2202
+G1 X74.9 Y110.1
+G1 X60.1 E1331.8355
+G1 Y124.9 E1332.4508
+G1 X74.9 E1333.0661
+G1 Y110.1 E1333.6815
+G1 E1333.48 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1333.68 F1800
+G1 Y124.54 E1334.2668 F3600
+G1 X174.54 E1334.8522
+G1 Y110.46 E1335.4376
+G1 X160.46 E1336.023
+G1 X160.1 Y110.1
+G1 Y124.9 E1336.6383
+G1 X174.9 E1337.2536
+G1 Y110.1 E1337.8689
+G1 X160.1 E1338.4842
+;layer #120
+G1 E1338.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E1338.48 F1800
+G1 Z30 F300
+G1 Y124.54 E1339.0696 F3600
+G1 X174.54 E1339.655
+G1 Y110.46 E1340.2404
+G1 X160.46 E1340.8257
+G1 X160.1 Y110.1
+G1 Y124.9 E1341.441
+G1 X174.9 E1342.0564
+G1 Y110.1 E1342.6717
+G1 X160.1 E1343.287
+G1 E1343.09 F1800
+G1 X74.54 Y110.46 F9000
+G1 E1343.29 F1800
+G1 X60.46 E1343.8724 F3600
+G1 Y124.54 E1344.4577
+G1 X74.54 E1345.0431
+G1 Y110.46 E1345.6285
+G1 X74.9 Y110.1
+G1 X60.1 E1346.2438
+G1 Y124.9 E1346.8591
+G1 X74.9 E1347.4744
+G1 Y110.1 E1348.0897
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,643 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.45 [mm]
+;First layer line width: 0.45 [mm]
+;Layer height: 0.2 [mm]
+;Print speed: 80 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 200 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: true
+;Segment 3:   5mm @ 30mm/s
+;Segment 2:   4mm @ 30mm/s
+;Segment 1:   3mm @ 30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.2 F450
+G92 Z0.2
+G1 E-3 F1800
+G1 X52.5 Y92.5 F12000
+G1 E0 F1800
+G1 X182.5 E7.5667 F1800
+G1 Y93.2 F1800
+G1 X52.5 E15.1334 F1800
+G1 E12.13 F1800
+G1 X52.85 Y132.15 F12000
+G1 E15.13 F1800
+G1 Y131.1 E15.1977 F1800
+G1 X53.9 Y132.15 E15.2888 F1800
+G1 X54.94 E15.3532 F1800
+G1 X52.85 Y130.06 E15.5353 F1800
+G1 Y129.01 E15.5997 F1800
+G1 X55.99 Y132.15 E15.8728 F1800
+G1 X57.04 E15.9372 F1800
+G1 X52.85 Y127.96 E16.3014 F1800
+G1 Y126.92 E16.3658 F1800
+G1 X58.08 Y132.15 E16.821 F1800
+G1 X59.13 E16.8854 F1800
+G1 X52.85 Y125.87 E17.4317 F1800
+G1 Y124.82 E17.4961 F1800
+G1 X60.18 Y132.15 E18.1335 F1800
+G1 X61.22 E18.1978 F1800
+G1 X52.85 Y123.78 E18.9262 F1800
+G1 Y122.73 E18.9906 F1800
+G1 X62.27 Y132.15 E19.8101 F1800
+G1 X63.31 E19.8745 F1800
+G1 X52.85 Y121.69 E20.785 F1800
+G1 Y120.64 E20.8494 F1800
+G1 X64.36 Y132.15 E21.8509 F1800
+G1 X65.41 E21.9153 F1800
+G1 X52.85 Y119.59 E23.0079 F1800
+G1 Y118.55 E23.0723 F1800
+G1 X66.45 Y132.15 E24.2559 F1800
+G1 X67.5 E24.3203 F1800
+G1 X52.85 Y117.5 E25.595 F1800
+G1 Y116.45 E25.6594 F1800
+G1 X68.55 Y132.15 E27.0252 F1800
+G1 X69.59 E27.0896 F1800
+G1 X52.85 Y115.41 E28.5464 F1800
+G1 Y114.36 E28.6108 F1800
+G1 X70.64 Y132.15 E30.1586 F1800
+G1 X71.69 E30.223 F1800
+G1 X52.85 Y113.31 E31.8619 F1800
+G1 Y112.27 E31.9263 F1800
+G1 X72.73 Y132.15 E33.6563 F1800
+G1 X73.78 E33.7206 F1800
+G1 X52.85 Y111.22 E35.5417 F1800
+G1 Y110.18 E35.606 F1800
+G1 X74.83 Y132.15 E37.5181 F1800
+G1 X75.87 E37.5825 F1800
+G1 X52.85 Y109.13 E39.5856 F1800
+G1 Y108.08 E39.65 F1800
+G1 X76.92 Y132.15 E41.7441 F1800
+G1 X77.96 E41.8085 F1800
+G1 X52.85 Y107.04 E43.9937 F1800
+G1 Y105.99 E44.0581 F1800
+G1 X79.01 Y132.15 E46.3344 F1800
+G1 X80.06 E46.3988 F1800
+G1 X52.85 Y104.94 E48.7661 F1800
+G1 Y103.9 E48.8305 F1800
+G1 X81.1 Y132.15 E51.2888 F1800
+G1 X82.15 E51.3532 F1800
+G1 X52.85 Y102.85 E53.9026 F1800
+G1 X53.9 E53.967 F1800
+G1 X82.15 Y131.1 E56.4254 F1800
+G1 Y130.06 E56.4898 F1800
+G1 X54.94 Y102.85 E58.8571 F1800
+G1 X55.99 E58.9215 F1800
+G1 X82.15 Y129.01 E61.1977 F1800
+G1 Y127.96 E61.2621 F1800
+G1 X57.04 Y102.85 E63.4473 F1800
+G1 X58.08 E63.5117 F1800
+G1 X82.15 Y126.92 E65.6059 F1800
+G1 Y125.87 E65.6703 F1800
+G1 X59.13 Y102.85 E67.6734 F1800
+G1 X60.18 E67.7378 F1800
+G1 X82.15 Y124.83 E69.6498 F1800
+G1 Y123.78 E69.7142 F1800
+G1 X61.22 Y102.85 E71.5352 F1800
+G1 X62.27 E71.5996 F1800
+G1 X82.15 Y122.73 E73.3296 F1800
+G1 Y121.69 E73.394 F1800
+G1 X63.31 Y102.85 E75.0329 F1800
+G1 X64.36 E75.0973 F1800
+G1 X82.15 Y120.64 E76.6451 F1800
+G1 Y119.59 E76.7095 F1800
+G1 X65.41 Y102.85 E78.1663 F1800
+G1 X66.45 E78.2307 F1800
+G1 X82.15 Y118.55 E79.5965 F1800
+G1 Y117.5 E79.6608 F1800
+G1 X67.5 Y102.85 E80.9356 F1800
+G1 X68.55 E80.9999 F1800
+G1 X82.15 Y116.45 E82.1836 F1800
+G1 Y115.41 E82.248 F1800
+G1 X69.59 Y102.85 E83.3406 F1800
+G1 X70.64 E83.405 F1800
+G1 X82.15 Y114.36 E84.4065 F1800
+G1 Y113.31 E84.4709 F1800
+G1 X71.69 Y102.85 E85.3814 F1800
+G1 X72.73 E85.4458 F1800
+G1 X82.15 Y112.27 E86.2653 F1800
+G1 Y111.22 E86.3296 F1800
+G1 X73.78 Y102.85 E87.058 F1800
+G1 X74.83 E87.1224 F1800
+G1 X82.15 Y110.18 E87.7598 F1800
+G1 Y109.13 E87.8242 F1800
+G1 X75.87 Y102.85 E88.3705 F1800
+G1 X76.92 E88.4348 F1800
+G1 X82.15 Y108.08 E88.8901 F1800
+G1 Y107.04 E88.9545 F1800
+G1 X77.96 Y102.85 E89.3187 F1800
+G1 X79.01 E89.3831 F1800
+G1 X82.15 Y105.99 E89.6562 F1800
+G1 Y104.94 E89.7206 F1800
+G1 X80.06 Y102.85 E89.9027 F1800
+G1 X81.1 E89.9671 F1800
+G1 X82.15 Y103.9 E90.0581 F1800
+G1 Y102.85 E90.1225 F1800
+G1 E87.12 F1800
+G1 X152.85 Y132.15 F12000
+G1 E90.12 F1800
+G1 Y131.1 E90.1869 F1800
+G1 X153.9 Y132.15 E90.278 F1800
+G1 X154.94 E90.3423 F1800
+G1 X152.85 Y130.06 E90.5244 F1800
+G1 Y129.01 E90.5888 F1800
+G1 X155.99 Y132.15 E90.862 F1800
+G1 X157.04 E90.9264 F1800
+G1 X152.85 Y127.96 E91.2906 F1800
+G1 Y126.92 E91.3549 F1800
+G1 X158.08 Y132.15 E91.8102 F1800
+G1 X159.13 E91.8746 F1800
+G1 X152.85 Y125.87 E92.4209 F1800
+G1 Y124.82 E92.4853 F1800
+G1 X160.18 Y132.15 E93.1226 F1800
+G1 X161.22 E93.187 F1800
+G1 X152.85 Y123.78 E93.9154 F1800
+G1 Y122.73 E93.9798 F1800
+G1 X162.27 Y132.15 E94.7992 F1800
+G1 X163.31 E94.8636 F1800
+G1 X152.85 Y121.69 E95.7741 F1800
+G1 Y120.64 E95.8385 F1800
+G1 X164.36 Y132.15 E96.8401 F1800
+G1 X165.41 E96.9045 F1800
+G1 X152.85 Y119.59 E97.9971 F1800
+G1 Y118.55 E98.0614 F1800
+G1 X166.45 Y132.15 E99.2451 F1800
+G1 X167.5 E99.3095 F1800
+G1 X152.85 Y117.5 E100.5842 F1800
+G1 Y116.45 E100.6486 F1800
+G1 X168.55 Y132.15 E102.0143 F1800
+G1 X169.59 E102.0787 F1800
+G1 X152.85 Y115.41 E103.5355 F1800
+G1 Y114.36 E103.5999 F1800
+G1 X170.64 Y132.15 E105.1478 F1800
+G1 X171.69 E105.2122 F1800
+G1 X152.85 Y113.31 E106.8511 F1800
+G1 Y112.27 E106.9155 F1800
+G1 X172.73 Y132.15 E108.6454 F1800
+G1 X173.78 E108.7098 F1800
+G1 X152.85 Y111.22 E110.5308 F1800
+G1 Y110.18 E110.5952 F1800
+G1 X174.83 Y132.15 E112.5073 F1800
+G1 X175.87 E112.5716 F1800
+G1 X152.85 Y109.13 E114.5748 F1800
+G1 Y108.08 E114.6391 F1800
+G1 X176.92 Y132.15 E116.7333 F1800
+G1 X177.96 E116.7977 F1800
+G1 X152.85 Y107.04 E118.9829 F1800
+G1 Y105.99 E119.0473 F1800
+G1 X179.01 Y132.15 E121.3236 F1800
+G1 X180.06 E121.3879 F1800
+G1 X152.85 Y104.94 E123.7553 F1800
+G1 Y103.9 E123.8196 F1800
+G1 X181.1 Y132.15 E126.278 F1800
+G1 X182.15 E126.3424 F1800
+G1 X152.85 Y102.85 E128.8918 F1800
+G1 X153.9 E128.9562 F1800
+G1 X182.15 Y131.1 E131.4146 F1800
+G1 Y130.06 E131.4789 F1800
+G1 X154.94 Y102.85 E133.8463 F1800
+G1 X155.99 E133.9106 F1800
+G1 X182.15 Y129.01 E136.1869 F1800
+G1 Y127.96 E136.2513 F1800
+G1 X157.04 Y102.85 E138.4365 F1800
+G1 X158.08 E138.5009 F1800
+G1 X182.15 Y126.92 E140.5951 F1800
+G1 Y125.87 E140.6594 F1800
+G1 X159.13 Y102.85 E142.6626 F1800
+G1 X160.18 E142.7269 F1800
+G1 X182.15 Y124.83 E144.639 F1800
+G1 Y123.78 E144.7034 F1800
+G1 X161.22 Y102.85 E146.5244 F1800
+G1 X162.27 E146.5888 F1800
+G1 X182.15 Y122.73 E148.3187 F1800
+G1 Y121.69 E148.3831 F1800
+G1 X163.31 Y102.85 E150.022 F1800
+G1 X164.36 E150.0864 F1800
+G1 X182.15 Y120.64 E151.6343 F1800
+G1 Y119.59 E151.6987 F1800
+G1 X165.41 Y102.85 E153.1555 F1800
+G1 X166.45 E153.2199 F1800
+G1 X182.15 Y118.55 E154.5856 F1800
+G1 Y117.5 E154.65 F1800
+G1 X167.5 Y102.85 E155.9247 F1800
+G1 X168.55 E155.9891 F1800
+G1 X182.15 Y116.45 E157.1727 F1800
+G1 Y115.41 E157.2371 F1800
+G1 X169.59 Y102.85 E158.3297 F1800
+G1 X170.64 E158.3941 F1800
+G1 X182.15 Y114.36 E159.3957 F1800
+G1 Y113.31 E159.4601 F1800
+G1 X171.69 Y102.85 E160.3706 F1800
+G1 X172.73 E160.435 F1800
+G1 X182.15 Y112.27 E161.2544 F1800
+G1 Y111.22 E161.3188 F1800
+G1 X173.78 Y102.85 E162.0472 F1800
+G1 X174.83 E162.1116 F1800
+G1 X182.15 Y110.18 E162.7489 F1800
+G1 Y109.13 E162.8133 F1800
+G1 X175.87 Y102.85 E163.3596 F1800
+G1 X176.92 E163.424 F1800
+G1 X182.15 Y108.08 E163.8793 F1800
+G1 Y107.04 E163.9436 F1800
+G1 X177.96 Y102.85 E164.3078 F1800
+G1 X179.01 E164.3722 F1800
+G1 X182.15 Y105.99 E164.6454 F1800
+G1 Y104.94 E164.7098 F1800
+G1 X180.06 Y102.85 E164.8919 F1800
+G1 X181.1 E164.9562 F1800
+G1 X182.15 Y103.9 E165.0473 F1800
+G1 Y102.85 E165.1117 F1800
+;layer #2
+M106 S169
+G1 E162.11 F1800
+G1 X160.52 Y110.52 F12000
+G1 E165.11 F1800
+G1 Z0.4 F300
+G1 Y124.48 E165.6342 F4800
+G1 X174.48 E166.1567
+G1 Y110.52 E166.6793
+G1 X160.52 E167.2018
+G1 X160.11 Y110.11
+G1 Y124.89 E167.7547
+G1 X174.89 E168.3075
+G1 Y110.11 E168.8604
+G1 X160.11 E169.4132
+G1 E166.41 F1800
+G1 X74.48 Y110.52 F12000
+G1 E169.41 F1800
+G1 X60.52 E169.9357 F4800
+G1 Y124.48 E170.4583
+G1 X74.48 E170.9808
+G1 Y110.52 E171.5034
+G1 X74.89 Y110.11
+G1 X60.11 E172.0562
+G1 Y124.89 E172.609
+G1 X74.89 E173.1619
+G1 Y110.11 E173.7147
+;layer #3
+M106 S254
+G1 E170.71 F1800
+G1 X160.52 Y110.52 F12000
+G1 E173.71 F1800
+G1 Z0.6 F300
+G1 Y124.48 E174.2373 F4800
+G1 X174.48 E174.7598
+G1 Y110.52 E175.2824
+G1 X160.52 E175.8049
+G1 X160.11 Y110.11
+G1 Y124.89 E176.3577
+G1 X174.89 E176.9106
+G1 Y110.11 E177.4634
+G1 X160.11 E178.0163
+G1 E175.02 F1800
+G1 X74.48 Y110.52 F12000
+G1 E178.02 F1800
+G1 X60.52 E178.5388 F4800
+G1 Y124.48 E179.0613
+G1 X74.48 E179.5839
+G1 Y110.52 E180.1064
+G1 X74.89 Y110.11
+G1 X60.11 E180.6593
+G1 Y124.89 E181.2121
+G1 X74.89 E181.765
+G1 Y110.11 E182.3178
+;layer #4
+G1 E179.32 F1800
+G1 X160.52 Y110.52 F12000
+G1 E182.32 F1800
+G1 Z0.8 F300
+G1 Y124.48 E182.8403 F4800
+G1 X174.48 E183.3629
+G1 Y110.52 E183.8854
+G1 X160.52 E184.408
+G1 X160.11 Y110.11
+G1 Y124.89 E184.9608
+G1 X174.89 E185.5136
+G1 Y110.11 E186.0665
+G1 X160.11 E186.6193
+G1 E183.62 F1800
+G1 X74.48 Y110.52 F12000
+G1 E186.62 F1800
+G1 X60.52 E187.1419 F4800
+G1 Y124.48 E187.6644
+G1 X74.48 E188.187
+G1 Y110.52 E188.7095
+G1 X74.89 Y110.11
+G1 X60.11 E189.2623
+G1 Y124.89 E189.8152
+G1 X74.89 E190.368
+G1 Y110.11 E190.9209
+;layer #5
+G1 E187.92 F1800
+G1 X160.52 Y110.52 F12000
+G1 E190.92 F1800
+G1 Z1 F300
+G1 Y124.48 E191.4434 F4800
+G1 X174.48 E191.9659
+G1 Y110.52 E192.4885
+G1 X160.52 E193.011
+G1 X160.11 Y110.11
+G1 Y124.89 E193.5639
+G1 X174.89 E194.1167
+G1 Y110.11 E194.6696
+G1 X160.11 E195.2224
+G1 E192.22 F1800
+G1 X74.48 Y110.52 F12000
+G1 E195.22 F1800
+G1 X60.52 E195.7449 F4800
+G1 Y124.48 E196.2675
+G1 X74.48 E196.79
+G1 Y110.52 E197.3126
+G1 X74.89 Y110.11
+G1 X60.11 E197.8654
+G1 Y124.89 E198.4182
+G1 X74.89 E198.9711
+G1 Y110.11 E199.5239
+;layer #6
+G1 E195.52 F1800
+G1 X160.41 Y110.41 F12000
+G1 E199.52 F1800
+G1 Z1.2 F300
+G1 Y124.6 E200.0549 F4800
+G1 X174.6 E200.5859
+G1 Y110.41 E201.1168
+G1 X160.41 E201.6478
+G1 X160 Y110
+G1 Y125 E202.209
+G1 X175 E202.7703
+G1 Y110 E203.3316
+G1 X160 E203.8928
+G1 E199.89 F1800
+G1 X74.6 Y110.41 F12000
+G1 E203.89 F1800
+G1 X60.41 E204.4238 F4800
+G1 Y124.6 E204.9547
+G1 X74.6 E205.4857
+G1 Y110.41 E206.0167
+G1 X75 Y110
+G1 X60 E206.5779
+G1 Y125 E207.1392
+G1 X75 E207.7004
+G1 Y110 E208.2617
+;layer #7
+G1 E204.26 F1800
+G1 X160.52 Y110.52 F12000
+G1 E208.26 F1800
+G1 Z1.4 F300
+G1 Y124.48 E208.7842 F4800
+G1 X174.48 E209.3068
+G1 Y110.52 E209.8293
+G1 X160.52 E210.3519
+G1 X160.11 Y110.11
+G1 Y124.89 E210.9047
+G1 X174.89 E211.4576
+G1 Y110.11 E212.0104
+G1 X160.11 E212.5632
+G1 E208.56 F1800
+G1 X74.48 Y110.52 F12000
+G1 E212.56 F1800
+G1 X60.52 E213.0858 F4800
+G1 Y124.48 E213.6083
+G1 X74.48 E214.1309
+G1 Y110.52 E214.6534
+G1 X74.89 Y110.11
+G1 X60.11 E215.2062
+G1 Y124.89 E215.7591
+G1 X74.89 E216.3119
+G1 Y110.11 E216.8648
+;layer #8
+G1 E212.86 F1800
+G1 X160.52 Y110.52 F12000
+G1 E216.86 F1800
+G1 Z1.6 F300
+G1 Y124.48 E217.3873 F4800
+G1 X174.48 E217.9099
+G1 Y110.52 E218.4324
+G1 X160.52 E218.9549
+G1 X160.11 Y110.11
+G1 Y124.89 E219.5078
+G1 X174.89 E220.0606
+G1 Y110.11 E220.6135
+G1 X160.11 E221.1663
+G1 E217.17 F1800
+G1 X74.48 Y110.52 F12000
+G1 E221.17 F1800
+G1 X60.52 E221.6888 F4800
+G1 Y124.48 E222.2114
+G1 X74.48 E222.7339
+G1 Y110.52 E223.2565
+G1 X74.89 Y110.11
+G1 X60.11 E223.8093
+G1 Y124.89 E224.3622
+G1 X74.89 E224.915
+G1 Y110.11 E225.4678
+;layer #9
+G1 E221.47 F1800
+G1 X160.52 Y110.52 F12000
+G1 E225.47 F1800
+G1 Z1.8 F300
+G1 Y124.48 E225.9904 F4800
+G1 X174.48 E226.5129
+G1 Y110.52 E227.0355
+G1 X160.52 E227.558
+G1 X160.11 Y110.11
+G1 Y124.89 E228.1108
+G1 X174.89 E228.6637
+G1 Y110.11 E229.2165
+G1 X160.11 E229.7694
+G1 E225.77 F1800
+G1 X74.48 Y110.52 F12000
+G1 E229.77 F1800
+G1 X60.52 E230.2919 F4800
+G1 Y124.48 E230.8145
+G1 X74.48 E231.337
+G1 Y110.52 E231.8595
+G1 X74.89 Y110.11
+G1 X60.11 E232.4124
+G1 Y124.89 E232.9652
+G1 X74.89 E233.5181
+G1 Y110.11 E234.0709
+;layer #10
+G1 E230.07 F1800
+G1 X160.52 Y110.52 F12000
+G1 E234.07 F1800
+G1 Z2 F300
+G1 Y124.48 E234.5934 F4800
+G1 X174.48 E235.116
+G1 Y110.52 E235.6385
+G1 X160.52 E236.1611
+G1 X160.11 Y110.11
+G1 Y124.89 E236.7139
+G1 X174.89 E237.2668
+G1 Y110.11 E237.8196
+G1 X160.11 E238.3724
+G1 E234.37 F1800
+G1 X74.48 Y110.52 F12000
+G1 E238.37 F1800
+G1 X60.52 E238.895 F4800
+G1 Y124.48 E239.4175
+G1 X74.48 E239.9401
+G1 Y110.52 E240.4626
+G1 X74.89 Y110.11
+G1 X60.11 E241.0154
+G1 Y124.89 E241.5683
+G1 X74.89 E242.1211
+G1 Y110.11 E242.674
+;layer #11
+G1 E237.67 F1800
+G1 X160.41 Y110.41 F12000
+G1 E242.67 F1800
+G1 Z2.2 F300
+G1 Y124.6 E243.2049 F4800
+G1 X174.6 E243.7359
+G1 Y110.41 E244.2668
+G1 X160.41 E244.7978
+G1 X160 Y110
+G1 Y125 E245.3591
+G1 X175 E245.9203
+G1 Y110 E246.4816
+G1 X160 E247.0429
+G1 E242.04 F1800
+G1 X74.6 Y110.41 F12000
+G1 E247.04 F1800
+G1 X60.41 E247.5738 F4800
+G1 Y124.6 E248.1048
+G1 X74.6 E248.6357
+G1 Y110.41 E249.1667
+G1 X75 Y110
+G1 X60 E249.728
+G1 Y125 E250.2892
+G1 X75 E250.8505
+G1 Y110 E251.4117
+;layer #12
+G1 E246.41 F1800
+G1 X160.52 Y110.52 F12000
+G1 E251.41 F1800
+G1 Z2.4 F300
+G1 Y124.48 E251.9343 F4800
+G1 X174.48 E252.4568
+G1 Y110.52 E252.9794
+G1 X160.52 E253.5019
+G1 X160.11 Y110.11
+G1 Y124.89 E254.0547
+G1 X174.89 E254.6076
+G1 Y110.11 E255.1604
+G1 X160.11 E255.7133
+G1 E250.71 F1800
+G1 X74.48 Y110.52 F12000
+G1 E255.71 F1800
+G1 X60.52 E256.2358 F4800
+G1 Y124.48 E256.7584
+G1 X74.48 E257.2809
+G1 Y110.52 E257.8034
+G1 X74.89 Y110.11
+G1 X60.11 E258.3563
+G1 Y124.89 E258.9091
+G1 X74.89 E259.462
+G1 Y110.11 E260.0148
+;layer #13
+G1 E255.01 F1800
+G1 X160.52 Y110.52 F12000
+G1 E260.01 F1800
+G1 Z2.6 F300
+G1 Y124.48 E260.5374 F4800
+G1 X174.48 E261.0599
+G1 Y110.52 E261.5824
+G1 X160.52 E262.105
+G1 X160.11 Y110.11
+G1 Y124.89 E262.6578
+G1 X174.89 E263.2107
+G1 Y110.11 E263.7635
+G1 X160.11 E264.3163
+G1 E259.32 F1800
+G1 X74.48 Y110.52 F12000
+G1 E264.32 F1800
+G1 X60.52 E264.8389 F4800
+G1 Y124.48 E265.3614
+G1 X74.48 E265.884
+G1 Y110.52 E266.4065
+G1 X74.89 Y110.11
+G1 X60.11 E266.9593
+G1 Y124.89 E267.5122
+G1 X74.89 E268.065
+G1 Y110.11 E268.6179
+;layer #14
+G1 E263.62 F1800
+G1 X160.52 Y110.52 F12000
+G1 E268.62 F1800
+G1 Z2.8 F300
+G1 Y124.48 E269.1404 F4800
+G1 X174.48 E269.663
+G1 Y110.52 E270.1855
+G1 X160.52 E270.708
+G1 X160.11 Y110.11
+G1 Y124.89 E271.2609
+G1 X174.89 E271.8137
+G1 Y110.11 E272.3666
+G1 X160.11 E272.9194
+G1 E267.92 F1800
+G1 X74.48 Y110.52 F12000
+G1 E272.92 F1800
+G1 X60.52 E273.442 F4800
+G1 Y124.48 E273.9645
+G1 X74.48 E274.487
+G1 Y110.52 E275.0096
+G1 X74.89 Y110.11
+G1 X60.11 E275.5624
+G1 Y124.89 E276.1153
+G1 X74.89 E276.6681
+G1 Y110.11 E277.2209
+;layer #15
+G1 E272.22 F1800
+G1 X160.52 Y110.52 F12000
+G1 E277.22 F1800
+G1 Z3 F300
+G1 Y124.48 E277.7435 F4800
+G1 X174.48 E278.266
+G1 Y110.52 E278.7886
+G1 X160.52 E279.3111
+G1 X160.11 Y110.11
+G1 Y124.89 E279.8639
+G1 X174.89 E280.4168
+G1 Y110.11 E280.9696
+G1 X160.11 E281.5225
+G1 E276.52 F1800
+G1 X74.48 Y110.52 F12000
+G1 E281.52 F1800
+G1 X60.52 E282.045 F4800
+G1 Y124.48 E282.5676
+G1 X74.48 E283.0901
+G1 Y110.52 E283.6126
+G1 X74.89 Y110.11
+G1 X60.11 E284.1655
+G1 Y124.89 E284.7183
+G1 X74.89 E285.2712
+G1 Y110.11 E285.824
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
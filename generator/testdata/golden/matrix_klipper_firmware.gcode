@@ -0,0 +1,1734 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 1
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: true
+;Matrix: 2 pairs, retraction length from bottom to top, speed from front to back
+;Firmware retraction: true
+;Segment 2:   0.2mm @ 25-45mm/s
+;Segment 1:   1mm @ 25-45mm/s
+;Pair 1:   25mm/s
+;Pair 2:   45mm/s
+SET_PRESSURE_ADVANCE ADVANCE=0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G10
+G1 X52.5 Y72.5 F9000
+G11
+G1 X182.5 E8.1072 F1800
+G1 Y73.1 F1800
+G1 X52.5 E16.2143 F1800
+G10
+G1 X52.8 Y112.2 F9000
+G11
+G1 Y111.28 E16.2764 F1800
+G1 X53.72 Y112.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y110.36 E16.6016 F1800
+G1 Y109.44 E16.6636 F1800
+G1 X55.56 Y112.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y108.53 E17.3398 F1800
+G1 Y107.61 E17.4018 F1800
+G1 X57.39 Y112.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y106.69 E18.429 F1800
+G1 Y105.77 E18.491 F1800
+G1 X59.23 Y112.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y104.85 E19.869 F1800
+G1 Y103.93 E19.9311 F1800
+G1 X61.07 Y112.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y103.01 E21.6601 F1800
+G1 Y102.09 E21.7221 F1800
+G1 X62.91 Y112.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y101.18 E23.802 F1800
+G1 Y100.26 E23.8641 F1800
+G1 X64.74 Y112.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y99.34 E26.2949 F1800
+G1 Y98.42 E26.357 F1800
+G1 X66.58 Y112.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y97.5 E29.1388 F1800
+G1 Y96.58 E29.2008 F1800
+G1 X68.42 Y112.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y95.66 E32.3335 F1800
+G1 Y94.74 E32.3956 F1800
+G1 X70.26 Y112.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y93.82 E35.8792 F1800
+G1 Y92.91 E35.9413 F1800
+G1 X72.09 Y112.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y91.99 E39.7759 F1800
+G1 Y91.07 E39.8379 F1800
+G1 X73.93 Y112.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y90.15 E44.0235 F1800
+G1 Y89.23 E44.0855 F1800
+G1 X75.77 Y112.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y88.31 E48.622 F1800
+G1 Y87.39 E48.684 F1800
+G1 X77.61 Y112.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y86.48 E53.5714 F1800
+G1 Y85.56 E53.6335 F1800
+G1 X79.44 Y112.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y84.64 E58.8718 F1800
+G1 Y83.72 E58.9339 F1800
+G1 X81.28 Y112.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y82.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y111.28 E67.3049 F1800
+G1 Y110.36 E67.367 F1800
+G1 X54.64 Y82.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y109.44 E72.6053 F1800
+G1 Y108.53 E72.6674 F1800
+G1 X56.48 Y82.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y107.61 E77.5548 F1800
+G1 Y106.69 E77.6168 F1800
+G1 X58.31 Y82.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y105.77 E82.1533 F1800
+G1 Y104.85 E82.2153 F1800
+G1 X60.15 Y82.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y103.93 E86.4009 F1800
+G1 Y103.01 E86.4629 F1800
+G1 X61.99 Y82.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y102.09 E90.2975 F1800
+G1 Y101.18 E90.3596 F1800
+G1 X63.83 Y82.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y100.26 E93.8432 F1800
+G1 Y99.34 E93.9053 F1800
+G1 X65.66 Y82.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y98.42 E97.038 F1800
+G1 Y97.5 E97.1 F1800
+G1 X67.5 Y82.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y96.58 E99.8818 F1800
+G1 Y95.66 E99.9439 F1800
+G1 X69.34 Y82.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y94.74 E102.3747 F1800
+G1 Y93.83 E102.4368 F1800
+G1 X71.18 Y82.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y92.91 E104.5167 F1800
+G1 Y91.99 E104.5787 F1800
+G1 X73.01 Y82.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y91.07 E106.3077 F1800
+G1 Y90.15 E106.3698 F1800
+G1 X74.85 Y82.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y89.23 E107.7478 F1800
+G1 Y88.31 E107.8099 F1800
+G1 X76.69 Y82.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y87.39 E108.837 F1800
+G1 Y86.48 E108.899 F1800
+G1 X78.53 Y82.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y85.56 E109.5752 F1800
+G1 Y84.64 E109.6372 F1800
+G1 X80.36 Y82.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y83.72 E109.9625 F1800
+G1 Y82.8 E110.0245 F1800
+G10
+G1 X152.8 Y112.2 F9000
+G11
+G1 Y111.28 E110.0865 F1800
+G1 X153.72 Y112.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y110.36 E110.4118 F1800
+G1 Y109.44 E110.4738 F1800
+G1 X155.56 Y112.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y108.53 E111.15 F1800
+G1 Y107.61 E111.212 F1800
+G1 X157.39 Y112.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y106.69 E112.2391 F1800
+G1 Y105.77 E112.3012 F1800
+G1 X159.23 Y112.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y104.85 E113.6792 F1800
+G1 Y103.93 E113.7413 F1800
+G1 X161.07 Y112.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y103.01 E115.4702 F1800
+G1 Y102.09 E115.5323 F1800
+G1 X162.91 Y112.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y101.18 E117.6122 F1800
+G1 Y100.26 E117.6742 F1800
+G1 X164.74 Y112.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y99.34 E120.1051 F1800
+G1 Y98.42 E120.1671 F1800
+G1 X166.58 Y112.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y97.5 E122.9489 F1800
+G1 Y96.58 E123.011 F1800
+G1 X168.42 Y112.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y95.66 E126.1437 F1800
+G1 Y94.74 E126.2057 F1800
+G1 X170.26 Y112.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y93.82 E129.6894 F1800
+G1 Y92.91 E129.7515 F1800
+G1 X172.09 Y112.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y91.99 E133.5861 F1800
+G1 Y91.07 E133.6481 F1800
+G1 X173.93 Y112.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y90.15 E137.8336 F1800
+G1 Y89.23 E137.8957 F1800
+G1 X175.77 Y112.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y88.31 E142.4322 F1800
+G1 Y87.39 E142.4942 F1800
+G1 X177.61 Y112.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y86.48 E147.3816 F1800
+G1 Y85.56 E147.4436 F1800
+G1 X179.44 Y112.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y84.64 E152.682 F1800
+G1 Y83.72 E152.744 F1800
+G1 X181.28 Y112.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y82.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y111.28 E161.1151 F1800
+G1 Y110.36 E161.1772 F1800
+G1 X154.64 Y82.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y109.44 E166.4155 F1800
+G1 Y108.53 E166.4776 F1800
+G1 X156.48 Y82.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y107.61 E171.365 F1800
+G1 Y106.69 E171.427 F1800
+G1 X158.31 Y82.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y105.77 E175.9635 F1800
+G1 Y104.85 E176.0255 F1800
+G1 X160.15 Y82.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y103.93 E180.2111 F1800
+G1 Y103.01 E180.2731 F1800
+G1 X161.99 Y82.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y102.09 E184.1077 F1800
+G1 Y101.18 E184.1697 F1800
+G1 X163.82 Y82.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y100.26 E187.6534 F1800
+G1 Y99.34 E187.7155 F1800
+G1 X165.66 Y82.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y98.42 E190.8482 F1800
+G1 Y97.5 E190.9102 F1800
+G1 X167.5 Y82.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y96.58 E193.692 F1800
+G1 Y95.66 E193.7541 F1800
+G1 X169.34 Y82.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y94.74 E196.1849 F1800
+G1 Y93.83 E196.247 F1800
+G1 X171.18 Y82.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y92.91 E198.3269 F1800
+G1 Y91.99 E198.3889 F1800
+G1 X173.01 Y82.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y91.07 E200.1179 F1800
+G1 Y90.15 E200.1799 F1800
+G1 X174.85 Y82.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y89.23 E201.558 F1800
+G1 Y88.31 E201.62 F1800
+G1 X176.69 Y82.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y87.39 E202.6471 F1800
+G1 Y86.48 E202.7092 F1800
+G1 X178.53 Y82.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y85.56 E203.3854 F1800
+G1 Y84.64 E203.4474 F1800
+G1 X180.36 Y82.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y83.72 E203.7726 F1800
+G1 Y82.8 E203.8347 F1800
+G10
+G1 X52.82 Y152.18 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y151.2 E203.905 F1800
+G1 X53.8 Y152.18 E204.0045 F1800
+G1 X54.78 E204.0748 F1800
+G1 X52.82 Y150.22 E204.2738 F1800
+G1 Y149.24 E204.3442 F1800
+G1 X55.76 Y152.18 E204.6426 F1800
+G1 X56.74 E204.713 F1800
+G1 X52.82 Y148.26 E205.1109 F1800
+G1 Y147.28 E205.1813 F1800
+G1 X57.72 Y152.18 E205.6787 F1800
+G1 X58.69 E205.749 F1800
+G1 X52.82 Y146.31 E206.3459 F1800
+G1 Y145.33 E206.4163 F1800
+G1 X59.67 Y152.18 E207.1127 F1800
+G1 X60.65 E207.183 F1800
+G1 X52.82 Y144.35 E207.9789 F1800
+G1 Y143.37 E208.0493 F1800
+G1 X61.63 Y152.18 E208.9446 F1800
+G1 X62.61 E209.015 F1800
+G1 X52.82 Y142.39 E210.0098 F1800
+G1 Y141.41 E210.0802 F1800
+G1 X63.59 Y152.18 E211.1745 F1800
+G1 X64.56 E211.2448 F1800
+G1 X52.82 Y140.44 E212.4387 F1800
+G1 Y139.46 E212.509 F1800
+G1 X65.54 Y152.18 E213.8023 F1800
+G1 X66.52 E213.8727 F1800
+G1 X52.82 Y138.48 E215.2655 F1800
+G1 Y137.5 E215.3358 F1800
+G1 X67.5 Y152.18 E216.8281 F1800
+G1 X68.48 E216.8984 F1800
+G1 X52.82 Y136.52 E218.4902 F1800
+G1 Y135.54 E218.5605 F1800
+G1 X69.46 Y152.18 E220.2518 F1800
+G1 X70.44 E220.3221 F1800
+G1 X52.82 Y134.56 E222.1129 F1800
+G1 Y133.59 E222.1832 F1800
+G1 X71.41 Y152.18 E224.0734 F1800
+G1 X72.39 E224.1438 F1800
+G1 X52.82 Y132.61 E226.1335 F1800
+G1 Y131.63 E226.2038 F1800
+G1 X73.37 Y152.18 E228.293 F1800
+G1 X74.35 E228.3633 F1800
+G1 X52.82 Y130.65 E230.552 F1800
+G1 Y129.67 E230.6224 F1800
+G1 X75.33 Y152.18 E232.9105 F1800
+G1 X76.31 E232.9809 F1800
+G1 X52.82 Y128.69 E235.3685 F1800
+G1 Y127.72 E235.4389 F1800
+G1 X77.28 Y152.18 E237.926 F1800
+G1 X78.26 E237.9963 F1800
+G1 X52.82 Y126.74 E240.5829 F1800
+G1 Y125.76 E240.6533 F1800
+G1 X79.24 Y152.18 E243.3394 F1800
+G1 X80.22 E243.4097 F1800
+G1 X52.82 Y124.78 E246.1953 F1800
+G1 Y123.8 E246.2657 F1800
+G1 X81.2 Y152.18 E249.1507 F1800
+G1 X82.18 E249.2211 F1800
+G1 X52.82 Y122.82 E252.2056 F1800
+G1 X53.8 E252.276 F1800
+G1 X82.18 Y151.2 E255.161 F1800
+G1 Y150.22 E255.2314 F1800
+G1 X54.78 Y122.82 E258.017 F1800
+G1 X55.76 E258.0873 F1800
+G1 X82.18 Y149.24 E260.7734 F1800
+G1 Y148.26 E260.8438 F1800
+G1 X56.74 Y122.82 E263.4304 F1800
+G1 X57.72 E263.5007 F1800
+G1 X82.18 Y147.28 E265.9878 F1800
+G1 Y146.31 E266.0582 F1800
+G1 X58.69 Y122.82 E268.4458 F1800
+G1 X59.67 E268.5162 F1800
+G1 X82.18 Y145.33 E270.8043 F1800
+G1 Y144.35 E270.8747 F1800
+G1 X60.65 Y122.82 E273.0634 F1800
+G1 X61.63 E273.1337 F1800
+G1 X82.18 Y143.37 E275.2229 F1800
+G1 Y142.39 E275.2932 F1800
+G1 X62.61 Y122.82 E277.2829 F1800
+G1 X63.59 E277.3533 F1800
+G1 X82.18 Y141.41 E279.2435 F1800
+G1 Y140.44 E279.3139 F1800
+G1 X64.56 Y122.82 E281.1046 F1800
+G1 X65.54 E281.1749 F1800
+G1 X82.18 Y139.46 E282.8662 F1800
+G1 Y138.48 E282.9365 F1800
+G1 X66.52 Y122.82 E284.5283 F1800
+G1 X67.5 E284.5986 F1800
+G1 X82.18 Y137.5 E286.0909 F1800
+G1 Y136.52 E286.1613 F1800
+G1 X68.48 Y122.82 E287.554 F1800
+G1 X69.46 E287.6244 F1800
+G1 X82.18 Y135.54 E288.9177 F1800
+G1 Y134.56 E288.988 F1800
+G1 X70.44 Y122.82 E290.1819 F1800
+G1 X71.41 E290.2522 F1800
+G1 X82.18 Y133.59 E291.3465 F1800
+G1 Y132.61 E291.4169 F1800
+G1 X72.39 Y122.82 E292.4117 F1800
+G1 X73.37 E292.4821 F1800
+G1 X82.18 Y131.63 E293.3775 F1800
+G1 Y130.65 E293.4478 F1800
+G1 X74.35 Y122.82 E294.2437 F1800
+G1 X75.33 E294.314 F1800
+G1 X82.18 Y129.67 E295.0104 F1800
+G1 Y128.69 E295.0808 F1800
+G1 X76.31 Y122.82 E295.6777 F1800
+G1 X77.28 E295.748 F1800
+G1 X82.18 Y127.72 E296.2455 F1800
+G1 Y126.74 E296.3158 F1800
+G1 X78.26 Y122.82 E296.7137 F1800
+G1 X79.24 E296.7841 F1800
+G1 X82.18 Y125.76 E297.0825 F1800
+G1 Y124.78 E297.1529 F1800
+G1 X80.22 Y122.82 E297.3519 F1800
+G1 X81.2 E297.4222 F1800
+G1 X82.18 Y123.8 E297.5217 F1800
+G1 Y122.82 E297.592 F1800
+G10
+G1 X152.82 Y152.18 F9000
+G11
+G1 Y151.2 E297.6624 F1800
+G1 X153.8 Y152.18 E297.7619 F1800
+G1 X154.78 E297.8322 F1800
+G1 X152.82 Y150.22 E298.0312 F1800
+G1 Y149.24 E298.1015 F1800
+G1 X155.76 Y152.18 E298.4 F1800
+G1 X156.74 E298.4703 F1800
+G1 X152.82 Y148.26 E298.8683 F1800
+G1 Y147.28 E298.9386 F1800
+G1 X157.72 Y152.18 E299.436 F1800
+G1 X158.69 E299.5064 F1800
+G1 X152.82 Y146.31 E300.1033 F1800
+G1 Y145.33 E300.1737 F1800
+G1 X159.67 Y152.18 E300.87 F1800
+G1 X160.65 E300.9404 F1800
+G1 X152.82 Y144.35 E301.7363 F1800
+G1 Y143.37 E301.8066 F1800
+G1 X161.63 Y152.18 E302.702 F1800
+G1 X162.61 E302.7723 F1800
+G1 X152.82 Y142.39 E303.7672 F1800
+G1 Y141.41 E303.8375 F1800
+G1 X163.59 Y152.18 E304.9319 F1800
+G1 X164.56 E305.0022 F1800
+G1 X152.82 Y140.44 E306.196 F1800
+G1 Y139.46 E306.2664 F1800
+G1 X165.54 Y152.18 E307.5597 F1800
+G1 X166.52 E307.63 F1800
+G1 X152.82 Y138.48 E309.0228 F1800
+G1 Y137.5 E309.0932 F1800
+G1 X167.5 Y152.18 E310.5854 F1800
+G1 X168.48 E310.6558 F1800
+G1 X152.82 Y136.52 E312.2476 F1800
+G1 Y135.54 E312.3179 F1800
+G1 X169.46 Y152.18 E314.0091 F1800
+G1 X170.44 E314.0795 F1800
+G1 X152.82 Y134.56 E315.8702 F1800
+G1 Y133.59 E315.9406 F1800
+G1 X171.41 Y152.18 E317.8308 F1800
+G1 X172.39 E317.9011 F1800
+G1 X152.82 Y132.61 E319.8908 F1800
+G1 Y131.63 E319.9612 F1800
+G1 X173.37 Y152.18 E322.0504 F1800
+G1 X174.35 E322.1207 F1800
+G1 X152.82 Y130.65 E324.3094 F1800
+G1 Y129.67 E324.3797 F1800
+G1 X175.33 Y152.18 E326.6679 F1800
+G1 X176.31 E326.7382 F1800
+G1 X152.82 Y128.69 E329.1259 F1800
+G1 Y127.72 E329.1962 F1800
+G1 X177.28 Y152.18 E331.6834 F1800
+G1 X178.26 E331.7537 F1800
+G1 X152.82 Y126.74 E334.3403 F1800
+G1 Y125.76 E334.4107 F1800
+G1 X179.24 Y152.18 E337.0968 F1800
+G1 X180.22 E337.1671 F1800
+G1 X152.82 Y124.78 E339.9527 F1800
+G1 Y123.8 E340.023 F1800
+G1 X181.2 Y152.18 E342.9081 F1800
+G1 X182.18 E342.9784 F1800
+G1 X152.82 Y122.82 E345.963 F1800
+G1 X153.8 E346.0333 F1800
+G1 X182.18 Y151.2 E348.9184 F1800
+G1 Y150.22 E348.9888 F1800
+G1 X154.78 Y122.82 E351.7743 F1800
+G1 X155.76 E351.8447 F1800
+G1 X182.18 Y149.24 E354.5308 F1800
+G1 Y148.26 E354.6011 F1800
+G1 X156.74 Y122.82 E357.1877 F1800
+G1 X157.72 E357.2581 F1800
+G1 X182.18 Y147.28 E359.7452 F1800
+G1 Y146.31 E359.8156 F1800
+G1 X158.69 Y122.82 E362.2032 F1800
+G1 X159.67 E362.2736 F1800
+G1 X182.18 Y145.33 E364.5617 F1800
+G1 Y144.35 E364.6321 F1800
+G1 X160.65 Y122.82 E366.8207 F1800
+G1 X161.63 E366.8911 F1800
+G1 X182.18 Y143.37 E368.9803 F1800
+G1 Y142.39 E369.0506 F1800
+G1 X162.61 Y122.82 E371.0403 F1800
+G1 X163.59 E371.1107 F1800
+G1 X182.18 Y141.41 E373.0009 F1800
+G1 Y140.44 E373.0712 F1800
+G1 X164.56 Y122.82 E374.862 F1800
+G1 X165.54 E374.9323 F1800
+G1 X182.18 Y139.46 E376.6235 F1800
+G1 Y138.48 E376.6939 F1800
+G1 X166.52 Y122.82 E378.2857 F1800
+G1 X167.5 E378.356 F1800
+G1 X182.18 Y137.5 E379.8483 F1800
+G1 Y136.52 E379.9186 F1800
+G1 X168.48 Y122.82 E381.3114 F1800
+G1 X169.46 E381.3818 F1800
+G1 X182.18 Y135.54 E382.6751 F1800
+G1 Y134.56 E382.7454 F1800
+G1 X170.44 Y122.82 E383.9392 F1800
+G1 X171.41 E384.0096 F1800
+G1 X182.18 Y133.59 E385.1039 F1800
+G1 Y132.61 E385.1743 F1800
+G1 X172.39 Y122.82 E386.1691 F1800
+G1 X173.37 E386.2395 F1800
+G1 X182.18 Y131.63 E387.1348 F1800
+G1 Y130.65 E387.2052 F1800
+G1 X174.35 Y122.82 E388.0011 F1800
+G1 X175.33 E388.0714 F1800
+G1 X182.18 Y129.67 E388.7678 F1800
+G1 Y128.69 E388.8381 F1800
+G1 X176.31 Y122.82 E389.4351 F1800
+G1 X177.28 E389.5054 F1800
+G1 X182.18 Y127.72 E390.0028 F1800
+G1 Y126.74 E390.0732 F1800
+G1 X178.26 Y122.82 E390.4711 F1800
+G1 X179.24 E390.5415 F1800
+G1 X182.18 Y125.76 E390.8399 F1800
+G1 Y124.78 E390.9103 F1800
+G1 X180.22 Y122.82 E391.1092 F1800
+G1 X181.2 E391.1796 F1800
+G1 X182.18 Y123.8 E391.2791 F1800
+G1 Y122.82 E391.3494 F1800
+;layer #2
+M106 S169
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z0.5 F300
+G1 Y144.54 E391.9348 F3600
+G1 X174.54 E392.5202
+G1 Y130.46 E393.1055
+G1 X160.46 E393.6909
+G1 X160.1 Y130.1
+G1 Y144.9 E394.3062
+G1 X174.9 E394.9215
+G1 Y130.1 E395.5369
+G1 X160.1 E396.1522
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E396.7375 F3600
+G1 Y144.54 E397.3229
+G1 X74.54 E397.9083
+G1 Y130.46 E398.4937
+G1 X74.9 Y130.1
+G1 X60.1 E399.109
+G1 Y144.9 E399.7243
+G1 X74.9 E400.3396
+G1 Y130.1 E400.9549
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E401.5403 F3600
+G1 X174.54 E402.1257
+G1 Y90.46 E402.7111
+G1 X160.46 E403.2964
+G1 X160.1 Y90.1
+G1 Y104.9 E403.9118
+G1 X174.9 E404.5271
+G1 Y90.1 E405.1424
+G1 X160.1 E405.7577
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E406.3431 F3600
+G1 Y104.54 E406.9285
+G1 X74.54 E407.5138
+G1 Y90.46 E408.0992
+G1 X74.9 Y90.1
+G1 X60.1 E408.7145
+G1 Y104.9 E409.3298
+G1 X74.9 E409.9451
+G1 Y90.1 E410.5605
+;layer #3
+M106 S254
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z0.75 F300
+G1 Y104.54 E411.1458 F3600
+G1 X174.54 E411.7312
+G1 Y90.46 E412.3166
+G1 X160.46 E412.902
+G1 X160.1 Y90.1
+G1 Y104.9 E413.5173
+G1 X174.9 E414.1326
+G1 Y90.1 E414.7479
+G1 X160.1 E415.3632
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E415.9486 F3600
+G1 Y104.54 E416.534
+G1 X74.54 E417.1194
+G1 Y90.46 E417.7047
+G1 X74.9 Y90.1
+G1 X60.1 E418.32
+G1 Y104.9 E418.9354
+G1 X74.9 E419.5507
+G1 Y90.1 E420.166
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E420.7514 F3600
+G1 X174.54 E421.3367
+G1 Y130.46 E421.9221
+G1 X160.46 E422.5075
+G1 X160.1 Y130.1
+G1 Y144.9 E423.1228
+G1 X174.9 E423.7381
+G1 Y130.1 E424.3534
+G1 X160.1 E424.9688
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E425.5541 F3600
+G1 Y144.54 E426.1395
+G1 X74.54 E426.7249
+G1 Y130.46 E427.3103
+G1 X74.9 Y130.1
+G1 X60.1 E427.9256
+G1 Y144.9 E428.5409
+G1 X74.9 E429.1562
+G1 Y130.1 E429.7715
+;layer #4
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z1 F300
+G1 Y144.54 E430.3569 F3600
+G1 X174.54 E430.9423
+G1 Y130.46 E431.5276
+G1 X160.46 E432.113
+G1 X160.1 Y130.1
+G1 Y144.9 E432.7283
+G1 X174.9 E433.3437
+G1 Y130.1 E433.959
+G1 X160.1 E434.5743
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E435.1597 F3600
+G1 Y144.54 E435.745
+G1 X74.54 E436.3304
+G1 Y130.46 E436.9158
+G1 X74.9 Y130.1
+G1 X60.1 E437.5311
+G1 Y144.9 E438.1464
+G1 X74.9 E438.7617
+G1 Y130.1 E439.377
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E439.9624 F3600
+G1 X174.54 E440.5478
+G1 Y90.46 E441.1332
+G1 X160.46 E441.7186
+G1 X160.1 Y90.1
+G1 Y104.9 E442.3339
+G1 X174.9 E442.9492
+G1 Y90.1 E443.5645
+G1 X160.1 E444.1798
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E444.7652 F3600
+G1 Y104.54 E445.3506
+G1 X74.54 E445.9359
+G1 Y90.46 E446.5213
+G1 X74.9 Y90.1
+G1 X60.1 E447.1366
+G1 Y104.9 E447.7519
+G1 X74.9 E448.3673
+G1 Y90.1 E448.9826
+;layer #5
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z1.25 F300
+G1 Y104.54 E449.5679 F3600
+G1 X174.54 E450.1533
+G1 Y90.46 E450.7387
+G1 X160.46 E451.3241
+G1 X160.1 Y90.1
+G1 Y104.9 E451.9394
+G1 X174.9 E452.5547
+G1 Y90.1 E453.17
+G1 X160.1 E453.7853
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E454.3707 F3600
+G1 Y104.54 E454.9561
+G1 X74.54 E455.5415
+G1 Y90.46 E456.1268
+G1 X74.9 Y90.1
+G1 X60.1 E456.7422
+G1 Y104.9 E457.3575
+G1 X74.9 E457.9728
+G1 Y90.1 E458.5881
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E459.1735 F3600
+G1 X174.54 E459.7589
+G1 Y130.46 E460.3442
+G1 X160.46 E460.9296
+G1 X160.1 Y130.1
+G1 Y144.9 E461.5449
+G1 X174.9 E462.1602
+G1 Y130.1 E462.7755
+G1 X160.1 E463.3909
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E463.9762 F3600
+G1 Y144.54 E464.5616
+G1 X74.54 E465.147
+G1 Y130.46 E465.7324
+G1 X74.9 Y130.1
+G1 X60.1 E466.3477
+G1 Y144.9 E466.963
+G1 X74.9 E467.5783
+G1 Y130.1 E468.1936
+;layer #6
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z1.5 F300
+G1 Y144.54 E468.779 F3600
+G1 X174.54 E469.3644
+G1 Y130.46 E469.9498
+G1 X160.46 E470.5351
+G1 X160.1 Y130.1
+G1 Y144.9 E471.1504
+G1 X174.9 E471.7658
+G1 Y130.1 E472.3811
+G1 X160.1 E472.9964
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E473.5818 F3600
+G1 Y144.54 E474.1671
+G1 X74.54 E474.7525
+G1 Y130.46 E475.3379
+G1 X74.9 Y130.1
+G1 X60.1 E475.9532
+G1 Y144.9 E476.5685
+G1 X74.9 E477.1838
+G1 Y130.1 E477.7991
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E478.3845 F3600
+G1 X174.54 E478.9699
+G1 Y90.46 E479.5553
+G1 X160.46 E480.1407
+G1 X160.1 Y90.1
+G1 Y104.9 E480.756
+G1 X174.9 E481.3713
+G1 Y90.1 E481.9866
+G1 X160.1 E482.6019
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E483.1873 F3600
+G1 Y104.54 E483.7727
+G1 X74.54 E484.358
+G1 Y90.46 E484.9434
+G1 X74.9 Y90.1
+G1 X60.1 E485.5587
+G1 Y104.9 E486.1741
+G1 X74.9 E486.7894
+G1 Y90.1 E487.4047
+;layer #7
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z1.75 F300
+G1 Y104.54 E487.9901 F3600
+G1 X174.54 E488.5754
+G1 Y90.46 E489.1608
+G1 X160.46 E489.7462
+G1 X160.1 Y90.1
+G1 Y104.9 E490.3615
+G1 X174.9 E490.9768
+G1 Y90.1 E491.5921
+G1 X160.1 E492.2074
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E492.7928 F3600
+G1 Y104.54 E493.3782
+G1 X74.54 E493.9636
+G1 Y90.46 E494.549
+G1 X74.9 Y90.1
+G1 X60.1 E495.1643
+G1 Y104.9 E495.7796
+G1 X74.9 E496.3949
+G1 Y90.1 E497.0102
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E497.5956 F3600
+G1 X174.54 E498.181
+G1 Y130.46 E498.7663
+G1 X160.46 E499.3517
+G1 X160.1 Y130.1
+G1 Y144.9 E499.967
+G1 X174.9 E500.5823
+G1 Y130.1 E501.1977
+G1 X160.1 E501.813
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E502.3983 F3600
+G1 Y144.54 E502.9837
+G1 X74.54 E503.5691
+G1 Y130.46 E504.1545
+G1 X74.9 Y130.1
+G1 X60.1 E504.7698
+G1 Y144.9 E505.3851
+G1 X74.9 E506.0004
+G1 Y130.1 E506.6157
+;layer #8
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z2 F300
+G1 Y144.54 E507.2011 F3600
+G1 X174.54 E507.7865
+G1 Y130.46 E508.3719
+G1 X160.46 E508.9572
+G1 X160.1 Y130.1
+G1 Y144.9 E509.5726
+G1 X174.9 E510.1879
+G1 Y130.1 E510.8032
+G1 X160.1 E511.4185
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E512.0039 F3600
+G1 Y144.54 E512.5893
+G1 X74.54 E513.1746
+G1 Y130.46 E513.76
+G1 X74.9 Y130.1
+G1 X60.1 E514.3753
+G1 Y144.9 E514.9906
+G1 X74.9 E515.6059
+G1 Y130.1 E516.2213
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E516.8066 F3600
+G1 X174.54 E517.392
+G1 Y90.46 E517.9774
+G1 X160.46 E518.5628
+G1 X160.1 Y90.1
+G1 Y104.9 E519.1781
+G1 X174.9 E519.7934
+G1 Y90.1 E520.4087
+G1 X160.1 E521.024
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E521.6094 F3600
+G1 Y104.54 E522.1948
+G1 X74.54 E522.7802
+G1 Y90.46 E523.3655
+G1 X74.9 Y90.1
+G1 X60.1 E523.9808
+G1 Y104.9 E524.5962
+G1 X74.9 E525.2115
+G1 Y90.1 E525.8268
+;layer #9
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z2.25 F300
+G1 Y104.54 E526.4122 F3600
+G1 X174.54 E526.9975
+G1 Y90.46 E527.5829
+G1 X160.46 E528.1683
+G1 X160.1 Y90.1
+G1 Y104.9 E528.7836
+G1 X174.9 E529.3989
+G1 Y90.1 E530.0142
+G1 X160.1 E530.6295
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E531.2149 F3600
+G1 Y104.54 E531.8003
+G1 X74.54 E532.3857
+G1 Y90.46 E532.9711
+G1 X74.9 Y90.1
+G1 X60.1 E533.5864
+G1 Y104.9 E534.2017
+G1 X74.9 E534.817
+G1 Y90.1 E535.4323
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E536.0177 F3600
+G1 X174.54 E536.6031
+G1 Y130.46 E537.1884
+G1 X160.46 E537.7738
+G1 X160.1 Y130.1
+G1 Y144.9 E538.3891
+G1 X174.9 E539.0044
+G1 Y130.1 E539.6198
+G1 X160.1 E540.2351
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E540.8205 F3600
+G1 Y144.54 E541.4058
+G1 X74.54 E541.9912
+G1 Y130.46 E542.5766
+G1 X74.9 Y130.1
+G1 X60.1 E543.1919
+G1 Y144.9 E543.8072
+G1 X74.9 E544.4225
+G1 Y130.1 E545.0378
+;layer #10
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z2.5 F300
+G1 Y144.54 E545.6232 F3600
+G1 X174.54 E546.2086
+G1 Y130.46 E546.794
+G1 X160.46 E547.3794
+G1 X160.1 Y130.1
+G1 Y144.9 E547.9947
+G1 X174.9 E548.61
+G1 Y130.1 E549.2253
+G1 X160.1 E549.8406
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E550.426 F3600
+G1 Y144.54 E551.0114
+G1 X74.54 E551.5967
+G1 Y130.46 E552.1821
+G1 X74.9 Y130.1
+G1 X60.1 E552.7974
+G1 Y144.9 E553.4127
+G1 X74.9 E554.0281
+G1 Y130.1 E554.6434
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E555.2287 F3600
+G1 X174.54 E555.8141
+G1 Y90.46 E556.3995
+G1 X160.46 E556.9849
+G1 X160.1 Y90.1
+G1 Y104.9 E557.6002
+G1 X174.9 E558.2155
+G1 Y90.1 E558.8308
+G1 X160.1 E559.4461
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E560.0315 F3600
+G1 Y104.54 E560.6169
+G1 X74.54 E561.2023
+G1 Y90.46 E561.7876
+G1 X74.9 Y90.1
+G1 X60.1 E562.403
+G1 Y104.9 E563.0183
+G1 X74.9 E563.6336
+G1 Y90.1 E564.2489
+;layer #11
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z2.75 F300
+G1 Y104.54 E564.8343 F3600
+G1 X174.54 E565.4196
+G1 Y90.46 E566.005
+G1 X160.46 E566.5904
+G1 X160.1 Y90.1
+G1 Y104.9 E567.2057
+G1 X174.9 E567.821
+G1 Y90.1 E568.4363
+G1 X160.1 E569.0517
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E569.637 F3600
+G1 Y104.54 E570.2224
+G1 X74.54 E570.8078
+G1 Y90.46 E571.3932
+G1 X74.9 Y90.1
+G1 X60.1 E572.0085
+G1 Y104.9 E572.6238
+G1 X74.9 E573.2391
+G1 Y90.1 E573.8544
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E574.4398 F3600
+G1 X174.54 E575.0252
+G1 Y130.46 E575.6106
+G1 X160.46 E576.1959
+G1 X160.1 Y130.1
+G1 Y144.9 E576.8112
+G1 X174.9 E577.4266
+G1 Y130.1 E578.0419
+G1 X160.1 E578.6572
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E579.2426 F3600
+G1 Y144.54 E579.8279
+G1 X74.54 E580.4133
+G1 Y130.46 E580.9987
+G1 X74.9 Y130.1
+G1 X60.1 E581.614
+G1 Y144.9 E582.2293
+G1 X74.9 E582.8446
+G1 Y130.1 E583.4599
+;layer #12
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z3 F300
+G1 Y144.54 E584.0453 F3600
+G1 X174.54 E584.6307
+G1 Y130.46 E585.2161
+G1 X160.46 E585.8015
+G1 X160.1 Y130.1
+G1 Y144.9 E586.4168
+G1 X174.9 E587.0321
+G1 Y130.1 E587.6474
+G1 X160.1 E588.2627
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E588.8481 F3600
+G1 Y144.54 E589.4335
+G1 X74.54 E590.0188
+G1 Y130.46 E590.6042
+G1 X74.9 Y130.1
+G1 X60.1 E591.2195
+G1 Y144.9 E591.8348
+G1 X74.9 E592.4502
+G1 Y130.1 E593.0655
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=1 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E593.6509 F3600
+G1 X174.54 E594.2362
+G1 Y90.46 E594.8216
+G1 X160.46 E595.407
+G1 X160.1 Y90.1
+G1 Y104.9 E596.0223
+G1 X174.9 E596.6376
+G1 Y90.1 E597.2529
+G1 X160.1 E597.8682
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E598.4536 F3600
+G1 Y104.54 E599.039
+G1 X74.54 E599.6244
+G1 Y90.46 E600.2098
+G1 X74.9 Y90.1
+G1 X60.1 E600.8251
+G1 Y104.9 E601.4404
+G1 X74.9 E602.0557
+G1 Y90.1 E602.671
+;layer #13
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G10
+G1 X160.36 Y90.36 F9000
+G11
+G1 Z3.25 F300
+G1 Y104.64 E603.2647 F3600
+G1 X174.64 E603.8584
+G1 Y90.36 E604.4521
+G1 X160.36 E605.0458
+G1 X160 Y90
+G1 Y105 E605.6694
+G1 X175 E606.293
+G1 Y90 E606.9167
+G1 X160 E607.5403
+G10
+G1 X74.64 Y90.36 F9000
+G11
+G1 X60.36 E608.134 F3600
+G1 Y104.64 E608.7277
+G1 X74.64 E609.3214
+G1 Y90.36 E609.9151
+G1 X75 Y90
+G1 X60 E610.5387
+G1 Y105 E611.1623
+G1 X75 E611.7859
+G1 Y90 E612.4096
+G10
+G1 X160.36 Y130.36 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.64 E613.0033 F3600
+G1 X174.64 E613.597
+G1 Y130.36 E614.1906
+G1 X160.36 E614.7843
+G1 X160 Y130
+G1 Y145 E615.408
+G1 X175 E616.0316
+G1 Y130 E616.6552
+G1 X160 E617.2789
+G10
+G1 X74.64 Y130.36 F9000
+G11
+G1 X60.36 E617.8725 F3600
+G1 Y144.64 E618.4662
+G1 X74.64 E619.0599
+G1 Y130.36 E619.6536
+G1 X75 Y130
+G1 X60 E620.2773
+G1 Y145 E620.9009
+G1 X75 E621.5245
+G1 Y130 E622.1481
+;layer #14
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z3.5 F300
+G1 Y144.54 E622.7335 F3600
+G1 X174.54 E623.3189
+G1 Y130.46 E623.9043
+G1 X160.46 E624.4896
+G1 X160.1 Y130.1
+G1 Y144.9 E625.105
+G1 X174.9 E625.7203
+G1 Y130.1 E626.3356
+G1 X160.1 E626.9509
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E627.5363 F3600
+G1 Y144.54 E628.1217
+G1 X74.54 E628.707
+G1 Y130.46 E629.2924
+G1 X74.9 Y130.1
+G1 X60.1 E629.9077
+G1 Y144.9 E630.523
+G1 X74.9 E631.1383
+G1 Y130.1 E631.7537
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E632.339 F3600
+G1 X174.54 E632.9244
+G1 Y90.46 E633.5098
+G1 X160.46 E634.0952
+G1 X160.1 Y90.1
+G1 Y104.9 E634.7105
+G1 X174.9 E635.3258
+G1 Y90.1 E635.9411
+G1 X160.1 E636.5564
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E637.1418 F3600
+G1 Y104.54 E637.7272
+G1 X74.54 E638.3126
+G1 Y90.46 E638.8979
+G1 X74.9 Y90.1
+G1 X60.1 E639.5133
+G1 Y104.9 E640.1286
+G1 X74.9 E640.7439
+G1 Y90.1 E641.3592
+;layer #15
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z3.75 F300
+G1 Y104.54 E641.9446 F3600
+G1 X174.54 E642.5299
+G1 Y90.46 E643.1153
+G1 X160.46 E643.7007
+G1 X160.1 Y90.1
+G1 Y104.9 E644.316
+G1 X174.9 E644.9313
+G1 Y90.1 E645.5466
+G1 X160.1 E646.162
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E646.7473 F3600
+G1 Y104.54 E647.3327
+G1 X74.54 E647.9181
+G1 Y90.46 E648.5035
+G1 X74.9 Y90.1
+G1 X60.1 E649.1188
+G1 Y104.9 E649.7341
+G1 X74.9 E650.3494
+G1 Y90.1 E650.9647
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E651.5501 F3600
+G1 X174.54 E652.1355
+G1 Y130.46 E652.7209
+G1 X160.46 E653.3062
+G1 X160.1 Y130.1
+G1 Y144.9 E653.9215
+G1 X174.9 E654.5369
+G1 Y130.1 E655.1522
+G1 X160.1 E655.7675
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E656.3529 F3600
+G1 Y144.54 E656.9382
+G1 X74.54 E657.5236
+G1 Y130.46 E658.109
+G1 X74.9 Y130.1
+G1 X60.1 E658.7243
+G1 Y144.9 E659.3396
+G1 X74.9 E659.9549
+G1 Y130.1 E660.5702
+;layer #16
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z4 F300
+G1 Y144.54 E661.1556 F3600
+G1 X174.54 E661.741
+G1 Y130.46 E662.3264
+G1 X160.46 E662.9118
+G1 X160.1 Y130.1
+G1 Y144.9 E663.5271
+G1 X174.9 E664.1424
+G1 Y130.1 E664.7577
+G1 X160.1 E665.373
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E665.9584 F3600
+G1 Y144.54 E666.5438
+G1 X74.54 E667.1291
+G1 Y130.46 E667.7145
+G1 X74.9 Y130.1
+G1 X60.1 E668.3298
+G1 Y144.9 E668.9451
+G1 X74.9 E669.5605
+G1 Y130.1 E670.1758
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E670.7611 F3600
+G1 X174.54 E671.3465
+G1 Y90.46 E671.9319
+G1 X160.46 E672.5173
+G1 X160.1 Y90.1
+G1 Y104.9 E673.1326
+G1 X174.9 E673.7479
+G1 Y90.1 E674.3632
+G1 X160.1 E674.9785
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E675.5639 F3600
+G1 Y104.54 E676.1493
+G1 X74.54 E676.7347
+G1 Y90.46 E677.32
+G1 X74.9 Y90.1
+G1 X60.1 E677.9354
+G1 Y104.9 E678.5507
+G1 X74.9 E679.166
+G1 Y90.1 E679.7813
+;layer #17
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z4.25 F300
+G1 Y104.54 E680.3667 F3600
+G1 X174.54 E680.9521
+G1 Y90.46 E681.5374
+G1 X160.46 E682.1228
+G1 X160.1 Y90.1
+G1 Y104.9 E682.7381
+G1 X174.9 E683.3534
+G1 Y90.1 E683.9687
+G1 X160.1 E684.5841
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E685.1694 F3600
+G1 Y104.54 E685.7548
+G1 X74.54 E686.3402
+G1 Y90.46 E686.9256
+G1 X74.9 Y90.1
+G1 X60.1 E687.5409
+G1 Y104.9 E688.1562
+G1 X74.9 E688.7715
+G1 Y90.1 E689.3868
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E689.9722 F3600
+G1 X174.54 E690.5576
+G1 Y130.46 E691.143
+G1 X160.46 E691.7283
+G1 X160.1 Y130.1
+G1 Y144.9 E692.3437
+G1 X174.9 E692.959
+G1 Y130.1 E693.5743
+G1 X160.1 E694.1896
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E694.775 F3600
+G1 Y144.54 E695.3603
+G1 X74.54 E695.9457
+G1 Y130.46 E696.5311
+G1 X74.9 Y130.1
+G1 X60.1 E697.1464
+G1 Y144.9 E697.7617
+G1 X74.9 E698.377
+G1 Y130.1 E698.9924
+;layer #18
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z4.5 F300
+G1 Y144.54 E699.5777 F3600
+G1 X174.54 E700.1631
+G1 Y130.46 E700.7485
+G1 X160.46 E701.3339
+G1 X160.1 Y130.1
+G1 Y144.9 E701.9492
+G1 X174.9 E702.5645
+G1 Y130.1 E703.1798
+G1 X160.1 E703.7951
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E704.3805 F3600
+G1 Y144.54 E704.9659
+G1 X74.54 E705.5513
+G1 Y130.46 E706.1366
+G1 X74.9 Y130.1
+G1 X60.1 E706.7519
+G1 Y144.9 E707.3673
+G1 X74.9 E707.9826
+G1 Y130.1 E708.5979
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E709.1833 F3600
+G1 X174.54 E709.7686
+G1 Y90.46 E710.354
+G1 X160.46 E710.9394
+G1 X160.1 Y90.1
+G1 Y104.9 E711.5547
+G1 X174.9 E712.17
+G1 Y90.1 E712.7853
+G1 X160.1 E713.4006
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E713.986 F3600
+G1 Y104.54 E714.5714
+G1 X74.54 E715.1568
+G1 Y90.46 E715.7422
+G1 X74.9 Y90.1
+G1 X60.1 E716.3575
+G1 Y104.9 E716.9728
+G1 X74.9 E717.5881
+G1 Y90.1 E718.2034
+;layer #19
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z4.75 F300
+G1 Y104.54 E718.7888 F3600
+G1 X174.54 E719.3742
+G1 Y90.46 E719.9595
+G1 X160.46 E720.5449
+G1 X160.1 Y90.1
+G1 Y104.9 E721.1602
+G1 X174.9 E721.7755
+G1 Y90.1 E722.3909
+G1 X160.1 E723.0062
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E723.5915 F3600
+G1 Y104.54 E724.1769
+G1 X74.54 E724.7623
+G1 Y90.46 E725.3477
+G1 X74.9 Y90.1
+G1 X60.1 E725.963
+G1 Y104.9 E726.5783
+G1 X74.9 E727.1936
+G1 Y90.1 E727.8089
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E728.3943 F3600
+G1 X174.54 E728.9797
+G1 Y130.46 E729.5651
+G1 X160.46 E730.1504
+G1 X160.1 Y130.1
+G1 Y144.9 E730.7658
+G1 X174.9 E731.3811
+G1 Y130.1 E731.9964
+G1 X160.1 E732.6117
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E733.1971 F3600
+G1 Y144.54 E733.7825
+G1 X74.54 E734.3678
+G1 Y130.46 E734.9532
+G1 X74.9 Y130.1
+G1 X60.1 E735.5685
+G1 Y144.9 E736.1838
+G1 X74.9 E736.7991
+G1 Y130.1 E737.4145
+;layer #20
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z5 F300
+G1 Y144.54 E737.9998 F3600
+G1 X174.54 E738.5852
+G1 Y130.46 E739.1706
+G1 X160.46 E739.756
+G1 X160.1 Y130.1
+G1 Y144.9 E740.3713
+G1 X174.9 E740.9866
+G1 Y130.1 E741.6019
+G1 X160.1 E742.2172
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E742.8026 F3600
+G1 Y144.54 E743.388
+G1 X74.54 E743.9734
+G1 Y130.46 E744.5587
+G1 X74.9 Y130.1
+G1 X60.1 E745.174
+G1 Y144.9 E745.7894
+G1 X74.9 E746.4047
+G1 Y130.1 E747.02
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E747.6054 F3600
+G1 X174.54 E748.1907
+G1 Y90.46 E748.7761
+G1 X160.46 E749.3615
+G1 X160.1 Y90.1
+G1 Y104.9 E749.9768
+G1 X174.9 E750.5921
+G1 Y90.1 E751.2074
+G1 X160.1 E751.8227
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E752.4081 F3600
+G1 Y104.54 E752.9935
+G1 X74.54 E753.5789
+G1 Y90.46 E754.1643
+G1 X74.9 Y90.1
+G1 X60.1 E754.7796
+G1 Y104.9 E755.3949
+G1 X74.9 E756.0102
+G1 Y90.1 E756.6255
+;layer #21
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z5.25 F300
+G1 Y104.54 E757.2109 F3600
+G1 X174.54 E757.7963
+G1 Y90.46 E758.3816
+G1 X160.46 E758.967
+G1 X160.1 Y90.1
+G1 Y104.9 E759.5823
+G1 X174.9 E760.1977
+G1 Y90.1 E760.813
+G1 X160.1 E761.4283
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E762.0137 F3600
+G1 Y104.54 E762.599
+G1 X74.54 E763.1844
+G1 Y90.46 E763.7698
+G1 X74.9 Y90.1
+G1 X60.1 E764.3851
+G1 Y104.9 E765.0004
+G1 X74.9 E765.6157
+G1 Y90.1 E766.231
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E766.8164 F3600
+G1 X174.54 E767.4018
+G1 Y130.46 E767.9872
+G1 X160.46 E768.5726
+G1 X160.1 Y130.1
+G1 Y144.9 E769.1879
+G1 X174.9 E769.8032
+G1 Y130.1 E770.4185
+G1 X160.1 E771.0338
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E771.6192 F3600
+G1 Y144.54 E772.2046
+G1 X74.54 E772.7899
+G1 Y130.46 E773.3753
+G1 X74.9 Y130.1
+G1 X60.1 E773.9906
+G1 Y144.9 E774.6059
+G1 X74.9 E775.2213
+G1 Y130.1 E775.8366
+;layer #22
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z5.5 F300
+G1 Y144.54 E776.4219 F3600
+G1 X174.54 E777.0073
+G1 Y130.46 E777.5927
+G1 X160.46 E778.1781
+G1 X160.1 Y130.1
+G1 Y144.9 E778.7934
+G1 X174.9 E779.4087
+G1 Y130.1 E780.024
+G1 X160.1 E780.6393
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E781.2247 F3600
+G1 Y144.54 E781.8101
+G1 X74.54 E782.3955
+G1 Y130.46 E782.9808
+G1 X74.9 Y130.1
+G1 X60.1 E783.5962
+G1 Y144.9 E784.2115
+G1 X74.9 E784.8268
+G1 Y130.1 E785.4421
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E786.0275 F3600
+G1 X174.54 E786.6129
+G1 Y90.46 E787.1982
+G1 X160.46 E787.7836
+G1 X160.1 Y90.1
+G1 Y104.9 E788.3989
+G1 X174.9 E789.0142
+G1 Y90.1 E789.6295
+G1 X160.1 E790.2449
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E790.8302 F3600
+G1 Y104.54 E791.4156
+G1 X74.54 E792.001
+G1 Y90.46 E792.5864
+G1 X74.9 Y90.1
+G1 X60.1 E793.2017
+G1 Y104.9 E793.817
+G1 X74.9 E794.4323
+G1 Y90.1 E795.0476
+;layer #23
+G10
+G1 X160.46 Y90.46 F9000
+G11
+G1 Z5.75 F300
+G1 Y104.54 E795.633 F3600
+G1 X174.54 E796.2184
+G1 Y90.46 E796.8038
+G1 X160.46 E797.3891
+G1 X160.1 Y90.1
+G1 Y104.9 E798.0044
+G1 X174.9 E798.6198
+G1 Y90.1 E799.2351
+G1 X160.1 E799.8504
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E800.4358 F3600
+G1 Y104.54 E801.0211
+G1 X74.54 E801.6065
+G1 Y90.46 E802.1919
+G1 X74.9 Y90.1
+G1 X60.1 E802.8072
+G1 Y104.9 E803.4225
+G1 X74.9 E804.0378
+G1 Y90.1 E804.6531
+G10
+G1 X160.46 Y130.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=45 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=45
+G11
+G1 Y144.54 E805.2385 F3600
+G1 X174.54 E805.8239
+G1 Y130.46 E806.4093
+G1 X160.46 E806.9947
+G1 X160.1 Y130.1
+G1 Y144.9 E807.61
+G1 X174.9 E808.2253
+G1 Y130.1 E808.8406
+G1 X160.1 E809.4559
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E810.0413 F3600
+G1 Y144.54 E810.6267
+G1 X74.54 E811.212
+G1 Y130.46 E811.7974
+G1 X74.9 Y130.1
+G1 X60.1 E812.4127
+G1 Y144.9 E813.0281
+G1 X74.9 E813.6434
+G1 Y130.1 E814.2587
+;layer #24
+G10
+G1 X160.46 Y130.46 F9000
+G11
+G1 Z6 F300
+G1 Y144.54 E814.8441 F3600
+G1 X174.54 E815.4294
+G1 Y130.46 E816.0148
+G1 X160.46 E816.6002
+G1 X160.1 Y130.1
+G1 Y144.9 E817.2155
+G1 X174.9 E817.8308
+G1 Y130.1 E818.4461
+G1 X160.1 E819.0614
+G10
+G1 X74.54 Y130.46 F9000
+G11
+G1 X60.46 E819.6468 F3600
+G1 Y144.54 E820.2322
+G1 X74.54 E820.8176
+G1 Y130.46 E821.403
+G1 X74.9 Y130.1
+G1 X60.1 E822.0183
+G1 Y144.9 E822.6336
+G1 X74.9 E823.2489
+G1 Y130.1 E823.8642
+G10
+G1 X160.46 Y90.46 F9000
+SET_RETRACTION RETRACT_LENGTH=0.2 RETRACT_SPEED=25 UNRETRACT_EXTRA_LENGTH=0 UNRETRACT_SPEED=25
+G11
+G1 Y104.54 E824.4496 F3600
+G1 X174.54 E825.035
+G1 Y90.46 E825.6203
+G1 X160.46 E826.2057
+G1 X160.1 Y90.1
+G1 Y104.9 E826.821
+G1 X174.9 E827.4363
+G1 Y90.1 E828.0517
+G1 X160.1 E828.667
+G10
+G1 X74.54 Y90.46 F9000
+G11
+G1 X60.46 E829.2523 F3600
+G1 Y104.54 E829.8377
+G1 X74.54 E830.4231
+G1 Y90.46 E831.0085
+G1 X74.9 Y90.1
+G1 X60.1 E831.6238
+G1 Y104.9 E832.2391
+G1 X74.9 E832.8544
+G1 Y90.1 E833.4697
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
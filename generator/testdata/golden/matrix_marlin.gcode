@@ -0,0 +1,3377 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Matrix: 3 pairs, retraction length from bottom to top, speed from front to back
+;Segment 3:   0.2mm @ 20-60mm/s
+;Segment 2:   0.6mm @ 20-60mm/s
+;Segment 1:   1mm @ 20-60mm/s
+;Pair 1:   20mm/s
+;Pair 2:   40mm/s
+;Pair 3:   60mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1200
+G1 X52.5 Y52.5 F9000
+G1 E0 F1200
+G1 X182.5 E8.1072 F1800
+G1 Y53.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1200
+G1 X52.8 Y92.2 F9000
+G1 E16.21 F1200
+G1 Y91.28 E16.2764 F1800
+G1 X53.72 Y92.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y90.36 E16.6016 F1800
+G1 Y89.44 E16.6636 F1800
+G1 X55.56 Y92.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y88.53 E17.3398 F1800
+G1 Y87.61 E17.4018 F1800
+G1 X57.39 Y92.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y86.69 E18.429 F1800
+G1 Y85.77 E18.491 F1800
+G1 X59.23 Y92.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y84.85 E19.869 F1800
+G1 Y83.93 E19.9311 F1800
+G1 X61.07 Y92.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y83.01 E21.6601 F1800
+G1 Y82.09 E21.7221 F1800
+G1 X62.91 Y92.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y81.18 E23.802 F1800
+G1 Y80.26 E23.8641 F1800
+G1 X64.74 Y92.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y79.34 E26.2949 F1800
+G1 Y78.42 E26.357 F1800
+G1 X66.58 Y92.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y77.5 E29.1388 F1800
+G1 Y76.58 E29.2008 F1800
+G1 X68.42 Y92.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y75.66 E32.3335 F1800
+G1 Y74.74 E32.3956 F1800
+G1 X70.26 Y92.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y73.82 E35.8792 F1800
+G1 Y72.91 E35.9413 F1800
+G1 X72.09 Y92.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y71.99 E39.7759 F1800
+G1 Y71.07 E39.8379 F1800
+G1 X73.93 Y92.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y70.15 E44.0235 F1800
+G1 Y69.23 E44.0855 F1800
+G1 X75.77 Y92.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y68.31 E48.622 F1800
+G1 Y67.39 E48.684 F1800
+G1 X77.61 Y92.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y66.47 E53.5714 F1800
+G1 Y65.56 E53.6335 F1800
+G1 X79.44 Y92.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y64.64 E58.8718 F1800
+G1 Y63.72 E58.9339 F1800
+G1 X81.28 Y92.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y62.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y91.28 E67.3049 F1800
+G1 Y90.36 E67.367 F1800
+G1 X54.64 Y62.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y89.44 E72.6053 F1800
+G1 Y88.53 E72.6674 F1800
+G1 X56.48 Y62.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y87.61 E77.5548 F1800
+G1 Y86.69 E77.6168 F1800
+G1 X58.31 Y62.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y85.77 E82.1533 F1800
+G1 Y84.85 E82.2153 F1800
+G1 X60.15 Y62.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y83.93 E86.4009 F1800
+G1 Y83.01 E86.4629 F1800
+G1 X61.99 Y62.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y82.09 E90.2975 F1800
+G1 Y81.18 E90.3596 F1800
+G1 X63.83 Y62.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y80.26 E93.8432 F1800
+G1 Y79.34 E93.9053 F1800
+G1 X65.66 Y62.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y78.42 E97.038 F1800
+G1 Y77.5 E97.1 F1800
+G1 X67.5 Y62.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y76.58 E99.8818 F1800
+G1 Y75.66 E99.9439 F1800
+G1 X69.34 Y62.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y74.74 E102.3747 F1800
+G1 Y73.83 E102.4368 F1800
+G1 X71.18 Y62.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y72.91 E104.5167 F1800
+G1 Y71.99 E104.5787 F1800
+G1 X73.01 Y62.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y71.07 E106.3077 F1800
+G1 Y70.15 E106.3698 F1800
+G1 X74.85 Y62.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y69.23 E107.7478 F1800
+G1 Y68.31 E107.8099 F1800
+G1 X76.69 Y62.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y67.39 E108.837 F1800
+G1 Y66.48 E108.899 F1800
+G1 X78.53 Y62.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y65.56 E109.5752 F1800
+G1 Y64.64 E109.6372 F1800
+G1 X80.36 Y62.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y63.72 E109.9625 F1800
+G1 Y62.8 E110.0245 F1800
+G1 E109.02 F1200
+G1 X152.8 Y92.2 F9000
+G1 E110.02 F1200
+G1 Y91.28 E110.0865 F1800
+G1 X153.72 Y92.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y90.36 E110.4118 F1800
+G1 Y89.44 E110.4738 F1800
+G1 X155.56 Y92.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y88.53 E111.15 F1800
+G1 Y87.61 E111.212 F1800
+G1 X157.39 Y92.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y86.69 E112.2391 F1800
+G1 Y85.77 E112.3012 F1800
+G1 X159.23 Y92.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y84.85 E113.6792 F1800
+G1 Y83.93 E113.7413 F1800
+G1 X161.07 Y92.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y83.01 E115.4702 F1800
+G1 Y82.09 E115.5323 F1800
+G1 X162.91 Y92.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y81.18 E117.6122 F1800
+G1 Y80.26 E117.6742 F1800
+G1 X164.74 Y92.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y79.34 E120.1051 F1800
+G1 Y78.42 E120.1671 F1800
+G1 X166.58 Y92.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y77.5 E122.9489 F1800
+G1 Y76.58 E123.011 F1800
+G1 X168.42 Y92.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y75.66 E126.1437 F1800
+G1 Y74.74 E126.2057 F1800
+G1 X170.26 Y92.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y73.82 E129.6894 F1800
+G1 Y72.91 E129.7515 F1800
+G1 X172.09 Y92.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y71.99 E133.5861 F1800
+G1 Y71.07 E133.6481 F1800
+G1 X173.93 Y92.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y70.15 E137.8336 F1800
+G1 Y69.23 E137.8957 F1800
+G1 X175.77 Y92.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y68.31 E142.4322 F1800
+G1 Y67.39 E142.4942 F1800
+G1 X177.61 Y92.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y66.47 E147.3816 F1800
+G1 Y65.56 E147.4436 F1800
+G1 X179.44 Y92.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y64.64 E152.682 F1800
+G1 Y63.72 E152.744 F1800
+G1 X181.28 Y92.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y62.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y91.28 E161.1151 F1800
+G1 Y90.36 E161.1772 F1800
+G1 X154.64 Y62.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y89.44 E166.4155 F1800
+G1 Y88.53 E166.4776 F1800
+G1 X156.48 Y62.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y87.61 E171.365 F1800
+G1 Y86.69 E171.427 F1800
+G1 X158.31 Y62.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y85.77 E175.9635 F1800
+G1 Y84.85 E176.0255 F1800
+G1 X160.15 Y62.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y83.93 E180.2111 F1800
+G1 Y83.01 E180.2731 F1800
+G1 X161.99 Y62.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y82.09 E184.1077 F1800
+G1 Y81.18 E184.1697 F1800
+G1 X163.82 Y62.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y80.26 E187.6534 F1800
+G1 Y79.34 E187.7155 F1800
+G1 X165.66 Y62.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y78.42 E190.8482 F1800
+G1 Y77.5 E190.9102 F1800
+G1 X167.5 Y62.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y76.58 E193.692 F1800
+G1 Y75.66 E193.7541 F1800
+G1 X169.34 Y62.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y74.74 E196.1849 F1800
+G1 Y73.83 E196.247 F1800
+G1 X171.18 Y62.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y72.91 E198.3269 F1800
+G1 Y71.99 E198.3889 F1800
+G1 X173.01 Y62.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y71.07 E200.1179 F1800
+G1 Y70.15 E200.1799 F1800
+G1 X174.85 Y62.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y69.23 E201.558 F1800
+G1 Y68.31 E201.62 F1800
+G1 X176.69 Y62.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y67.39 E202.6471 F1800
+G1 Y66.48 E202.7092 F1800
+G1 X178.53 Y62.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y65.56 E203.3854 F1800
+G1 Y64.64 E203.4474 F1800
+G1 X180.36 Y62.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y63.72 E203.7726 F1800
+G1 Y62.8 E203.8347 F1800
+G1 E202.83 F1200
+G1 X52.82 Y132.18 F9000
+G1 E203.83 F2400
+G1 Y131.2 E203.905 F1800
+G1 X53.8 Y132.18 E204.0045 F1800
+G1 X54.78 E204.0748 F1800
+G1 X52.82 Y130.22 E204.2738 F1800
+G1 Y129.24 E204.3442 F1800
+G1 X55.76 Y132.18 E204.6426 F1800
+G1 X56.74 E204.713 F1800
+G1 X52.82 Y128.26 E205.1109 F1800
+G1 Y127.28 E205.1813 F1800
+G1 X57.72 Y132.18 E205.6787 F1800
+G1 X58.69 E205.749 F1800
+G1 X52.82 Y126.31 E206.3459 F1800
+G1 Y125.33 E206.4163 F1800
+G1 X59.67 Y132.18 E207.1127 F1800
+G1 X60.65 E207.183 F1800
+G1 X52.82 Y124.35 E207.9789 F1800
+G1 Y123.37 E208.0493 F1800
+G1 X61.63 Y132.18 E208.9446 F1800
+G1 X62.61 E209.015 F1800
+G1 X52.82 Y122.39 E210.0098 F1800
+G1 Y121.41 E210.0802 F1800
+G1 X63.59 Y132.18 E211.1745 F1800
+G1 X64.56 E211.2448 F1800
+G1 X52.82 Y120.44 E212.4387 F1800
+G1 Y119.46 E212.509 F1800
+G1 X65.54 Y132.18 E213.8023 F1800
+G1 X66.52 E213.8727 F1800
+G1 X52.82 Y118.48 E215.2655 F1800
+G1 Y117.5 E215.3358 F1800
+G1 X67.5 Y132.18 E216.8281 F1800
+G1 X68.48 E216.8984 F1800
+G1 X52.82 Y116.52 E218.4902 F1800
+G1 Y115.54 E218.5605 F1800
+G1 X69.46 Y132.18 E220.2518 F1800
+G1 X70.44 E220.3221 F1800
+G1 X52.82 Y114.56 E222.1129 F1800
+G1 Y113.59 E222.1832 F1800
+G1 X71.41 Y132.18 E224.0734 F1800
+G1 X72.39 E224.1438 F1800
+G1 X52.82 Y112.61 E226.1335 F1800
+G1 Y111.63 E226.2038 F1800
+G1 X73.37 Y132.18 E228.293 F1800
+G1 X74.35 E228.3633 F1800
+G1 X52.82 Y110.65 E230.552 F1800
+G1 Y109.67 E230.6224 F1800
+G1 X75.33 Y132.18 E232.9105 F1800
+G1 X76.31 E232.9809 F1800
+G1 X52.82 Y108.69 E235.3685 F1800
+G1 Y107.72 E235.4389 F1800
+G1 X77.28 Y132.18 E237.926 F1800
+G1 X78.26 E237.9963 F1800
+G1 X52.82 Y106.74 E240.5829 F1800
+G1 Y105.76 E240.6533 F1800
+G1 X79.24 Y132.18 E243.3394 F1800
+G1 X80.22 E243.4097 F1800
+G1 X52.82 Y104.78 E246.1953 F1800
+G1 Y103.8 E246.2657 F1800
+G1 X81.2 Y132.18 E249.1507 F1800
+G1 X82.18 E249.2211 F1800
+G1 X52.82 Y102.82 E252.2056 F1800
+G1 X53.8 E252.276 F1800
+G1 X82.18 Y131.2 E255.161 F1800
+G1 Y130.22 E255.2314 F1800
+G1 X54.78 Y102.82 E258.017 F1800
+G1 X55.76 E258.0873 F1800
+G1 X82.18 Y129.24 E260.7734 F1800
+G1 Y128.26 E260.8438 F1800
+G1 X56.74 Y102.82 E263.4304 F1800
+G1 X57.72 E263.5007 F1800
+G1 X82.18 Y127.28 E265.9878 F1800
+G1 Y126.31 E266.0582 F1800
+G1 X58.69 Y102.82 E268.4458 F1800
+G1 X59.67 E268.5162 F1800
+G1 X82.18 Y125.33 E270.8043 F1800
+G1 Y124.35 E270.8747 F1800
+G1 X60.65 Y102.82 E273.0634 F1800
+G1 X61.63 E273.1337 F1800
+G1 X82.18 Y123.37 E275.2229 F1800
+G1 Y122.39 E275.2932 F1800
+G1 X62.61 Y102.82 E277.2829 F1800
+G1 X63.59 E277.3533 F1800
+G1 X82.18 Y121.41 E279.2435 F1800
+G1 Y120.44 E279.3139 F1800
+G1 X64.56 Y102.82 E281.1046 F1800
+G1 X65.54 E281.1749 F1800
+G1 X82.18 Y119.46 E282.8662 F1800
+G1 Y118.48 E282.9365 F1800
+G1 X66.52 Y102.82 E284.5283 F1800
+G1 X67.5 E284.5986 F1800
+G1 X82.18 Y117.5 E286.0909 F1800
+G1 Y116.52 E286.1613 F1800
+G1 X68.48 Y102.82 E287.554 F1800
+G1 X69.46 E287.6244 F1800
+G1 X82.18 Y115.54 E288.9177 F1800
+G1 Y114.56 E288.988 F1800
+G1 X70.44 Y102.82 E290.1819 F1800
+G1 X71.41 E290.2522 F1800
+G1 X82.18 Y113.59 E291.3465 F1800
+G1 Y112.61 E291.4169 F1800
+G1 X72.39 Y102.82 E292.4117 F1800
+G1 X73.37 E292.4821 F1800
+G1 X82.18 Y111.63 E293.3775 F1800
+G1 Y110.65 E293.4478 F1800
+G1 X74.35 Y102.82 E294.2437 F1800
+G1 X75.33 E294.314 F1800
+G1 X82.18 Y109.67 E295.0104 F1800
+G1 Y108.69 E295.0808 F1800
+G1 X76.31 Y102.82 E295.6777 F1800
+G1 X77.28 E295.748 F1800
+G1 X82.18 Y107.72 E296.2455 F1800
+G1 Y106.74 E296.3158 F1800
+G1 X78.26 Y102.82 E296.7137 F1800
+G1 X79.24 E296.7841 F1800
+G1 X82.18 Y105.76 E297.0825 F1800
+G1 Y104.78 E297.1529 F1800
+G1 X80.22 Y102.82 E297.3519 F1800
+G1 X81.2 E297.4222 F1800
+G1 X82.18 Y103.8 E297.5217 F1800
+G1 Y102.82 E297.592 F1800
+G1 E296.59 F2400
+G1 X152.82 Y132.18 F9000
+G1 E297.59 F2400
+G1 Y131.2 E297.6624 F1800
+G1 X153.8 Y132.18 E297.7619 F1800
+G1 X154.78 E297.8322 F1800
+G1 X152.82 Y130.22 E298.0312 F1800
+G1 Y129.24 E298.1015 F1800
+G1 X155.76 Y132.18 E298.4 F1800
+G1 X156.74 E298.4703 F1800
+G1 X152.82 Y128.26 E298.8683 F1800
+G1 Y127.28 E298.9386 F1800
+G1 X157.72 Y132.18 E299.436 F1800
+G1 X158.69 E299.5064 F1800
+G1 X152.82 Y126.31 E300.1033 F1800
+G1 Y125.33 E300.1737 F1800
+G1 X159.67 Y132.18 E300.87 F1800
+G1 X160.65 E300.9404 F1800
+G1 X152.82 Y124.35 E301.7363 F1800
+G1 Y123.37 E301.8066 F1800
+G1 X161.63 Y132.18 E302.702 F1800
+G1 X162.61 E302.7723 F1800
+G1 X152.82 Y122.39 E303.7672 F1800
+G1 Y121.41 E303.8375 F1800
+G1 X163.59 Y132.18 E304.9319 F1800
+G1 X164.56 E305.0022 F1800
+G1 X152.82 Y120.44 E306.196 F1800
+G1 Y119.46 E306.2664 F1800
+G1 X165.54 Y132.18 E307.5597 F1800
+G1 X166.52 E307.63 F1800
+G1 X152.82 Y118.48 E309.0228 F1800
+G1 Y117.5 E309.0932 F1800
+G1 X167.5 Y132.18 E310.5854 F1800
+G1 X168.48 E310.6558 F1800
+G1 X152.82 Y116.52 E312.2476 F1800
+G1 Y115.54 E312.3179 F1800
+G1 X169.46 Y132.18 E314.0091 F1800
+G1 X170.44 E314.0795 F1800
+G1 X152.82 Y114.56 E315.8702 F1800
+G1 Y113.59 E315.9406 F1800
+G1 X171.41 Y132.18 E317.8308 F1800
+G1 X172.39 E317.9011 F1800
+G1 X152.82 Y112.61 E319.8908 F1800
+G1 Y111.63 E319.9612 F1800
+G1 X173.37 Y132.18 E322.0504 F1800
+G1 X174.35 E322.1207 F1800
+G1 X152.82 Y110.65 E324.3094 F1800
+G1 Y109.67 E324.3797 F1800
+G1 X175.33 Y132.18 E326.6679 F1800
+G1 X176.31 E326.7382 F1800
+G1 X152.82 Y108.69 E329.1259 F1800
+G1 Y107.72 E329.1962 F1800
+G1 X177.28 Y132.18 E331.6834 F1800
+G1 X178.26 E331.7537 F1800
+G1 X152.82 Y106.74 E334.3403 F1800
+G1 Y105.76 E334.4107 F1800
+G1 X179.24 Y132.18 E337.0968 F1800
+G1 X180.22 E337.1671 F1800
+G1 X152.82 Y104.78 E339.9527 F1800
+G1 Y103.8 E340.023 F1800
+G1 X181.2 Y132.18 E342.9081 F1800
+G1 X182.18 E342.9784 F1800
+G1 X152.82 Y102.82 E345.963 F1800
+G1 X153.8 E346.0333 F1800
+G1 X182.18 Y131.2 E348.9184 F1800
+G1 Y130.22 E348.9888 F1800
+G1 X154.78 Y102.82 E351.7743 F1800
+G1 X155.76 E351.8447 F1800
+G1 X182.18 Y129.24 E354.5308 F1800
+G1 Y128.26 E354.6011 F1800
+G1 X156.74 Y102.82 E357.1877 F1800
+G1 X157.72 E357.2581 F1800
+G1 X182.18 Y127.28 E359.7452 F1800
+G1 Y126.31 E359.8156 F1800
+G1 X158.69 Y102.82 E362.2032 F1800
+G1 X159.67 E362.2736 F1800
+G1 X182.18 Y125.33 E364.5617 F1800
+G1 Y124.35 E364.6321 F1800
+G1 X160.65 Y102.82 E366.8207 F1800
+G1 X161.63 E366.8911 F1800
+G1 X182.18 Y123.37 E368.9803 F1800
+G1 Y122.39 E369.0506 F1800
+G1 X162.61 Y102.82 E371.0403 F1800
+G1 X163.59 E371.1107 F1800
+G1 X182.18 Y121.41 E373.0009 F1800
+G1 Y120.44 E373.0712 F1800
+G1 X164.56 Y102.82 E374.862 F1800
+G1 X165.54 E374.9323 F1800
+G1 X182.18 Y119.46 E376.6235 F1800
+G1 Y118.48 E376.6939 F1800
+G1 X166.52 Y102.82 E378.2857 F1800
+G1 X167.5 E378.356 F1800
+G1 X182.18 Y117.5 E379.8483 F1800
+G1 Y116.52 E379.9186 F1800
+G1 X168.48 Y102.82 E381.3114 F1800
+G1 X169.46 E381.3818 F1800
+G1 X182.18 Y115.54 E382.6751 F1800
+G1 Y114.56 E382.7454 F1800
+G1 X170.44 Y102.82 E383.9392 F1800
+G1 X171.41 E384.0096 F1800
+G1 X182.18 Y113.59 E385.1039 F1800
+G1 Y112.61 E385.1743 F1800
+G1 X172.39 Y102.82 E386.1691 F1800
+G1 X173.37 E386.2395 F1800
+G1 X182.18 Y111.63 E387.1348 F1800
+G1 Y110.65 E387.2052 F1800
+G1 X174.35 Y102.82 E388.0011 F1800
+G1 X175.33 E388.0714 F1800
+G1 X182.18 Y109.67 E388.7678 F1800
+G1 Y108.69 E388.8381 F1800
+G1 X176.31 Y102.82 E389.4351 F1800
+G1 X177.28 E389.5054 F1800
+G1 X182.18 Y107.72 E390.0028 F1800
+G1 Y106.74 E390.0732 F1800
+G1 X178.26 Y102.82 E390.4711 F1800
+G1 X179.24 E390.5415 F1800
+G1 X182.18 Y105.76 E390.8399 F1800
+G1 Y104.78 E390.9103 F1800
+G1 X180.22 Y102.82 E391.1092 F1800
+G1 X181.2 E391.1796 F1800
+G1 X182.18 Y103.8 E391.2791 F1800
+G1 Y102.82 E391.3494 F1800
+G1 E390.35 F2400
+G1 X52.85 Y172.15 F9000
+G1 E391.35 F3600
+G1 Y171.11 E391.4299 F1800
+G1 X53.89 Y172.15 E391.5438 F1800
+G1 X54.94 E391.6243 F1800
+G1 X52.85 Y170.06 E391.8521 F1800
+G1 Y169.01 E391.9326 F1800
+G1 X55.99 Y172.15 E392.2742 F1800
+G1 X57.03 E392.3548 F1800
+G1 X52.85 Y167.97 E392.8103 F1800
+G1 Y166.92 E392.8908 F1800
+G1 X58.08 Y172.15 E393.4602 F1800
+G1 X59.13 E393.5407 F1800
+G1 X52.85 Y165.87 E394.224 F1800
+G1 Y164.83 E394.3045 F1800
+G1 X60.17 Y172.15 E395.1016 F1800
+G1 X61.22 E395.1822 F1800
+G1 X52.85 Y163.78 E396.0932 F1800
+G1 Y162.73 E396.1737 F1800
+G1 X62.27 Y172.15 E397.1986 F1800
+G1 X63.31 E397.2791 F1800
+G1 X52.85 Y161.69 E398.4179 F1800
+G1 Y160.64 E398.4984 F1800
+G1 X64.36 Y172.15 E399.7511 F1800
+G1 X65.41 E399.8316 F1800
+G1 X52.85 Y159.59 E401.1981 F1800
+G1 Y158.55 E401.2786 F1800
+G1 X66.45 Y172.15 E402.759 F1800
+G1 X67.5 E402.8395 F1800
+G1 X52.85 Y157.5 E404.4338 F1800
+G1 Y156.45 E404.5144 F1800
+G1 X68.55 Y172.15 E406.2225 F1800
+G1 X69.59 E406.303 F1800
+G1 X52.85 Y155.41 E408.1251 F1800
+G1 Y154.36 E408.2056 F1800
+G1 X70.64 Y172.15 E410.1415 F1800
+G1 X71.69 E410.222 F1800
+G1 X52.85 Y153.31 E412.2718 F1800
+G1 Y152.27 E412.3523 F1800
+G1 X72.73 Y172.15 E414.516 F1800
+G1 X73.78 E414.5965 F1800
+G1 X52.85 Y151.22 E416.8741 F1800
+G1 Y150.17 E416.9546 F1800
+G1 X74.83 Y172.15 E419.346 F1800
+G1 X75.87 E419.4265 F1800
+G1 X52.85 Y149.13 E421.9318 F1800
+G1 Y148.08 E422.0124 F1800
+G1 X76.92 Y172.15 E424.6315 F1800
+G1 X77.97 E424.712 F1800
+G1 X52.85 Y147.03 E427.4451 F1800
+G1 Y145.99 E427.5256 F1800
+G1 X79.01 Y172.15 E430.3725 F1800
+G1 X80.06 E430.4531 F1800
+G1 X52.85 Y144.94 E433.4139 F1800
+G1 Y143.89 E433.4944 F1800
+G1 X81.11 Y172.15 E436.5691 F1800
+G1 X82.15 E436.6496 F1800
+G1 X52.85 Y142.85 E439.8382 F1800
+G1 X53.89 E439.9187 F1800
+G1 X82.15 Y171.11 E442.9934 F1800
+G1 Y170.06 E443.0739 F1800
+G1 X54.94 Y142.85 E446.0347 F1800
+G1 X55.99 E446.1152 F1800
+G1 X82.15 Y169.01 E448.9621 F1800
+G1 Y167.97 E449.0427 F1800
+G1 X57.03 Y142.85 E451.7757 F1800
+G1 X58.08 E451.8562 F1800
+G1 X82.15 Y166.92 E454.4754 F1800
+G1 Y165.87 E454.5559 F1800
+G1 X59.13 Y142.85 E457.0612 F1800
+G1 X60.17 E457.1418 F1800
+G1 X82.15 Y164.83 E459.5332 F1800
+G1 Y163.78 E459.6137 F1800
+G1 X61.22 Y142.85 E461.8912 F1800
+G1 X62.27 E461.9718 F1800
+G1 X82.15 Y162.73 E464.1354 F1800
+G1 Y161.69 E464.216 F1800
+G1 X63.31 Y142.85 E466.2657 F1800
+G1 X64.36 E466.3463 F1800
+G1 X82.15 Y160.64 E468.2822 F1800
+G1 Y159.59 E468.3627 F1800
+G1 X65.41 Y142.85 E470.1847 F1800
+G1 X66.45 E470.2653 F1800
+G1 X82.15 Y158.55 E471.9734 F1800
+G1 Y157.5 E472.0539 F1800
+G1 X67.5 Y142.85 E473.6482 F1800
+G1 X68.55 E473.7287 F1800
+G1 X82.15 Y156.45 E475.2091 F1800
+G1 Y155.41 E475.2897 F1800
+G1 X69.59 Y142.85 E476.6562 F1800
+G1 X70.64 E476.7367 F1800
+G1 X82.15 Y154.36 E477.9894 F1800
+G1 Y153.31 E478.0699 F1800
+G1 X71.69 Y142.85 E479.2087 F1800
+G1 X72.73 E479.2892 F1800
+G1 X82.15 Y152.27 E480.3141 F1800
+G1 Y151.22 E480.3946 F1800
+G1 X73.78 Y142.85 E481.3056 F1800
+G1 X74.83 E481.3861 F1800
+G1 X82.15 Y150.17 E482.1833 F1800
+G1 Y149.13 E482.2638 F1800
+G1 X75.87 Y142.85 E482.9471 F1800
+G1 X76.92 E483.0276 F1800
+G1 X82.15 Y148.08 E483.597 F1800
+G1 Y147.03 E483.6775 F1800
+G1 X77.97 Y142.85 E484.133 F1800
+G1 X79.01 E484.2135 F1800
+G1 X82.15 Y145.99 E484.5552 F1800
+G1 Y144.94 E484.6357 F1800
+G1 X80.06 Y142.85 E484.8634 F1800
+G1 X81.11 E484.944 F1800
+G1 X82.15 Y143.89 E485.0578 F1800
+G1 Y142.85 E485.1384 F1800
+G1 E484.14 F3600
+G1 X152.85 Y172.15 F9000
+G1 E485.14 F3600
+G1 Y171.11 E485.2189 F1800
+G1 X153.89 Y172.15 E485.3328 F1800
+G1 X154.94 E485.4133 F1800
+G1 X152.85 Y170.06 E485.641 F1800
+G1 Y169.01 E485.7216 F1800
+G1 X155.99 Y172.15 E486.0632 F1800
+G1 X157.03 E486.1437 F1800
+G1 X152.85 Y167.97 E486.5992 F1800
+G1 Y166.92 E486.6797 F1800
+G1 X158.08 Y172.15 E487.2491 F1800
+G1 X159.13 E487.3297 F1800
+G1 X152.85 Y165.87 E488.0129 F1800
+G1 Y164.83 E488.0934 F1800
+G1 X160.17 Y172.15 E488.8906 F1800
+G1 X161.22 E488.9711 F1800
+G1 X152.85 Y163.78 E489.8821 F1800
+G1 Y162.73 E489.9626 F1800
+G1 X162.27 Y172.15 E490.9875 F1800
+G1 X163.31 E491.0681 F1800
+G1 X152.85 Y161.69 E492.2068 F1800
+G1 Y160.64 E492.2874 F1800
+G1 X164.36 Y172.15 E493.54 F1800
+G1 X165.41 E493.6205 F1800
+G1 X152.85 Y159.59 E494.9871 F1800
+G1 Y158.55 E495.0676 F1800
+G1 X166.45 Y172.15 E496.548 F1800
+G1 X167.5 E496.6285 F1800
+G1 X152.85 Y157.5 E498.2228 F1800
+G1 Y156.45 E498.3033 F1800
+G1 X168.55 Y172.15 E500.0115 F1800
+G1 X169.59 E500.092 F1800
+G1 X152.85 Y155.41 E501.914 F1800
+G1 Y154.36 E501.9945 F1800
+G1 X170.64 Y172.15 E503.9305 F1800
+G1 X171.69 E504.011 F1800
+G1 X152.85 Y153.31 E506.0608 F1800
+G1 Y152.27 E506.1413 F1800
+G1 X172.73 Y172.15 E508.305 F1800
+G1 X173.78 E508.3855 F1800
+G1 X152.85 Y151.22 E510.663 F1800
+G1 Y150.17 E510.7435 F1800
+G1 X174.83 Y172.15 E513.135 F1800
+G1 X175.87 E513.2155 F1800
+G1 X152.85 Y149.13 E515.7208 F1800
+G1 Y148.08 E515.8013 F1800
+G1 X176.92 Y172.15 E518.4205 F1800
+G1 X177.97 E518.501 F1800
+G1 X152.85 Y147.03 E521.2341 F1800
+G1 Y145.99 E521.3146 F1800
+G1 X179.01 Y172.15 E524.1615 F1800
+G1 X180.06 E524.242 F1800
+G1 X152.85 Y144.94 E527.2028 F1800
+G1 Y143.89 E527.2834 F1800
+G1 X181.11 Y172.15 E530.358 F1800
+G1 X182.15 E530.4386 F1800
+G1 X152.85 Y142.85 E533.6271 F1800
+G1 X153.89 E533.7076 F1800
+G1 X182.15 Y171.11 E536.7823 F1800
+G1 Y170.06 E536.8628 F1800
+G1 X154.94 Y142.85 E539.8237 F1800
+G1 X155.99 E539.9042 F1800
+G1 X182.15 Y169.01 E542.7511 F1800
+G1 Y167.97 E542.8316 F1800
+G1 X157.03 Y142.85 E545.5647 F1800
+G1 X158.08 E545.6452 F1800
+G1 X182.15 Y166.92 E548.2644 F1800
+G1 Y165.87 E548.3449 F1800
+G1 X159.13 Y142.85 E550.8502 F1800
+G1 X160.17 E550.9307 F1800
+G1 X182.15 Y164.83 E553.3221 F1800
+G1 Y163.78 E553.4027 F1800
+G1 X161.22 Y142.85 E555.6802 F1800
+G1 X162.27 E555.7607 F1800
+G1 X182.15 Y162.73 E557.9244 F1800
+G1 Y161.69 E558.0049 F1800
+G1 X163.31 Y142.85 E560.0547 F1800
+G1 X164.36 E560.1352 F1800
+G1 X182.15 Y160.64 E562.0711 F1800
+G1 Y159.59 E562.1517 F1800
+G1 X165.41 Y142.85 E563.9737 F1800
+G1 X166.45 E564.0542 F1800
+G1 X182.15 Y158.55 E565.7624 F1800
+G1 Y157.5 E565.8429 F1800
+G1 X167.5 Y142.85 E567.4372 F1800
+G1 X168.55 E567.5177 F1800
+G1 X182.15 Y156.45 E568.9981 F1800
+G1 Y155.41 E569.0786 F1800
+G1 X169.59 Y142.85 E570.4452 F1800
+G1 X170.64 E570.5257 F1800
+G1 X182.15 Y154.36 E571.7783 F1800
+G1 Y153.31 E571.8588 F1800
+G1 X171.69 Y142.85 E572.9976 F1800
+G1 X172.73 E573.0781 F1800
+G1 X182.15 Y152.27 E574.103 F1800
+G1 Y151.22 E574.1836 F1800
+G1 X173.78 Y142.85 E575.0946 F1800
+G1 X174.83 E575.1751 F1800
+G1 X182.15 Y150.17 E575.9722 F1800
+G1 Y149.13 E576.0528 F1800
+G1 X175.87 Y142.85 E576.736 F1800
+G1 X176.92 E576.8165 F1800
+G1 X182.15 Y148.08 E577.3859 F1800
+G1 Y147.03 E577.4665 F1800
+G1 X177.97 Y142.85 E577.922 F1800
+G1 X179.01 E578.0025 F1800
+G1 X182.15 Y145.99 E578.3441 F1800
+G1 Y144.94 E578.4246 F1800
+G1 X180.06 Y142.85 E578.6524 F1800
+G1 X181.11 E578.7329 F1800
+G1 X182.15 Y143.89 E578.8468 F1800
+G1 Y142.85 E578.9273 F1800
+;layer #2
+M106 S169
+G1 E577.93 F3600
+G1 X160.46 Y150.46 F9000
+G1 E578.93 F3600
+G1 Z0.5 F300
+G1 Y164.54 E579.5127 F3600
+G1 X174.54 E580.0981
+G1 Y150.46 E580.6835
+G1 X160.46 E581.2688
+G1 X160.1 Y150.1
+G1 Y164.9 E581.8841
+G1 X174.9 E582.4995
+G1 Y150.1 E583.1148
+G1 X160.1 E583.7301
+G1 E582.73 F3600
+G1 X74.54 Y150.46 F9000
+G1 E583.73 F3600
+G1 X60.46 E584.3155
+G1 Y164.54 E584.9008
+G1 X74.54 E585.4862
+G1 Y150.46 E586.0716
+G1 X74.9 Y150.1
+G1 X60.1 E586.6869
+G1 Y164.9 E587.3022
+G1 X74.9 E587.9175
+G1 Y150.1 E588.5328
+G1 E587.53 F3600
+G1 X160.46 Y110.46 F9000
+G1 E588.53 F2400
+G1 Y124.54 E589.1182 F3600
+G1 X174.54 E589.7036
+G1 Y110.46 E590.289
+G1 X160.46 E590.8744
+G1 X160.1 Y110.1
+G1 Y124.9 E591.4897
+G1 X174.9 E592.105
+G1 Y110.1 E592.7203
+G1 X160.1 E593.3356
+G1 E592.34 F2400
+G1 X74.54 Y110.46 F9000
+G1 E593.34 F2400
+G1 X60.46 E593.921 F3600
+G1 Y124.54 E594.5064
+G1 X74.54 E595.0917
+G1 Y110.46 E595.6771
+G1 X74.9 Y110.1
+G1 X60.1 E596.2924
+G1 Y124.9 E596.9077
+G1 X74.9 E597.5231
+G1 Y110.1 E598.1384
+G1 E597.14 F2400
+G1 X160.46 Y70.46 F9000
+G1 E598.14 F1200
+G1 Y84.54 E598.7238 F3600
+G1 X174.54 E599.3091
+G1 Y70.46 E599.8945
+G1 X160.46 E600.4799
+G1 X160.1 Y70.1
+G1 Y84.9 E601.0952
+G1 X174.9 E601.7105
+G1 Y70.1 E602.3258
+G1 X160.1 E602.9411
+G1 E601.94 F1200
+G1 X74.54 Y70.46 F9000
+G1 E602.94 F1200
+G1 X60.46 E603.5265 F3600
+G1 Y84.54 E604.1119
+G1 X74.54 E604.6973
+G1 Y70.46 E605.2826
+G1 X74.9 Y70.1
+G1 X60.1 E605.898
+G1 Y84.9 E606.5133
+G1 X74.9 E607.1286
+G1 Y70.1 E607.7439
+;layer #3
+M106 S254
+G1 E606.74 F1200
+G1 X74.54 Y70.46 F9000
+G1 E607.74 F1200
+G1 Z0.75 F300
+G1 X60.46 E608.3293 F3600
+G1 Y84.54 E608.9147
+G1 X74.54 E609.5
+G1 Y70.46 E610.0854
+G1 X74.9 Y70.1
+G1 X60.1 E610.7007
+G1 Y84.9 E611.316
+G1 X74.9 E611.9314
+G1 Y70.1 E612.5467
+G1 E611.55 F1200
+G1 X160.46 Y70.46 F9000
+G1 E612.55 F1200
+G1 Y84.54 E613.132 F3600
+G1 X174.54 E613.7174
+G1 Y70.46 E614.3028
+G1 X160.46 E614.8882
+G1 X160.1 Y70.1
+G1 Y84.9 E615.5035
+G1 X174.9 E616.1188
+G1 Y70.1 E616.7341
+G1 X160.1 E617.3494
+G1 E616.35 F1200
+G1 X74.54 Y110.46 F9000
+G1 E617.35 F2400
+G1 X60.46 E617.9348 F3600
+G1 Y124.54 E618.5202
+G1 X74.54 E619.1056
+G1 Y110.46 E619.6909
+G1 X74.9 Y110.1
+G1 X60.1 E620.3063
+G1 Y124.9 E620.9216
+G1 X74.9 E621.5369
+G1 Y110.1 E622.1522
+G1 E621.15 F2400
+G1 X160.46 Y110.46 F9000
+G1 E622.15 F2400
+G1 Y124.54 E622.7376 F3600
+G1 X174.54 E623.3229
+G1 Y110.46 E623.9083
+G1 X160.46 E624.4937
+G1 X160.1 Y110.1
+G1 Y124.9 E625.109
+G1 X174.9 E625.7243
+G1 Y110.1 E626.3396
+G1 X160.1 E626.955
+G1 E625.95 F2400
+G1 X74.54 Y150.46 F9000
+G1 E626.95 F3600
+G1 X60.46 E627.5403
+G1 Y164.54 E628.1257
+G1 X74.54 E628.7111
+G1 Y150.46 E629.2965
+G1 X74.9 Y150.1
+G1 X60.1 E629.9118
+G1 Y164.9 E630.5271
+G1 X74.9 E631.1424
+G1 Y150.1 E631.7577
+G1 E630.76 F3600
+G1 X160.46 Y150.46 F9000
+G1 E631.76 F3600
+G1 Y164.54 E632.3431
+G1 X174.54 E632.9285
+G1 Y150.46 E633.5139
+G1 X160.46 E634.0992
+G1 X160.1 Y150.1
+G1 Y164.9 E634.7145
+G1 X174.9 E635.3299
+G1 Y150.1 E635.9452
+G1 X160.1 E636.5605
+;layer #4
+G1 E635.56 F3600
+G1 X160.46 Y150.46 F9000
+G1 E636.56 F3600
+G1 Z1 F300
+G1 Y164.54 E637.1459 F3600
+G1 X174.54 E637.7312
+G1 Y150.46 E638.3166
+G1 X160.46 E638.902
+G1 X160.1 Y150.1
+G1 Y164.9 E639.5173
+G1 X174.9 E640.1326
+G1 Y150.1 E640.7479
+G1 X160.1 E641.3632
+G1 E640.36 F3600
+G1 X74.54 Y150.46 F9000
+G1 E641.36 F3600
+G1 X60.46 E641.9486
+G1 Y164.54 E642.534
+G1 X74.54 E643.1194
+G1 Y150.46 E643.7048
+G1 X74.9 Y150.1
+G1 X60.1 E644.3201
+G1 Y164.9 E644.9354
+G1 X74.9 E645.5507
+G1 Y150.1 E646.166
+G1 E645.17 F3600
+G1 X160.46 Y110.46 F9000
+G1 E646.17 F2400
+G1 Y124.54 E646.7514 F3600
+G1 X174.54 E647.3368
+G1 Y110.46 E647.9221
+G1 X160.46 E648.5075
+G1 X160.1 Y110.1
+G1 Y124.9 E649.1228
+G1 X174.9 E649.7381
+G1 Y110.1 E650.3535
+G1 X160.1 E650.9688
+G1 E649.97 F2400
+G1 X74.54 Y110.46 F9000
+G1 E650.97 F2400
+G1 X60.46 E651.5541 F3600
+G1 Y124.54 E652.1395
+G1 X74.54 E652.7249
+G1 Y110.46 E653.3103
+G1 X74.9 Y110.1
+G1 X60.1 E653.9256
+G1 Y124.9 E654.5409
+G1 X74.9 E655.1562
+G1 Y110.1 E655.7715
+G1 E654.77 F2400
+G1 X160.46 Y70.46 F9000
+G1 E655.77 F1200
+G1 Y84.54 E656.3569 F3600
+G1 X174.54 E656.9423
+G1 Y70.46 E657.5277
+G1 X160.46 E658.113
+G1 X160.1 Y70.1
+G1 Y84.9 E658.7284
+G1 X174.9 E659.3437
+G1 Y70.1 E659.959
+G1 X160.1 E660.5743
+G1 E659.57 F1200
+G1 X74.54 Y70.46 F9000
+G1 E660.57 F1200
+G1 X60.46 E661.1597 F3600
+G1 Y84.54 E661.7451
+G1 X74.54 E662.3304
+G1 Y70.46 E662.9158
+G1 X74.9 Y70.1
+G1 X60.1 E663.5311
+G1 Y84.9 E664.1464
+G1 X74.9 E664.7617
+G1 Y70.1 E665.3771
+;layer #5
+G1 E664.38 F1200
+G1 X74.54 Y70.46 F9000
+G1 E665.38 F1200
+G1 Z1.25 F300
+G1 X60.46 E665.9624 F3600
+G1 Y84.54 E666.5478
+G1 X74.54 E667.1332
+G1 Y70.46 E667.7186
+G1 X74.9 Y70.1
+G1 X60.1 E668.3339
+G1 Y84.9 E668.9492
+G1 X74.9 E669.5645
+G1 Y70.1 E670.1798
+G1 E669.18 F1200
+G1 X160.46 Y70.46 F9000
+G1 E670.18 F1200
+G1 Y84.54 E670.7652 F3600
+G1 X174.54 E671.3506
+G1 Y70.46 E671.936
+G1 X160.46 E672.5213
+G1 X160.1 Y70.1
+G1 Y84.9 E673.1367
+G1 X174.9 E673.752
+G1 Y70.1 E674.3673
+G1 X160.1 E674.9826
+G1 E673.98 F1200
+G1 X74.54 Y110.46 F9000
+G1 E674.98 F2400
+G1 X60.46 E675.568 F3600
+G1 Y124.54 E676.1533
+G1 X74.54 E676.7387
+G1 Y110.46 E677.3241
+G1 X74.9 Y110.1
+G1 X60.1 E677.9394
+G1 Y124.9 E678.5547
+G1 X74.9 E679.17
+G1 Y110.1 E679.7854
+G1 E678.79 F2400
+G1 X160.46 Y110.46 F9000
+G1 E679.79 F2400
+G1 Y124.54 E680.3707 F3600
+G1 X174.54 E680.9561
+G1 Y110.46 E681.5415
+G1 X160.46 E682.1269
+G1 X160.1 Y110.1
+G1 Y124.9 E682.7422
+G1 X174.9 E683.3575
+G1 Y110.1 E683.9728
+G1 X160.1 E684.5881
+G1 E683.59 F2400
+G1 X74.54 Y150.46 F9000
+G1 E684.59 F3600
+G1 X60.46 E685.1735
+G1 Y164.54 E685.7589
+G1 X74.54 E686.3443
+G1 Y150.46 E686.9296
+G1 X74.9 Y150.1
+G1 X60.1 E687.5449
+G1 Y164.9 E688.1603
+G1 X74.9 E688.7756
+G1 Y150.1 E689.3909
+G1 E688.39 F3600
+G1 X160.46 Y150.46 F9000
+G1 E689.39 F3600
+G1 Y164.54 E689.9763
+G1 X174.54 E690.5616
+G1 Y150.46 E691.147
+G1 X160.46 E691.7324
+G1 X160.1 Y150.1
+G1 Y164.9 E692.3477
+G1 X174.9 E692.963
+G1 Y150.1 E693.5783
+G1 X160.1 E694.1936
+;layer #6
+G1 E693.19 F3600
+G1 X160.46 Y150.46 F9000
+G1 E694.19 F3600
+G1 Z1.5 F300
+G1 Y164.54 E694.779 F3600
+G1 X174.54 E695.3644
+G1 Y150.46 E695.9498
+G1 X160.46 E696.5352
+G1 X160.1 Y150.1
+G1 Y164.9 E697.1505
+G1 X174.9 E697.7658
+G1 Y150.1 E698.3811
+G1 X160.1 E698.9964
+G1 E698 F3600
+G1 X74.54 Y150.46 F9000
+G1 E699 F3600
+G1 X60.46 E699.5818
+G1 Y164.54 E700.1672
+G1 X74.54 E700.7525
+G1 Y150.46 E701.3379
+G1 X74.9 Y150.1
+G1 X60.1 E701.9532
+G1 Y164.9 E702.5685
+G1 X74.9 E703.1839
+G1 Y150.1 E703.7992
+G1 E702.8 F3600
+G1 X160.46 Y110.46 F9000
+G1 E703.8 F2400
+G1 Y124.54 E704.3845 F3600
+G1 X174.54 E704.9699
+G1 Y110.46 E705.5553
+G1 X160.46 E706.1407
+G1 X160.1 Y110.1
+G1 Y124.9 E706.756
+G1 X174.9 E707.3713
+G1 Y110.1 E707.9866
+G1 X160.1 E708.6019
+G1 E707.6 F2400
+G1 X74.54 Y110.46 F9000
+G1 E708.6 F2400
+G1 X60.46 E709.1873 F3600
+G1 Y124.54 E709.7727
+G1 X74.54 E710.3581
+G1 Y110.46 E710.9434
+G1 X74.9 Y110.1
+G1 X60.1 E711.5588
+G1 Y124.9 E712.1741
+G1 X74.9 E712.7894
+G1 Y110.1 E713.4047
+G1 E712.4 F2400
+G1 X160.46 Y70.46 F9000
+G1 E713.4 F1200
+G1 Y84.54 E713.9901 F3600
+G1 X174.54 E714.5755
+G1 Y70.46 E715.1608
+G1 X160.46 E715.7462
+G1 X160.1 Y70.1
+G1 Y84.9 E716.3615
+G1 X174.9 E716.9768
+G1 Y70.1 E717.5921
+G1 X160.1 E718.2075
+G1 E717.21 F1200
+G1 X74.54 Y70.46 F9000
+G1 E718.21 F1200
+G1 X60.46 E718.7928 F3600
+G1 Y84.54 E719.3782
+G1 X74.54 E719.9636
+G1 Y70.46 E720.549
+G1 X74.9 Y70.1
+G1 X60.1 E721.1643
+G1 Y84.9 E721.7796
+G1 X74.9 E722.3949
+G1 Y70.1 E723.0102
+;layer #7
+G1 E722.01 F1200
+G1 X74.54 Y70.46 F9000
+G1 E723.01 F1200
+G1 Z1.75 F300
+G1 X60.46 E723.5956 F3600
+G1 Y84.54 E724.181
+G1 X74.54 E724.7664
+G1 Y70.46 E725.3517
+G1 X74.9 Y70.1
+G1 X60.1 E725.967
+G1 Y84.9 E726.5824
+G1 X74.9 E727.1977
+G1 Y70.1 E727.813
+G1 E726.81 F1200
+G1 X160.46 Y70.46 F9000
+G1 E727.81 F1200
+G1 Y84.54 E728.3984 F3600
+G1 X174.54 E728.9837
+G1 Y70.46 E729.5691
+G1 X160.46 E730.1545
+G1 X160.1 Y70.1
+G1 Y84.9 E730.7698
+G1 X174.9 E731.3851
+G1 Y70.1 E732.0004
+G1 X160.1 E732.6158
+G1 E731.62 F1200
+G1 X74.54 Y110.46 F9000
+G1 E732.62 F2400
+G1 X60.46 E733.2011 F3600
+G1 Y124.54 E733.7865
+G1 X74.54 E734.3719
+G1 Y110.46 E734.9573
+G1 X74.9 Y110.1
+G1 X60.1 E735.5726
+G1 Y124.9 E736.1879
+G1 X74.9 E736.8032
+G1 Y110.1 E737.4185
+G1 E736.42 F2400
+G1 X160.46 Y110.46 F9000
+G1 E737.42 F2400
+G1 Y124.54 E738.0039 F3600
+G1 X174.54 E738.5893
+G1 Y110.46 E739.1746
+G1 X160.46 E739.76
+G1 X160.1 Y110.1
+G1 Y124.9 E740.3753
+G1 X174.9 E740.9907
+G1 Y110.1 E741.606
+G1 X160.1 E742.2213
+G1 E741.22 F2400
+G1 X74.54 Y150.46 F9000
+G1 E742.22 F3600
+G1 X60.46 E742.8067
+G1 Y164.54 E743.392
+G1 X74.54 E743.9774
+G1 Y150.46 E744.5628
+G1 X74.9 Y150.1
+G1 X60.1 E745.1781
+G1 Y164.9 E745.7934
+G1 X74.9 E746.4087
+G1 Y150.1 E747.024
+G1 E746.02 F3600
+G1 X160.46 Y150.46 F9000
+G1 E747.02 F3600
+G1 Y164.54 E747.6094
+G1 X174.54 E748.1948
+G1 Y150.46 E748.7802
+G1 X160.46 E749.3656
+G1 X160.1 Y150.1
+G1 Y164.9 E749.9809
+G1 X174.9 E750.5962
+G1 Y150.1 E751.2115
+G1 X160.1 E751.8268
+;layer #8
+G1 E750.83 F3600
+G1 X160.46 Y150.46 F9000
+G1 E751.83 F3600
+G1 Z2 F300
+G1 Y164.54 E752.4122 F3600
+G1 X174.54 E752.9976
+G1 Y150.46 E753.5829
+G1 X160.46 E754.1683
+G1 X160.1 Y150.1
+G1 Y164.9 E754.7836
+G1 X174.9 E755.3989
+G1 Y150.1 E756.0143
+G1 X160.1 E756.6296
+G1 E755.63 F3600
+G1 X74.54 Y150.46 F9000
+G1 E756.63 F3600
+G1 X60.46 E757.2149
+G1 Y164.54 E757.8003
+G1 X74.54 E758.3857
+G1 Y150.46 E758.9711
+G1 X74.9 Y150.1
+G1 X60.1 E759.5864
+G1 Y164.9 E760.2017
+G1 X74.9 E760.817
+G1 Y150.1 E761.4323
+G1 E760.43 F3600
+G1 X160.46 Y110.46 F9000
+G1 E761.43 F2400
+G1 Y124.54 E762.0177 F3600
+G1 X174.54 E762.6031
+G1 Y110.46 E763.1885
+G1 X160.46 E763.7738
+G1 X160.1 Y110.1
+G1 Y124.9 E764.3892
+G1 X174.9 E765.0045
+G1 Y110.1 E765.6198
+G1 X160.1 E766.2351
+G1 E765.24 F2400
+G1 X74.54 Y110.46 F9000
+G1 E766.24 F2400
+G1 X60.46 E766.8205 F3600
+G1 Y124.54 E767.4059
+G1 X74.54 E767.9912
+G1 Y110.46 E768.5766
+G1 X74.9 Y110.1
+G1 X60.1 E769.1919
+G1 Y124.9 E769.8072
+G1 X74.9 E770.4225
+G1 Y110.1 E771.0379
+G1 E770.04 F2400
+G1 X160.46 Y70.46 F9000
+G1 E771.04 F1200
+G1 Y84.54 E771.6232 F3600
+G1 X174.54 E772.2086
+G1 Y70.46 E772.794
+G1 X160.46 E773.3794
+G1 X160.1 Y70.1
+G1 Y84.9 E773.9947
+G1 X174.9 E774.61
+G1 Y70.1 E775.2253
+G1 X160.1 E775.8406
+G1 E774.84 F1200
+G1 X74.54 Y70.46 F9000
+G1 E775.84 F1200
+G1 X60.46 E776.426 F3600
+G1 Y84.54 E777.0114
+G1 X74.54 E777.5968
+G1 Y70.46 E778.1821
+G1 X74.9 Y70.1
+G1 X60.1 E778.7974
+G1 Y84.9 E779.4128
+G1 X74.9 E780.0281
+G1 Y70.1 E780.6434
+;layer #9
+G1 E779.64 F1200
+G1 X74.54 Y70.46 F9000
+G1 E780.64 F1200
+G1 Z2.25 F300
+G1 X60.46 E781.2288 F3600
+G1 Y84.54 E781.8141
+G1 X74.54 E782.3995
+G1 Y70.46 E782.9849
+G1 X74.9 Y70.1
+G1 X60.1 E783.6002
+G1 Y84.9 E784.2155
+G1 X74.9 E784.8308
+G1 Y70.1 E785.4461
+G1 E784.45 F1200
+G1 X160.46 Y70.46 F9000
+G1 E785.45 F1200
+G1 Y84.54 E786.0315 F3600
+G1 X174.54 E786.6169
+G1 Y70.46 E787.2023
+G1 X160.46 E787.7877
+G1 X160.1 Y70.1
+G1 Y84.9 E788.403
+G1 X174.9 E789.0183
+G1 Y70.1 E789.6336
+G1 X160.1 E790.2489
+G1 E789.25 F1200
+G1 X74.54 Y110.46 F9000
+G1 E790.25 F2400
+G1 X60.46 E790.8343 F3600
+G1 Y124.54 E791.4197
+G1 X74.54 E792.005
+G1 Y110.46 E792.5904
+G1 X74.9 Y110.1
+G1 X60.1 E793.2057
+G1 Y124.9 E793.8211
+G1 X74.9 E794.4364
+G1 Y110.1 E795.0517
+G1 E794.05 F2400
+G1 X160.46 Y110.46 F9000
+G1 E795.05 F2400
+G1 Y124.54 E795.6371 F3600
+G1 X174.54 E796.2224
+G1 Y110.46 E796.8078
+G1 X160.46 E797.3932
+G1 X160.1 Y110.1
+G1 Y124.9 E798.0085
+G1 X174.9 E798.6238
+G1 Y110.1 E799.2391
+G1 X160.1 E799.8544
+G1 E798.85 F2400
+G1 X74.54 Y150.46 F9000
+G1 E799.85 F3600
+G1 X60.46 E800.4398
+G1 Y164.54 E801.0252
+G1 X74.54 E801.6106
+G1 Y150.46 E802.196
+G1 X74.9 Y150.1
+G1 X60.1 E802.8113
+G1 Y164.9 E803.4266
+G1 X74.9 E804.0419
+G1 Y150.1 E804.6572
+G1 E803.66 F3600
+G1 X160.46 Y150.46 F9000
+G1 E804.66 F3600
+G1 Y164.54 E805.2426
+G1 X174.54 E805.828
+G1 Y150.46 E806.4133
+G1 X160.46 E806.9987
+G1 X160.1 Y150.1
+G1 Y164.9 E807.614
+G1 X174.9 E808.2293
+G1 Y150.1 E808.8447
+G1 X160.1 E809.46
+;layer #10
+G1 E808.46 F3600
+G1 X160.46 Y150.46 F9000
+G1 E809.46 F3600
+G1 Z2.5 F300
+G1 Y164.54 E810.0453 F3600
+G1 X174.54 E810.6307
+G1 Y150.46 E811.2161
+G1 X160.46 E811.8015
+G1 X160.1 Y150.1
+G1 Y164.9 E812.4168
+G1 X174.9 E813.0321
+G1 Y150.1 E813.6474
+G1 X160.1 E814.2627
+G1 E813.26 F3600
+G1 X74.54 Y150.46 F9000
+G1 E814.26 F3600
+G1 X60.46 E814.8481
+G1 Y164.54 E815.4335
+G1 X74.54 E816.0189
+G1 Y150.46 E816.6042
+G1 X74.9 Y150.1
+G1 X60.1 E817.2196
+G1 Y164.9 E817.8349
+G1 X74.9 E818.4502
+G1 Y150.1 E819.0655
+G1 E818.07 F3600
+G1 X160.46 Y110.46 F9000
+G1 E819.07 F2400
+G1 Y124.54 E819.6509 F3600
+G1 X174.54 E820.2363
+G1 Y110.46 E820.8216
+G1 X160.46 E821.407
+G1 X160.1 Y110.1
+G1 Y124.9 E822.0223
+G1 X174.9 E822.6376
+G1 Y110.1 E823.2529
+G1 X160.1 E823.8683
+G1 E822.87 F2400
+G1 X74.54 Y110.46 F9000
+G1 E823.87 F2400
+G1 X60.46 E824.4536 F3600
+G1 Y124.54 E825.039
+G1 X74.54 E825.6244
+G1 Y110.46 E826.2098
+G1 X74.9 Y110.1
+G1 X60.1 E826.8251
+G1 Y124.9 E827.4404
+G1 X74.9 E828.0557
+G1 Y110.1 E828.671
+G1 E827.67 F2400
+G1 X160.46 Y70.46 F9000
+G1 E828.67 F1200
+G1 Y84.54 E829.2564 F3600
+G1 X174.54 E829.8418
+G1 Y70.46 E830.4272
+G1 X160.46 E831.0125
+G1 X160.1 Y70.1
+G1 Y84.9 E831.6278
+G1 X174.9 E832.2432
+G1 Y70.1 E832.8585
+G1 X160.1 E833.4738
+G1 E832.47 F1200
+G1 X74.54 Y70.46 F9000
+G1 E833.47 F1200
+G1 X60.46 E834.0592 F3600
+G1 Y84.54 E834.6445
+G1 X74.54 E835.2299
+G1 Y70.46 E835.8153
+G1 X74.9 Y70.1
+G1 X60.1 E836.4306
+G1 Y84.9 E837.0459
+G1 X74.9 E837.6612
+G1 Y70.1 E838.2765
+;layer #11
+G1 E837.28 F1200
+G1 X74.54 Y70.46 F9000
+G1 E838.28 F1200
+G1 Z2.75 F300
+G1 X60.46 E838.8619 F3600
+G1 Y84.54 E839.4473
+G1 X74.54 E840.0327
+G1 Y70.46 E840.6181
+G1 X74.9 Y70.1
+G1 X60.1 E841.2334
+G1 Y84.9 E841.8487
+G1 X74.9 E842.464
+G1 Y70.1 E843.0793
+G1 E842.08 F1200
+G1 X160.46 Y70.46 F9000
+G1 E843.08 F1200
+G1 Y84.54 E843.6647 F3600
+G1 X174.54 E844.2501
+G1 Y70.46 E844.8354
+G1 X160.46 E845.4208
+G1 X160.1 Y70.1
+G1 Y84.9 E846.0361
+G1 X174.9 E846.6514
+G1 Y70.1 E847.2668
+G1 X160.1 E847.8821
+G1 E846.88 F1200
+G1 X74.54 Y110.46 F9000
+G1 E847.88 F2400
+G1 X60.46 E848.4675 F3600
+G1 Y124.54 E849.0528
+G1 X74.54 E849.6382
+G1 Y110.46 E850.2236
+G1 X74.9 Y110.1
+G1 X60.1 E850.8389
+G1 Y124.9 E851.4542
+G1 X74.9 E852.0695
+G1 Y110.1 E852.6848
+G1 E851.68 F2400
+G1 X160.46 Y110.46 F9000
+G1 E852.68 F2400
+G1 Y124.54 E853.2702 F3600
+G1 X174.54 E853.8556
+G1 Y110.46 E854.441
+G1 X160.46 E855.0264
+G1 X160.1 Y110.1
+G1 Y124.9 E855.6417
+G1 X174.9 E856.257
+G1 Y110.1 E856.8723
+G1 X160.1 E857.4876
+G1 E856.49 F2400
+G1 X74.54 Y150.46 F9000
+G1 E857.49 F3600
+G1 X60.46 E858.073
+G1 Y164.54 E858.6584
+G1 X74.54 E859.2437
+G1 Y150.46 E859.8291
+G1 X74.9 Y150.1
+G1 X60.1 E860.4444
+G1 Y164.9 E861.0597
+G1 X74.9 E861.6751
+G1 Y150.1 E862.2904
+G1 E861.29 F3600
+G1 X160.46 Y150.46 F9000
+G1 E862.29 F3600
+G1 Y164.54 E862.8757
+G1 X174.54 E863.4611
+G1 Y150.46 E864.0465
+G1 X160.46 E864.6319
+G1 X160.1 Y150.1
+G1 Y164.9 E865.2472
+G1 X174.9 E865.8625
+G1 Y150.1 E866.4778
+G1 X160.1 E867.0931
+;layer #12
+G1 E866.09 F3600
+G1 X160.46 Y150.46 F9000
+G1 E867.09 F3600
+G1 Z3 F300
+G1 Y164.54 E867.6785 F3600
+G1 X174.54 E868.2639
+G1 Y150.46 E868.8493
+G1 X160.46 E869.4346
+G1 X160.1 Y150.1
+G1 Y164.9 E870.05
+G1 X174.9 E870.6653
+G1 Y150.1 E871.2806
+G1 X160.1 E871.8959
+G1 E870.9 F3600
+G1 X74.54 Y150.46 F9000
+G1 E871.9 F3600
+G1 X60.46 E872.4813
+G1 Y164.54 E873.0666
+G1 X74.54 E873.652
+G1 Y150.46 E874.2374
+G1 X74.9 Y150.1
+G1 X60.1 E874.8527
+G1 Y164.9 E875.468
+G1 X74.9 E876.0833
+G1 Y150.1 E876.6987
+G1 E875.7 F3600
+G1 X160.46 Y110.46 F9000
+G1 E876.7 F2400
+G1 Y124.54 E877.284 F3600
+G1 X174.54 E877.8694
+G1 Y110.46 E878.4548
+G1 X160.46 E879.0402
+G1 X160.1 Y110.1
+G1 Y124.9 E879.6555
+G1 X174.9 E880.2708
+G1 Y110.1 E880.8861
+G1 X160.1 E881.5014
+G1 E880.5 F2400
+G1 X74.54 Y110.46 F9000
+G1 E881.5 F2400
+G1 X60.46 E882.0868 F3600
+G1 Y124.54 E882.6722
+G1 X74.54 E883.2576
+G1 Y110.46 E883.8429
+G1 X74.9 Y110.1
+G1 X60.1 E884.4582
+G1 Y124.9 E885.0736
+G1 X74.9 E885.6889
+G1 Y110.1 E886.3042
+G1 E885.3 F2400
+G1 X160.46 Y70.46 F9000
+G1 E886.3 F1200
+G1 Y84.54 E886.8896 F3600
+G1 X174.54 E887.4749
+G1 Y70.46 E888.0603
+G1 X160.46 E888.6457
+G1 X160.1 Y70.1
+G1 Y84.9 E889.261
+G1 X174.9 E889.8763
+G1 Y70.1 E890.4916
+G1 X160.1 E891.1069
+G1 E890.11 F1200
+G1 X74.54 Y70.46 F9000
+G1 E891.11 F1200
+G1 X60.46 E891.6923 F3600
+G1 Y84.54 E892.2777
+G1 X74.54 E892.8631
+G1 Y70.46 E893.4485
+G1 X74.9 Y70.1
+G1 X60.1 E894.0638
+G1 Y84.9 E894.6791
+G1 X74.9 E895.2944
+G1 Y70.1 E895.9097
+;layer #13
+G1 E895.31 F1200
+G1 X74.64 Y70.36 F9000
+G1 E895.91 F1200
+G1 Z3.25 F300
+G1 X60.36 E896.5034 F3600
+G1 Y84.64 E897.0971
+G1 X74.64 E897.6908
+G1 Y70.36 E898.2845
+G1 X75 Y70
+G1 X60 E898.9081
+G1 Y85 E899.5317
+G1 X75 E900.1554
+G1 Y70 E900.779
+G1 E900.18 F1200
+G1 X160.36 Y70.36 F9000
+G1 E900.78 F1200
+G1 Y84.64 E901.3727 F3600
+G1 X174.64 E901.9664
+G1 Y70.36 E902.5601
+G1 X160.36 E903.1538
+G1 X160 Y70
+G1 Y85 E903.7774
+G1 X175 E904.401
+G1 Y70 E905.0246
+G1 X160 E905.6483
+G1 E905.05 F1200
+G1 X74.64 Y110.36 F9000
+G1 E905.65 F2400
+G1 X60.36 E906.242 F3600
+G1 Y124.64 E906.8357
+G1 X74.64 E907.4294
+G1 Y110.36 E908.0231
+G1 X75 Y110
+G1 X60 E908.6467
+G1 Y125 E909.2703
+G1 X75 E909.8939
+G1 Y110 E910.5176
+G1 E909.92 F2400
+G1 X160.36 Y110.36 F9000
+G1 E910.52 F2400
+G1 Y124.64 E911.1113 F3600
+G1 X174.64 E911.7049
+G1 Y110.36 E912.2986
+G1 X160.36 E912.8923
+G1 X160 Y110
+G1 Y125 E913.516
+G1 X175 E914.1396
+G1 Y110 E914.7632
+G1 X160 E915.3868
+G1 E914.79 F2400
+G1 X74.64 Y150.36 F9000
+G1 E915.39 F3600
+G1 X60.36 E915.9805
+G1 Y164.64 E916.5742
+G1 X74.64 E917.1679
+G1 Y150.36 E917.7616
+G1 X75 Y150
+G1 X60 E918.3852
+G1 Y165 E919.0089
+G1 X75 E919.6325
+G1 Y150 E920.2561
+G1 E919.66 F3600
+G1 X160.36 Y150.36 F9000
+G1 E920.26 F3600
+G1 Y164.64 E920.8498
+G1 X174.64 E921.4435
+G1 Y150.36 E922.0372
+G1 X160.36 E922.6309
+G1 X160 Y150
+G1 Y165 E923.2545
+G1 X175 E923.8782
+G1 Y150 E924.5018
+G1 X160 E925.1254
+;layer #14
+G1 E924.53 F3600
+G1 X160.46 Y150.46 F9000
+G1 E925.13 F3600
+G1 Z3.5 F300
+G1 Y164.54 E925.7108 F3600
+G1 X174.54 E926.2962
+G1 Y150.46 E926.8815
+G1 X160.46 E927.4669
+G1 X160.1 Y150.1
+G1 Y164.9 E928.0822
+G1 X174.9 E928.6976
+G1 Y150.1 E929.3129
+G1 X160.1 E929.9282
+G1 E929.33 F3600
+G1 X74.54 Y150.46 F9000
+G1 E929.93 F3600
+G1 X60.46 E930.5136
+G1 Y164.54 E931.0989
+G1 X74.54 E931.6843
+G1 Y150.46 E932.2697
+G1 X74.9 Y150.1
+G1 X60.1 E932.885
+G1 Y164.9 E933.5003
+G1 X74.9 E934.1156
+G1 Y150.1 E934.7309
+G1 E934.13 F3600
+G1 X160.46 Y110.46 F9000
+G1 E934.73 F2400
+G1 Y124.54 E935.3163 F3600
+G1 X174.54 E935.9017
+G1 Y110.46 E936.4871
+G1 X160.46 E937.0725
+G1 X160.1 Y110.1
+G1 Y124.9 E937.6878
+G1 X174.9 E938.3031
+G1 Y110.1 E938.9184
+G1 X160.1 E939.5337
+G1 E938.93 F2400
+G1 X74.54 Y110.46 F9000
+G1 E939.53 F2400
+G1 X60.46 E940.1191 F3600
+G1 Y124.54 E940.7045
+G1 X74.54 E941.2898
+G1 Y110.46 E941.8752
+G1 X74.9 Y110.1
+G1 X60.1 E942.4905
+G1 Y124.9 E943.1058
+G1 X74.9 E943.7212
+G1 Y110.1 E944.3365
+G1 E943.74 F2400
+G1 X160.46 Y70.46 F9000
+G1 E944.34 F1200
+G1 Y84.54 E944.9218 F3600
+G1 X174.54 E945.5072
+G1 Y70.46 E946.0926
+G1 X160.46 E946.678
+G1 X160.1 Y70.1
+G1 Y84.9 E947.2933
+G1 X174.9 E947.9086
+G1 Y70.1 E948.5239
+G1 X160.1 E949.1392
+G1 E948.54 F1200
+G1 X74.54 Y70.46 F9000
+G1 E949.14 F1200
+G1 X60.46 E949.7246 F3600
+G1 Y84.54 E950.31
+G1 X74.54 E950.8954
+G1 Y70.46 E951.4807
+G1 X74.9 Y70.1
+G1 X60.1 E952.0961
+G1 Y84.9 E952.7114
+G1 X74.9 E953.3267
+G1 Y70.1 E953.942
+;layer #15
+G1 E953.34 F1200
+G1 X74.54 Y70.46 F9000
+G1 E953.94 F1200
+G1 Z3.75 F300
+G1 X60.46 E954.5274 F3600
+G1 Y84.54 E955.1128
+G1 X74.54 E955.6981
+G1 Y70.46 E956.2835
+G1 X74.9 Y70.1
+G1 X60.1 E956.8988
+G1 Y84.9 E957.5141
+G1 X74.9 E958.1294
+G1 Y70.1 E958.7448
+G1 E958.14 F1200
+G1 X160.46 Y70.46 F9000
+G1 E958.74 F1200
+G1 Y84.54 E959.3301 F3600
+G1 X174.54 E959.9155
+G1 Y70.46 E960.5009
+G1 X160.46 E961.0863
+G1 X160.1 Y70.1
+G1 Y84.9 E961.7016
+G1 X174.9 E962.3169
+G1 Y70.1 E962.9322
+G1 X160.1 E963.5475
+G1 E962.95 F1200
+G1 X74.54 Y110.46 F9000
+G1 E963.55 F2400
+G1 X60.46 E964.1329 F3600
+G1 Y124.54 E964.7183
+G1 X74.54 E965.3037
+G1 Y110.46 E965.889
+G1 X74.9 Y110.1
+G1 X60.1 E966.5043
+G1 Y124.9 E967.1197
+G1 X74.9 E967.735
+G1 Y110.1 E968.3503
+G1 E967.75 F2400
+G1 X160.46 Y110.46 F9000
+G1 E968.35 F2400
+G1 Y124.54 E968.9357 F3600
+G1 X174.54 E969.521
+G1 Y110.46 E970.1064
+G1 X160.46 E970.6918
+G1 X160.1 Y110.1
+G1 Y124.9 E971.3071
+G1 X174.9 E971.9224
+G1 Y110.1 E972.5377
+G1 X160.1 E973.153
+G1 E972.55 F2400
+G1 X74.54 Y150.46 F9000
+G1 E973.15 F3600
+G1 X60.46 E973.7384
+G1 Y164.54 E974.3238
+G1 X74.54 E974.9092
+G1 Y150.46 E975.4946
+G1 X74.9 Y150.1
+G1 X60.1 E976.1099
+G1 Y164.9 E976.7252
+G1 X74.9 E977.3405
+G1 Y150.1 E977.9558
+G1 E977.36 F3600
+G1 X160.46 Y150.46 F9000
+G1 E977.96 F3600
+G1 Y164.54 E978.5412
+G1 X174.54 E979.1266
+G1 Y150.46 E979.7119
+G1 X160.46 E980.2973
+G1 X160.1 Y150.1
+G1 Y164.9 E980.9126
+G1 X174.9 E981.5279
+G1 Y150.1 E982.1433
+G1 X160.1 E982.7586
+;layer #16
+G1 E982.16 F3600
+G1 X160.46 Y150.46 F9000
+G1 E982.76 F3600
+G1 Z4 F300
+G1 Y164.54 E983.344 F3600
+G1 X174.54 E983.9293
+G1 Y150.46 E984.5147
+G1 X160.46 E985.1001
+G1 X160.1 Y150.1
+G1 Y164.9 E985.7154
+G1 X174.9 E986.3307
+G1 Y150.1 E986.946
+G1 X160.1 E987.5613
+G1 E986.96 F3600
+G1 X74.54 Y150.46 F9000
+G1 E987.56 F3600
+G1 X60.46 E988.1467
+G1 Y164.54 E988.7321
+G1 X74.54 E989.3175
+G1 Y150.46 E989.9029
+G1 X74.9 Y150.1
+G1 X60.1 E990.5182
+G1 Y164.9 E991.1335
+G1 X74.9 E991.7488
+G1 Y150.1 E992.3641
+G1 E991.76 F3600
+G1 X160.46 Y110.46 F9000
+G1 E992.36 F2400
+G1 Y124.54 E992.9495 F3600
+G1 X174.54 E993.5349
+G1 Y110.46 E994.1202
+G1 X160.46 E994.7056
+G1 X160.1 Y110.1
+G1 Y124.9 E995.3209
+G1 X174.9 E995.9362
+G1 Y110.1 E996.5516
+G1 X160.1 E997.1669
+G1 E996.57 F2400
+G1 X74.54 Y110.46 F9000
+G1 E997.17 F2400
+G1 X60.46 E997.7522 F3600
+G1 Y124.54 E998.3376
+G1 X74.54 E998.923
+G1 Y110.46 E999.5084
+G1 X74.9 Y110.1
+G1 X60.1 E1000.1237
+G1 Y124.9 E1000.739
+G1 X74.9 E1001.3543
+G1 Y110.1 E1001.9696
+G1 E1001.37 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1001.97 F1200
+G1 Y84.54 E1002.555 F3600
+G1 X174.54 E1003.1404
+G1 Y70.46 E1003.7258
+G1 X160.46 E1004.3111
+G1 X160.1 Y70.1
+G1 Y84.9 E1004.9265
+G1 X174.9 E1005.5418
+G1 Y70.1 E1006.1571
+G1 X160.1 E1006.7724
+G1 E1006.17 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1006.77 F1200
+G1 X60.46 E1007.3578 F3600
+G1 Y84.54 E1007.9431
+G1 X74.54 E1008.5285
+G1 Y70.46 E1009.1139
+G1 X74.9 Y70.1
+G1 X60.1 E1009.7292
+G1 Y84.9 E1010.3445
+G1 X74.9 E1010.9598
+G1 Y70.1 E1011.5752
+;layer #17
+G1 E1010.98 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1011.58 F1200
+G1 Z4.25 F300
+G1 X60.46 E1012.1605 F3600
+G1 Y84.54 E1012.7459
+G1 X74.54 E1013.3313
+G1 Y70.46 E1013.9167
+G1 X74.9 Y70.1
+G1 X60.1 E1014.532
+G1 Y84.9 E1015.1473
+G1 X74.9 E1015.7626
+G1 Y70.1 E1016.3779
+G1 E1015.78 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1016.38 F1200
+G1 Y84.54 E1016.9633 F3600
+G1 X174.54 E1017.5487
+G1 Y70.46 E1018.1341
+G1 X160.46 E1018.7194
+G1 X160.1 Y70.1
+G1 Y84.9 E1019.3347
+G1 X174.9 E1019.9501
+G1 Y70.1 E1020.5654
+G1 X160.1 E1021.1807
+G1 E1020.58 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1021.18 F2400
+G1 X60.46 E1021.7661 F3600
+G1 Y124.54 E1022.3514
+G1 X74.54 E1022.9368
+G1 Y110.46 E1023.5222
+G1 X74.9 Y110.1
+G1 X60.1 E1024.1375
+G1 Y124.9 E1024.7528
+G1 X74.9 E1025.3681
+G1 Y110.1 E1025.9834
+G1 E1025.38 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1025.98 F2400
+G1 Y124.54 E1026.5688 F3600
+G1 X174.54 E1027.1542
+G1 Y110.46 E1027.7396
+G1 X160.46 E1028.325
+G1 X160.1 Y110.1
+G1 Y124.9 E1028.9403
+G1 X174.9 E1029.5556
+G1 Y110.1 E1030.1709
+G1 X160.1 E1030.7862
+G1 E1030.19 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1030.79 F3600
+G1 X60.46 E1031.3716
+G1 Y164.54 E1031.957
+G1 X74.54 E1032.5423
+G1 Y150.46 E1033.1277
+G1 X74.9 Y150.1
+G1 X60.1 E1033.743
+G1 Y164.9 E1034.3583
+G1 X74.9 E1034.9737
+G1 Y150.1 E1035.589
+G1 E1034.99 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1035.59 F3600
+G1 Y164.54 E1036.1744
+G1 X174.54 E1036.7597
+G1 Y150.46 E1037.3451
+G1 X160.46 E1037.9305
+G1 X160.1 Y150.1
+G1 Y164.9 E1038.5458
+G1 X174.9 E1039.1611
+G1 Y150.1 E1039.7764
+G1 X160.1 E1040.3917
+;layer #18
+G1 E1039.79 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1040.39 F3600
+G1 Z4.5 F300
+G1 Y164.54 E1040.9771 F3600
+G1 X174.54 E1041.5625
+G1 Y150.46 E1042.1479
+G1 X160.46 E1042.7332
+G1 X160.1 Y150.1
+G1 Y164.9 E1043.3486
+G1 X174.9 E1043.9639
+G1 Y150.1 E1044.5792
+G1 X160.1 E1045.1945
+G1 E1044.59 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1045.19 F3600
+G1 X60.46 E1045.7799
+G1 Y164.54 E1046.3653
+G1 X74.54 E1046.9506
+G1 Y150.46 E1047.536
+G1 X74.9 Y150.1
+G1 X60.1 E1048.1513
+G1 Y164.9 E1048.7666
+G1 X74.9 E1049.382
+G1 Y150.1 E1049.9973
+G1 E1049.4 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1050 F2400
+G1 Y124.54 E1050.5826 F3600
+G1 X174.54 E1051.168
+G1 Y110.46 E1051.7534
+G1 X160.46 E1052.3388
+G1 X160.1 Y110.1
+G1 Y124.9 E1052.9541
+G1 X174.9 E1053.5694
+G1 Y110.1 E1054.1847
+G1 X160.1 E1054.8
+G1 E1054.2 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1054.8 F2400
+G1 X60.46 E1055.3854 F3600
+G1 Y124.54 E1055.9708
+G1 X74.54 E1056.5562
+G1 Y110.46 E1057.1415
+G1 X74.9 Y110.1
+G1 X60.1 E1057.7569
+G1 Y124.9 E1058.3722
+G1 X74.9 E1058.9875
+G1 Y110.1 E1059.6028
+G1 E1059 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1059.6 F1200
+G1 Y84.54 E1060.1882 F3600
+G1 X174.54 E1060.7735
+G1 Y70.46 E1061.3589
+G1 X160.46 E1061.9443
+G1 X160.1 Y70.1
+G1 Y84.9 E1062.5596
+G1 X174.9 E1063.1749
+G1 Y70.1 E1063.7902
+G1 X160.1 E1064.4056
+G1 E1063.81 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1064.41 F1200
+G1 X60.46 E1064.9909 F3600
+G1 Y84.54 E1065.5763
+G1 X74.54 E1066.1617
+G1 Y70.46 E1066.7471
+G1 X74.9 Y70.1
+G1 X60.1 E1067.3624
+G1 Y84.9 E1067.9777
+G1 X74.9 E1068.593
+G1 Y70.1 E1069.2083
+;layer #19
+G1 E1068.61 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1069.21 F1200
+G1 Z4.75 F300
+G1 X60.46 E1069.7937 F3600
+G1 Y84.54 E1070.3791
+G1 X74.54 E1070.9645
+G1 Y70.46 E1071.5498
+G1 X74.9 Y70.1
+G1 X60.1 E1072.1651
+G1 Y84.9 E1072.7805
+G1 X74.9 E1073.3958
+G1 Y70.1 E1074.0111
+G1 E1073.41 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1074.01 F1200
+G1 Y84.54 E1074.5965 F3600
+G1 X174.54 E1075.1818
+G1 Y70.46 E1075.7672
+G1 X160.46 E1076.3526
+G1 X160.1 Y70.1
+G1 Y84.9 E1076.9679
+G1 X174.9 E1077.5832
+G1 Y70.1 E1078.1985
+G1 X160.1 E1078.8138
+G1 E1078.21 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1078.81 F2400
+G1 X60.46 E1079.3992 F3600
+G1 Y124.54 E1079.9846
+G1 X74.54 E1080.57
+G1 Y110.46 E1081.1554
+G1 X74.9 Y110.1
+G1 X60.1 E1081.7707
+G1 Y124.9 E1082.386
+G1 X74.9 E1083.0013
+G1 Y110.1 E1083.6166
+G1 E1083.02 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1083.62 F2400
+G1 Y124.54 E1084.202 F3600
+G1 X174.54 E1084.7874
+G1 Y110.46 E1085.3727
+G1 X160.46 E1085.9581
+G1 X160.1 Y110.1
+G1 Y124.9 E1086.5734
+G1 X174.9 E1087.1887
+G1 Y110.1 E1087.8041
+G1 X160.1 E1088.4194
+G1 E1087.82 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1088.42 F3600
+G1 X60.46 E1089.0047
+G1 Y164.54 E1089.5901
+G1 X74.54 E1090.1755
+G1 Y150.46 E1090.7609
+G1 X74.9 Y150.1
+G1 X60.1 E1091.3762
+G1 Y164.9 E1091.9915
+G1 X74.9 E1092.6068
+G1 Y150.1 E1093.2221
+G1 E1092.62 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1093.22 F3600
+G1 Y164.54 E1093.8075
+G1 X174.54 E1094.3929
+G1 Y150.46 E1094.9783
+G1 X160.46 E1095.5636
+G1 X160.1 Y150.1
+G1 Y164.9 E1096.179
+G1 X174.9 E1096.7943
+G1 Y150.1 E1097.4096
+G1 X160.1 E1098.0249
+;layer #20
+G1 E1097.42 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1098.02 F3600
+G1 Z5 F300
+G1 Y164.54 E1098.6103 F3600
+G1 X174.54 E1099.1957
+G1 Y150.46 E1099.781
+G1 X160.46 E1100.3664
+G1 X160.1 Y150.1
+G1 Y164.9 E1100.9817
+G1 X174.9 E1101.597
+G1 Y150.1 E1102.2123
+G1 X160.1 E1102.8277
+G1 E1102.23 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1102.83 F3600
+G1 X60.46 E1103.413
+G1 Y164.54 E1103.9984
+G1 X74.54 E1104.5838
+G1 Y150.46 E1105.1692
+G1 X74.9 Y150.1
+G1 X60.1 E1105.7845
+G1 Y164.9 E1106.3998
+G1 X74.9 E1107.0151
+G1 Y150.1 E1107.6304
+G1 E1107.03 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1107.63 F2400
+G1 Y124.54 E1108.2158 F3600
+G1 X174.54 E1108.8012
+G1 Y110.46 E1109.3866
+G1 X160.46 E1109.9719
+G1 X160.1 Y110.1
+G1 Y124.9 E1110.5873
+G1 X174.9 E1111.2026
+G1 Y110.1 E1111.8179
+G1 X160.1 E1112.4332
+G1 E1111.83 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1112.43 F2400
+G1 X60.46 E1113.0186 F3600
+G1 Y124.54 E1113.6039
+G1 X74.54 E1114.1893
+G1 Y110.46 E1114.7747
+G1 X74.9 Y110.1
+G1 X60.1 E1115.39
+G1 Y124.9 E1116.0053
+G1 X74.9 E1116.6206
+G1 Y110.1 E1117.236
+G1 E1116.64 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1117.24 F1200
+G1 Y84.54 E1117.8213 F3600
+G1 X174.54 E1118.4067
+G1 Y70.46 E1118.9921
+G1 X160.46 E1119.5775
+G1 X160.1 Y70.1
+G1 Y84.9 E1120.1928
+G1 X174.9 E1120.8081
+G1 Y70.1 E1121.4234
+G1 X160.1 E1122.0387
+G1 E1121.44 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1122.04 F1200
+G1 X60.46 E1122.6241 F3600
+G1 Y84.54 E1123.2095
+G1 X74.54 E1123.7949
+G1 Y70.46 E1124.3802
+G1 X74.9 Y70.1
+G1 X60.1 E1124.9955
+G1 Y84.9 E1125.6109
+G1 X74.9 E1126.2262
+G1 Y70.1 E1126.8415
+;layer #21
+G1 E1126.24 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1126.84 F1200
+G1 Z5.25 F300
+G1 X60.46 E1127.4269 F3600
+G1 Y84.54 E1128.0122
+G1 X74.54 E1128.5976
+G1 Y70.46 E1129.183
+G1 X74.9 Y70.1
+G1 X60.1 E1129.7983
+G1 Y84.9 E1130.4136
+G1 X74.9 E1131.0289
+G1 Y70.1 E1131.6442
+G1 E1131.04 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1131.64 F1200
+G1 Y84.54 E1132.2296 F3600
+G1 X174.54 E1132.815
+G1 Y70.46 E1133.4004
+G1 X160.46 E1133.9858
+G1 X160.1 Y70.1
+G1 Y84.9 E1134.6011
+G1 X174.9 E1135.2164
+G1 Y70.1 E1135.8317
+G1 X160.1 E1136.447
+G1 E1135.85 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1136.45 F2400
+G1 X60.46 E1137.0324 F3600
+G1 Y124.54 E1137.6178
+G1 X74.54 E1138.2031
+G1 Y110.46 E1138.7885
+G1 X74.9 Y110.1
+G1 X60.1 E1139.4038
+G1 Y124.9 E1140.0191
+G1 X74.9 E1140.6345
+G1 Y110.1 E1141.2498
+G1 E1140.65 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1141.25 F2400
+G1 Y124.54 E1141.8351 F3600
+G1 X174.54 E1142.4205
+G1 Y110.46 E1143.0059
+G1 X160.46 E1143.5913
+G1 X160.1 Y110.1
+G1 Y124.9 E1144.2066
+G1 X174.9 E1144.8219
+G1 Y110.1 E1145.4372
+G1 X160.1 E1146.0525
+G1 E1145.45 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1146.05 F3600
+G1 X60.46 E1146.6379
+G1 Y164.54 E1147.2233
+G1 X74.54 E1147.8087
+G1 Y150.46 E1148.394
+G1 X74.9 Y150.1
+G1 X60.1 E1149.0094
+G1 Y164.9 E1149.6247
+G1 X74.9 E1150.24
+G1 Y150.1 E1150.8553
+G1 E1150.26 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1150.86 F3600
+G1 Y164.54 E1151.4407
+G1 X174.54 E1152.0261
+G1 Y150.46 E1152.6114
+G1 X160.46 E1153.1968
+G1 X160.1 Y150.1
+G1 Y164.9 E1153.8121
+G1 X174.9 E1154.4274
+G1 Y150.1 E1155.0427
+G1 X160.1 E1155.6581
+;layer #22
+G1 E1155.06 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1155.66 F3600
+G1 Z5.5 F300
+G1 Y164.54 E1156.2434 F3600
+G1 X174.54 E1156.8288
+G1 Y150.46 E1157.4142
+G1 X160.46 E1157.9996
+G1 X160.1 Y150.1
+G1 Y164.9 E1158.6149
+G1 X174.9 E1159.2302
+G1 Y150.1 E1159.8455
+G1 X160.1 E1160.4608
+G1 E1159.86 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1160.46 F3600
+G1 X60.46 E1161.0462
+G1 Y164.54 E1161.6316
+G1 X74.54 E1162.217
+G1 Y150.46 E1162.8023
+G1 X74.9 Y150.1
+G1 X60.1 E1163.4176
+G1 Y164.9 E1164.033
+G1 X74.9 E1164.6483
+G1 Y150.1 E1165.2636
+G1 E1164.66 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1165.26 F2400
+G1 Y124.54 E1165.849 F3600
+G1 X174.54 E1166.4343
+G1 Y110.46 E1167.0197
+G1 X160.46 E1167.6051
+G1 X160.1 Y110.1
+G1 Y124.9 E1168.2204
+G1 X174.9 E1168.8357
+G1 Y110.1 E1169.451
+G1 X160.1 E1170.0664
+G1 E1169.47 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1170.07 F2400
+G1 X60.46 E1170.6517 F3600
+G1 Y124.54 E1171.2371
+G1 X74.54 E1171.8225
+G1 Y110.46 E1172.4079
+G1 X74.9 Y110.1
+G1 X60.1 E1173.0232
+G1 Y124.9 E1173.6385
+G1 X74.9 E1174.2538
+G1 Y110.1 E1174.8691
+G1 E1174.27 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1174.87 F1200
+G1 Y84.54 E1175.4545 F3600
+G1 X174.54 E1176.0399
+G1 Y70.46 E1176.6252
+G1 X160.46 E1177.2106
+G1 X160.1 Y70.1
+G1 Y84.9 E1177.8259
+G1 X174.9 E1178.4413
+G1 Y70.1 E1179.0566
+G1 X160.1 E1179.6719
+G1 E1179.07 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1179.67 F1200
+G1 X60.46 E1180.2573 F3600
+G1 Y84.54 E1180.8426
+G1 X74.54 E1181.428
+G1 Y70.46 E1182.0134
+G1 X74.9 Y70.1
+G1 X60.1 E1182.6287
+G1 Y84.9 E1183.244
+G1 X74.9 E1183.8593
+G1 Y70.1 E1184.4746
+;layer #23
+G1 E1183.87 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1184.47 F1200
+G1 Z5.75 F300
+G1 X60.46 E1185.06 F3600
+G1 Y84.54 E1185.6454
+G1 X74.54 E1186.2308
+G1 Y70.46 E1186.8162
+G1 X74.9 Y70.1
+G1 X60.1 E1187.4315
+G1 Y84.9 E1188.0468
+G1 X74.9 E1188.6621
+G1 Y70.1 E1189.2774
+G1 E1188.68 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1189.28 F1200
+G1 Y84.54 E1189.8628 F3600
+G1 X174.54 E1190.4482
+G1 Y70.46 E1191.0335
+G1 X160.46 E1191.6189
+G1 X160.1 Y70.1
+G1 Y84.9 E1192.2342
+G1 X174.9 E1192.8495
+G1 Y70.1 E1193.4649
+G1 X160.1 E1194.0802
+G1 E1193.48 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1194.08 F2400
+G1 X60.46 E1194.6655 F3600
+G1 Y124.54 E1195.2509
+G1 X74.54 E1195.8363
+G1 Y110.46 E1196.4217
+G1 X74.9 Y110.1
+G1 X60.1 E1197.037
+G1 Y124.9 E1197.6523
+G1 X74.9 E1198.2676
+G1 Y110.1 E1198.8829
+G1 E1198.28 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1198.88 F2400
+G1 Y124.54 E1199.4683 F3600
+G1 X174.54 E1200.0537
+G1 Y110.46 E1200.6391
+G1 X160.46 E1201.2244
+G1 X160.1 Y110.1
+G1 Y124.9 E1201.8398
+G1 X174.9 E1202.4551
+G1 Y110.1 E1203.0704
+G1 X160.1 E1203.6857
+G1 E1203.09 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1203.69 F3600
+G1 X60.46 E1204.2711
+G1 Y164.54 E1204.8565
+G1 X74.54 E1205.4418
+G1 Y150.46 E1206.0272
+G1 X74.9 Y150.1
+G1 X60.1 E1206.6425
+G1 Y164.9 E1207.2578
+G1 X74.9 E1207.8731
+G1 Y150.1 E1208.4885
+G1 E1207.89 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1208.49 F3600
+G1 Y164.54 E1209.0738
+G1 X174.54 E1209.6592
+G1 Y150.46 E1210.2446
+G1 X160.46 E1210.83
+G1 X160.1 Y150.1
+G1 Y164.9 E1211.4453
+G1 X174.9 E1212.0606
+G1 Y150.1 E1212.6759
+G1 X160.1 E1213.2912
+;layer #24
+G1 E1212.69 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1213.29 F3600
+G1 Z6 F300
+G1 Y164.54 E1213.8766 F3600
+G1 X174.54 E1214.462
+G1 Y150.46 E1215.0474
+G1 X160.46 E1215.6327
+G1 X160.1 Y150.1
+G1 Y164.9 E1216.248
+G1 X174.9 E1216.8634
+G1 Y150.1 E1217.4787
+G1 X160.1 E1218.094
+G1 E1217.49 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1218.09 F3600
+G1 X60.46 E1218.6794
+G1 Y164.54 E1219.2647
+G1 X74.54 E1219.8501
+G1 Y150.46 E1220.4355
+G1 X74.9 Y150.1
+G1 X60.1 E1221.0508
+G1 Y164.9 E1221.6661
+G1 X74.9 E1222.2814
+G1 Y150.1 E1222.8967
+G1 E1222.3 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1222.9 F2400
+G1 Y124.54 E1223.4821 F3600
+G1 X174.54 E1224.0675
+G1 Y110.46 E1224.6529
+G1 X160.46 E1225.2383
+G1 X160.1 Y110.1
+G1 Y124.9 E1225.8536
+G1 X174.9 E1226.4689
+G1 Y110.1 E1227.0842
+G1 X160.1 E1227.6995
+G1 E1227.1 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1227.7 F2400
+G1 X60.46 E1228.2849 F3600
+G1 Y124.54 E1228.8703
+G1 X74.54 E1229.4556
+G1 Y110.46 E1230.041
+G1 X74.9 Y110.1
+G1 X60.1 E1230.6563
+G1 Y124.9 E1231.2717
+G1 X74.9 E1231.887
+G1 Y110.1 E1232.5023
+G1 E1231.9 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1232.5 F1200
+G1 Y84.54 E1233.0877 F3600
+G1 X174.54 E1233.673
+G1 Y70.46 E1234.2584
+G1 X160.46 E1234.8438
+G1 X160.1 Y70.1
+G1 Y84.9 E1235.4591
+G1 X174.9 E1236.0744
+G1 Y70.1 E1236.6897
+G1 X160.1 E1237.305
+G1 E1236.71 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1237.31 F1200
+G1 X60.46 E1237.8904 F3600
+G1 Y84.54 E1238.4758
+G1 X74.54 E1239.0612
+G1 Y70.46 E1239.6466
+G1 X74.9 Y70.1
+G1 X60.1 E1240.2619
+G1 Y84.9 E1240.8772
+G1 X74.9 E1241.4925
+G1 Y70.1 E1242.1078
+;layer #25
+G1 E1241.91 F1200
+G1 X74.64 Y70.36 F9000
+G1 E1242.11 F1200
+G1 Z6.25 F300
+G1 X60.36 E1242.7015 F3600
+G1 Y84.64 E1243.2952
+G1 X74.64 E1243.8889
+G1 Y70.36 E1244.4826
+G1 X75 Y70
+G1 X60 E1245.1062
+G1 Y85 E1245.7298
+G1 X75 E1246.3535
+G1 Y70 E1246.9771
+G1 E1246.78 F1200
+G1 X160.36 Y70.36 F9000
+G1 E1246.98 F1200
+G1 Y84.64 E1247.5708 F3600
+G1 X174.64 E1248.1645
+G1 Y70.36 E1248.7582
+G1 X160.36 E1249.3519
+G1 X160 Y70
+G1 Y85 E1249.9755
+G1 X175 E1250.5991
+G1 Y70 E1251.2227
+G1 X160 E1251.8464
+G1 E1251.65 F1200
+G1 X74.64 Y110.36 F9000
+G1 E1251.85 F2400
+G1 X60.36 E1252.4401 F3600
+G1 Y124.64 E1253.0338
+G1 X74.64 E1253.6275
+G1 Y110.36 E1254.2211
+G1 X75 Y110
+G1 X60 E1254.8448
+G1 Y125 E1255.4684
+G1 X75 E1256.092
+G1 Y110 E1256.7157
+G1 E1256.52 F2400
+G1 X160.36 Y110.36 F9000
+G1 E1256.72 F2400
+G1 Y124.64 E1257.3093 F3600
+G1 X174.64 E1257.903
+G1 Y110.36 E1258.4967
+G1 X160.36 E1259.0904
+G1 X160 Y110
+G1 Y125 E1259.7141
+G1 X175 E1260.3377
+G1 Y110 E1260.9613
+G1 X160 E1261.5849
+G1 E1261.38 F2400
+G1 X74.64 Y150.36 F9000
+G1 E1261.58 F3600
+G1 X60.36 E1262.1786
+G1 Y164.64 E1262.7723
+G1 X74.64 E1263.366
+G1 Y150.36 E1263.9597
+G1 X75 Y150
+G1 X60 E1264.5833
+G1 Y165 E1265.207
+G1 X75 E1265.8306
+G1 Y150 E1266.4542
+G1 E1266.25 F3600
+G1 X160.36 Y150.36 F9000
+G1 E1266.45 F3600
+G1 Y164.64 E1267.0479
+G1 X174.64 E1267.6416
+G1 Y150.36 E1268.2353
+G1 X160.36 E1268.829
+G1 X160 Y150
+G1 Y165 E1269.4526
+G1 X175 E1270.0763
+G1 Y150 E1270.6999
+G1 X160 E1271.3235
+;layer #26
+G1 E1271.12 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1271.32 F3600
+G1 Z6.5 F300
+G1 Y164.54 E1271.9089 F3600
+G1 X174.54 E1272.4943
+G1 Y150.46 E1273.0796
+G1 X160.46 E1273.665
+G1 X160.1 Y150.1
+G1 Y164.9 E1274.2803
+G1 X174.9 E1274.8956
+G1 Y150.1 E1275.511
+G1 X160.1 E1276.1263
+G1 E1275.93 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1276.13 F3600
+G1 X60.46 E1276.7116
+G1 Y164.54 E1277.297
+G1 X74.54 E1277.8824
+G1 Y150.46 E1278.4678
+G1 X74.9 Y150.1
+G1 X60.1 E1279.0831
+G1 Y164.9 E1279.6984
+G1 X74.9 E1280.3137
+G1 Y150.1 E1280.929
+G1 E1280.73 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1280.93 F2400
+G1 Y124.54 E1281.5144 F3600
+G1 X174.54 E1282.0998
+G1 Y110.46 E1282.6852
+G1 X160.46 E1283.2705
+G1 X160.1 Y110.1
+G1 Y124.9 E1283.8859
+G1 X174.9 E1284.5012
+G1 Y110.1 E1285.1165
+G1 X160.1 E1285.7318
+G1 E1285.53 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1285.73 F2400
+G1 X60.46 E1286.3172 F3600
+G1 Y124.54 E1286.9026
+G1 X74.54 E1287.4879
+G1 Y110.46 E1288.0733
+G1 X74.9 Y110.1
+G1 X60.1 E1288.6886
+G1 Y124.9 E1289.3039
+G1 X74.9 E1289.9192
+G1 Y110.1 E1290.5346
+G1 E1290.33 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1290.53 F1200
+G1 Y84.54 E1291.1199 F3600
+G1 X174.54 E1291.7053
+G1 Y70.46 E1292.2907
+G1 X160.46 E1292.8761
+G1 X160.1 Y70.1
+G1 Y84.9 E1293.4914
+G1 X174.9 E1294.1067
+G1 Y70.1 E1294.722
+G1 X160.1 E1295.3373
+G1 E1295.14 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1295.34 F1200
+G1 X60.46 E1295.9227 F3600
+G1 Y84.54 E1296.5081
+G1 X74.54 E1297.0935
+G1 Y70.46 E1297.6788
+G1 X74.9 Y70.1
+G1 X60.1 E1298.2941
+G1 Y84.9 E1298.9095
+G1 X74.9 E1299.5248
+G1 Y70.1 E1300.1401
+;layer #27
+G1 E1299.94 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1300.14 F1200
+G1 Z6.75 F300
+G1 X60.46 E1300.7255 F3600
+G1 Y84.54 E1301.3108
+G1 X74.54 E1301.8962
+G1 Y70.46 E1302.4816
+G1 X74.9 Y70.1
+G1 X60.1 E1303.0969
+G1 Y84.9 E1303.7122
+G1 X74.9 E1304.3275
+G1 Y70.1 E1304.9429
+G1 E1304.74 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1304.94 F1200
+G1 Y84.54 E1305.5282 F3600
+G1 X174.54 E1306.1136
+G1 Y70.46 E1306.699
+G1 X160.46 E1307.2844
+G1 X160.1 Y70.1
+G1 Y84.9 E1307.8997
+G1 X174.9 E1308.515
+G1 Y70.1 E1309.1303
+G1 X160.1 E1309.7456
+G1 E1309.55 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1309.75 F2400
+G1 X60.46 E1310.331 F3600
+G1 Y124.54 E1310.9164
+G1 X74.54 E1311.5017
+G1 Y110.46 E1312.0871
+G1 X74.9 Y110.1
+G1 X60.1 E1312.7024
+G1 Y124.9 E1313.3178
+G1 X74.9 E1313.9331
+G1 Y110.1 E1314.5484
+G1 E1314.35 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1314.55 F2400
+G1 Y124.54 E1315.1338 F3600
+G1 X174.54 E1315.7191
+G1 Y110.46 E1316.3045
+G1 X160.46 E1316.8899
+G1 X160.1 Y110.1
+G1 Y124.9 E1317.5052
+G1 X174.9 E1318.1205
+G1 Y110.1 E1318.7358
+G1 X160.1 E1319.3511
+G1 E1319.15 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1319.35 F3600
+G1 X60.46 E1319.9365
+G1 Y164.54 E1320.5219
+G1 X74.54 E1321.1073
+G1 Y150.46 E1321.6927
+G1 X74.9 Y150.1
+G1 X60.1 E1322.308
+G1 Y164.9 E1322.9233
+G1 X74.9 E1323.5386
+G1 Y150.1 E1324.1539
+G1 E1323.95 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1324.15 F3600
+G1 Y164.54 E1324.7393
+G1 X174.54 E1325.3247
+G1 Y150.46 E1325.91
+G1 X160.46 E1326.4954
+G1 X160.1 Y150.1
+G1 Y164.9 E1327.1107
+G1 X174.9 E1327.726
+G1 Y150.1 E1328.3414
+G1 X160.1 E1328.9567
+;layer #28
+G1 E1328.76 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1328.96 F3600
+G1 Z7 F300
+G1 Y164.54 E1329.542 F3600
+G1 X174.54 E1330.1274
+G1 Y150.46 E1330.7128
+G1 X160.46 E1331.2982
+G1 X160.1 Y150.1
+G1 Y164.9 E1331.9135
+G1 X174.9 E1332.5288
+G1 Y150.1 E1333.1441
+G1 X160.1 E1333.7594
+G1 E1333.56 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1333.76 F3600
+G1 X60.46 E1334.3448
+G1 Y164.54 E1334.9302
+G1 X74.54 E1335.5156
+G1 Y150.46 E1336.1009
+G1 X74.9 Y150.1
+G1 X60.1 E1336.7163
+G1 Y164.9 E1337.3316
+G1 X74.9 E1337.9469
+G1 Y150.1 E1338.5622
+G1 E1338.36 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1338.56 F2400
+G1 Y124.54 E1339.1476 F3600
+G1 X174.54 E1339.733
+G1 Y110.46 E1340.3183
+G1 X160.46 E1340.9037
+G1 X160.1 Y110.1
+G1 Y124.9 E1341.519
+G1 X174.9 E1342.1343
+G1 Y110.1 E1342.7496
+G1 X160.1 E1343.365
+G1 E1343.16 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1343.36 F2400
+G1 X60.46 E1343.9503 F3600
+G1 Y124.54 E1344.5357
+G1 X74.54 E1345.1211
+G1 Y110.46 E1345.7065
+G1 X74.9 Y110.1
+G1 X60.1 E1346.3218
+G1 Y124.9 E1346.9371
+G1 X74.9 E1347.5524
+G1 Y110.1 E1348.1677
+G1 E1347.97 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1348.17 F1200
+G1 Y84.54 E1348.7531 F3600
+G1 X174.54 E1349.3385
+G1 Y70.46 E1349.9239
+G1 X160.46 E1350.5092
+G1 X160.1 Y70.1
+G1 Y84.9 E1351.1245
+G1 X174.9 E1351.7399
+G1 Y70.1 E1352.3552
+G1 X160.1 E1352.9705
+G1 E1352.77 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1352.97 F1200
+G1 X60.46 E1353.5559 F3600
+G1 Y84.54 E1354.1412
+G1 X74.54 E1354.7266
+G1 Y70.46 E1355.312
+G1 X74.9 Y70.1
+G1 X60.1 E1355.9273
+G1 Y84.9 E1356.5426
+G1 X74.9 E1357.1579
+G1 Y70.1 E1357.7732
+;layer #29
+G1 E1357.57 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1357.77 F1200
+G1 Z7.25 F300
+G1 X60.46 E1358.3586 F3600
+G1 Y84.54 E1358.944
+G1 X74.54 E1359.5294
+G1 Y70.46 E1360.1148
+G1 X74.9 Y70.1
+G1 X60.1 E1360.7301
+G1 Y84.9 E1361.3454
+G1 X74.9 E1361.9607
+G1 Y70.1 E1362.576
+G1 E1362.38 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1362.58 F1200
+G1 Y84.54 E1363.1614 F3600
+G1 X174.54 E1363.7468
+G1 Y70.46 E1364.3321
+G1 X160.46 E1364.9175
+G1 X160.1 Y70.1
+G1 Y84.9 E1365.5328
+G1 X174.9 E1366.1482
+G1 Y70.1 E1366.7635
+G1 X160.1 E1367.3788
+G1 E1367.18 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1367.38 F2400
+G1 X60.46 E1367.9642 F3600
+G1 Y124.54 E1368.5495
+G1 X74.54 E1369.1349
+G1 Y110.46 E1369.7203
+G1 X74.9 Y110.1
+G1 X60.1 E1370.3356
+G1 Y124.9 E1370.9509
+G1 X74.9 E1371.5662
+G1 Y110.1 E1372.1815
+G1 E1371.98 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1372.18 F2400
+G1 Y124.54 E1372.7669 F3600
+G1 X174.54 E1373.3523
+G1 Y110.46 E1373.9377
+G1 X160.46 E1374.5231
+G1 X160.1 Y110.1
+G1 Y124.9 E1375.1384
+G1 X174.9 E1375.7537
+G1 Y110.1 E1376.369
+G1 X160.1 E1376.9843
+G1 E1376.78 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1376.98 F3600
+G1 X60.46 E1377.5697
+G1 Y164.54 E1378.1551
+G1 X74.54 E1378.7404
+G1 Y150.46 E1379.3258
+G1 X74.9 Y150.1
+G1 X60.1 E1379.9411
+G1 Y164.9 E1380.5564
+G1 X74.9 E1381.1718
+G1 Y150.1 E1381.7871
+G1 E1381.59 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1381.79 F3600
+G1 Y164.54 E1382.3724
+G1 X174.54 E1382.9578
+G1 Y150.46 E1383.5432
+G1 X160.46 E1384.1286
+G1 X160.1 Y150.1
+G1 Y164.9 E1384.7439
+G1 X174.9 E1385.3592
+G1 Y150.1 E1385.9745
+G1 X160.1 E1386.5898
+;layer #30
+G1 E1386.39 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1386.59 F3600
+G1 Z7.5 F300
+G1 Y164.54 E1387.1752 F3600
+G1 X174.54 E1387.7606
+G1 Y150.46 E1388.346
+G1 X160.46 E1388.9313
+G1 X160.1 Y150.1
+G1 Y164.9 E1389.5467
+G1 X174.9 E1390.162
+G1 Y150.1 E1390.7773
+G1 X160.1 E1391.3926
+G1 E1391.19 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1391.39 F3600
+G1 X60.46 E1391.978
+G1 Y164.54 E1392.5634
+G1 X74.54 E1393.1487
+G1 Y150.46 E1393.7341
+G1 X74.9 Y150.1
+G1 X60.1 E1394.3494
+G1 Y164.9 E1394.9647
+G1 X74.9 E1395.58
+G1 Y150.1 E1396.1954
+G1 E1396 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1396.2 F2400
+G1 Y124.54 E1396.7807 F3600
+G1 X174.54 E1397.3661
+G1 Y110.46 E1397.9515
+G1 X160.46 E1398.5369
+G1 X160.1 Y110.1
+G1 Y124.9 E1399.1522
+G1 X174.9 E1399.7675
+G1 Y110.1 E1400.3828
+G1 X160.1 E1400.9981
+G1 E1400.8 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1401 F2400
+G1 X60.46 E1401.5835 F3600
+G1 Y124.54 E1402.1689
+G1 X74.54 E1402.7543
+G1 Y110.46 E1403.3396
+G1 X74.9 Y110.1
+G1 X60.1 E1403.9549
+G1 Y124.9 E1404.5703
+G1 X74.9 E1405.1856
+G1 Y110.1 E1405.8009
+G1 E1405.6 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1405.8 F1200
+G1 Y84.54 E1406.3863 F3600
+G1 X174.54 E1406.9716
+G1 Y70.46 E1407.557
+G1 X160.46 E1408.1424
+G1 X160.1 Y70.1
+G1 Y84.9 E1408.7577
+G1 X174.9 E1409.373
+G1 Y70.1 E1409.9883
+G1 X160.1 E1410.6036
+G1 E1410.4 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1410.6 F1200
+G1 X60.46 E1411.189 F3600
+G1 Y84.54 E1411.7744
+G1 X74.54 E1412.3598
+G1 Y70.46 E1412.9452
+G1 X74.9 Y70.1
+G1 X60.1 E1413.5605
+G1 Y84.9 E1414.1758
+G1 X74.9 E1414.7911
+G1 Y70.1 E1415.4064
+;layer #31
+G1 E1415.21 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1415.41 F1200
+G1 Z7.75 F300
+G1 X60.46 E1415.9918 F3600
+G1 Y84.54 E1416.5772
+G1 X74.54 E1417.1625
+G1 Y70.46 E1417.7479
+G1 X74.9 Y70.1
+G1 X60.1 E1418.3632
+G1 Y84.9 E1418.9785
+G1 X74.9 E1419.5939
+G1 Y70.1 E1420.2092
+G1 E1420.01 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1420.21 F1200
+G1 Y84.54 E1420.7946 F3600
+G1 X174.54 E1421.3799
+G1 Y70.46 E1421.9653
+G1 X160.46 E1422.5507
+G1 X160.1 Y70.1
+G1 Y84.9 E1423.166
+G1 X174.9 E1423.7813
+G1 Y70.1 E1424.3966
+G1 X160.1 E1425.0119
+G1 E1424.81 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1425.01 F2400
+G1 X60.46 E1425.5973 F3600
+G1 Y124.54 E1426.1827
+G1 X74.54 E1426.7681
+G1 Y110.46 E1427.3535
+G1 X74.9 Y110.1
+G1 X60.1 E1427.9688
+G1 Y124.9 E1428.5841
+G1 X74.9 E1429.1994
+G1 Y110.1 E1429.8147
+G1 E1429.61 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1429.81 F2400
+G1 Y124.54 E1430.4001 F3600
+G1 X174.54 E1430.9855
+G1 Y110.46 E1431.5708
+G1 X160.46 E1432.1562
+G1 X160.1 Y110.1
+G1 Y124.9 E1432.7715
+G1 X174.9 E1433.3868
+G1 Y110.1 E1434.0022
+G1 X160.1 E1434.6175
+G1 E1434.42 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1434.62 F3600
+G1 X60.46 E1435.2028
+G1 Y164.54 E1435.7882
+G1 X74.54 E1436.3736
+G1 Y150.46 E1436.959
+G1 X74.9 Y150.1
+G1 X60.1 E1437.5743
+G1 Y164.9 E1438.1896
+G1 X74.9 E1438.8049
+G1 Y150.1 E1439.4202
+G1 E1439.22 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1439.42 F3600
+G1 Y164.54 E1440.0056
+G1 X174.54 E1440.591
+G1 Y150.46 E1441.1764
+G1 X160.46 E1441.7617
+G1 X160.1 Y150.1
+G1 Y164.9 E1442.3771
+G1 X174.9 E1442.9924
+G1 Y150.1 E1443.6077
+G1 X160.1 E1444.223
+;layer #32
+G1 E1444.02 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1444.22 F3600
+G1 Z8 F300
+G1 Y164.54 E1444.8084 F3600
+G1 X174.54 E1445.3937
+G1 Y150.46 E1445.9791
+G1 X160.46 E1446.5645
+G1 X160.1 Y150.1
+G1 Y164.9 E1447.1798
+G1 X174.9 E1447.7951
+G1 Y150.1 E1448.4104
+G1 X160.1 E1449.0258
+G1 E1448.83 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1449.03 F3600
+G1 X60.46 E1449.6111
+G1 Y164.54 E1450.1965
+G1 X74.54 E1450.7819
+G1 Y150.46 E1451.3673
+G1 X74.9 Y150.1
+G1 X60.1 E1451.9826
+G1 Y164.9 E1452.5979
+G1 X74.9 E1453.2132
+G1 Y150.1 E1453.8285
+G1 E1453.63 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1453.83 F2400
+G1 Y124.54 E1454.4139 F3600
+G1 X174.54 E1454.9993
+G1 Y110.46 E1455.5847
+G1 X160.46 E1456.17
+G1 X160.1 Y110.1
+G1 Y124.9 E1456.7853
+G1 X174.9 E1457.4007
+G1 Y110.1 E1458.016
+G1 X160.1 E1458.6313
+G1 E1458.43 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1458.63 F2400
+G1 X60.46 E1459.2167 F3600
+G1 Y124.54 E1459.802
+G1 X74.54 E1460.3874
+G1 Y110.46 E1460.9728
+G1 X74.9 Y110.1
+G1 X60.1 E1461.5881
+G1 Y124.9 E1462.2034
+G1 X74.9 E1462.8187
+G1 Y110.1 E1463.434
+G1 E1463.23 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1463.43 F1200
+G1 Y84.54 E1464.0194 F3600
+G1 X174.54 E1464.6048
+G1 Y70.46 E1465.1902
+G1 X160.46 E1465.7756
+G1 X160.1 Y70.1
+G1 Y84.9 E1466.3909
+G1 X174.9 E1467.0062
+G1 Y70.1 E1467.6215
+G1 X160.1 E1468.2368
+G1 E1468.04 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1468.24 F1200
+G1 X60.46 E1468.8222 F3600
+G1 Y84.54 E1469.4076
+G1 X74.54 E1469.9929
+G1 Y70.46 E1470.5783
+G1 X74.9 Y70.1
+G1 X60.1 E1471.1936
+G1 Y84.9 E1471.8089
+G1 X74.9 E1472.4243
+G1 Y70.1 E1473.0396
+;layer #33
+G1 E1472.84 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1473.04 F1200
+G1 Z8.25 F300
+G1 X60.46 E1473.625 F3600
+G1 Y84.54 E1474.2103
+G1 X74.54 E1474.7957
+G1 Y70.46 E1475.3811
+G1 X74.9 Y70.1
+G1 X60.1 E1475.9964
+G1 Y84.9 E1476.6117
+G1 X74.9 E1477.227
+G1 Y70.1 E1477.8423
+G1 E1477.64 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1477.84 F1200
+G1 Y84.54 E1478.4277 F3600
+G1 X174.54 E1479.0131
+G1 Y70.46 E1479.5985
+G1 X160.46 E1480.1839
+G1 X160.1 Y70.1
+G1 Y84.9 E1480.7992
+G1 X174.9 E1481.4145
+G1 Y70.1 E1482.0298
+G1 X160.1 E1482.6451
+G1 E1482.45 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1482.65 F2400
+G1 X60.46 E1483.2305 F3600
+G1 Y124.54 E1483.8159
+G1 X74.54 E1484.4012
+G1 Y110.46 E1484.9866
+G1 X74.9 Y110.1
+G1 X60.1 E1485.6019
+G1 Y124.9 E1486.2172
+G1 X74.9 E1486.8326
+G1 Y110.1 E1487.4479
+G1 E1487.25 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1487.45 F2400
+G1 Y124.54 E1488.0332 F3600
+G1 X174.54 E1488.6186
+G1 Y110.46 E1489.204
+G1 X160.46 E1489.7894
+G1 X160.1 Y110.1
+G1 Y124.9 E1490.4047
+G1 X174.9 E1491.02
+G1 Y110.1 E1491.6353
+G1 X160.1 E1492.2506
+G1 E1492.05 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1492.25 F3600
+G1 X60.46 E1492.836
+G1 Y164.54 E1493.4214
+G1 X74.54 E1494.0068
+G1 Y150.46 E1494.5921
+G1 X74.9 Y150.1
+G1 X60.1 E1495.2075
+G1 Y164.9 E1495.8228
+G1 X74.9 E1496.4381
+G1 Y150.1 E1497.0534
+G1 E1496.85 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1497.05 F3600
+G1 Y164.54 E1497.6388
+G1 X174.54 E1498.2241
+G1 Y150.46 E1498.8095
+G1 X160.46 E1499.3949
+G1 X160.1 Y150.1
+G1 Y164.9 E1500.0102
+G1 X174.9 E1500.6255
+G1 Y150.1 E1501.2408
+G1 X160.1 E1501.8562
+;layer #34
+G1 E1501.66 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1501.86 F3600
+G1 Z8.5 F300
+G1 Y164.54 E1502.4415 F3600
+G1 X174.54 E1503.0269
+G1 Y150.46 E1503.6123
+G1 X160.46 E1504.1977
+G1 X160.1 Y150.1
+G1 Y164.9 E1504.813
+G1 X174.9 E1505.4283
+G1 Y150.1 E1506.0436
+G1 X160.1 E1506.6589
+G1 E1506.46 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1506.66 F3600
+G1 X60.46 E1507.2443
+G1 Y164.54 E1507.8297
+G1 X74.54 E1508.4151
+G1 Y150.46 E1509.0004
+G1 X74.9 Y150.1
+G1 X60.1 E1509.6157
+G1 Y164.9 E1510.2311
+G1 X74.9 E1510.8464
+G1 Y150.1 E1511.4617
+G1 E1511.26 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1511.46 F2400
+G1 Y124.54 E1512.0471 F3600
+G1 X174.54 E1512.6324
+G1 Y110.46 E1513.2178
+G1 X160.46 E1513.8032
+G1 X160.1 Y110.1
+G1 Y124.9 E1514.4185
+G1 X174.9 E1515.0338
+G1 Y110.1 E1515.6491
+G1 X160.1 E1516.2644
+G1 E1516.06 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1516.26 F2400
+G1 X60.46 E1516.8498 F3600
+G1 Y124.54 E1517.4352
+G1 X74.54 E1518.0206
+G1 Y110.46 E1518.606
+G1 X74.9 Y110.1
+G1 X60.1 E1519.2213
+G1 Y124.9 E1519.8366
+G1 X74.9 E1520.4519
+G1 Y110.1 E1521.0672
+G1 E1520.87 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1521.07 F1200
+G1 Y84.54 E1521.6526 F3600
+G1 X174.54 E1522.238
+G1 Y70.46 E1522.8233
+G1 X160.46 E1523.4087
+G1 X160.1 Y70.1
+G1 Y84.9 E1524.024
+G1 X174.9 E1524.6393
+G1 Y70.1 E1525.2547
+G1 X160.1 E1525.87
+G1 E1525.67 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1525.87 F1200
+G1 X60.46 E1526.4553 F3600
+G1 Y84.54 E1527.0407
+G1 X74.54 E1527.6261
+G1 Y70.46 E1528.2115
+G1 X74.9 Y70.1
+G1 X60.1 E1528.8268
+G1 Y84.9 E1529.4421
+G1 X74.9 E1530.0574
+G1 Y70.1 E1530.6727
+;layer #35
+G1 E1530.47 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1530.67 F1200
+G1 Z8.75 F300
+G1 X60.46 E1531.2581 F3600
+G1 Y84.54 E1531.8435
+G1 X74.54 E1532.4289
+G1 Y70.46 E1533.0142
+G1 X74.9 Y70.1
+G1 X60.1 E1533.6296
+G1 Y84.9 E1534.2449
+G1 X74.9 E1534.8602
+G1 Y70.1 E1535.4755
+G1 E1535.28 F1200
+G1 X160.46 Y70.46 F9000
+G1 E1535.48 F1200
+G1 Y84.54 E1536.0609 F3600
+G1 X174.54 E1536.6463
+G1 Y70.46 E1537.2316
+G1 X160.46 E1537.817
+G1 X160.1 Y70.1
+G1 Y84.9 E1538.4323
+G1 X174.9 E1539.0476
+G1 Y70.1 E1539.6629
+G1 X160.1 E1540.2783
+G1 E1540.08 F1200
+G1 X74.54 Y110.46 F9000
+G1 E1540.28 F2400
+G1 X60.46 E1540.8636 F3600
+G1 Y124.54 E1541.449
+G1 X74.54 E1542.0344
+G1 Y110.46 E1542.6198
+G1 X74.9 Y110.1
+G1 X60.1 E1543.2351
+G1 Y124.9 E1543.8504
+G1 X74.9 E1544.4657
+G1 Y110.1 E1545.081
+G1 E1544.88 F2400
+G1 X160.46 Y110.46 F9000
+G1 E1545.08 F2400
+G1 Y124.54 E1545.6664 F3600
+G1 X174.54 E1546.2518
+G1 Y110.46 E1546.8372
+G1 X160.46 E1547.4225
+G1 X160.1 Y110.1
+G1 Y124.9 E1548.0379
+G1 X174.9 E1548.6532
+G1 Y110.1 E1549.2685
+G1 X160.1 E1549.8838
+G1 E1549.68 F2400
+G1 X74.54 Y150.46 F9000
+G1 E1549.88 F3600
+G1 X60.46 E1550.4692
+G1 Y164.54 E1551.0545
+G1 X74.54 E1551.6399
+G1 Y150.46 E1552.2253
+G1 X74.9 Y150.1
+G1 X60.1 E1552.8406
+G1 Y164.9 E1553.4559
+G1 X74.9 E1554.0712
+G1 Y150.1 E1554.6866
+G1 E1554.49 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1554.69 F3600
+G1 Y164.54 E1555.2719
+G1 X174.54 E1555.8573
+G1 Y150.46 E1556.4427
+G1 X160.46 E1557.0281
+G1 X160.1 Y150.1
+G1 Y164.9 E1557.6434
+G1 X174.9 E1558.2587
+G1 Y150.1 E1558.874
+G1 X160.1 E1559.4893
+;layer #36
+G1 E1559.29 F3600
+G1 X160.46 Y150.46 F9000
+G1 E1559.49 F3600
+G1 Z9 F300
+G1 Y164.54 E1560.0747 F3600
+G1 X174.54 E1560.6601
+G1 Y150.46 E1561.2455
+G1 X160.46 E1561.8308
+G1 X160.1 Y150.1
+G1 Y164.9 E1562.4461
+G1 X174.9 E1563.0615
+G1 Y150.1 E1563.6768
+G1 X160.1 E1564.2921
+G1 E1564.09 F3600
+G1 X74.54 Y150.46 F9000
+G1 E1564.29 F3600
+G1 X60.46 E1564.8775
+G1 Y164.54 E1565.4628
+G1 X74.54 E1566.0482
+G1 Y150.46 E1566.6336
+G1 X74.9 Y150.1
+G1 X60.1 E1567.2489
+G1 Y164.9 E1567.8642
+G1 X74.9 E1568.4795
+G1 Y150.1 E1569.0948
+G1 E1568.89 F3600
+G1 X160.46 Y110.46 F9000
+G1 E1569.09 F2400
+G1 Y124.54 E1569.6802 F3600
+G1 X174.54 E1570.2656
+G1 Y110.46 E1570.851
+G1 X160.46 E1571.4364
+G1 X160.1 Y110.1
+G1 Y124.9 E1572.0517
+G1 X174.9 E1572.667
+G1 Y110.1 E1573.2823
+G1 X160.1 E1573.8976
+G1 E1573.7 F2400
+G1 X74.54 Y110.46 F9000
+G1 E1573.9 F2400
+G1 X60.46 E1574.483 F3600
+G1 Y124.54 E1575.0684
+G1 X74.54 E1575.6537
+G1 Y110.46 E1576.2391
+G1 X74.9 Y110.1
+G1 X60.1 E1576.8544
+G1 Y124.9 E1577.4697
+G1 X74.9 E1578.0851
+G1 Y110.1 E1578.7004
+G1 E1578.5 F2400
+G1 X160.46 Y70.46 F9000
+G1 E1578.7 F1200
+G1 Y84.54 E1579.2857 F3600
+G1 X174.54 E1579.8711
+G1 Y70.46 E1580.4565
+G1 X160.46 E1581.0419
+G1 X160.1 Y70.1
+G1 Y84.9 E1581.6572
+G1 X174.9 E1582.2725
+G1 Y70.1 E1582.8878
+G1 X160.1 E1583.5031
+G1 E1583.3 F1200
+G1 X74.54 Y70.46 F9000
+G1 E1583.5 F1200
+G1 X60.46 E1584.0885 F3600
+G1 Y84.54 E1584.6739
+G1 X74.54 E1585.2593
+G1 Y70.46 E1585.8446
+G1 X74.9 Y70.1
+G1 X60.1 E1586.46
+G1 Y84.9 E1587.0753
+G1 X74.9 E1587.6906
+G1 Y70.1 E1588.3059
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,1539 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 1
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0.02 [s]
+;Segment height: 3 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;K-Factor sweep: 0.02-0.08 [s]
+;Segment 4:   0.6mm @ 30mm/s @ K0.08
+;Segment 3:   0.6mm @ 30mm/s @ K0.06
+;Segment 2:   0.6mm @ 30mm/s @ K0.04
+;Segment 1:   0.6mm @ 30mm/s @ K0.02
+SET_PRESSURE_ADVANCE ADVANCE=0.02 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+SET_PRESSURE_ADVANCE ADVANCE=0.02
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-0.6 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.61 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.42 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 E203.23 F1800
+G1 X160.46 Y110.46 F9000
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 Y124.54 E204.42 F3600
+G1 X174.54 E205.0054
+G1 Y110.46 E205.5908
+G1 X160.46 E206.1762
+G1 X160.1 Y110.1
+G1 Y124.9 E206.7915
+G1 X174.9 E207.4068
+G1 Y110.1 E208.0221
+G1 X160.1 E208.6374
+G1 E208.04 F1800
+G1 X74.54 Y110.46 F9000
+G1 E208.64 F1800
+G1 X60.46 E209.2228 F3600
+G1 Y124.54 E209.8082
+G1 X74.54 E210.3936
+G1 Y110.46 E210.9789
+G1 X74.9 Y110.1
+G1 X60.1 E211.5943
+G1 Y124.9 E212.2096
+G1 X74.9 E212.8249
+G1 Y110.1 E213.4402
+;layer #3
+M106 S254
+G1 E212.84 F1800
+G1 X74.54 Y110.46 F9000
+G1 E213.44 F1800
+G1 Z0.75 F300
+G1 X60.46 E214.0256 F3600
+G1 Y124.54 E214.611
+G1 X74.54 E215.1963
+G1 Y110.46 E215.7817
+G1 X74.9 Y110.1
+G1 X60.1 E216.397
+G1 Y124.9 E217.0123
+G1 X74.9 E217.6276
+G1 Y110.1 E218.243
+G1 E217.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E218.24 F1800
+G1 Y124.54 E218.8283 F3600
+G1 X174.54 E219.4137
+G1 Y110.46 E219.9991
+G1 X160.46 E220.5845
+G1 X160.1 Y110.1
+G1 Y124.9 E221.1998
+G1 X174.9 E221.8151
+G1 Y110.1 E222.4304
+G1 X160.1 E223.0457
+;layer #4
+G1 E222.45 F1800
+G1 X160.46 Y110.46 F9000
+G1 E223.05 F1800
+G1 Z1 F300
+G1 Y124.54 E223.6311 F3600
+G1 X174.54 E224.2165
+G1 Y110.46 E224.8019
+G1 X160.46 E225.3872
+G1 X160.1 Y110.1
+G1 Y124.9 E226.0026
+G1 X174.9 E226.6179
+G1 Y110.1 E227.2332
+G1 X160.1 E227.8485
+G1 E227.25 F1800
+G1 X74.54 Y110.46 F9000
+G1 E227.85 F1800
+G1 X60.46 E228.4339 F3600
+G1 Y124.54 E229.0192
+G1 X74.54 E229.6046
+G1 Y110.46 E230.19
+G1 X74.9 Y110.1
+G1 X60.1 E230.8053
+G1 Y124.9 E231.4206
+G1 X74.9 E232.0359
+G1 Y110.1 E232.6513
+;layer #5
+G1 E232.05 F1800
+G1 X74.54 Y110.46 F9000
+G1 E232.65 F1800
+G1 Z1.25 F300
+G1 X60.46 E233.2366 F3600
+G1 Y124.54 E233.822
+G1 X74.54 E234.4074
+G1 Y110.46 E234.9928
+G1 X74.9 Y110.1
+G1 X60.1 E235.6081
+G1 Y124.9 E236.2234
+G1 X74.9 E236.8387
+G1 Y110.1 E237.454
+G1 E236.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E237.45 F1800
+G1 Y124.54 E238.0394 F3600
+G1 X174.54 E238.6248
+G1 Y110.46 E239.2101
+G1 X160.46 E239.7955
+G1 X160.1 Y110.1
+G1 Y124.9 E240.4108
+G1 X174.9 E241.0262
+G1 Y110.1 E241.6415
+G1 X160.1 E242.2568
+;layer #6
+G1 E241.66 F1800
+G1 X160.46 Y110.46 F9000
+G1 E242.26 F1800
+G1 Z1.5 F300
+G1 Y124.54 E242.8422 F3600
+G1 X174.54 E243.4275
+G1 Y110.46 E244.0129
+G1 X160.46 E244.5983
+G1 X160.1 Y110.1
+G1 Y124.9 E245.2136
+G1 X174.9 E245.8289
+G1 Y110.1 E246.4442
+G1 X160.1 E247.0595
+G1 E246.46 F1800
+G1 X74.54 Y110.46 F9000
+G1 E247.06 F1800
+G1 X60.46 E247.6449 F3600
+G1 Y124.54 E248.2303
+G1 X74.54 E248.8157
+G1 Y110.46 E249.4011
+G1 X74.9 Y110.1
+G1 X60.1 E250.0164
+G1 Y124.9 E250.6317
+G1 X74.9 E251.247
+G1 Y110.1 E251.8623
+;layer #7
+G1 E251.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E251.86 F1800
+G1 Z1.75 F300
+G1 X60.46 E252.4477 F3600
+G1 Y124.54 E253.0331
+G1 X74.54 E253.6184
+G1 Y110.46 E254.2038
+G1 X74.9 Y110.1
+G1 X60.1 E254.8191
+G1 Y124.9 E255.4344
+G1 X74.9 E256.0498
+G1 Y110.1 E256.6651
+G1 E256.07 F1800
+G1 X160.46 Y110.46 F9000
+G1 E256.67 F1800
+G1 Y124.54 E257.2504 F3600
+G1 X174.54 E257.8358
+G1 Y110.46 E258.4212
+G1 X160.46 E259.0066
+G1 X160.1 Y110.1
+G1 Y124.9 E259.6219
+G1 X174.9 E260.2372
+G1 Y110.1 E260.8525
+G1 X160.1 E261.4678
+;layer #8
+G1 E260.87 F1800
+G1 X160.46 Y110.46 F9000
+G1 E261.47 F1800
+G1 Z2 F300
+G1 Y124.54 E262.0532 F3600
+G1 X174.54 E262.6386
+G1 Y110.46 E263.224
+G1 X160.46 E263.8093
+G1 X160.1 Y110.1
+G1 Y124.9 E264.4247
+G1 X174.9 E265.04
+G1 Y110.1 E265.6553
+G1 X160.1 E266.2706
+G1 E265.67 F1800
+G1 X74.54 Y110.46 F9000
+G1 E266.27 F1800
+G1 X60.46 E266.856 F3600
+G1 Y124.54 E267.4414
+G1 X74.54 E268.0267
+G1 Y110.46 E268.6121
+G1 X74.9 Y110.1
+G1 X60.1 E269.2274
+G1 Y124.9 E269.8427
+G1 X74.9 E270.458
+G1 Y110.1 E271.0734
+;layer #9
+G1 E270.47 F1800
+G1 X74.54 Y110.46 F9000
+G1 E271.07 F1800
+G1 Z2.25 F300
+G1 X60.46 E271.6587 F3600
+G1 Y124.54 E272.2441
+G1 X74.54 E272.8295
+G1 Y110.46 E273.4149
+G1 X74.9 Y110.1
+G1 X60.1 E274.0302
+G1 Y124.9 E274.6455
+G1 X74.9 E275.2608
+G1 Y110.1 E275.8761
+G1 E275.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E275.88 F1800
+G1 Y124.54 E276.4615 F3600
+G1 X174.54 E277.0469
+G1 Y110.46 E277.6323
+G1 X160.46 E278.2176
+G1 X160.1 Y110.1
+G1 Y124.9 E278.8329
+G1 X174.9 E279.4483
+G1 Y110.1 E280.0636
+G1 X160.1 E280.6789
+;layer #10
+G1 E280.08 F1800
+G1 X160.46 Y110.46 F9000
+G1 E280.68 F1800
+G1 Z2.5 F300
+G1 Y124.54 E281.2643 F3600
+G1 X174.54 E281.8496
+G1 Y110.46 E282.435
+G1 X160.46 E283.0204
+G1 X160.1 Y110.1
+G1 Y124.9 E283.6357
+G1 X174.9 E284.251
+G1 Y110.1 E284.8663
+G1 X160.1 E285.4816
+G1 E284.88 F1800
+G1 X74.54 Y110.46 F9000
+G1 E285.48 F1800
+G1 X60.46 E286.067 F3600
+G1 Y124.54 E286.6524
+G1 X74.54 E287.2378
+G1 Y110.46 E287.8232
+G1 X74.9 Y110.1
+G1 X60.1 E288.4385
+G1 Y124.9 E289.0538
+G1 X74.9 E289.6691
+G1 Y110.1 E290.2844
+;layer #11
+G1 E289.68 F1800
+G1 X74.54 Y110.46 F9000
+G1 E290.28 F1800
+G1 Z2.75 F300
+G1 X60.46 E290.8698 F3600
+G1 Y124.54 E291.4552
+G1 X74.54 E292.0405
+G1 Y110.46 E292.6259
+G1 X74.9 Y110.1
+G1 X60.1 E293.2412
+G1 Y124.9 E293.8566
+G1 X74.9 E294.4719
+G1 Y110.1 E295.0872
+G1 E294.49 F1800
+G1 X160.46 Y110.46 F9000
+G1 E295.09 F1800
+G1 Y124.54 E295.6726 F3600
+G1 X174.54 E296.2579
+G1 Y110.46 E296.8433
+G1 X160.46 E297.4287
+G1 X160.1 Y110.1
+G1 Y124.9 E298.044
+G1 X174.9 E298.6593
+G1 Y110.1 E299.2746
+G1 X160.1 E299.8899
+;layer #12
+G1 E299.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E299.89 F1800
+G1 Z3 F300
+G1 Y124.54 E300.4753 F3600
+G1 X174.54 E301.0607
+G1 Y110.46 E301.6461
+G1 X160.46 E302.2315
+G1 X160.1 Y110.1
+G1 Y124.9 E302.8468
+G1 X174.9 E303.4621
+G1 Y110.1 E304.0774
+G1 X160.1 E304.6927
+G1 E304.09 F1800
+G1 X74.54 Y110.46 F9000
+G1 E304.69 F1800
+G1 X60.46 E305.2781 F3600
+G1 Y124.54 E305.8635
+G1 X74.54 E306.4488
+G1 Y110.46 E307.0342
+G1 X74.9 Y110.1
+G1 X60.1 E307.6495
+G1 Y124.9 E308.2648
+G1 X74.9 E308.8802
+G1 Y110.1 E309.4955
+;layer #13
+SET_PRESSURE_ADVANCE ADVANCE=0.04
+G1 E308.9 F1800
+G1 X74.64 Y110.36 F9000
+G1 E309.5 F1800
+G1 Z3.25 F300
+G1 X60.36 E310.0892 F3600
+G1 Y124.64 E310.6829
+G1 X74.64 E311.2765
+G1 Y110.36 E311.8702
+G1 X75 Y110
+G1 X60 E312.4939
+G1 Y125 E313.1175
+G1 X75 E313.7411
+G1 Y110 E314.3648
+G1 E313.76 F1800
+G1 X160.36 Y110.36 F9000
+G1 E314.36 F1800
+G1 Y124.64 E314.9584 F3600
+G1 X174.64 E315.5521
+G1 Y110.36 E316.1458
+G1 X160.36 E316.7395
+G1 X160 Y110
+G1 Y125 E317.3632
+G1 X175 E317.9868
+G1 Y110 E318.6104
+G1 X160 E319.234
+;layer #14
+G1 E318.63 F1800
+G1 X160.46 Y110.46 F9000
+G1 E319.23 F1800
+G1 Z3.5 F300
+G1 Y124.54 E319.8194 F3600
+G1 X174.54 E320.4048
+G1 Y110.46 E320.9902
+G1 X160.46 E321.5755
+G1 X160.1 Y110.1
+G1 Y124.9 E322.1909
+G1 X174.9 E322.8062
+G1 Y110.1 E323.4215
+G1 X160.1 E324.0368
+G1 E323.44 F1800
+G1 X74.54 Y110.46 F9000
+G1 E324.04 F1800
+G1 X60.46 E324.6222 F3600
+G1 Y124.54 E325.2076
+G1 X74.54 E325.7929
+G1 Y110.46 E326.3783
+G1 X74.9 Y110.1
+G1 X60.1 E326.9936
+G1 Y124.9 E327.6089
+G1 X74.9 E328.2242
+G1 Y110.1 E328.8396
+;layer #15
+G1 E328.24 F1800
+G1 X74.54 Y110.46 F9000
+G1 E328.84 F1800
+G1 Z3.75 F300
+G1 X60.46 E329.4249 F3600
+G1 Y124.54 E330.0103
+G1 X74.54 E330.5957
+G1 Y110.46 E331.1811
+G1 X74.9 Y110.1
+G1 X60.1 E331.7964
+G1 Y124.9 E332.4117
+G1 X74.9 E333.027
+G1 Y110.1 E333.6423
+G1 E333.04 F1800
+G1 X160.46 Y110.46 F9000
+G1 E333.64 F1800
+G1 Y124.54 E334.2277 F3600
+G1 X174.54 E334.8131
+G1 Y110.46 E335.3985
+G1 X160.46 E335.9838
+G1 X160.1 Y110.1
+G1 Y124.9 E336.5992
+G1 X174.9 E337.2145
+G1 Y110.1 E337.8298
+G1 X160.1 E338.4451
+;layer #16
+G1 E337.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E338.45 F1800
+G1 Z4 F300
+G1 Y124.54 E339.0305 F3600
+G1 X174.54 E339.6158
+G1 Y110.46 E340.2012
+G1 X160.46 E340.7866
+G1 X160.1 Y110.1
+G1 Y124.9 E341.4019
+G1 X174.9 E342.0172
+G1 Y110.1 E342.6325
+G1 X160.1 E343.2479
+G1 E342.65 F1800
+G1 X74.54 Y110.46 F9000
+G1 E343.25 F1800
+G1 X60.46 E343.8332 F3600
+G1 Y124.54 E344.4186
+G1 X74.54 E345.004
+G1 Y110.46 E345.5894
+G1 X74.9 Y110.1
+G1 X60.1 E346.2047
+G1 Y124.9 E346.82
+G1 X74.9 E347.4353
+G1 Y110.1 E348.0506
+;layer #17
+G1 E347.45 F1800
+G1 X74.54 Y110.46 F9000
+G1 E348.05 F1800
+G1 Z4.25 F300
+G1 X60.46 E348.636 F3600
+G1 Y124.54 E349.2214
+G1 X74.54 E349.8068
+G1 Y110.46 E350.3921
+G1 X74.9 Y110.1
+G1 X60.1 E351.0074
+G1 Y124.9 E351.6228
+G1 X74.9 E352.2381
+G1 Y110.1 E352.8534
+G1 E352.25 F1800
+G1 X160.46 Y110.46 F9000
+G1 E352.85 F1800
+G1 Y124.54 E353.4388 F3600
+G1 X174.54 E354.0241
+G1 Y110.46 E354.6095
+G1 X160.46 E355.1949
+G1 X160.1 Y110.1
+G1 Y124.9 E355.8102
+G1 X174.9 E356.4255
+G1 Y110.1 E357.0408
+G1 X160.1 E357.6561
+;layer #18
+G1 E357.06 F1800
+G1 X160.46 Y110.46 F9000
+G1 E357.66 F1800
+G1 Z4.5 F300
+G1 Y124.54 E358.2415 F3600
+G1 X174.54 E358.8269
+G1 Y110.46 E359.4123
+G1 X160.46 E359.9977
+G1 X160.1 Y110.1
+G1 Y124.9 E360.613
+G1 X174.9 E361.2283
+G1 Y110.1 E361.8436
+G1 X160.1 E362.4589
+G1 E361.86 F1800
+G1 X74.54 Y110.46 F9000
+G1 E362.46 F1800
+G1 X60.46 E363.0443 F3600
+G1 Y124.54 E363.6297
+G1 X74.54 E364.215
+G1 Y110.46 E364.8004
+G1 X74.9 Y110.1
+G1 X60.1 E365.4157
+G1 Y124.9 E366.031
+G1 X74.9 E366.6464
+G1 Y110.1 E367.2617
+;layer #19
+G1 E366.66 F1800
+G1 X74.54 Y110.46 F9000
+G1 E367.26 F1800
+G1 Z4.75 F300
+G1 X60.46 E367.847 F3600
+G1 Y124.54 E368.4324
+G1 X74.54 E369.0178
+G1 Y110.46 E369.6032
+G1 X74.9 Y110.1
+G1 X60.1 E370.2185
+G1 Y124.9 E370.8338
+G1 X74.9 E371.4491
+G1 Y110.1 E372.0644
+G1 E371.46 F1800
+G1 X160.46 Y110.46 F9000
+G1 E372.06 F1800
+G1 Y124.54 E372.6498 F3600
+G1 X174.54 E373.2352
+G1 Y110.46 E373.8206
+G1 X160.46 E374.4059
+G1 X160.1 Y110.1
+G1 Y124.9 E375.0213
+G1 X174.9 E375.6366
+G1 Y110.1 E376.2519
+G1 X160.1 E376.8672
+;layer #20
+G1 E376.27 F1800
+G1 X160.46 Y110.46 F9000
+G1 E376.87 F1800
+G1 Z5 F300
+G1 Y124.54 E377.4526 F3600
+G1 X174.54 E378.038
+G1 Y110.46 E378.6233
+G1 X160.46 E379.2087
+G1 X160.1 Y110.1
+G1 Y124.9 E379.824
+G1 X174.9 E380.4393
+G1 Y110.1 E381.0546
+G1 X160.1 E381.67
+G1 E381.07 F1800
+G1 X74.54 Y110.46 F9000
+G1 E381.67 F1800
+G1 X60.46 E382.2553 F3600
+G1 Y124.54 E382.8407
+G1 X74.54 E383.4261
+G1 Y110.46 E384.0115
+G1 X74.9 Y110.1
+G1 X60.1 E384.6268
+G1 Y124.9 E385.2421
+G1 X74.9 E385.8574
+G1 Y110.1 E386.4727
+;layer #21
+G1 E385.87 F1800
+G1 X74.54 Y110.46 F9000
+G1 E386.47 F1800
+G1 Z5.25 F300
+G1 X60.46 E387.0581 F3600
+G1 Y124.54 E387.6435
+G1 X74.54 E388.2289
+G1 Y110.46 E388.8142
+G1 X74.9 Y110.1
+G1 X60.1 E389.4295
+G1 Y124.9 E390.0449
+G1 X74.9 E390.6602
+G1 Y110.1 E391.2755
+G1 E390.68 F1800
+G1 X160.46 Y110.46 F9000
+G1 E391.28 F1800
+G1 Y124.54 E391.8609 F3600
+G1 X174.54 E392.4462
+G1 Y110.46 E393.0316
+G1 X160.46 E393.617
+G1 X160.1 Y110.1
+G1 Y124.9 E394.2323
+G1 X174.9 E394.8476
+G1 Y110.1 E395.4629
+G1 X160.1 E396.0783
+;layer #22
+G1 E395.48 F1800
+G1 X160.46 Y110.46 F9000
+G1 E396.08 F1800
+G1 Z5.5 F300
+G1 Y124.54 E396.6636 F3600
+G1 X174.54 E397.249
+G1 Y110.46 E397.8344
+G1 X160.46 E398.4198
+G1 X160.1 Y110.1
+G1 Y124.9 E399.0351
+G1 X174.9 E399.6504
+G1 Y110.1 E400.2657
+G1 X160.1 E400.881
+G1 E400.28 F1800
+G1 X74.54 Y110.46 F9000
+G1 E400.88 F1800
+G1 X60.46 E401.4664 F3600
+G1 Y124.54 E402.0518
+G1 X74.54 E402.6371
+G1 Y110.46 E403.2225
+G1 X74.9 Y110.1
+G1 X60.1 E403.8378
+G1 Y124.9 E404.4532
+G1 X74.9 E405.0685
+G1 Y110.1 E405.6838
+;layer #23
+G1 E405.08 F1800
+G1 X74.54 Y110.46 F9000
+G1 E405.68 F1800
+G1 Z5.75 F300
+G1 X60.46 E406.2692 F3600
+G1 Y124.54 E406.8545
+G1 X74.54 E407.4399
+G1 Y110.46 E408.0253
+G1 X74.9 Y110.1
+G1 X60.1 E408.6406
+G1 Y124.9 E409.2559
+G1 X74.9 E409.8712
+G1 Y110.1 E410.4865
+G1 E409.89 F1800
+G1 X160.46 Y110.46 F9000
+G1 E410.49 F1800
+G1 Y124.54 E411.0719 F3600
+G1 X174.54 E411.6573
+G1 Y110.46 E412.2427
+G1 X160.46 E412.8281
+G1 X160.1 Y110.1
+G1 Y124.9 E413.4434
+G1 X174.9 E414.0587
+G1 Y110.1 E414.674
+G1 X160.1 E415.2893
+;layer #24
+G1 E414.69 F1800
+G1 X160.46 Y110.46 F9000
+G1 E415.29 F1800
+G1 Z6 F300
+G1 Y124.54 E415.8747 F3600
+G1 X174.54 E416.4601
+G1 Y110.46 E417.0454
+G1 X160.46 E417.6308
+G1 X160.1 Y110.1
+G1 Y124.9 E418.2461
+G1 X174.9 E418.8614
+G1 Y110.1 E419.4768
+G1 X160.1 E420.0921
+G1 E419.49 F1800
+G1 X74.54 Y110.46 F9000
+G1 E420.09 F1800
+G1 X60.46 E420.6774 F3600
+G1 Y124.54 E421.2628
+G1 X74.54 E421.8482
+G1 Y110.46 E422.4336
+G1 X74.9 Y110.1
+G1 X60.1 E423.0489
+G1 Y124.9 E423.6642
+G1 X74.9 E424.2795
+G1 Y110.1 E424.8948
+;layer #25
+SET_PRESSURE_ADVANCE ADVANCE=0.06
+G1 E424.29 F1800
+G1 X74.64 Y110.36 F9000
+G1 E424.89 F1800
+G1 Z6.25 F300
+G1 X60.36 E425.4885 F3600
+G1 Y124.64 E426.0822
+G1 X74.64 E426.6759
+G1 Y110.36 E427.2696
+G1 X75 Y110
+G1 X60 E427.8932
+G1 Y125 E428.5169
+G1 X75 E429.1405
+G1 Y110 E429.7641
+G1 E429.16 F1800
+G1 X160.36 Y110.36 F9000
+G1 E429.76 F1800
+G1 Y124.64 E430.3578 F3600
+G1 X174.64 E430.9515
+G1 Y110.36 E431.5452
+G1 X160.36 E432.1389
+G1 X160 Y110
+G1 Y125 E432.7625
+G1 X175 E433.3861
+G1 Y110 E434.0098
+G1 X160 E434.6334
+;layer #26
+G1 E434.03 F1800
+G1 X160.46 Y110.46 F9000
+G1 E434.63 F1800
+G1 Z6.5 F300
+G1 Y124.54 E435.2188 F3600
+G1 X174.54 E435.8042
+G1 Y110.46 E436.3895
+G1 X160.46 E436.9749
+G1 X160.1 Y110.1
+G1 Y124.9 E437.5902
+G1 X174.9 E438.2055
+G1 Y110.1 E438.8209
+G1 X160.1 E439.4362
+G1 E438.84 F1800
+G1 X74.54 Y110.46 F9000
+G1 E439.44 F1800
+G1 X60.46 E440.0215 F3600
+G1 Y124.54 E440.6069
+G1 X74.54 E441.1923
+G1 Y110.46 E441.7777
+G1 X74.9 Y110.1
+G1 X60.1 E442.393
+G1 Y124.9 E443.0083
+G1 X74.9 E443.6236
+G1 Y110.1 E444.2389
+;layer #27
+G1 E443.64 F1800
+G1 X74.54 Y110.46 F9000
+G1 E444.24 F1800
+G1 Z6.75 F300
+G1 X60.46 E444.8243 F3600
+G1 Y124.54 E445.4097
+G1 X74.54 E445.9951
+G1 Y110.46 E446.5804
+G1 X74.9 Y110.1
+G1 X60.1 E447.1958
+G1 Y124.9 E447.8111
+G1 X74.9 E448.4264
+G1 Y110.1 E449.0417
+G1 E448.44 F1800
+G1 X160.46 Y110.46 F9000
+G1 E449.04 F1800
+G1 Y124.54 E449.6271 F3600
+G1 X174.54 E450.2124
+G1 Y110.46 E450.7978
+G1 X160.46 E451.3832
+G1 X160.1 Y110.1
+G1 Y124.9 E451.9985
+G1 X174.9 E452.6138
+G1 Y110.1 E453.2291
+G1 X160.1 E453.8445
+;layer #28
+G1 E453.24 F1800
+G1 X160.46 Y110.46 F9000
+G1 E453.84 F1800
+G1 Z7 F300
+G1 Y124.54 E454.4298 F3600
+G1 X174.54 E455.0152
+G1 Y110.46 E455.6006
+G1 X160.46 E456.186
+G1 X160.1 Y110.1
+G1 Y124.9 E456.8013
+G1 X174.9 E457.4166
+G1 Y110.1 E458.0319
+G1 X160.1 E458.6472
+G1 E458.05 F1800
+G1 X74.54 Y110.46 F9000
+G1 E458.65 F1800
+G1 X60.46 E459.2326 F3600
+G1 Y124.54 E459.818
+G1 X74.54 E460.4034
+G1 Y110.46 E460.9887
+G1 X74.9 Y110.1
+G1 X60.1 E461.604
+G1 Y124.9 E462.2194
+G1 X74.9 E462.8347
+G1 Y110.1 E463.45
+;layer #29
+G1 E462.85 F1800
+G1 X74.54 Y110.46 F9000
+G1 E463.45 F1800
+G1 Z7.25 F300
+G1 X60.46 E464.0354 F3600
+G1 Y124.54 E464.6207
+G1 X74.54 E465.2061
+G1 Y110.46 E465.7915
+G1 X74.9 Y110.1
+G1 X60.1 E466.4068
+G1 Y124.9 E467.0221
+G1 X74.9 E467.6374
+G1 Y110.1 E468.2527
+G1 E467.65 F1800
+G1 X160.46 Y110.46 F9000
+G1 E468.25 F1800
+G1 Y124.54 E468.8381 F3600
+G1 X174.54 E469.4235
+G1 Y110.46 E470.0089
+G1 X160.46 E470.5943
+G1 X160.1 Y110.1
+G1 Y124.9 E471.2096
+G1 X174.9 E471.8249
+G1 Y110.1 E472.4402
+G1 X160.1 E473.0555
+;layer #30
+G1 E472.46 F1800
+G1 X160.46 Y110.46 F9000
+G1 E473.06 F1800
+G1 Z7.5 F300
+G1 Y124.54 E473.6409 F3600
+G1 X174.54 E474.2263
+G1 Y110.46 E474.8116
+G1 X160.46 E475.397
+G1 X160.1 Y110.1
+G1 Y124.9 E476.0123
+G1 X174.9 E476.6276
+G1 Y110.1 E477.243
+G1 X160.1 E477.8583
+G1 E477.26 F1800
+G1 X74.54 Y110.46 F9000
+G1 E477.86 F1800
+G1 X60.46 E478.4436 F3600
+G1 Y124.54 E479.029
+G1 X74.54 E479.6144
+G1 Y110.46 E480.1998
+G1 X74.9 Y110.1
+G1 X60.1 E480.8151
+G1 Y124.9 E481.4304
+G1 X74.9 E482.0457
+G1 Y110.1 E482.661
+;layer #31
+G1 E482.06 F1800
+G1 X74.54 Y110.46 F9000
+G1 E482.66 F1800
+G1 Z7.75 F300
+G1 X60.46 E483.2464 F3600
+G1 Y124.54 E483.8318
+G1 X74.54 E484.4172
+G1 Y110.46 E485.0025
+G1 X74.9 Y110.1
+G1 X60.1 E485.6179
+G1 Y124.9 E486.2332
+G1 X74.9 E486.8485
+G1 Y110.1 E487.4638
+G1 E486.86 F1800
+G1 X160.46 Y110.46 F9000
+G1 E487.46 F1800
+G1 Y124.54 E488.0492 F3600
+G1 X174.54 E488.6346
+G1 Y110.46 E489.2199
+G1 X160.46 E489.8053
+G1 X160.1 Y110.1
+G1 Y124.9 E490.4206
+G1 X174.9 E491.0359
+G1 Y110.1 E491.6512
+G1 X160.1 E492.2666
+;layer #32
+G1 E491.67 F1800
+G1 X160.46 Y110.46 F9000
+G1 E492.27 F1800
+G1 Z8 F300
+G1 Y124.54 E492.8519 F3600
+G1 X174.54 E493.4373
+G1 Y110.46 E494.0227
+G1 X160.46 E494.6081
+G1 X160.1 Y110.1
+G1 Y124.9 E495.2234
+G1 X174.9 E495.8387
+G1 Y110.1 E496.454
+G1 X160.1 E497.0693
+G1 E496.47 F1800
+G1 X74.54 Y110.46 F9000
+G1 E497.07 F1800
+G1 X60.46 E497.6547 F3600
+G1 Y124.54 E498.2401
+G1 X74.54 E498.8255
+G1 Y110.46 E499.4108
+G1 X74.9 Y110.1
+G1 X60.1 E500.0262
+G1 Y124.9 E500.6415
+G1 X74.9 E501.2568
+G1 Y110.1 E501.8721
+;layer #33
+G1 E501.27 F1800
+G1 X74.54 Y110.46 F9000
+G1 E501.87 F1800
+G1 Z8.25 F300
+G1 X60.46 E502.4575 F3600
+G1 Y124.54 E503.0428
+G1 X74.54 E503.6282
+G1 Y110.46 E504.2136
+G1 X74.9 Y110.1
+G1 X60.1 E504.8289
+G1 Y124.9 E505.4442
+G1 X74.9 E506.0595
+G1 Y110.1 E506.6749
+G1 E506.07 F1800
+G1 X160.46 Y110.46 F9000
+G1 E506.67 F1800
+G1 Y124.54 E507.2602 F3600
+G1 X174.54 E507.8456
+G1 Y110.46 E508.431
+G1 X160.46 E509.0164
+G1 X160.1 Y110.1
+G1 Y124.9 E509.6317
+G1 X174.9 E510.247
+G1 Y110.1 E510.8623
+G1 X160.1 E511.4776
+;layer #34
+G1 E510.88 F1800
+G1 X160.46 Y110.46 F9000
+G1 E511.48 F1800
+G1 Z8.5 F300
+G1 Y124.54 E512.063 F3600
+G1 X174.54 E512.6484
+G1 Y110.46 E513.2338
+G1 X160.46 E513.8191
+G1 X160.1 Y110.1
+G1 Y124.9 E514.4344
+G1 X174.9 E515.0498
+G1 Y110.1 E515.6651
+G1 X160.1 E516.2804
+G1 E515.68 F1800
+G1 X74.54 Y110.46 F9000
+G1 E516.28 F1800
+G1 X60.46 E516.8658 F3600
+G1 Y124.54 E517.4511
+G1 X74.54 E518.0365
+G1 Y110.46 E518.6219
+G1 X74.9 Y110.1
+G1 X60.1 E519.2372
+G1 Y124.9 E519.8525
+G1 X74.9 E520.4678
+G1 Y110.1 E521.0831
+;layer #35
+G1 E520.48 F1800
+G1 X74.54 Y110.46 F9000
+G1 E521.08 F1800
+G1 Z8.75 F300
+G1 X60.46 E521.6685 F3600
+G1 Y124.54 E522.2539
+G1 X74.54 E522.8393
+G1 Y110.46 E523.4247
+G1 X74.9 Y110.1
+G1 X60.1 E524.04
+G1 Y124.9 E524.6553
+G1 X74.9 E525.2706
+G1 Y110.1 E525.8859
+G1 E525.29 F1800
+G1 X160.46 Y110.46 F9000
+G1 E525.89 F1800
+G1 Y124.54 E526.4713 F3600
+G1 X174.54 E527.0567
+G1 Y110.46 E527.642
+G1 X160.46 E528.2274
+G1 X160.1 Y110.1
+G1 Y124.9 E528.8427
+G1 X174.9 E529.458
+G1 Y110.1 E530.0734
+G1 X160.1 E530.6887
+;layer #36
+G1 E530.09 F1800
+G1 X160.46 Y110.46 F9000
+G1 E530.69 F1800
+G1 Z9 F300
+G1 Y124.54 E531.274 F3600
+G1 X174.54 E531.8594
+G1 Y110.46 E532.4448
+G1 X160.46 E533.0302
+G1 X160.1 Y110.1
+G1 Y124.9 E533.6455
+G1 X174.9 E534.2608
+G1 Y110.1 E534.8761
+G1 X160.1 E535.4914
+G1 E534.89 F1800
+G1 X74.54 Y110.46 F9000
+G1 E535.49 F1800
+G1 X60.46 E536.0768 F3600
+G1 Y124.54 E536.6622
+G1 X74.54 E537.2476
+G1 Y110.46 E537.8329
+G1 X74.9 Y110.1
+G1 X60.1 E538.4483
+G1 Y124.9 E539.0636
+G1 X74.9 E539.6789
+G1 Y110.1 E540.2942
+;layer #37
+SET_PRESSURE_ADVANCE ADVANCE=0.08
+G1 E539.69 F1800
+G1 X74.64 Y110.36 F9000
+G1 E540.29 F1800
+G1 Z9.25 F300
+G1 X60.36 E540.8879 F3600
+G1 Y124.64 E541.4816
+G1 X74.64 E542.0753
+G1 Y110.36 E542.669
+G1 X75 Y110
+G1 X60 E543.2926
+G1 Y125 E543.9162
+G1 X75 E544.5399
+G1 Y110 E545.1635
+G1 E544.56 F1800
+G1 X160.36 Y110.36 F9000
+G1 E545.16 F1800
+G1 Y124.64 E545.7572 F3600
+G1 X174.64 E546.3509
+G1 Y110.36 E546.9446
+G1 X160.36 E547.5383
+G1 X160 Y110
+G1 Y125 E548.1619
+G1 X175 E548.7855
+G1 Y110 E549.4091
+G1 X160 E550.0328
+;layer #38
+G1 E549.43 F1800
+G1 X160.46 Y110.46 F9000
+G1 E550.03 F1800
+G1 Z9.5 F300
+G1 Y124.54 E550.6181 F3600
+G1 X174.54 E551.2035
+G1 Y110.46 E551.7889
+G1 X160.46 E552.3743
+G1 X160.1 Y110.1
+G1 Y124.9 E552.9896
+G1 X174.9 E553.6049
+G1 Y110.1 E554.2202
+G1 X160.1 E554.8355
+G1 E554.24 F1800
+G1 X74.54 Y110.46 F9000
+G1 E554.84 F1800
+G1 X60.46 E555.4209 F3600
+G1 Y124.54 E556.0063
+G1 X74.54 E556.5917
+G1 Y110.46 E557.177
+G1 X74.9 Y110.1
+G1 X60.1 E557.7924
+G1 Y124.9 E558.4077
+G1 X74.9 E559.023
+G1 Y110.1 E559.6383
+;layer #39
+G1 E559.04 F1800
+G1 X74.54 Y110.46 F9000
+G1 E559.64 F1800
+G1 Z9.75 F300
+G1 X60.46 E560.2237 F3600
+G1 Y124.54 E560.809
+G1 X74.54 E561.3944
+G1 Y110.46 E561.9798
+G1 X74.9 Y110.1
+G1 X60.1 E562.5951
+G1 Y124.9 E563.2104
+G1 X74.9 E563.8257
+G1 Y110.1 E564.4411
+G1 E563.84 F1800
+G1 X160.46 Y110.46 F9000
+G1 E564.44 F1800
+G1 Y124.54 E565.0264 F3600
+G1 X174.54 E565.6118
+G1 Y110.46 E566.1972
+G1 X160.46 E566.7826
+G1 X160.1 Y110.1
+G1 Y124.9 E567.3979
+G1 X174.9 E568.0132
+G1 Y110.1 E568.6285
+G1 X160.1 E569.2438
+;layer #40
+G1 E568.64 F1800
+G1 X160.46 Y110.46 F9000
+G1 E569.24 F1800
+G1 Z10 F300
+G1 Y124.54 E569.8292 F3600
+G1 X174.54 E570.4146
+G1 Y110.46 E571
+G1 X160.46 E571.5853
+G1 X160.1 Y110.1
+G1 Y124.9 E572.2006
+G1 X174.9 E572.816
+G1 Y110.1 E573.4313
+G1 X160.1 E574.0466
+G1 E573.45 F1800
+G1 X74.54 Y110.46 F9000
+G1 E574.05 F1800
+G1 X60.46 E574.632 F3600
+G1 Y124.54 E575.2173
+G1 X74.54 E575.8027
+G1 Y110.46 E576.3881
+G1 X74.9 Y110.1
+G1 X60.1 E577.0034
+G1 Y124.9 E577.6187
+G1 X74.9 E578.234
+G1 Y110.1 E578.8493
+;layer #41
+G1 E578.25 F1800
+G1 X74.54 Y110.46 F9000
+G1 E578.85 F1800
+G1 Z10.25 F300
+G1 X60.46 E579.4347 F3600
+G1 Y124.54 E580.0201
+G1 X74.54 E580.6055
+G1 Y110.46 E581.1909
+G1 X74.9 Y110.1
+G1 X60.1 E581.8062
+G1 Y124.9 E582.4215
+G1 X74.9 E583.0368
+G1 Y110.1 E583.6521
+G1 E583.05 F1800
+G1 X160.46 Y110.46 F9000
+G1 E583.65 F1800
+G1 Y124.54 E584.2375 F3600
+G1 X174.54 E584.8229
+G1 Y110.46 E585.4082
+G1 X160.46 E585.9936
+G1 X160.1 Y110.1
+G1 Y124.9 E586.6089
+G1 X174.9 E587.2242
+G1 Y110.1 E587.8396
+G1 X160.1 E588.4549
+;layer #42
+G1 E587.85 F1800
+G1 X160.46 Y110.46 F9000
+G1 E588.45 F1800
+G1 Z10.5 F300
+G1 Y124.54 E589.0403 F3600
+G1 X174.54 E589.6256
+G1 Y110.46 E590.211
+G1 X160.46 E590.7964
+G1 X160.1 Y110.1
+G1 Y124.9 E591.4117
+G1 X174.9 E592.027
+G1 Y110.1 E592.6423
+G1 X160.1 E593.2576
+G1 E592.66 F1800
+G1 X74.54 Y110.46 F9000
+G1 E593.26 F1800
+G1 X60.46 E593.843 F3600
+G1 Y124.54 E594.4284
+G1 X74.54 E595.0138
+G1 Y110.46 E595.5991
+G1 X74.9 Y110.1
+G1 X60.1 E596.2145
+G1 Y124.9 E596.8298
+G1 X74.9 E597.4451
+G1 Y110.1 E598.0604
+;layer #43
+G1 E597.46 F1800
+G1 X74.54 Y110.46 F9000
+G1 E598.06 F1800
+G1 Z10.75 F300
+G1 X60.46 E598.6458 F3600
+G1 Y124.54 E599.2312
+G1 X74.54 E599.8165
+G1 Y110.46 E600.4019
+G1 X74.9 Y110.1
+G1 X60.1 E601.0172
+G1 Y124.9 E601.6325
+G1 X74.9 E602.2478
+G1 Y110.1 E602.8632
+G1 E602.26 F1800
+G1 X160.46 Y110.46 F9000
+G1 E602.86 F1800
+G1 Y124.54 E603.4485 F3600
+G1 X174.54 E604.0339
+G1 Y110.46 E604.6193
+G1 X160.46 E605.2047
+G1 X160.1 Y110.1
+G1 Y124.9 E605.82
+G1 X174.9 E606.4353
+G1 Y110.1 E607.0506
+G1 X160.1 E607.6659
+;layer #44
+G1 E607.07 F1800
+G1 X160.46 Y110.46 F9000
+G1 E607.67 F1800
+G1 Z11 F300
+G1 Y124.54 E608.2513 F3600
+G1 X174.54 E608.8367
+G1 Y110.46 E609.4221
+G1 X160.46 E610.0074
+G1 X160.1 Y110.1
+G1 Y124.9 E610.6228
+G1 X174.9 E611.2381
+G1 Y110.1 E611.8534
+G1 X160.1 E612.4687
+G1 E611.87 F1800
+G1 X74.54 Y110.46 F9000
+G1 E612.47 F1800
+G1 X60.46 E613.0541 F3600
+G1 Y124.54 E613.6394
+G1 X74.54 E614.2248
+G1 Y110.46 E614.8102
+G1 X74.9 Y110.1
+G1 X60.1 E615.4255
+G1 Y124.9 E616.0408
+G1 X74.9 E616.6561
+G1 Y110.1 E617.2715
+;layer #45
+G1 E616.67 F1800
+G1 X74.54 Y110.46 F9000
+G1 E617.27 F1800
+G1 Z11.25 F300
+G1 X60.46 E617.8568 F3600
+G1 Y124.54 E618.4422
+G1 X74.54 E619.0276
+G1 Y110.46 E619.613
+G1 X74.9 Y110.1
+G1 X60.1 E620.2283
+G1 Y124.9 E620.8436
+G1 X74.9 E621.4589
+G1 Y110.1 E622.0742
+G1 E621.47 F1800
+G1 X160.46 Y110.46 F9000
+G1 E622.07 F1800
+G1 Y124.54 E622.6596 F3600
+G1 X174.54 E623.245
+G1 Y110.46 E623.8304
+G1 X160.46 E624.4157
+G1 X160.1 Y110.1
+G1 Y124.9 E625.031
+G1 X174.9 E625.6464
+G1 Y110.1 E626.2617
+G1 X160.1 E626.877
+;layer #46
+G1 E626.28 F1800
+G1 X160.46 Y110.46 F9000
+G1 E626.88 F1800
+G1 Z11.5 F300
+G1 Y124.54 E627.4624 F3600
+G1 X174.54 E628.0477
+G1 Y110.46 E628.6331
+G1 X160.46 E629.2185
+G1 X160.1 Y110.1
+G1 Y124.9 E629.8338
+G1 X174.9 E630.4491
+G1 Y110.1 E631.0644
+G1 X160.1 E631.6797
+G1 E631.08 F1800
+G1 X74.54 Y110.46 F9000
+G1 E631.68 F1800
+G1 X60.46 E632.2651 F3600
+G1 Y124.54 E632.8505
+G1 X74.54 E633.4359
+G1 Y110.46 E634.0213
+G1 X74.9 Y110.1
+G1 X60.1 E634.6366
+G1 Y124.9 E635.2519
+G1 X74.9 E635.8672
+G1 Y110.1 E636.4825
+;layer #47
+G1 E635.88 F1800
+G1 X74.54 Y110.46 F9000
+G1 E636.48 F1800
+G1 Z11.75 F300
+G1 X60.46 E637.0679 F3600
+G1 Y124.54 E637.6533
+G1 X74.54 E638.2386
+G1 Y110.46 E638.824
+G1 X74.9 Y110.1
+G1 X60.1 E639.4393
+G1 Y124.9 E640.0546
+G1 X74.9 E640.67
+G1 Y110.1 E641.2853
+G1 E640.69 F1800
+G1 X160.46 Y110.46 F9000
+G1 E641.29 F1800
+G1 Y124.54 E641.8706 F3600
+G1 X174.54 E642.456
+G1 Y110.46 E643.0414
+G1 X160.46 E643.6268
+G1 X160.1 Y110.1
+G1 Y124.9 E644.2421
+G1 X174.9 E644.8574
+G1 Y110.1 E645.4727
+G1 X160.1 E646.088
+;layer #48
+G1 E645.49 F1800
+G1 X160.46 Y110.46 F9000
+G1 E646.09 F1800
+G1 Z12 F300
+G1 Y124.54 E646.6734 F3600
+G1 X174.54 E647.2588
+G1 Y110.46 E647.8442
+G1 X160.46 E648.4295
+G1 X160.1 Y110.1
+G1 Y124.9 E649.0449
+G1 X174.9 E649.6602
+G1 Y110.1 E650.2755
+G1 X160.1 E650.8908
+G1 E650.29 F1800
+G1 X74.54 Y110.46 F9000
+G1 E650.89 F1800
+G1 X60.46 E651.4762 F3600
+G1 Y124.54 E652.0616
+G1 X74.54 E652.6469
+G1 Y110.46 E653.2323
+G1 X74.9 Y110.1
+G1 X60.1 E653.8476
+G1 Y124.9 E654.4629
+G1 X74.9 E655.0782
+G1 Y110.1 E655.6936
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
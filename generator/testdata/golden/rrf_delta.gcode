@@ -0,0 +1,597 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 200:200 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 2
+;Z-offset: 0.05 [mm]
+;Delta: true
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 95
+;Fan: 49.8
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 80 [mm]
+;Hardmode: false
+;Segment 3:   0.2mm @ 30mm/s
+;Segment 2:   0.6mm @ 30mm/s
+;Segment 1:   1mm @ 30mm/s
+M572 D0 S0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S95 ;flow multiplier from settings
+M82
+M106 S42
+G1 Z0.3 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X-55 Y-25 F9000
+G1 E0 F1800
+G1 X55 E6.8599 F1800
+G1 Y-24.4 F1800
+G1 X-55 E13.7198 F1800
+G1 E12.72 F1800
+G1 X-54.7 Y14.7 F9000
+G1 E13.72 F1800
+G1 Y13.78 E13.7818 F1800
+G1 X-53.78 Y14.7 E13.8696 F1800
+G1 X-52.86 E13.9316 F1800
+G1 X-54.7 Y12.86 E14.1071 F1800
+G1 Y11.94 E14.1691 F1800
+G1 X-51.94 Y14.7 E14.4323 F1800
+G1 X-51.03 E14.4944 F1800
+G1 X-54.7 Y11.02 E14.8453 F1800
+G1 Y10.11 E14.9073 F1800
+G1 X-50.11 Y14.7 E15.346 F1800
+G1 X-49.19 E15.408 F1800
+G1 X-54.7 Y9.19 E15.9344 F1800
+G1 Y8.27 E15.9965 F1800
+G1 X-48.27 Y14.7 E16.6106 F1800
+G1 X-47.35 E16.6727 F1800
+G1 X-54.7 Y7.35 E17.3745 F1800
+G1 Y6.43 E17.4366 F1800
+G1 X-46.43 Y14.7 E18.2262 F1800
+G1 X-45.51 E18.2882 F1800
+G1 X-54.7 Y5.51 E19.1656 F1800
+G1 Y4.59 E19.2276 F1800
+G1 X-44.59 Y14.7 E20.1927 F1800
+G1 X-43.68 E20.2547 F1800
+G1 X-54.7 Y3.68 E21.3075 F1800
+G1 Y2.76 E21.3696 F1800
+G1 X-42.76 Y14.7 E22.5101 F1800
+G1 X-41.84 E22.5721 F1800
+G1 X-54.7 Y1.84 E23.8004 F1800
+G1 Y0.92 E23.8625 F1800
+G1 X-40.92 Y14.7 E25.1785 F1800
+G1 X-40 E25.2405 F1800
+G1 X-54.7 Y0 E26.6443 F1800
+G1 Y-0.92 E26.7063 F1800
+G1 X-39.08 Y14.7 E28.1978 F1800
+G1 X-38.16 E28.2598 F1800
+G1 X-54.7 Y-1.84 E29.839 F1800
+G1 Y-2.76 E29.9011 F1800
+G1 X-37.24 Y14.7 E31.568 F1800
+G1 X-36.33 E31.63 F1800
+G1 X-54.7 Y-3.68 E33.3847 F1800
+G1 Y-4.59 E33.4468 F1800
+G1 X-35.41 Y14.7 E35.2892 F1800
+G1 X-34.49 E35.3512 F1800
+G1 X-54.7 Y-5.51 E37.2814 F1800
+G1 Y-6.43 E37.3434 F1800
+G1 X-33.57 Y14.7 E39.3613 F1800
+G1 X-32.65 E39.4233 F1800
+G1 X-54.7 Y-7.35 E41.529 F1800
+G1 Y-8.27 E41.591 F1800
+G1 X-31.73 Y14.7 E43.7843 F1800
+G1 X-30.81 E43.8464 F1800
+G1 X-54.7 Y-9.19 E46.1275 F1800
+G1 Y-10.11 E46.1895 F1800
+G1 X-29.89 Y14.7 E48.5583 F1800
+G1 X-28.98 E48.6204 F1800
+G1 X-54.7 Y-11.02 E51.0769 F1800
+G1 Y-11.94 E51.139 F1800
+G1 X-28.06 Y14.7 E53.6833 F1800
+G1 X-27.14 E53.7453 F1800
+G1 X-54.7 Y-12.86 E56.3773 F1800
+G1 Y-13.78 E56.4394 F1800
+G1 X-26.22 Y14.7 E59.1591 F1800
+G1 X-25.3 E59.2211 F1800
+G1 X-54.7 Y-14.7 E62.0286 F1800
+G1 X-53.78 E62.0907 F1800
+G1 X-25.3 Y13.78 E64.8104 F1800
+G1 Y12.86 E64.8725 F1800
+G1 X-52.86 Y-14.7 E67.5045 F1800
+G1 X-51.94 E67.5665 F1800
+G1 X-25.3 Y11.94 E70.1108 F1800
+G1 Y11.02 E70.1729 F1800
+G1 X-51.03 Y-14.7 E72.6294 F1800
+G1 X-50.11 E72.6915 F1800
+G1 X-25.3 Y10.11 E75.0603 F1800
+G1 Y9.19 E75.1223 F1800
+G1 X-49.19 Y-14.7 E77.4034 F1800
+G1 X-48.27 E77.4654 F1800
+G1 X-25.3 Y8.27 E79.6588 F1800
+G1 Y7.35 E79.7208 F1800
+G1 X-47.35 Y-14.7 E81.8265 F1800
+G1 X-46.43 E81.8885 F1800
+G1 X-25.3 Y6.43 E83.9064 F1800
+G1 Y5.51 E83.9684 F1800
+G1 X-45.51 Y-14.7 E85.8986 F1800
+G1 X-44.59 E85.9606 F1800
+G1 X-25.3 Y4.59 E87.803 F1800
+G1 Y3.68 E87.8651 F1800
+G1 X-43.68 Y-14.7 E89.6197 F1800
+G1 X-42.76 E89.6818 F1800
+G1 X-25.3 Y2.76 E91.3487 F1800
+G1 Y1.84 E91.4108 F1800
+G1 X-41.84 Y-14.7 E92.99 F1800
+G1 X-40.92 E93.052 F1800
+G1 X-25.3 Y0.92 E94.5435 F1800
+G1 Y0 E94.6055 F1800
+G1 X-40 Y-14.7 E96.0093 F1800
+G1 X-39.08 E96.0713 F1800
+G1 X-25.3 Y-0.92 E97.3873 F1800
+G1 Y-1.84 E97.4494 F1800
+G1 X-38.16 Y-14.7 E98.6777 F1800
+G1 X-37.24 E98.7397 F1800
+G1 X-25.3 Y-2.76 E99.8802 F1800
+G1 Y-3.68 E99.9423 F1800
+G1 X-36.33 Y-14.7 E100.9951 F1800
+G1 X-35.41 E101.0571 F1800
+G1 X-25.3 Y-4.59 E102.0222 F1800
+G1 Y-5.51 E102.0842 F1800
+G1 X-34.49 Y-14.7 E102.9616 F1800
+G1 X-33.57 E103.0236 F1800
+G1 X-25.3 Y-6.43 E103.8132 F1800
+G1 Y-7.35 E103.8753 F1800
+G1 X-32.65 Y-14.7 E104.5771 F1800
+G1 X-31.73 E104.6392 F1800
+G1 X-25.3 Y-8.27 E105.2533 F1800
+G1 Y-9.19 E105.3153 F1800
+G1 X-30.81 Y-14.7 E105.8417 F1800
+G1 X-29.89 E105.9038 F1800
+G1 X-25.3 Y-10.11 E106.3425 F1800
+G1 Y-11.02 E106.4045 F1800
+G1 X-28.98 Y-14.7 E106.7554 F1800
+G1 X-28.06 E106.8175 F1800
+G1 X-25.3 Y-11.94 E107.0807 F1800
+G1 Y-12.86 E107.1427 F1800
+G1 X-27.14 Y-14.7 E107.3182 F1800
+G1 X-26.22 E107.3802 F1800
+G1 X-25.3 Y-13.78 E107.4679 F1800
+G1 Y-14.7 E107.53 F1800
+G1 E106.53 F1800
+G1 X25.3 Y14.7 F9000
+G1 E107.53 F1800
+G1 Y13.78 E107.592 F1800
+G1 X26.22 Y14.7 E107.6798 F1800
+G1 X27.14 E107.7418 F1800
+G1 X25.3 Y12.86 E107.9173 F1800
+G1 Y11.94 E107.9793 F1800
+G1 X28.06 Y14.7 E108.2425 F1800
+G1 X28.97 E108.3045 F1800
+G1 X25.3 Y11.02 E108.6555 F1800
+G1 Y10.11 E108.7175 F1800
+G1 X29.89 Y14.7 E109.1562 F1800
+G1 X30.81 E109.2182 F1800
+G1 X25.3 Y9.19 E109.7446 F1800
+G1 Y8.27 E109.8067 F1800
+G1 X31.73 Y14.7 E110.4208 F1800
+G1 X32.65 E110.4828 F1800
+G1 X25.3 Y7.35 E111.1847 F1800
+G1 Y6.43 E111.2467 F1800
+G1 X33.57 Y14.7 E112.0364 F1800
+G1 X34.49 E112.0984 F1800
+G1 X25.3 Y5.51 E112.9757 F1800
+G1 Y4.59 E113.0378 F1800
+G1 X35.41 Y14.7 E114.0028 F1800
+G1 X36.32 E114.0649 F1800
+G1 X25.3 Y3.68 E115.1177 F1800
+G1 Y2.76 E115.1797 F1800
+G1 X37.24 Y14.7 E116.3203 F1800
+G1 X38.16 E116.3823 F1800
+G1 X25.3 Y1.84 E117.6106 F1800
+G1 Y0.92 E117.6726 F1800
+G1 X39.08 Y14.7 E118.9886 F1800
+G1 X40 E119.0507 F1800
+G1 X25.3 Y0 E120.4544 F1800
+G1 Y-0.92 E120.5165 F1800
+G1 X40.92 Y14.7 E122.0079 F1800
+G1 X41.84 E122.07 F1800
+G1 X25.3 Y-1.84 E123.6492 F1800
+G1 Y-2.76 E123.7112 F1800
+G1 X42.76 Y14.7 E125.3782 F1800
+G1 X43.68 E125.4402 F1800
+G1 X25.3 Y-3.68 E127.1949 F1800
+G1 Y-4.59 E127.2569 F1800
+G1 X44.59 Y14.7 E129.0994 F1800
+G1 X45.51 E129.1614 F1800
+G1 X25.3 Y-5.51 E131.0915 F1800
+G1 Y-6.43 E131.1536 F1800
+G1 X46.43 Y14.7 E133.1715 F1800
+G1 X47.35 E133.2335 F1800
+G1 X25.3 Y-7.35 E135.3391 F1800
+G1 Y-8.27 E135.4012 F1800
+G1 X48.27 Y14.7 E137.5945 F1800
+G1 X49.19 E137.6566 F1800
+G1 X25.3 Y-9.19 E139.9376 F1800
+G1 Y-10.11 E139.9997 F1800
+G1 X50.11 Y14.7 E142.3685 F1800
+G1 X51.02 E142.4305 F1800
+G1 X25.3 Y-11.02 E144.8871 F1800
+G1 Y-11.94 E144.9491 F1800
+G1 X51.94 Y14.7 E147.4934 F1800
+G1 X52.86 E147.5555 F1800
+G1 X25.3 Y-12.86 E150.1875 F1800
+G1 Y-13.78 E150.2495 F1800
+G1 X53.78 Y14.7 E152.9693 F1800
+G1 X54.7 E153.0313 F1800
+G1 X25.3 Y-14.7 E155.8388 F1800
+G1 X26.22 E155.9009 F1800
+G1 X54.7 Y13.78 E158.6206 F1800
+G1 Y12.86 E158.6827 F1800
+G1 X27.14 Y-14.7 E161.3147 F1800
+G1 X28.06 E161.3767 F1800
+G1 X54.7 Y11.94 E163.921 F1800
+G1 Y11.02 E163.983 F1800
+G1 X28.98 Y-14.7 E166.4396 F1800
+G1 X29.89 E166.5016 F1800
+G1 X54.7 Y10.11 E168.8705 F1800
+G1 Y9.19 E168.9325 F1800
+G1 X30.81 Y-14.7 E171.2136 F1800
+G1 X31.73 E171.2756 F1800
+G1 X54.7 Y8.27 E173.469 F1800
+G1 Y7.35 E173.531 F1800
+G1 X32.65 Y-14.7 E175.6366 F1800
+G1 X33.57 E175.6987 F1800
+G1 X54.7 Y6.43 E177.7166 F1800
+G1 Y5.51 E177.7786 F1800
+G1 X34.49 Y-14.7 E179.7087 F1800
+G1 X35.41 E179.7708 F1800
+G1 X54.7 Y4.59 E181.6132 F1800
+G1 Y3.68 E181.6752 F1800
+G1 X36.33 Y-14.7 E183.4299 F1800
+G1 X37.24 E183.492 F1800
+G1 X54.7 Y2.76 E185.1589 F1800
+G1 Y1.84 E185.2209 F1800
+G1 X38.16 Y-14.7 E186.8002 F1800
+G1 X39.08 E186.8622 F1800
+G1 X54.7 Y0.92 E188.3537 F1800
+G1 Y0 E188.4157 F1800
+G1 X40 Y-14.7 E189.8195 F1800
+G1 X40.92 E189.8815 F1800
+G1 X54.7 Y-0.92 E191.1975 F1800
+G1 Y-1.84 E191.2595 F1800
+G1 X41.84 Y-14.7 E192.4878 F1800
+G1 X42.76 E192.5499 F1800
+G1 X54.7 Y-2.76 E193.6904 F1800
+G1 Y-3.68 E193.7524 F1800
+G1 X43.68 Y-14.7 E194.8053 F1800
+G1 X44.59 E194.8673 F1800
+G1 X54.7 Y-4.59 E195.8324 F1800
+G1 Y-5.51 E195.8944 F1800
+G1 X45.51 Y-14.7 E196.7717 F1800
+G1 X46.43 E196.8338 F1800
+G1 X54.7 Y-6.43 E197.6234 F1800
+G1 Y-7.35 E197.6854 F1800
+G1 X47.35 Y-14.7 E198.3873 F1800
+G1 X48.27 E198.4493 F1800
+G1 X54.7 Y-8.27 E199.0635 F1800
+G1 Y-9.19 E199.1255 F1800
+G1 X49.19 Y-14.7 E199.6519 F1800
+G1 X50.11 E199.714 F1800
+G1 X54.7 Y-10.11 E200.1526 F1800
+G1 Y-11.02 E200.2147 F1800
+G1 X51.03 Y-14.7 E200.5656 F1800
+G1 X51.94 E200.6276 F1800
+G1 X54.7 Y-11.94 E200.8908 F1800
+G1 Y-12.86 E200.9529 F1800
+G1 X52.86 Y-14.7 E201.1284 F1800
+G1 X53.78 E201.1904 F1800
+G1 X54.7 Y-13.78 E201.2781 F1800
+G1 Y-14.7 E201.3402 F1800
+;layer #2
+M106 S84
+G1 E200.34 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E201.34 F1800
+G1 Z0.5 F300
+G1 Y7.04 E201.9255 F3600
+G1 X47.04 E202.5109
+G1 Y-7.04 E203.0963
+G1 X32.96 E203.6817
+G1 X32.6 Y-7.4
+G1 Y7.4 E204.297
+G1 X47.4 E204.9123
+G1 Y-7.4 E205.5276
+G1 X32.6 E206.1429
+G1 E205.14 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E206.14 F1800
+G1 X-47.04 E206.7283 F3600
+G1 Y7.04 E207.3137
+G1 X-32.96 E207.8991
+G1 Y-7.04 E208.4844
+G1 X-32.6 Y-7.4
+G1 X-47.4 E209.0997
+G1 Y7.4 E209.7151
+G1 X-32.6 E210.3304
+G1 Y-7.4 E210.9457
+;layer #3
+M106 S127
+G1 E209.95 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E210.95 F1800
+G1 Z0.75 F300
+G1 X-47.04 E211.5311 F3600
+G1 Y7.04 E212.1164
+G1 X-32.96 E212.7018
+G1 Y-7.04 E213.2872
+G1 X-32.6 Y-7.4
+G1 X-47.4 E213.9025
+G1 Y7.4 E214.5178
+G1 X-32.6 E215.1331
+G1 Y-7.4 E215.7485
+G1 E214.75 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E215.75 F1800
+G1 Y7.04 E216.3338 F3600
+G1 X47.04 E216.9192
+G1 Y-7.04 E217.5046
+G1 X32.96 E218.09
+G1 X32.6 Y-7.4
+G1 Y7.4 E218.7053
+G1 X47.4 E219.3206
+G1 Y-7.4 E219.9359
+G1 X32.6 E220.5512
+;layer #4
+G1 E219.55 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E220.55 F1800
+G1 Z1 F300
+G1 Y7.04 E221.1366 F3600
+G1 X47.04 E221.722
+G1 Y-7.04 E222.3073
+G1 X32.96 E222.8927
+G1 X32.6 Y-7.4
+G1 Y7.4 E223.508
+G1 X47.4 E224.1234
+G1 Y-7.4 E224.7387
+G1 X32.6 E225.354
+G1 E224.35 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E225.35 F1800
+G1 X-47.04 E225.9394 F3600
+G1 Y7.04 E226.5247
+G1 X-32.96 E227.1101
+G1 Y-7.04 E227.6955
+G1 X-32.6 Y-7.4
+G1 X-47.4 E228.3108
+G1 Y7.4 E228.9261
+G1 X-32.6 E229.5414
+G1 Y-7.4 E230.1567
+;layer #5
+G1 E229.56 F1800
+G1 X-32.86 Y-7.14 F9000
+G1 E230.16 F1800
+G1 Z1.25 F300
+G1 X-47.14 E230.7504 F3600
+G1 Y7.14 E231.3441
+G1 X-32.86 E231.9378
+G1 Y-7.14 E232.5315
+G1 X-32.5 Y-7.5
+G1 X-47.5 E233.1551
+G1 Y7.5 E233.7788
+G1 X-32.5 E234.4024
+G1 Y-7.5 E235.026
+G1 E234.43 F1800
+G1 X32.86 Y-7.14 F9000
+G1 E235.03 F1800
+G1 Y7.14 E235.6197 F3600
+G1 X47.14 E236.2134
+G1 Y-7.14 E236.8071
+G1 X32.86 E237.4008
+G1 X32.5 Y-7.5
+G1 Y7.5 E238.0244
+G1 X47.5 E238.6481
+G1 Y-7.5 E239.2717
+G1 X32.5 E239.8953
+;layer #6
+G1 E239.3 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E239.9 F1800
+G1 Z1.5 F300
+G1 Y7.04 E240.4807 F3600
+G1 X47.04 E241.0661
+G1 Y-7.04 E241.6514
+G1 X32.96 E242.2368
+G1 X32.6 Y-7.4
+G1 Y7.4 E242.8521
+G1 X47.4 E243.4674
+G1 Y-7.4 E244.0828
+G1 X32.6 E244.6981
+G1 E244.1 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E244.7 F1800
+G1 X-47.04 E245.2835 F3600
+G1 Y7.04 E245.8688
+G1 X-32.96 E246.4542
+G1 Y-7.04 E247.0396
+G1 X-32.6 Y-7.4
+G1 X-47.4 E247.6549
+G1 Y7.4 E248.2702
+G1 X-32.6 E248.8855
+G1 Y-7.4 E249.5008
+;layer #7
+G1 E248.9 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E249.5 F1800
+G1 Z1.75 F300
+G1 X-47.04 E250.0862 F3600
+G1 Y7.04 E250.6716
+G1 X-32.96 E251.257
+G1 Y-7.04 E251.8423
+G1 X-32.6 Y-7.4
+G1 X-47.4 E252.4577
+G1 Y7.4 E253.073
+G1 X-32.6 E253.6883
+G1 Y-7.4 E254.3036
+G1 E253.7 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E254.3 F1800
+G1 Y7.04 E254.889 F3600
+G1 X47.04 E255.4744
+G1 Y-7.04 E256.0597
+G1 X32.96 E256.6451
+G1 X32.6 Y-7.4
+G1 Y7.4 E257.2604
+G1 X47.4 E257.8757
+G1 Y-7.4 E258.4911
+G1 X32.6 E259.1064
+;layer #8
+G1 E258.51 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E259.11 F1800
+G1 Z2 F300
+G1 Y7.04 E259.6917 F3600
+G1 X47.04 E260.2771
+G1 Y-7.04 E260.8625
+G1 X32.96 E261.4479
+G1 X32.6 Y-7.4
+G1 Y7.4 E262.0632
+G1 X47.4 E262.6785
+G1 Y-7.4 E263.2938
+G1 X32.6 E263.9091
+G1 E263.31 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E263.91 F1800
+G1 X-47.04 E264.4945 F3600
+G1 Y7.04 E265.0799
+G1 X-32.96 E265.6653
+G1 Y-7.04 E266.2506
+G1 X-32.6 Y-7.4
+G1 X-47.4 E266.866
+G1 Y7.4 E267.4813
+G1 X-32.6 E268.0966
+G1 Y-7.4 E268.7119
+;layer #9
+G1 E268.51 F1800
+G1 X-32.86 Y-7.14 F9000
+G1 E268.71 F1800
+G1 Z2.25 F300
+G1 X-47.14 E269.3056 F3600
+G1 Y7.14 E269.8993
+G1 X-32.86 E270.493
+G1 Y-7.14 E271.0867
+G1 X-32.5 Y-7.5
+G1 X-47.5 E271.7103
+G1 Y7.5 E272.3339
+G1 X-32.5 E272.9575
+G1 Y-7.5 E273.5812
+G1 E273.38 F1800
+G1 X32.86 Y-7.14 F9000
+G1 E273.58 F1800
+G1 Y7.14 E274.1749 F3600
+G1 X47.14 E274.7686
+G1 Y-7.14 E275.3623
+G1 X32.86 E275.9559
+G1 X32.5 Y-7.5
+G1 Y7.5 E276.5796
+G1 X47.5 E277.2032
+G1 Y-7.5 E277.8268
+G1 X32.5 E278.4505
+;layer #10
+G1 E278.25 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E278.45 F1800
+G1 Z2.5 F300
+G1 Y7.04 E279.0358 F3600
+G1 X47.04 E279.6212
+G1 Y-7.04 E280.2066
+G1 X32.96 E280.792
+G1 X32.6 Y-7.4
+G1 Y7.4 E281.4073
+G1 X47.4 E282.0226
+G1 Y-7.4 E282.6379
+G1 X32.6 E283.2532
+G1 E283.05 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E283.25 F1800
+G1 X-47.04 E283.8386 F3600
+G1 Y7.04 E284.424
+G1 X-32.96 E285.0094
+G1 Y-7.04 E285.5947
+G1 X-32.6 Y-7.4
+G1 X-47.4 E286.21
+G1 Y7.4 E286.8254
+G1 X-32.6 E287.4407
+G1 Y-7.4 E288.056
+;layer #11
+G1 E287.86 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E288.06 F1800
+G1 Z2.75 F300
+G1 X-47.04 E288.6414 F3600
+G1 Y7.04 E289.2267
+G1 X-32.96 E289.8121
+G1 Y-7.04 E290.3975
+G1 X-32.6 Y-7.4
+G1 X-47.4 E291.0128
+G1 Y7.4 E291.6281
+G1 X-32.6 E292.2434
+G1 Y-7.4 E292.8587
+G1 E292.66 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E292.86 F1800
+G1 Y7.04 E293.4441 F3600
+G1 X47.04 E294.0295
+G1 Y-7.04 E294.6149
+G1 X32.96 E295.2003
+G1 X32.6 Y-7.4
+G1 Y7.4 E295.8156
+G1 X47.4 E296.4309
+G1 Y-7.4 E297.0462
+G1 X32.6 E297.6615
+;layer #12
+G1 E297.46 F1800
+G1 X32.96 Y-7.04 F9000
+G1 E297.66 F1800
+G1 Z3 F300
+G1 Y7.04 E298.2469 F3600
+G1 X47.04 E298.8323
+G1 Y-7.04 E299.4176
+G1 X32.96 E300.003
+G1 X32.6 Y-7.4
+G1 Y7.4 E300.6183
+G1 X47.4 E301.2336
+G1 Y-7.4 E301.849
+G1 X32.6 E302.4643
+G1 E302.26 F1800
+G1 X-32.96 Y-7.04 F9000
+G1 E302.46 F1800
+G1 X-47.04 E303.0497 F3600
+G1 Y7.04 E303.635
+G1 X-32.96 E304.2204
+G1 Y-7.04 E304.8058
+G1 X-32.6 Y-7.4
+G1 X-47.4 E305.4211
+G1 Y7.4 E306.0364
+G1 X-32.6 E306.6517
+G1 Y-7.4 E307.267
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
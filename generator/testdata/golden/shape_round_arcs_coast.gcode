@@ -0,0 +1,736 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 2
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Tower shape: round, G2 arcs
+;Wipe: 2-2 [mm], retract while wiping: 100%
+;Coasting: 0.5-2 [mm]
+;Short hops: 3 [mm]
+;Segment 3:   0.2mm @ 30mm/s @ wipe 2mm @ coast 2mm
+;Segment 2:   0.6mm @ 30mm/s @ wipe 2mm @ coast 1.25mm
+;Segment 1:   1mm @ 30mm/s @ wipe 2mm @ coast 0.5mm
+M572 D0 S0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 Y103.72 E203.3753 F9000
+G1 X181.44 Y102.95 E202.8347
+G1 X162.52 Y112.52
+G1 E203.83 F1800
+G1 Z0.5 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E204.2944 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E204.7542
+G2 X172.48 Y112.52 I-4.978 J-4.978 E205.2139
+G2 X162.52 Y112.52 I-4.978 J4.978 E205.6737
+G1 X163.32 Y111.83 E205.145 F9000
+G1 X164.12 Y111.33 E204.6737
+G1 X160.63 Y114.76
+G1 E205.67 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E206.0322 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E206.5155
+G2 X172.73 Y112.27 I-5.233 J-5.233 E206.9988
+G2 X162.27 Y112.27 I-5.233 J5.233 E207.482
+G2 X160.83 Y114.3 I5.233 J5.233 E207.586
+G2 X160.63 Y114.76 I6.671 J3.202
+G1 X160.83 Y114.3 E207.3357 F9000
+G1 X161.61 Y113.02 E206.586
+G1 X72.48 Y112.52
+G1 E207.59 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E208.0457 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E208.5055
+G2 X72.48 Y122.48 I4.978 J-4.978 E208.9652
+G2 X72.48 Y112.52 I-4.978 J-4.978 E209.425
+G1 X73.17 Y113.32 E208.8963 F9000
+G1 X73.67 Y114.12 E208.425
+G1 X70.24 Y110.63
+G1 E209.42 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E209.7835 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E210.2668
+G2 X72.73 Y122.73 I5.233 J-5.233 E210.7501
+G2 X72.73 Y112.27 I-5.233 J-5.233 E211.2333
+G2 X70.7 Y110.83 I-5.233 J5.233 E211.3373
+G2 X70.24 Y110.63 I-3.202 J6.671
+;layer #3
+M106 S254
+G1 X70.7 Y110.83 E211.0872 F9000
+G1 X71.15 Y111.06 E210.8371
+G1 X71.98 Y111.61 E210.3373
+G1 X72.48 Y112.52
+G1 E211.34 F1800
+G1 Z0.75 F300
+G2 X62.52 Y112.52 I-4.978 J4.978 E211.797 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E212.2568
+G2 X72.48 Y122.48 I4.978 J-4.978 E212.7165
+G2 X72.48 Y112.52 I-4.978 J-4.978 E213.1763
+G1 X73.17 Y113.32 E212.6476 F9000
+G1 X73.67 Y114.12 E212.1763
+G1 X70.24 Y110.63
+G1 E213.18 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E213.5348 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E214.0181
+G2 X72.73 Y122.73 I5.233 J-5.233 E214.5013
+G2 X72.73 Y112.27 I-5.233 J-5.233 E214.9846
+G2 X70.7 Y110.83 I-5.233 J5.233 E215.0886
+G2 X70.24 Y110.63 I-3.202 J6.671
+G1 X70.7 Y110.83 E214.8385 F9000
+G1 X71.15 Y111.06 E214.5884
+G1 X71.98 Y111.61 E214.0886
+G1 X162.52 Y112.52
+G1 E215.09 F1800
+G2 X162.52 Y122.48 I4.978 J4.978 E215.5483 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E216.0081
+G2 X172.48 Y112.52 I-4.978 J-4.978 E216.4678
+G2 X162.52 Y112.52 I-4.978 J4.978 E216.9276
+G1 X163.32 Y111.83 E216.3989 F9000
+G1 X164.12 Y111.33 E215.9276
+G1 X160.63 Y114.76
+G1 E216.93 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E217.2861 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E217.7694
+G2 X172.73 Y112.27 I-5.233 J-5.233 E218.2526
+G2 X162.27 Y112.27 I-5.233 J5.233 E218.7359
+G2 X160.83 Y114.3 I5.233 J5.233 E218.8398
+G2 X160.63 Y114.76 I6.671 J3.202
+;layer #4
+G1 X160.83 Y114.3 E218.5896 F9000
+G1 X161.61 Y113.02 E217.8398
+G1 X162.52 Y112.52
+G1 E218.84 F1800
+G1 Z1 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E219.2996 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E219.7594
+G2 X172.48 Y112.52 I-4.978 J-4.978 E220.2191
+G2 X162.52 Y112.52 I-4.978 J4.978 E220.6789
+G1 X163.32 Y111.83 E220.1502 F9000
+G1 X164.12 Y111.33 E219.6789
+G1 X160.63 Y114.76
+G1 E220.68 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E221.0374 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E221.5207
+G2 X172.73 Y112.27 I-5.233 J-5.233 E222.0039
+G2 X162.27 Y112.27 I-5.233 J5.233 E222.4872
+G2 X160.83 Y114.3 I5.233 J5.233 E222.5911
+G2 X160.63 Y114.76 I6.671 J3.202
+G1 X160.83 Y114.3 E222.3409 F9000
+G1 X161.61 Y113.02 E221.5911
+G1 X72.48 Y112.52
+G1 E222.59 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E223.0509 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E223.5107
+G2 X72.48 Y122.48 I4.978 J-4.978 E223.9704
+G2 X72.48 Y112.52 I-4.978 J-4.978 E224.4302
+G1 X73.17 Y113.32 E223.9015 F9000
+G1 X73.67 Y114.12 E223.4302
+G1 X70.24 Y110.63
+G1 E224.43 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E224.7887 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E225.272
+G2 X72.73 Y122.73 I5.233 J-5.233 E225.7552
+G2 X72.73 Y112.27 I-5.233 J-5.233 E226.2385
+G2 X70.7 Y110.83 I-5.233 J5.233 E226.3424
+G2 X70.24 Y110.63 I-3.202 J6.671
+;layer #5
+G1 X70.7 Y110.83 E226.1924 F9000
+G1 X71.15 Y111.06 E226.0423
+G1 X71.98 Y111.61 E225.7424
+G1 X72.55 Y112.45
+G1 E226.34 F1800
+G1 Z1.25 F300
+G2 X62.45 Y112.45 I-5.049 J5.049 E226.8087 F3600
+G2 X62.45 Y122.55 I5.049 J5.049 E227.275
+G2 X72.55 Y122.55 I5.049 J-5.049 E227.7413
+G2 X72.55 Y112.45 I-5.049 J-5.049 E228.2076
+G1 X73.33 Y113.38 E227.8434 F9000
+G1 X73.74 Y114.05 E227.6076
+G1 X70.32 Y110.55
+G1 E228.21 F1800
+G2 X62.2 Y112.2 I-2.819 J6.95 E228.5726 F3600
+G2 X62.2 Y122.8 I5.303 J5.303 E229.0624
+G2 X72.8 Y122.8 I5.303 J-5.303 E229.5522
+G2 X72.8 Y112.2 I-5.303 J-5.303 E230.042
+G2 X71.43 Y111.11 I-5.303 J5.303 E230.1148
+G2 X70.32 Y110.55 I-3.933 J6.386
+G1 X71.43 Y111.11 E229.7399 F9000
+G1 X72.05 Y111.54 E229.5148
+G1 X162.45 Y112.45
+G1 E230.11 F1800
+G2 X162.45 Y122.55 I5.049 J5.049 E230.5811 F3600
+G2 X172.55 Y122.55 I5.049 J-5.049 E231.0474
+G2 X172.55 Y112.45 I-5.049 J-5.049 E231.5136
+G2 X162.45 Y112.45 I-5.049 J5.049 E231.9799
+G1 X163.38 Y111.67 E231.6157 F9000
+G1 X164.05 Y111.26 E231.3799
+G1 X160.55 Y114.68
+G1 E231.98 F1800
+G2 X162.2 Y122.8 I6.95 J2.819 E232.345 F3600
+G2 X172.8 Y122.8 I5.303 J-5.303 E232.8348
+G2 X172.8 Y112.2 I-5.303 J-5.303 E233.3246
+G2 X162.2 Y112.2 I-5.303 J5.303 E233.8144
+G2 X161.11 Y113.57 I5.303 J5.303 E233.8871
+G2 X160.55 Y114.68 I6.386 J3.933
+;layer #6
+G1 X161.11 Y113.57 E233.5123 F9000
+G1 X161.54 Y112.95 E233.2871
+G1 X162.52 Y112.52
+G1 E233.89 F1800
+G1 Z1.5 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E234.3469 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E234.8067
+G2 X172.48 Y112.52 I-4.978 J-4.978 E235.2664
+G2 X162.52 Y112.52 I-4.978 J4.978 E235.7262
+G1 X163.32 Y111.83 E235.4089 F9000
+G1 X164.12 Y111.33 E235.1262
+G1 X160.63 Y114.76
+G1 E235.73 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E236.0847 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E236.568
+G2 X172.73 Y112.27 I-5.233 J-5.233 E237.0512
+G2 X162.27 Y112.27 I-5.233 J5.233 E237.5345
+G2 X161.19 Y113.64 I5.233 J5.233 E237.6073
+G2 X160.63 Y114.76 I6.313 J3.861
+G1 X161.19 Y113.64 E237.2324 F9000
+G1 X161.61 Y113.02 E237.0073
+G1 X72.48 Y112.52
+G1 E237.61 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E238.067 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E238.5268
+G2 X72.48 Y122.48 I4.978 J-4.978 E238.9865
+G2 X72.48 Y112.52 I-4.978 J-4.978 E239.4463
+G1 X73.17 Y113.32 E239.1291 F9000
+G1 X73.67 Y114.12 E238.8463
+G1 X70.24 Y110.63
+G1 E239.45 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E239.8048 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E240.2881
+G2 X72.73 Y122.73 I5.233 J-5.233 E240.7714
+G2 X72.73 Y112.27 I-5.233 J-5.233 E241.2546
+G2 X71.36 Y111.19 I-5.233 J5.233 E241.3274
+G2 X70.24 Y110.63 I-3.861 J6.313
+;layer #7
+G1 X71.36 Y111.19 E240.9525 F9000
+G1 X71.98 Y111.61 E240.7274
+G1 X72.48 Y112.52
+G1 E241.33 F1800
+G1 Z1.75 F300
+G2 X62.52 Y112.52 I-4.978 J4.978 E241.7871 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E242.2469
+G2 X72.48 Y122.48 I4.978 J-4.978 E242.7066
+G2 X72.48 Y112.52 I-4.978 J-4.978 E243.1664
+G1 X73.17 Y113.32 E242.8492 F9000
+G1 X73.67 Y114.12 E242.5664
+G1 X70.24 Y110.63
+G1 E243.17 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E243.5249 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E244.0082
+G2 X72.73 Y122.73 I5.233 J-5.233 E244.4915
+G2 X72.73 Y112.27 I-5.233 J-5.233 E244.9747
+G2 X71.36 Y111.19 I-5.233 J5.233 E245.0475
+G2 X70.24 Y110.63 I-3.861 J6.313
+G1 X71.36 Y111.19 E244.6726 F9000
+G1 X71.98 Y111.61 E244.4475
+G1 X162.52 Y112.52
+G1 E245.05 F1800
+G2 X162.52 Y122.48 I4.978 J4.978 E245.5072 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E245.967
+G2 X172.48 Y112.52 I-4.978 J-4.978 E246.4268
+G2 X162.52 Y112.52 I-4.978 J4.978 E246.8865
+G1 X163.32 Y111.83 E246.5693 F9000
+G1 X164.12 Y111.33 E246.2865
+G1 X160.63 Y114.76
+G1 E246.89 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E247.245 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E247.7283
+G2 X172.73 Y112.27 I-5.233 J-5.233 E248.2116
+G2 X162.27 Y112.27 I-5.233 J5.233 E248.6948
+G2 X161.19 Y113.64 I5.233 J5.233 E248.7676
+G2 X160.63 Y114.76 I6.313 J3.861
+;layer #8
+G1 X161.19 Y113.64 E248.3927 F9000
+G1 X161.61 Y113.02 E248.1676
+G1 X162.52 Y112.52
+G1 E248.77 F1800
+G1 Z2 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E249.2274 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E249.6871
+G2 X172.48 Y112.52 I-4.978 J-4.978 E250.1469
+G2 X162.52 Y112.52 I-4.978 J4.978 E250.6066
+G1 X163.32 Y111.83 E250.2894 F9000
+G1 X164.12 Y111.33 E250.0066
+G1 X160.63 Y114.76
+G1 E250.61 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E250.9652 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E251.4484
+G2 X172.73 Y112.27 I-5.233 J-5.233 E251.9317
+G2 X162.27 Y112.27 I-5.233 J5.233 E252.415
+G2 X161.19 Y113.64 I5.233 J5.233 E252.4877
+G2 X160.63 Y114.76 I6.313 J3.861
+G1 X161.19 Y113.64 E252.1128 F9000
+G1 X161.61 Y113.02 E251.8877
+G1 X72.48 Y112.52
+G1 E252.49 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E252.9475 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E253.4072
+G2 X72.48 Y122.48 I4.978 J-4.978 E253.867
+G2 X72.48 Y112.52 I-4.978 J-4.978 E254.3267
+G1 X73.17 Y113.32 E254.0095 F9000
+G1 X73.67 Y114.12 E253.7267
+G1 X70.24 Y110.63
+G1 E254.33 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E254.6853 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E255.1685
+G2 X72.73 Y122.73 I5.233 J-5.233 E255.6518
+G2 X72.73 Y112.27 I-5.233 J-5.233 E256.1351
+G2 X71.36 Y111.19 I-5.233 J5.233 E256.2078
+G2 X70.24 Y110.63 I-3.861 J6.313
+;layer #9
+G1 X71.36 Y111.19 E256.0829 F9000
+G1 X71.98 Y111.61 E256.0078
+G1 X72.55 Y112.45
+G1 E256.21 F1800
+G1 Z2.25 F300
+G2 X62.45 Y112.45 I-5.049 J5.049 E256.6741 F3600
+G2 X62.45 Y122.55 I5.049 J5.049 E257.1404
+G2 X72.55 Y122.55 I5.049 J-5.049 E257.6067
+G2 X72.55 Y112.45 I-5.049 J-5.049 E258.073
+G1 X73.33 Y113.38 E257.9516 F9000
+G1 X73.74 Y114.05 E257.873
+G1 X70.32 Y110.55
+G1 E258.07 F1800
+G2 X62.2 Y112.2 I-2.819 J6.95 E258.438 F3600
+G2 X62.2 Y122.8 I5.303 J5.303 E258.9278
+G2 X72.8 Y122.8 I5.303 J-5.303 E259.4176
+G2 X72.8 Y112.2 I-5.303 J-5.303 E259.9074
+G2 X72.05 Y111.54 I-5.303 J5.303 E259.949
+G2 X70.32 Y110.55 I-4.551 J5.961
+G1 X71.22 Y110.99 E259.849 F9000
+G1 X72.05 Y111.54 E259.749
+G1 X162.45 Y112.45
+G1 E259.95 F1800
+G2 X162.45 Y122.55 I5.049 J5.049 E260.4153 F3600
+G2 X172.55 Y122.55 I5.049 J-5.049 E260.8816
+G2 X172.55 Y112.45 I-5.049 J-5.049 E261.3479
+G2 X162.45 Y112.45 I-5.049 J5.049 E261.8141
+G1 X163.38 Y111.67 E261.6927 F9000
+G1 X164.05 Y111.26 E261.6141
+G1 X160.55 Y114.68
+G1 E261.81 F1800
+G2 X162.2 Y122.8 I6.95 J2.819 E262.1792 F3600
+G2 X172.8 Y122.8 I5.303 J-5.303 E262.669
+G2 X172.8 Y112.2 I-5.303 J-5.303 E263.1588
+G2 X162.2 Y112.2 I-5.303 J5.303 E263.6486
+G2 X161.54 Y112.95 I5.303 J5.303 E263.6902
+G2 X160.55 Y114.68 I5.961 J4.551
+;layer #10
+G1 X160.99 Y113.78 E263.5902 F9000
+G1 X161.54 Y112.95 E263.4902
+G1 X162.52 Y112.52
+G1 E263.69 F1800
+G1 Z2.5 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E264.1499 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E264.6097
+G2 X172.48 Y112.52 I-4.978 J-4.978 E265.0694
+G2 X162.52 Y112.52 I-4.978 J4.978 E265.5292
+G1 X163.32 Y111.83 E265.4235 F9000
+G1 X164.12 Y111.33 E265.3292
+G1 X160.63 Y114.76
+G1 E265.53 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E265.8877 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E266.371
+G2 X172.73 Y112.27 I-5.233 J-5.233 E266.8543
+G2 X162.27 Y112.27 I-5.233 J5.233 E267.3375
+G2 X161.61 Y113.02 I5.233 J5.233 E267.3791
+G2 X160.63 Y114.76 I5.89 J4.48
+G1 X161.06 Y113.85 E267.2791 F9000
+G1 X161.61 Y113.02 E267.1791
+G1 X72.48 Y112.52
+G1 E267.38 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E267.8389 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E268.2986
+G2 X72.48 Y122.48 I4.978 J-4.978 E268.7584
+G2 X72.48 Y112.52 I-4.978 J-4.978 E269.2181
+G1 X73.17 Y113.32 E269.1124 F9000
+G1 X73.67 Y114.12 E269.0181
+G1 X70.24 Y110.63
+G1 E269.22 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E269.5767 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E270.0599
+G2 X72.73 Y122.73 I5.233 J-5.233 E270.5432
+G2 X72.73 Y112.27 I-5.233 J-5.233 E271.0265
+G2 X71.98 Y111.61 I-5.233 J5.233 E271.068
+G2 X70.24 Y110.63 I-4.48 J5.89
+;layer #11
+G1 X71.15 Y111.06 E270.968 F9000
+G1 X71.98 Y111.61 E270.868
+G1 X72.48 Y112.52
+G1 E271.07 F1800
+G1 Z2.75 F300
+G2 X62.52 Y112.52 I-4.978 J4.978 E271.5278 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E271.9876
+G2 X72.48 Y122.48 I4.978 J-4.978 E272.4473
+G2 X72.48 Y112.52 I-4.978 J-4.978 E272.9071
+G1 X73.17 Y113.32 E272.8013 F9000
+G1 X73.67 Y114.12 E272.7071
+G1 X70.24 Y110.63
+G1 E272.91 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E273.2656 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E273.7489
+G2 X72.73 Y122.73 I5.233 J-5.233 E274.2321
+G2 X72.73 Y112.27 I-5.233 J-5.233 E274.7154
+G2 X71.98 Y111.61 I-5.233 J5.233 E274.757
+G2 X70.24 Y110.63 I-4.48 J5.89
+G1 X71.15 Y111.06 E274.657 F9000
+G1 X71.98 Y111.61 E274.557
+G1 X162.52 Y112.52
+G1 E274.76 F1800
+G2 X162.52 Y122.48 I4.978 J4.978 E275.2167 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E275.6765
+G2 X172.48 Y112.52 I-4.978 J-4.978 E276.1362
+G2 X162.52 Y112.52 I-4.978 J4.978 E276.596
+G1 X163.32 Y111.83 E276.4903 F9000
+G1 X164.12 Y111.33 E276.396
+G1 X160.63 Y114.76
+G1 E276.6 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E276.9545 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E277.4378
+G2 X172.73 Y112.27 I-5.233 J-5.233 E277.9211
+G2 X162.27 Y112.27 I-5.233 J5.233 E278.4043
+G2 X161.61 Y113.02 I5.233 J5.233 E278.4459
+G2 X160.63 Y114.76 I5.89 J4.48
+;layer #12
+G1 X161.06 Y113.85 E278.3459 F9000
+G1 X161.61 Y113.02 E278.2459
+G1 X162.52 Y112.52
+G1 E278.45 F1800
+G1 Z3 F300
+G2 X162.52 Y122.48 I4.978 J4.978 E278.9057 F3600
+G2 X172.48 Y122.48 I4.978 J-4.978 E279.3654
+G2 X172.48 Y112.52 I-4.978 J-4.978 E279.8252
+G2 X162.52 Y112.52 I-4.978 J4.978 E280.2849
+G1 X163.32 Y111.83 E280.1792 F9000
+G1 X164.12 Y111.33 E280.0849
+G1 X160.63 Y114.76
+G1 E280.28 F1800
+G2 X162.27 Y122.73 I6.872 J2.745 E280.6435 F3600
+G2 X172.73 Y122.73 I5.233 J-5.233 E281.1267
+G2 X172.73 Y112.27 I-5.233 J-5.233 E281.61
+G2 X162.27 Y112.27 I-5.233 J5.233 E282.0933
+G2 X161.61 Y113.02 I5.233 J5.233 E282.1348
+G2 X160.63 Y114.76 I5.89 J4.48
+G1 X161.06 Y113.85 E282.0348 F9000
+G1 X161.61 Y113.02 E281.9348
+G1 X72.48 Y112.52
+G1 E282.13 F1800
+G2 X62.52 Y112.52 I-4.978 J4.978 E282.5946 F3600
+G2 X62.52 Y122.48 I4.978 J4.978 E283.0543
+G2 X72.48 Y122.48 I4.978 J-4.978 E283.5141
+G2 X72.48 Y112.52 I-4.978 J-4.978 E283.9739
+G1 X73.17 Y113.32 E283.8681 F9000
+G1 X73.67 Y114.12 E283.7739
+G1 X70.24 Y110.63
+G1 E283.97 F1800
+G2 X62.27 Y112.27 I-2.745 J6.872 E284.3324 F3600
+G2 X62.27 Y122.73 I5.233 J5.233 E284.8157
+G2 X72.73 Y122.73 I5.233 J-5.233 E285.2989
+G2 X72.73 Y112.27 I-5.233 J-5.233 E285.7822
+G2 X71.98 Y111.61 I-5.233 J5.233 E285.8238
+G2 X70.24 Y110.63 I-4.48 J5.89
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,2534 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Tower shape: round
+;Segment 3:   0.2mm @ 30mm/s
+;Segment 2:   0.6mm @ 30mm/s
+;Segment 1:   1mm @ 30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y92.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y93.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y132.2 F9000
+G1 E16.21 F1800
+G1 Y131.28 E16.2764 F1800
+G1 X53.72 Y132.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y130.36 E16.6016 F1800
+G1 Y129.44 E16.6636 F1800
+G1 X55.56 Y132.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y128.53 E17.3398 F1800
+G1 Y127.61 E17.4018 F1800
+G1 X57.39 Y132.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y126.69 E18.429 F1800
+G1 Y125.77 E18.491 F1800
+G1 X59.23 Y132.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y124.85 E19.869 F1800
+G1 Y123.93 E19.9311 F1800
+G1 X61.07 Y132.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y123.01 E21.6601 F1800
+G1 Y122.09 E21.7221 F1800
+G1 X62.91 Y132.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y121.18 E23.802 F1800
+G1 Y120.26 E23.8641 F1800
+G1 X64.74 Y132.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y119.34 E26.2949 F1800
+G1 Y118.42 E26.357 F1800
+G1 X66.58 Y132.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y117.5 E29.1388 F1800
+G1 Y116.58 E29.2008 F1800
+G1 X68.42 Y132.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y115.66 E32.3335 F1800
+G1 Y114.74 E32.3956 F1800
+G1 X70.26 Y132.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y113.82 E35.8792 F1800
+G1 Y112.91 E35.9413 F1800
+G1 X72.09 Y132.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y111.99 E39.7759 F1800
+G1 Y111.07 E39.8379 F1800
+G1 X73.93 Y132.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y110.15 E44.0235 F1800
+G1 Y109.23 E44.0855 F1800
+G1 X75.77 Y132.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y108.31 E48.622 F1800
+G1 Y107.39 E48.684 F1800
+G1 X77.61 Y132.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y106.48 E53.5714 F1800
+G1 Y105.56 E53.6335 F1800
+G1 X79.44 Y132.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y104.64 E58.8718 F1800
+G1 Y103.72 E58.9339 F1800
+G1 X81.28 Y132.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y102.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y131.28 E67.3049 F1800
+G1 Y130.36 E67.367 F1800
+G1 X54.64 Y102.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y129.44 E72.6053 F1800
+G1 Y128.52 E72.6674 F1800
+G1 X56.48 Y102.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y127.61 E77.5548 F1800
+G1 Y126.69 E77.6168 F1800
+G1 X58.31 Y102.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y125.77 E82.1533 F1800
+G1 Y124.85 E82.2153 F1800
+G1 X60.15 Y102.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y123.93 E86.4009 F1800
+G1 Y123.01 E86.4629 F1800
+G1 X61.99 Y102.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y122.09 E90.2975 F1800
+G1 Y121.17 E90.3596 F1800
+G1 X63.83 Y102.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y120.26 E93.8432 F1800
+G1 Y119.34 E93.9053 F1800
+G1 X65.66 Y102.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y118.42 E97.038 F1800
+G1 Y117.5 E97.1 F1800
+G1 X67.5 Y102.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y116.58 E99.8818 F1800
+G1 Y115.66 E99.9439 F1800
+G1 X69.34 Y102.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y114.74 E102.3747 F1800
+G1 Y113.82 E102.4368 F1800
+G1 X71.18 Y102.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y112.91 E104.5167 F1800
+G1 Y111.99 E104.5787 F1800
+G1 X73.01 Y102.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y111.07 E106.3077 F1800
+G1 Y110.15 E106.3698 F1800
+G1 X74.85 Y102.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y109.23 E107.7478 F1800
+G1 Y108.31 E107.8099 F1800
+G1 X76.69 Y102.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y107.39 E108.837 F1800
+G1 Y106.48 E108.899 F1800
+G1 X78.53 Y102.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y105.56 E109.5752 F1800
+G1 Y104.64 E109.6372 F1800
+G1 X80.36 Y102.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y103.72 E109.9625 F1800
+G1 Y102.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y132.2 F9000
+G1 E110.02 F1800
+G1 Y131.28 E110.0865 F1800
+G1 X153.72 Y132.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y130.36 E110.4118 F1800
+G1 Y129.44 E110.4738 F1800
+G1 X155.56 Y132.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y128.53 E111.15 F1800
+G1 Y127.61 E111.212 F1800
+G1 X157.39 Y132.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y126.69 E112.2391 F1800
+G1 Y125.77 E112.3012 F1800
+G1 X159.23 Y132.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y124.85 E113.6792 F1800
+G1 Y123.93 E113.7413 F1800
+G1 X161.07 Y132.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y123.01 E115.4702 F1800
+G1 Y122.09 E115.5323 F1800
+G1 X162.91 Y132.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y121.18 E117.6122 F1800
+G1 Y120.26 E117.6742 F1800
+G1 X164.74 Y132.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y119.34 E120.1051 F1800
+G1 Y118.42 E120.1671 F1800
+G1 X166.58 Y132.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y117.5 E122.9489 F1800
+G1 Y116.58 E123.011 F1800
+G1 X168.42 Y132.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y115.66 E126.1437 F1800
+G1 Y114.74 E126.2057 F1800
+G1 X170.26 Y132.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y113.82 E129.6894 F1800
+G1 Y112.91 E129.7515 F1800
+G1 X172.09 Y132.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y111.99 E133.5861 F1800
+G1 Y111.07 E133.6481 F1800
+G1 X173.93 Y132.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y110.15 E137.8336 F1800
+G1 Y109.23 E137.8957 F1800
+G1 X175.77 Y132.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y108.31 E142.4322 F1800
+G1 Y107.39 E142.4942 F1800
+G1 X177.61 Y132.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y106.48 E147.3816 F1800
+G1 Y105.56 E147.4436 F1800
+G1 X179.44 Y132.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y104.64 E152.682 F1800
+G1 Y103.72 E152.744 F1800
+G1 X181.28 Y132.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y102.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y131.28 E161.1151 F1800
+G1 Y130.36 E161.1772 F1800
+G1 X154.64 Y102.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y129.44 E166.4155 F1800
+G1 Y128.52 E166.4776 F1800
+G1 X156.48 Y102.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y127.61 E171.365 F1800
+G1 Y126.69 E171.427 F1800
+G1 X158.31 Y102.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y125.77 E175.9635 F1800
+G1 Y124.85 E176.0255 F1800
+G1 X160.15 Y102.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y123.93 E180.2111 F1800
+G1 Y123.01 E180.2731 F1800
+G1 X161.99 Y102.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y122.09 E184.1077 F1800
+G1 Y121.17 E184.1697 F1800
+G1 X163.82 Y102.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y120.26 E187.6534 F1800
+G1 Y119.34 E187.7155 F1800
+G1 X165.66 Y102.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y118.42 E190.8482 F1800
+G1 Y117.5 E190.9102 F1800
+G1 X167.5 Y102.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y116.58 E193.692 F1800
+G1 Y115.66 E193.7541 F1800
+G1 X169.34 Y102.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y114.74 E196.1849 F1800
+G1 Y113.82 E196.247 F1800
+G1 X171.18 Y102.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y112.91 E198.3269 F1800
+G1 Y111.99 E198.3889 F1800
+G1 X173.01 Y102.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y111.07 E200.1179 F1800
+G1 Y110.15 E200.1799 F1800
+G1 X174.85 Y102.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y109.23 E201.558 F1800
+G1 Y108.31 E201.62 F1800
+G1 X176.69 Y102.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y107.39 E202.6471 F1800
+G1 Y106.48 E202.7092 F1800
+G1 X178.53 Y102.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y105.56 E203.3854 F1800
+G1 Y104.64 E203.4474 F1800
+G1 X180.36 Y102.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y103.72 E203.7726 F1800
+G1 Y102.8 E203.8347 F1800
+;layer #2
+M106 S169
+G1 E202.83 F1800
+G1 X162.52 Y112.52 F9000
+G1 E203.83 F1800
+G1 Z0.5 F300
+G1 X161.91 Y113.21 E203.873 F3600
+G1 X161.4 Y113.98 E203.9112
+G1 X161 Y114.81 E203.9495
+G1 X160.7 Y115.68 E203.9878
+G1 X160.52 Y116.58 E204.0261
+G1 X160.46 Y117.5 E204.0644
+G1 X160.52 Y118.42 E204.1027
+G1 X160.7 Y119.32 E204.141
+G1 X161 Y120.19 E204.1792
+G1 X161.4 Y121.02 E204.2175
+G1 X161.91 Y121.79 E204.2558
+G1 X162.52 Y122.48 E204.2941
+G1 X163.21 Y123.09 E204.3324
+G1 X163.98 Y123.6 E204.3707
+G1 X164.81 Y124 E204.409
+G1 X165.68 Y124.3 E204.4472
+G1 X166.58 Y124.48 E204.4855
+G1 X167.5 Y124.54 E204.5238
+G1 X168.42 Y124.48 E204.5621
+G1 X169.32 Y124.3 E204.6004
+G1 X170.19 Y124 E204.6387
+G1 X171.02 Y123.6 E204.677
+G1 X171.79 Y123.09 E204.7152
+G1 X172.48 Y122.48 E204.7535
+G1 X173.09 Y121.79 E204.7918
+G1 X173.6 Y121.02 E204.8301
+G1 X174 Y120.19 E204.8684
+G1 X174.3 Y119.32 E204.9067
+G1 X174.48 Y118.42 E204.945
+G1 X174.54 Y117.5 E204.9832
+G1 X174.48 Y116.58 E205.0215
+G1 X174.3 Y115.68 E205.0598
+G1 X174 Y114.81 E205.0981
+G1 X173.6 Y113.98 E205.1364
+G1 X173.09 Y113.21 E205.1747
+G1 X172.48 Y112.52 E205.213
+G1 X171.79 Y111.91 E205.2512
+G1 X171.02 Y111.4 E205.2895
+G1 X170.19 Y111 E205.3278
+G1 X169.32 Y110.7 E205.3661
+G1 X168.42 Y110.52 E205.4044
+G1 X167.5 Y110.46 E205.4427
+G1 X166.58 Y110.52 E205.481
+G1 X165.68 Y110.7 E205.5192
+G1 X164.81 Y111 E205.5575
+G1 X163.98 Y111.4 E205.5958
+G1 X163.21 Y111.91 E205.6341
+G1 X162.52 Y112.52 E205.6724
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E205.7126
+G1 X161.09 Y113.8 E205.7529
+G1 X160.66 Y114.67 E205.7931
+G1 X160.35 Y115.58 E205.8334
+G1 X160.16 Y116.53 E205.8736
+G1 X160.1 Y117.5 E205.9138
+G1 X160.16 Y118.47 E205.9541
+G1 X160.35 Y119.42 E205.9943
+G1 X160.66 Y120.33 E206.0346
+G1 X161.09 Y121.2 E206.0748
+G1 X161.63 Y122 E206.1151
+G1 X162.27 Y122.73 E206.1553
+G1 X163 Y123.37 E206.1955
+G1 X163.8 Y123.91 E206.2358
+G1 X164.67 Y124.34 E206.276
+G1 X165.58 Y124.65 E206.3163
+G1 X166.53 Y124.84 E206.3565
+G1 X167.5 Y124.9 E206.3968
+G1 X168.47 Y124.84 E206.437
+G1 X169.42 Y124.65 E206.4772
+G1 X170.33 Y124.34 E206.5175
+G1 X171.2 Y123.91 E206.5577
+G1 X172 Y123.37 E206.598
+G1 X172.73 Y122.73 E206.6382
+G1 X173.37 Y122 E206.6785
+G1 X173.91 Y121.2 E206.7187
+G1 X174.34 Y120.33 E206.7589
+G1 X174.65 Y119.42 E206.7992
+G1 X174.84 Y118.47 E206.8394
+G1 X174.9 Y117.5 E206.8797
+G1 X174.84 Y116.53 E206.9199
+G1 X174.65 Y115.58 E206.9602
+G1 X174.34 Y114.67 E207.0004
+G1 X173.91 Y113.8 E207.0407
+G1 X173.37 Y113 E207.0809
+G1 X172.73 Y112.27 E207.1211
+G1 X172 Y111.63 E207.1614
+G1 X171.2 Y111.09 E207.2016
+G1 X170.33 Y110.66 E207.2419
+G1 X169.42 Y110.35 E207.2821
+G1 X168.47 Y110.16 E207.3224
+G1 X167.5 Y110.1 E207.3626
+G1 X166.53 Y110.16 E207.4028
+G1 X165.58 Y110.35 E207.4431
+G1 X164.67 Y110.66 E207.4833
+G1 X163.8 Y111.09 E207.5236
+G1 X163 Y111.63 E207.5638
+G1 X162.27 Y112.27 E207.6041
+G1 E206.6 F1800
+G1 X72.48 Y112.52 F9000
+G1 E207.6 F1800
+G1 X71.79 Y111.91 E207.6423 F3600
+G1 X71.02 Y111.4 E207.6806
+G1 X70.19 Y111 E207.7189
+G1 X69.32 Y110.7 E207.7572
+G1 X68.42 Y110.52 E207.7955
+G1 X67.5 Y110.46 E207.8338
+G1 X66.58 Y110.52 E207.8721
+G1 X65.68 Y110.7 E207.9103
+G1 X64.81 Y111 E207.9486
+G1 X63.98 Y111.4 E207.9869
+G1 X63.21 Y111.91 E208.0252
+G1 X62.52 Y112.52 E208.0635
+G1 X61.91 Y113.21 E208.1018
+G1 X61.4 Y113.98 E208.1401
+G1 X61 Y114.81 E208.1783
+G1 X60.7 Y115.68 E208.2166
+G1 X60.52 Y116.58 E208.2549
+G1 X60.46 Y117.5 E208.2932
+G1 X60.52 Y118.42 E208.3315
+G1 X60.7 Y119.32 E208.3698
+G1 X61 Y120.19 E208.4081
+G1 X61.4 Y121.02 E208.4463
+G1 X61.91 Y121.79 E208.4846
+G1 X62.52 Y122.48 E208.5229
+G1 X63.21 Y123.09 E208.5612
+G1 X63.98 Y123.6 E208.5995
+G1 X64.81 Y124 E208.6378
+G1 X65.68 Y124.3 E208.6761
+G1 X66.58 Y124.48 E208.7143
+G1 X67.5 Y124.54 E208.7526
+G1 X68.42 Y124.48 E208.7909
+G1 X69.32 Y124.3 E208.8292
+G1 X70.19 Y124 E208.8675
+G1 X71.02 Y123.6 E208.9058
+G1 X71.79 Y123.09 E208.9441
+G1 X72.48 Y122.48 E208.9823
+G1 X73.09 Y121.79 E209.0206
+G1 X73.6 Y121.02 E209.0589
+G1 X74 Y120.19 E209.0972
+G1 X74.3 Y119.32 E209.1355
+G1 X74.48 Y118.42 E209.1738
+G1 X74.54 Y117.5 E209.2121
+G1 X74.48 Y116.58 E209.2503
+G1 X74.3 Y115.68 E209.2886
+G1 X74 Y114.81 E209.3269
+G1 X73.6 Y113.98 E209.3652
+G1 X73.09 Y113.21 E209.4035
+G1 X72.48 Y112.52 E209.4418
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E209.482
+G1 X71.2 Y111.09 E209.5223
+G1 X70.33 Y110.66 E209.5625
+G1 X69.42 Y110.35 E209.6027
+G1 X68.47 Y110.16 E209.643
+G1 X67.5 Y110.1 E209.6832
+G1 X66.53 Y110.16 E209.7235
+G1 X65.58 Y110.35 E209.7637
+G1 X64.67 Y110.66 E209.804
+G1 X63.8 Y111.09 E209.8442
+G1 X63 Y111.63 E209.8844
+G1 X62.27 Y112.27 E209.9247
+G1 X61.63 Y113 E209.9649
+G1 X61.09 Y113.8 E210.0052
+G1 X60.66 Y114.67 E210.0454
+G1 X60.35 Y115.58 E210.0857
+G1 X60.16 Y116.53 E210.1259
+G1 X60.1 Y117.5 E210.1661
+G1 X60.16 Y118.47 E210.2064
+G1 X60.35 Y119.42 E210.2466
+G1 X60.66 Y120.33 E210.2869
+G1 X61.09 Y121.2 E210.3271
+G1 X61.63 Y122 E210.3674
+G1 X62.27 Y122.73 E210.4076
+G1 X63 Y123.37 E210.4479
+G1 X63.8 Y123.91 E210.4881
+G1 X64.67 Y124.34 E210.5283
+G1 X65.58 Y124.65 E210.5686
+G1 X66.53 Y124.84 E210.6088
+G1 X67.5 Y124.9 E210.6491
+G1 X68.47 Y124.84 E210.6893
+G1 X69.42 Y124.65 E210.7296
+G1 X70.33 Y124.34 E210.7698
+G1 X71.2 Y123.91 E210.81
+G1 X72 Y123.37 E210.8503
+G1 X72.73 Y122.73 E210.8905
+G1 X73.37 Y122 E210.9308
+G1 X73.91 Y121.2 E210.971
+G1 X74.34 Y120.33 E211.0113
+G1 X74.65 Y119.42 E211.0515
+G1 X74.84 Y118.47 E211.0917
+G1 X74.9 Y117.5 E211.132
+G1 X74.84 Y116.53 E211.1722
+G1 X74.65 Y115.58 E211.2125
+G1 X74.34 Y114.67 E211.2527
+G1 X73.91 Y113.8 E211.293
+G1 X73.37 Y113 E211.3332
+G1 X72.73 Y112.27 E211.3734
+;layer #3
+M106 S254
+G1 E210.37 F1800
+G1 X72.48 Y112.52 F9000
+G1 E211.37 F1800
+G1 Z0.75 F300
+G1 X71.79 Y111.91 E211.4117 F3600
+G1 X71.02 Y111.4 E211.45
+G1 X70.19 Y111 E211.4883
+G1 X69.32 Y110.7 E211.5266
+G1 X68.42 Y110.52 E211.5649
+G1 X67.5 Y110.46 E211.6032
+G1 X66.58 Y110.52 E211.6414
+G1 X65.68 Y110.7 E211.6797
+G1 X64.81 Y111 E211.718
+G1 X63.98 Y111.4 E211.7563
+G1 X63.21 Y111.91 E211.7946
+G1 X62.52 Y112.52 E211.8329
+G1 X61.91 Y113.21 E211.8712
+G1 X61.4 Y113.98 E211.9094
+G1 X61 Y114.81 E211.9477
+G1 X60.7 Y115.68 E211.986
+G1 X60.52 Y116.58 E212.0243
+G1 X60.46 Y117.5 E212.0626
+G1 X60.52 Y118.42 E212.1009
+G1 X60.7 Y119.32 E212.1392
+G1 X61 Y120.19 E212.1774
+G1 X61.4 Y121.02 E212.2157
+G1 X61.91 Y121.79 E212.254
+G1 X62.52 Y122.48 E212.2923
+G1 X63.21 Y123.09 E212.3306
+G1 X63.98 Y123.6 E212.3689
+G1 X64.81 Y124 E212.4072
+G1 X65.68 Y124.3 E212.4454
+G1 X66.58 Y124.48 E212.4837
+G1 X67.5 Y124.54 E212.522
+G1 X68.42 Y124.48 E212.5603
+G1 X69.32 Y124.3 E212.5986
+G1 X70.19 Y124 E212.6369
+G1 X71.02 Y123.6 E212.6752
+G1 X71.79 Y123.09 E212.7134
+G1 X72.48 Y122.48 E212.7517
+G1 X73.09 Y121.79 E212.79
+G1 X73.6 Y121.02 E212.8283
+G1 X74 Y120.19 E212.8666
+G1 X74.3 Y119.32 E212.9049
+G1 X74.48 Y118.42 E212.9432
+G1 X74.54 Y117.5 E212.9814
+G1 X74.48 Y116.58 E213.0197
+G1 X74.3 Y115.68 E213.058
+G1 X74 Y114.81 E213.0963
+G1 X73.6 Y113.98 E213.1346
+G1 X73.09 Y113.21 E213.1729
+G1 X72.48 Y112.52 E213.2112
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E213.2514
+G1 X71.2 Y111.09 E213.2916
+G1 X70.33 Y110.66 E213.3319
+G1 X69.42 Y110.35 E213.3721
+G1 X68.47 Y110.16 E213.4124
+G1 X67.5 Y110.1 E213.4526
+G1 X66.53 Y110.16 E213.4929
+G1 X65.58 Y110.35 E213.5331
+G1 X64.67 Y110.66 E213.5733
+G1 X63.8 Y111.09 E213.6136
+G1 X63 Y111.63 E213.6538
+G1 X62.27 Y112.27 E213.6941
+G1 X61.63 Y113 E213.7343
+G1 X61.09 Y113.8 E213.7746
+G1 X60.66 Y114.67 E213.8148
+G1 X60.35 Y115.58 E213.8551
+G1 X60.16 Y116.53 E213.8953
+G1 X60.1 Y117.5 E213.9355
+G1 X60.16 Y118.47 E213.9758
+G1 X60.35 Y119.42 E214.016
+G1 X60.66 Y120.33 E214.0563
+G1 X61.09 Y121.2 E214.0965
+G1 X61.63 Y122 E214.1368
+G1 X62.27 Y122.73 E214.177
+G1 X63 Y123.37 E214.2172
+G1 X63.8 Y123.91 E214.2575
+G1 X64.67 Y124.34 E214.2977
+G1 X65.58 Y124.65 E214.338
+G1 X66.53 Y124.84 E214.3782
+G1 X67.5 Y124.9 E214.4185
+G1 X68.47 Y124.84 E214.4587
+G1 X69.42 Y124.65 E214.4989
+G1 X70.33 Y124.34 E214.5392
+G1 X71.2 Y123.91 E214.5794
+G1 X72 Y123.37 E214.6197
+G1 X72.73 Y122.73 E214.6599
+G1 X73.37 Y122 E214.7002
+G1 X73.91 Y121.2 E214.7404
+G1 X74.34 Y120.33 E214.7806
+G1 X74.65 Y119.42 E214.8209
+G1 X74.84 Y118.47 E214.8611
+G1 X74.9 Y117.5 E214.9014
+G1 X74.84 Y116.53 E214.9416
+G1 X74.65 Y115.58 E214.9819
+G1 X74.34 Y114.67 E215.0221
+G1 X73.91 Y113.8 E215.0624
+G1 X73.37 Y113 E215.1026
+G1 X72.73 Y112.27 E215.1428
+G1 E214.14 F1800
+G1 X162.52 Y112.52 F9000
+G1 E215.14 F1800
+G1 X161.91 Y113.21 E215.1811 F3600
+G1 X161.4 Y113.98 E215.2194
+G1 X161 Y114.81 E215.2577
+G1 X160.7 Y115.68 E215.296
+G1 X160.52 Y116.58 E215.3343
+G1 X160.46 Y117.5 E215.3726
+G1 X160.52 Y118.42 E215.4108
+G1 X160.7 Y119.32 E215.4491
+G1 X161 Y120.19 E215.4874
+G1 X161.4 Y121.02 E215.5257
+G1 X161.91 Y121.79 E215.564
+G1 X162.52 Y122.48 E215.6023
+G1 X163.21 Y123.09 E215.6406
+G1 X163.98 Y123.6 E215.6788
+G1 X164.81 Y124 E215.7171
+G1 X165.68 Y124.3 E215.7554
+G1 X166.58 Y124.48 E215.7937
+G1 X167.5 Y124.54 E215.832
+G1 X168.42 Y124.48 E215.8703
+G1 X169.32 Y124.3 E215.9085
+G1 X170.19 Y124 E215.9468
+G1 X171.02 Y123.6 E215.9851
+G1 X171.79 Y123.09 E216.0234
+G1 X172.48 Y122.48 E216.0617
+G1 X173.09 Y121.79 E216.1
+G1 X173.6 Y121.02 E216.1383
+G1 X174 Y120.19 E216.1765
+G1 X174.3 Y119.32 E216.2148
+G1 X174.48 Y118.42 E216.2531
+G1 X174.54 Y117.5 E216.2914
+G1 X174.48 Y116.58 E216.3297
+G1 X174.3 Y115.68 E216.368
+G1 X174 Y114.81 E216.4063
+G1 X173.6 Y113.98 E216.4445
+G1 X173.09 Y113.21 E216.4828
+G1 X172.48 Y112.52 E216.5211
+G1 X171.79 Y111.91 E216.5594
+G1 X171.02 Y111.4 E216.5977
+G1 X170.19 Y111 E216.636
+G1 X169.32 Y110.7 E216.6743
+G1 X168.42 Y110.52 E216.7125
+G1 X167.5 Y110.46 E216.7508
+G1 X166.58 Y110.52 E216.7891
+G1 X165.68 Y110.7 E216.8274
+G1 X164.81 Y111 E216.8657
+G1 X163.98 Y111.4 E216.904
+G1 X163.21 Y111.91 E216.9423
+G1 X162.52 Y112.52 E216.9805
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E217.0208
+G1 X161.09 Y113.8 E217.061
+G1 X160.66 Y114.67 E217.1013
+G1 X160.35 Y115.58 E217.1415
+G1 X160.16 Y116.53 E217.1818
+G1 X160.1 Y117.5 E217.222
+G1 X160.16 Y118.47 E217.2622
+G1 X160.35 Y119.42 E217.3025
+G1 X160.66 Y120.33 E217.3427
+G1 X161.09 Y121.2 E217.383
+G1 X161.63 Y122 E217.4232
+G1 X162.27 Y122.73 E217.4635
+G1 X163 Y123.37 E217.5037
+G1 X163.8 Y123.91 E217.544
+G1 X164.67 Y124.34 E217.5842
+G1 X165.58 Y124.65 E217.6244
+G1 X166.53 Y124.84 E217.6647
+G1 X167.5 Y124.9 E217.7049
+G1 X168.47 Y124.84 E217.7452
+G1 X169.42 Y124.65 E217.7854
+G1 X170.33 Y124.34 E217.8257
+G1 X171.2 Y123.91 E217.8659
+G1 X172 Y123.37 E217.9061
+G1 X172.73 Y122.73 E217.9464
+G1 X173.37 Y122 E217.9866
+G1 X173.91 Y121.2 E218.0269
+G1 X174.34 Y120.33 E218.0671
+G1 X174.65 Y119.42 E218.1074
+G1 X174.84 Y118.47 E218.1476
+G1 X174.9 Y117.5 E218.1878
+G1 X174.84 Y116.53 E218.2281
+G1 X174.65 Y115.58 E218.2683
+G1 X174.34 Y114.67 E218.3086
+G1 X173.91 Y113.8 E218.3488
+G1 X173.37 Y113 E218.3891
+G1 X172.73 Y112.27 E218.4293
+G1 X172 Y111.63 E218.4695
+G1 X171.2 Y111.09 E218.5098
+G1 X170.33 Y110.66 E218.55
+G1 X169.42 Y110.35 E218.5903
+G1 X168.47 Y110.16 E218.6305
+G1 X167.5 Y110.1 E218.6708
+G1 X166.53 Y110.16 E218.711
+G1 X165.58 Y110.35 E218.7513
+G1 X164.67 Y110.66 E218.7915
+G1 X163.8 Y111.09 E218.8317
+G1 X163 Y111.63 E218.872
+G1 X162.27 Y112.27 E218.9122
+;layer #4
+G1 E217.91 F1800
+G1 X162.52 Y112.52 F9000
+G1 E218.91 F1800
+G1 Z1 F300
+G1 X161.91 Y113.21 E218.9505 F3600
+G1 X161.4 Y113.98 E218.9888
+G1 X161 Y114.81 E219.0271
+G1 X160.7 Y115.68 E219.0654
+G1 X160.52 Y116.58 E219.1037
+G1 X160.46 Y117.5 E219.1419
+G1 X160.52 Y118.42 E219.1802
+G1 X160.7 Y119.32 E219.2185
+G1 X161 Y120.19 E219.2568
+G1 X161.4 Y121.02 E219.2951
+G1 X161.91 Y121.79 E219.3334
+G1 X162.52 Y122.48 E219.3717
+G1 X163.21 Y123.09 E219.4099
+G1 X163.98 Y123.6 E219.4482
+G1 X164.81 Y124 E219.4865
+G1 X165.68 Y124.3 E219.5248
+G1 X166.58 Y124.48 E219.5631
+G1 X167.5 Y124.54 E219.6014
+G1 X168.42 Y124.48 E219.6397
+G1 X169.32 Y124.3 E219.6779
+G1 X170.19 Y124 E219.7162
+G1 X171.02 Y123.6 E219.7545
+G1 X171.79 Y123.09 E219.7928
+G1 X172.48 Y122.48 E219.8311
+G1 X173.09 Y121.79 E219.8694
+G1 X173.6 Y121.02 E219.9077
+G1 X174 Y120.19 E219.9459
+G1 X174.3 Y119.32 E219.9842
+G1 X174.48 Y118.42 E220.0225
+G1 X174.54 Y117.5 E220.0608
+G1 X174.48 Y116.58 E220.0991
+G1 X174.3 Y115.68 E220.1374
+G1 X174 Y114.81 E220.1757
+G1 X173.6 Y113.98 E220.2139
+G1 X173.09 Y113.21 E220.2522
+G1 X172.48 Y112.52 E220.2905
+G1 X171.79 Y111.91 E220.3288
+G1 X171.02 Y111.4 E220.3671
+G1 X170.19 Y111 E220.4054
+G1 X169.32 Y110.7 E220.4436
+G1 X168.42 Y110.52 E220.4819
+G1 X167.5 Y110.46 E220.5202
+G1 X166.58 Y110.52 E220.5585
+G1 X165.68 Y110.7 E220.5968
+G1 X164.81 Y111 E220.6351
+G1 X163.98 Y111.4 E220.6734
+G1 X163.21 Y111.91 E220.7116
+G1 X162.52 Y112.52 E220.7499
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E220.7902
+G1 X161.09 Y113.8 E220.8304
+G1 X160.66 Y114.67 E220.8707
+G1 X160.35 Y115.58 E220.9109
+G1 X160.16 Y116.53 E220.9512
+G1 X160.1 Y117.5 E220.9914
+G1 X160.16 Y118.47 E221.0316
+G1 X160.35 Y119.42 E221.0719
+G1 X160.66 Y120.33 E221.1121
+G1 X161.09 Y121.2 E221.1524
+G1 X161.63 Y122 E221.1926
+G1 X162.27 Y122.73 E221.2329
+G1 X163 Y123.37 E221.2731
+G1 X163.8 Y123.91 E221.3133
+G1 X164.67 Y124.34 E221.3536
+G1 X165.58 Y124.65 E221.3938
+G1 X166.53 Y124.84 E221.4341
+G1 X167.5 Y124.9 E221.4743
+G1 X168.47 Y124.84 E221.5146
+G1 X169.42 Y124.65 E221.5548
+G1 X170.33 Y124.34 E221.595
+G1 X171.2 Y123.91 E221.6353
+G1 X172 Y123.37 E221.6755
+G1 X172.73 Y122.73 E221.7158
+G1 X173.37 Y122 E221.756
+G1 X173.91 Y121.2 E221.7963
+G1 X174.34 Y120.33 E221.8365
+G1 X174.65 Y119.42 E221.8767
+G1 X174.84 Y118.47 E221.917
+G1 X174.9 Y117.5 E221.9572
+G1 X174.84 Y116.53 E221.9975
+G1 X174.65 Y115.58 E222.0377
+G1 X174.34 Y114.67 E222.078
+G1 X173.91 Y113.8 E222.1182
+G1 X173.37 Y113 E222.1585
+G1 X172.73 Y112.27 E222.1987
+G1 X172 Y111.63 E222.2389
+G1 X171.2 Y111.09 E222.2792
+G1 X170.33 Y110.66 E222.3194
+G1 X169.42 Y110.35 E222.3597
+G1 X168.47 Y110.16 E222.3999
+G1 X167.5 Y110.1 E222.4402
+G1 X166.53 Y110.16 E222.4804
+G1 X165.58 Y110.35 E222.5206
+G1 X164.67 Y110.66 E222.5609
+G1 X163.8 Y111.09 E222.6011
+G1 X163 Y111.63 E222.6414
+G1 X162.27 Y112.27 E222.6816
+G1 E221.68 F1800
+G1 X72.48 Y112.52 F9000
+G1 E222.68 F1800
+G1 X71.79 Y111.91 E222.7199 F3600
+G1 X71.02 Y111.4 E222.7582
+G1 X70.19 Y111 E222.7965
+G1 X69.32 Y110.7 E222.8348
+G1 X68.42 Y110.52 E222.873
+G1 X67.5 Y110.46 E222.9113
+G1 X66.58 Y110.52 E222.9496
+G1 X65.68 Y110.7 E222.9879
+G1 X64.81 Y111 E223.0262
+G1 X63.98 Y111.4 E223.0645
+G1 X63.21 Y111.91 E223.1028
+G1 X62.52 Y112.52 E223.141
+G1 X61.91 Y113.21 E223.1793
+G1 X61.4 Y113.98 E223.2176
+G1 X61 Y114.81 E223.2559
+G1 X60.7 Y115.68 E223.2942
+G1 X60.52 Y116.58 E223.3325
+G1 X60.46 Y117.5 E223.3708
+G1 X60.52 Y118.42 E223.409
+G1 X60.7 Y119.32 E223.4473
+G1 X61 Y120.19 E223.4856
+G1 X61.4 Y121.02 E223.5239
+G1 X61.91 Y121.79 E223.5622
+G1 X62.52 Y122.48 E223.6005
+G1 X63.21 Y123.09 E223.6388
+G1 X63.98 Y123.6 E223.677
+G1 X64.81 Y124 E223.7153
+G1 X65.68 Y124.3 E223.7536
+G1 X66.58 Y124.48 E223.7919
+G1 X67.5 Y124.54 E223.8302
+G1 X68.42 Y124.48 E223.8685
+G1 X69.32 Y124.3 E223.9068
+G1 X70.19 Y124 E223.945
+G1 X71.02 Y123.6 E223.9833
+G1 X71.79 Y123.09 E224.0216
+G1 X72.48 Y122.48 E224.0599
+G1 X73.09 Y121.79 E224.0982
+G1 X73.6 Y121.02 E224.1365
+G1 X74 Y120.19 E224.1748
+G1 X74.3 Y119.32 E224.213
+G1 X74.48 Y118.42 E224.2513
+G1 X74.54 Y117.5 E224.2896
+G1 X74.48 Y116.58 E224.3279
+G1 X74.3 Y115.68 E224.3662
+G1 X74 Y114.81 E224.4045
+G1 X73.6 Y113.98 E224.4428
+G1 X73.09 Y113.21 E224.481
+G1 X72.48 Y112.52 E224.5193
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E224.5596
+G1 X71.2 Y111.09 E224.5998
+G1 X70.33 Y110.66 E224.6401
+G1 X69.42 Y110.35 E224.6803
+G1 X68.47 Y110.16 E224.7205
+G1 X67.5 Y110.1 E224.7608
+G1 X66.53 Y110.16 E224.801
+G1 X65.58 Y110.35 E224.8413
+G1 X64.67 Y110.66 E224.8815
+G1 X63.8 Y111.09 E224.9218
+G1 X63 Y111.63 E224.962
+G1 X62.27 Y112.27 E225.0022
+G1 X61.63 Y113 E225.0425
+G1 X61.09 Y113.8 E225.0827
+G1 X60.66 Y114.67 E225.123
+G1 X60.35 Y115.58 E225.1632
+G1 X60.16 Y116.53 E225.2035
+G1 X60.1 Y117.5 E225.2437
+G1 X60.16 Y118.47 E225.2839
+G1 X60.35 Y119.42 E225.3242
+G1 X60.66 Y120.33 E225.3644
+G1 X61.09 Y121.2 E225.4047
+G1 X61.63 Y122 E225.4449
+G1 X62.27 Y122.73 E225.4852
+G1 X63 Y123.37 E225.5254
+G1 X63.8 Y123.91 E225.5657
+G1 X64.67 Y124.34 E225.6059
+G1 X65.58 Y124.65 E225.6461
+G1 X66.53 Y124.84 E225.6864
+G1 X67.5 Y124.9 E225.7266
+G1 X68.47 Y124.84 E225.7669
+G1 X69.42 Y124.65 E225.8071
+G1 X70.33 Y124.34 E225.8474
+G1 X71.2 Y123.91 E225.8876
+G1 X72 Y123.37 E225.9278
+G1 X72.73 Y122.73 E225.9681
+G1 X73.37 Y122 E226.0083
+G1 X73.91 Y121.2 E226.0486
+G1 X74.34 Y120.33 E226.0888
+G1 X74.65 Y119.42 E226.1291
+G1 X74.84 Y118.47 E226.1693
+G1 X74.9 Y117.5 E226.2095
+G1 X74.84 Y116.53 E226.2498
+G1 X74.65 Y115.58 E226.29
+G1 X74.34 Y114.67 E226.3303
+G1 X73.91 Y113.8 E226.3705
+G1 X73.37 Y113 E226.4108
+G1 X72.73 Y112.27 E226.451
+;layer #5
+G1 E225.85 F1800
+G1 X72.55 Y112.45 F9000
+G1 E226.45 F1800
+G1 Z1.25 F300
+G1 X71.85 Y111.84 E226.4898 F3600
+G1 X71.07 Y111.32 E226.5287
+G1 X70.23 Y110.9 E226.5675
+G1 X69.35 Y110.6 E226.6063
+G1 X68.43 Y110.42 E226.6452
+G1 X67.5 Y110.36 E226.684
+G1 X66.57 Y110.42 E226.7228
+G1 X65.65 Y110.6 E226.7616
+G1 X64.77 Y110.9 E226.8005
+G1 X63.93 Y111.32 E226.8393
+G1 X63.15 Y111.84 E226.8781
+G1 X62.45 Y112.45 E226.917
+G1 X61.84 Y113.15 E226.9558
+G1 X61.32 Y113.93 E226.9946
+G1 X60.9 Y114.77 E227.0334
+G1 X60.6 Y115.65 E227.0723
+G1 X60.42 Y116.57 E227.1111
+G1 X60.36 Y117.5 E227.1499
+G1 X60.42 Y118.43 E227.1888
+G1 X60.6 Y119.35 E227.2276
+G1 X60.9 Y120.23 E227.2664
+G1 X61.32 Y121.07 E227.3053
+G1 X61.84 Y121.85 E227.3441
+G1 X62.45 Y122.55 E227.3829
+G1 X63.15 Y123.16 E227.4217
+G1 X63.93 Y123.68 E227.4606
+G1 X64.77 Y124.1 E227.4994
+G1 X65.65 Y124.4 E227.5382
+G1 X66.57 Y124.58 E227.5771
+G1 X67.5 Y124.64 E227.6159
+G1 X68.43 Y124.58 E227.6547
+G1 X69.35 Y124.4 E227.6935
+G1 X70.23 Y124.1 E227.7324
+G1 X71.07 Y123.68 E227.7712
+G1 X71.85 Y123.16 E227.81
+G1 X72.55 Y122.55 E227.8489
+G1 X73.16 Y121.85 E227.8877
+G1 X73.68 Y121.07 E227.9265
+G1 X74.1 Y120.23 E227.9654
+G1 X74.4 Y119.35 E228.0042
+G1 X74.58 Y118.43 E228.043
+G1 X74.64 Y117.5 E228.0818
+G1 X74.58 Y116.57 E228.1207
+G1 X74.4 Y115.65 E228.1595
+G1 X74.1 Y114.77 E228.1983
+G1 X73.68 Y113.93 E228.2372
+G1 X73.16 Y113.15 E228.276
+G1 X72.55 Y112.45 E228.3148
+G1 X72.8 Y112.2
+G1 X72.07 Y111.55 E228.3556
+G1 X71.25 Y111 E228.3964
+G1 X70.37 Y110.57 E228.4372
+G1 X69.44 Y110.26 E228.478
+G1 X68.48 Y110.06 E228.5188
+G1 X67.5 Y110 E228.5595
+G1 X66.52 Y110.06 E228.6003
+G1 X65.56 Y110.26 E228.6411
+G1 X64.63 Y110.57 E228.6819
+G1 X63.75 Y111 E228.7227
+G1 X62.93 Y111.55 E228.7635
+G1 X62.2 Y112.2 E228.8043
+G1 X61.55 Y112.93 E228.8451
+G1 X61 Y113.75 E228.8858
+G1 X60.57 Y114.63 E228.9266
+G1 X60.26 Y115.56 E228.9674
+G1 X60.06 Y116.52 E229.0082
+G1 X60 Y117.5 E229.049
+G1 X60.06 Y118.48 E229.0898
+G1 X60.26 Y119.44 E229.1306
+G1 X60.57 Y120.37 E229.1713
+G1 X61 Y121.25 E229.2121
+G1 X61.55 Y122.07 E229.2529
+G1 X62.2 Y122.8 E229.2937
+G1 X62.93 Y123.45 E229.3345
+G1 X63.75 Y124 E229.3753
+G1 X64.63 Y124.43 E229.4161
+G1 X65.56 Y124.74 E229.4569
+G1 X66.52 Y124.94 E229.4976
+G1 X67.5 Y125 E229.5384
+G1 X68.48 Y124.94 E229.5792
+G1 X69.44 Y124.74 E229.62
+G1 X70.37 Y124.43 E229.6608
+G1 X71.25 Y124 E229.7016
+G1 X72.07 Y123.45 E229.7424
+G1 X72.8 Y122.8 E229.7832
+G1 X73.45 Y122.07 E229.8239
+G1 X74 Y121.25 E229.8647
+G1 X74.43 Y120.37 E229.9055
+G1 X74.74 Y119.44 E229.9463
+G1 X74.94 Y118.48 E229.9871
+G1 X75 Y117.5 E230.0279
+G1 X74.94 Y116.52 E230.0687
+G1 X74.74 Y115.56 E230.1095
+G1 X74.43 Y114.63 E230.1502
+G1 X74 Y113.75 E230.191
+G1 X73.45 Y112.93 E230.2318
+G1 X72.8 Y112.2 E230.2726
+G1 E229.67 F1800
+G1 X162.45 Y112.45 F9000
+G1 E230.27 F1800
+G1 X161.84 Y113.15 E230.3114 F3600
+G1 X161.32 Y113.93 E230.3503
+G1 X160.9 Y114.77 E230.3891
+G1 X160.6 Y115.65 E230.4279
+G1 X160.42 Y116.57 E230.4667
+G1 X160.36 Y117.5 E230.5056
+G1 X160.42 Y118.43 E230.5444
+G1 X160.6 Y119.35 E230.5832
+G1 X160.9 Y120.23 E230.6221
+G1 X161.32 Y121.07 E230.6609
+G1 X161.84 Y121.85 E230.6997
+G1 X162.45 Y122.55 E230.7386
+G1 X163.15 Y123.16 E230.7774
+G1 X163.93 Y123.68 E230.8162
+G1 X164.77 Y124.1 E230.855
+G1 X165.65 Y124.4 E230.8939
+G1 X166.57 Y124.58 E230.9327
+G1 X167.5 Y124.64 E230.9715
+G1 X168.43 Y124.58 E231.0104
+G1 X169.35 Y124.4 E231.0492
+G1 X170.23 Y124.1 E231.088
+G1 X171.07 Y123.68 E231.1268
+G1 X171.85 Y123.16 E231.1657
+G1 X172.55 Y122.55 E231.2045
+G1 X173.16 Y121.85 E231.2433
+G1 X173.68 Y121.07 E231.2822
+G1 X174.1 Y120.23 E231.321
+G1 X174.4 Y119.35 E231.3598
+G1 X174.58 Y118.43 E231.3987
+G1 X174.64 Y117.5 E231.4375
+G1 X174.58 Y116.57 E231.4763
+G1 X174.4 Y115.65 E231.5151
+G1 X174.1 Y114.77 E231.554
+G1 X173.68 Y113.93 E231.5928
+G1 X173.16 Y113.15 E231.6316
+G1 X172.55 Y112.45 E231.6705
+G1 X171.85 Y111.84 E231.7093
+G1 X171.07 Y111.32 E231.7481
+G1 X170.23 Y110.9 E231.7869
+G1 X169.35 Y110.6 E231.8258
+G1 X168.43 Y110.42 E231.8646
+G1 X167.5 Y110.36 E231.9034
+G1 X166.57 Y110.42 E231.9423
+G1 X165.65 Y110.6 E231.9811
+G1 X164.77 Y110.9 E232.0199
+G1 X163.93 Y111.32 E232.0588
+G1 X163.15 Y111.84 E232.0976
+G1 X162.45 Y112.45 E232.1364
+G1 X162.2 Y112.2
+G1 X161.55 Y112.93 E232.1772
+G1 X161 Y113.75 E232.218
+G1 X160.57 Y114.63 E232.2588
+G1 X160.26 Y115.56 E232.2996
+G1 X160.06 Y116.52 E232.3403
+G1 X160 Y117.5 E232.3811
+G1 X160.06 Y118.48 E232.4219
+G1 X160.26 Y119.44 E232.4627
+G1 X160.57 Y120.37 E232.5035
+G1 X161 Y121.25 E232.5443
+G1 X161.55 Y122.07 E232.5851
+G1 X162.2 Y122.8 E232.6259
+G1 X162.93 Y123.45 E232.6666
+G1 X163.75 Y124 E232.7074
+G1 X164.63 Y124.43 E232.7482
+G1 X165.56 Y124.74 E232.789
+G1 X166.52 Y124.94 E232.8298
+G1 X167.5 Y125 E232.8706
+G1 X168.48 Y124.94 E232.9114
+G1 X169.44 Y124.74 E232.9522
+G1 X170.37 Y124.43 E232.9929
+G1 X171.25 Y124 E233.0337
+G1 X172.07 Y123.45 E233.0745
+G1 X172.8 Y122.8 E233.1153
+G1 X173.45 Y122.07 E233.1561
+G1 X174 Y121.25 E233.1969
+G1 X174.43 Y120.37 E233.2377
+G1 X174.74 Y119.44 E233.2785
+G1 X174.94 Y118.48 E233.3192
+G1 X175 Y117.5 E233.36
+G1 X174.94 Y116.52 E233.4008
+G1 X174.74 Y115.56 E233.4416
+G1 X174.43 Y114.63 E233.4824
+G1 X174 Y113.75 E233.5232
+G1 X173.45 Y112.93 E233.564
+G1 X172.8 Y112.2 E233.6048
+G1 X172.07 Y111.55 E233.6455
+G1 X171.25 Y111 E233.6863
+G1 X170.37 Y110.57 E233.7271
+G1 X169.44 Y110.26 E233.7679
+G1 X168.48 Y110.06 E233.8087
+G1 X167.5 Y110 E233.8495
+G1 X166.52 Y110.06 E233.8903
+G1 X165.56 Y110.26 E233.931
+G1 X164.63 Y110.57 E233.9718
+G1 X163.75 Y111 E234.0126
+G1 X162.93 Y111.55 E234.0534
+G1 X162.2 Y112.2 E234.0942
+;layer #6
+G1 E233.49 F1800
+G1 X162.52 Y112.52 F9000
+G1 E234.09 F1800
+G1 Z1.5 F300
+G1 X161.91 Y113.21 E234.1325 F3600
+G1 X161.4 Y113.98 E234.1708
+G1 X161 Y114.81 E234.2091
+G1 X160.7 Y115.68 E234.2473
+G1 X160.52 Y116.58 E234.2856
+G1 X160.46 Y117.5 E234.3239
+G1 X160.52 Y118.42 E234.3622
+G1 X160.7 Y119.32 E234.4005
+G1 X161 Y120.19 E234.4388
+G1 X161.4 Y121.02 E234.4771
+G1 X161.91 Y121.79 E234.5153
+G1 X162.52 Y122.48 E234.5536
+G1 X163.21 Y123.09 E234.5919
+G1 X163.98 Y123.6 E234.6302
+G1 X164.81 Y124 E234.6685
+G1 X165.68 Y124.3 E234.7068
+G1 X166.58 Y124.48 E234.7451
+G1 X167.5 Y124.54 E234.7833
+G1 X168.42 Y124.48 E234.8216
+G1 X169.32 Y124.3 E234.8599
+G1 X170.19 Y124 E234.8982
+G1 X171.02 Y123.6 E234.9365
+G1 X171.79 Y123.09 E234.9748
+G1 X172.48 Y122.48 E235.0131
+G1 X173.09 Y121.79 E235.0513
+G1 X173.6 Y121.02 E235.0896
+G1 X174 Y120.19 E235.1279
+G1 X174.3 Y119.32 E235.1662
+G1 X174.48 Y118.42 E235.2045
+G1 X174.54 Y117.5 E235.2428
+G1 X174.48 Y116.58 E235.2811
+G1 X174.3 Y115.68 E235.3193
+G1 X174 Y114.81 E235.3576
+G1 X173.6 Y113.98 E235.3959
+G1 X173.09 Y113.21 E235.4342
+G1 X172.48 Y112.52 E235.4725
+G1 X171.79 Y111.91 E235.5108
+G1 X171.02 Y111.4 E235.5491
+G1 X170.19 Y111 E235.5873
+G1 X169.32 Y110.7 E235.6256
+G1 X168.42 Y110.52 E235.6639
+G1 X167.5 Y110.46 E235.7022
+G1 X166.58 Y110.52 E235.7405
+G1 X165.68 Y110.7 E235.7788
+G1 X164.81 Y111 E235.817
+G1 X163.98 Y111.4 E235.8553
+G1 X163.21 Y111.91 E235.8936
+G1 X162.52 Y112.52 E235.9319
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E235.9721
+G1 X161.09 Y113.8 E236.0124
+G1 X160.66 Y114.67 E236.0526
+G1 X160.35 Y115.58 E236.0929
+G1 X160.16 Y116.53 E236.1331
+G1 X160.1 Y117.5 E236.1734
+G1 X160.16 Y118.47 E236.2136
+G1 X160.35 Y119.42 E236.2539
+G1 X160.66 Y120.33 E236.2941
+G1 X161.09 Y121.2 E236.3343
+G1 X161.63 Y122 E236.3746
+G1 X162.27 Y122.73 E236.4148
+G1 X163 Y123.37 E236.4551
+G1 X163.8 Y123.91 E236.4953
+G1 X164.67 Y124.34 E236.5356
+G1 X165.58 Y124.65 E236.5758
+G1 X166.53 Y124.84 E236.616
+G1 X167.5 Y124.9 E236.6563
+G1 X168.47 Y124.84 E236.6965
+G1 X169.42 Y124.65 E236.7368
+G1 X170.33 Y124.34 E236.777
+G1 X171.2 Y123.91 E236.8173
+G1 X172 Y123.37 E236.8575
+G1 X172.73 Y122.73 E236.8977
+G1 X173.37 Y122 E236.938
+G1 X173.91 Y121.2 E236.9782
+G1 X174.34 Y120.33 E237.0185
+G1 X174.65 Y119.42 E237.0587
+G1 X174.84 Y118.47 E237.099
+G1 X174.9 Y117.5 E237.1392
+G1 X174.84 Y116.53 E237.1795
+G1 X174.65 Y115.58 E237.2197
+G1 X174.34 Y114.67 E237.2599
+G1 X173.91 Y113.8 E237.3002
+G1 X173.37 Y113 E237.3404
+G1 X172.73 Y112.27 E237.3807
+G1 X172 Y111.63 E237.4209
+G1 X171.2 Y111.09 E237.4612
+G1 X170.33 Y110.66 E237.5014
+G1 X169.42 Y110.35 E237.5416
+G1 X168.47 Y110.16 E237.5819
+G1 X167.5 Y110.1 E237.6221
+G1 X166.53 Y110.16 E237.6624
+G1 X165.58 Y110.35 E237.7026
+G1 X164.67 Y110.66 E237.7429
+G1 X163.8 Y111.09 E237.7831
+G1 X163 Y111.63 E237.8233
+G1 X162.27 Y112.27 E237.8636
+G1 E237.26 F1800
+G1 X72.48 Y112.52 F9000
+G1 E237.86 F1800
+G1 X71.79 Y111.91 E237.9019 F3600
+G1 X71.02 Y111.4 E237.9402
+G1 X70.19 Y111 E237.9784
+G1 X69.32 Y110.7 E238.0167
+G1 X68.42 Y110.52 E238.055
+G1 X67.5 Y110.46 E238.0933
+G1 X66.58 Y110.52 E238.1316
+G1 X65.68 Y110.7 E238.1699
+G1 X64.81 Y111 E238.2082
+G1 X63.98 Y111.4 E238.2464
+G1 X63.21 Y111.91 E238.2847
+G1 X62.52 Y112.52 E238.323
+G1 X61.91 Y113.21 E238.3613
+G1 X61.4 Y113.98 E238.3996
+G1 X61 Y114.81 E238.4379
+G1 X60.7 Y115.68 E238.4762
+G1 X60.52 Y116.58 E238.5144
+G1 X60.46 Y117.5 E238.5527
+G1 X60.52 Y118.42 E238.591
+G1 X60.7 Y119.32 E238.6293
+G1 X61 Y120.19 E238.6676
+G1 X61.4 Y121.02 E238.7059
+G1 X61.91 Y121.79 E238.7442
+G1 X62.52 Y122.48 E238.7824
+G1 X63.21 Y123.09 E238.8207
+G1 X63.98 Y123.6 E238.859
+G1 X64.81 Y124 E238.8973
+G1 X65.68 Y124.3 E238.9356
+G1 X66.58 Y124.48 E238.9739
+G1 X67.5 Y124.54 E239.0122
+G1 X68.42 Y124.48 E239.0504
+G1 X69.32 Y124.3 E239.0887
+G1 X70.19 Y124 E239.127
+G1 X71.02 Y123.6 E239.1653
+G1 X71.79 Y123.09 E239.2036
+G1 X72.48 Y122.48 E239.2419
+G1 X73.09 Y121.79 E239.2802
+G1 X73.6 Y121.02 E239.3184
+G1 X74 Y120.19 E239.3567
+G1 X74.3 Y119.32 E239.395
+G1 X74.48 Y118.42 E239.4333
+G1 X74.54 Y117.5 E239.4716
+G1 X74.48 Y116.58 E239.5099
+G1 X74.3 Y115.68 E239.5482
+G1 X74 Y114.81 E239.5864
+G1 X73.6 Y113.98 E239.6247
+G1 X73.09 Y113.21 E239.663
+G1 X72.48 Y112.52 E239.7013
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E239.7415
+G1 X71.2 Y111.09 E239.7818
+G1 X70.33 Y110.66 E239.822
+G1 X69.42 Y110.35 E239.8623
+G1 X68.47 Y110.16 E239.9025
+G1 X67.5 Y110.1 E239.9428
+G1 X66.53 Y110.16 E239.983
+G1 X65.58 Y110.35 E240.0232
+G1 X64.67 Y110.66 E240.0635
+G1 X63.8 Y111.09 E240.1037
+G1 X63 Y111.63 E240.144
+G1 X62.27 Y112.27 E240.1842
+G1 X61.63 Y113 E240.2245
+G1 X61.09 Y113.8 E240.2647
+G1 X60.66 Y114.67 E240.3049
+G1 X60.35 Y115.58 E240.3452
+G1 X60.16 Y116.53 E240.3854
+G1 X60.1 Y117.5 E240.4257
+G1 X60.16 Y118.47 E240.4659
+G1 X60.35 Y119.42 E240.5062
+G1 X60.66 Y120.33 E240.5464
+G1 X61.09 Y121.2 E240.5866
+G1 X61.63 Y122 E240.6269
+G1 X62.27 Y122.73 E240.6671
+G1 X63 Y123.37 E240.7074
+G1 X63.8 Y123.91 E240.7476
+G1 X64.67 Y124.34 E240.7879
+G1 X65.58 Y124.65 E240.8281
+G1 X66.53 Y124.84 E240.8684
+G1 X67.5 Y124.9 E240.9086
+G1 X68.47 Y124.84 E240.9488
+G1 X69.42 Y124.65 E240.9891
+G1 X70.33 Y124.34 E241.0293
+G1 X71.2 Y123.91 E241.0696
+G1 X72 Y123.37 E241.1098
+G1 X72.73 Y122.73 E241.1501
+G1 X73.37 Y122 E241.1903
+G1 X73.91 Y121.2 E241.2305
+G1 X74.34 Y120.33 E241.2708
+G1 X74.65 Y119.42 E241.311
+G1 X74.84 Y118.47 E241.3513
+G1 X74.9 Y117.5 E241.3915
+G1 X74.84 Y116.53 E241.4318
+G1 X74.65 Y115.58 E241.472
+G1 X74.34 Y114.67 E241.5122
+G1 X73.91 Y113.8 E241.5525
+G1 X73.37 Y113 E241.5927
+G1 X72.73 Y112.27 E241.633
+;layer #7
+G1 E241.03 F1800
+G1 X72.48 Y112.52 F9000
+G1 E241.63 F1800
+G1 Z1.75 F300
+G1 X71.79 Y111.91 E241.6713 F3600
+G1 X71.02 Y111.4 E241.7095
+G1 X70.19 Y111 E241.7478
+G1 X69.32 Y110.7 E241.7861
+G1 X68.42 Y110.52 E241.8244
+G1 X67.5 Y110.46 E241.8627
+G1 X66.58 Y110.52 E241.901
+G1 X65.68 Y110.7 E241.9393
+G1 X64.81 Y111 E241.9775
+G1 X63.98 Y111.4 E242.0158
+G1 X63.21 Y111.91 E242.0541
+G1 X62.52 Y112.52 E242.0924
+G1 X61.91 Y113.21 E242.1307
+G1 X61.4 Y113.98 E242.169
+G1 X61 Y114.81 E242.2073
+G1 X60.7 Y115.68 E242.2455
+G1 X60.52 Y116.58 E242.2838
+G1 X60.46 Y117.5 E242.3221
+G1 X60.52 Y118.42 E242.3604
+G1 X60.7 Y119.32 E242.3987
+G1 X61 Y120.19 E242.437
+G1 X61.4 Y121.02 E242.4753
+G1 X61.91 Y121.79 E242.5135
+G1 X62.52 Y122.48 E242.5518
+G1 X63.21 Y123.09 E242.5901
+G1 X63.98 Y123.6 E242.6284
+G1 X64.81 Y124 E242.6667
+G1 X65.68 Y124.3 E242.705
+G1 X66.58 Y124.48 E242.7433
+G1 X67.5 Y124.54 E242.7815
+G1 X68.42 Y124.48 E242.8198
+G1 X69.32 Y124.3 E242.8581
+G1 X70.19 Y124 E242.8964
+G1 X71.02 Y123.6 E242.9347
+G1 X71.79 Y123.09 E242.973
+G1 X72.48 Y122.48 E243.0113
+G1 X73.09 Y121.79 E243.0495
+G1 X73.6 Y121.02 E243.0878
+G1 X74 Y120.19 E243.1261
+G1 X74.3 Y119.32 E243.1644
+G1 X74.48 Y118.42 E243.2027
+G1 X74.54 Y117.5 E243.241
+G1 X74.48 Y116.58 E243.2793
+G1 X74.3 Y115.68 E243.3175
+G1 X74 Y114.81 E243.3558
+G1 X73.6 Y113.98 E243.3941
+G1 X73.09 Y113.21 E243.4324
+G1 X72.48 Y112.52 E243.4707
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E243.5109
+G1 X71.2 Y111.09 E243.5512
+G1 X70.33 Y110.66 E243.5914
+G1 X69.42 Y110.35 E243.6317
+G1 X68.47 Y110.16 E243.6719
+G1 X67.5 Y110.1 E243.7121
+G1 X66.53 Y110.16 E243.7524
+G1 X65.58 Y110.35 E243.7926
+G1 X64.67 Y110.66 E243.8329
+G1 X63.8 Y111.09 E243.8731
+G1 X63 Y111.63 E243.9134
+G1 X62.27 Y112.27 E243.9536
+G1 X61.63 Y113 E243.9938
+G1 X61.09 Y113.8 E244.0341
+G1 X60.66 Y114.67 E244.0743
+G1 X60.35 Y115.58 E244.1146
+G1 X60.16 Y116.53 E244.1548
+G1 X60.1 Y117.5 E244.1951
+G1 X60.16 Y118.47 E244.2353
+G1 X60.35 Y119.42 E244.2756
+G1 X60.66 Y120.33 E244.3158
+G1 X61.09 Y121.2 E244.356
+G1 X61.63 Y122 E244.3963
+G1 X62.27 Y122.73 E244.4365
+G1 X63 Y123.37 E244.4768
+G1 X63.8 Y123.91 E244.517
+G1 X64.67 Y124.34 E244.5573
+G1 X65.58 Y124.65 E244.5975
+G1 X66.53 Y124.84 E244.6377
+G1 X67.5 Y124.9 E244.678
+G1 X68.47 Y124.84 E244.7182
+G1 X69.42 Y124.65 E244.7585
+G1 X70.33 Y124.34 E244.7987
+G1 X71.2 Y123.91 E244.839
+G1 X72 Y123.37 E244.8792
+G1 X72.73 Y122.73 E244.9194
+G1 X73.37 Y122 E244.9597
+G1 X73.91 Y121.2 E244.9999
+G1 X74.34 Y120.33 E245.0402
+G1 X74.65 Y119.42 E245.0804
+G1 X74.84 Y118.47 E245.1207
+G1 X74.9 Y117.5 E245.1609
+G1 X74.84 Y116.53 E245.2011
+G1 X74.65 Y115.58 E245.2414
+G1 X74.34 Y114.67 E245.2816
+G1 X73.91 Y113.8 E245.3219
+G1 X73.37 Y113 E245.3621
+G1 X72.73 Y112.27 E245.4024
+G1 E244.8 F1800
+G1 X162.52 Y112.52 F9000
+G1 E245.4 F1800
+G1 X161.91 Y113.21 E245.4407 F3600
+G1 X161.4 Y113.98 E245.4789
+G1 X161 Y114.81 E245.5172
+G1 X160.7 Y115.68 E245.5555
+G1 X160.52 Y116.58 E245.5938
+G1 X160.46 Y117.5 E245.6321
+G1 X160.52 Y118.42 E245.6704
+G1 X160.7 Y119.32 E245.7087
+G1 X161 Y120.19 E245.7469
+G1 X161.4 Y121.02 E245.7852
+G1 X161.91 Y121.79 E245.8235
+G1 X162.52 Y122.48 E245.8618
+G1 X163.21 Y123.09 E245.9001
+G1 X163.98 Y123.6 E245.9384
+G1 X164.81 Y124 E245.9766
+G1 X165.68 Y124.3 E246.0149
+G1 X166.58 Y124.48 E246.0532
+G1 X167.5 Y124.54 E246.0915
+G1 X168.42 Y124.48 E246.1298
+G1 X169.32 Y124.3 E246.1681
+G1 X170.19 Y124 E246.2064
+G1 X171.02 Y123.6 E246.2446
+G1 X171.79 Y123.09 E246.2829
+G1 X172.48 Y122.48 E246.3212
+G1 X173.09 Y121.79 E246.3595
+G1 X173.6 Y121.02 E246.3978
+G1 X174 Y120.19 E246.4361
+G1 X174.3 Y119.32 E246.4744
+G1 X174.48 Y118.42 E246.5126
+G1 X174.54 Y117.5 E246.5509
+G1 X174.48 Y116.58 E246.5892
+G1 X174.3 Y115.68 E246.6275
+G1 X174 Y114.81 E246.6658
+G1 X173.6 Y113.98 E246.7041
+G1 X173.09 Y113.21 E246.7424
+G1 X172.48 Y112.52 E246.7806
+G1 X171.79 Y111.91 E246.8189
+G1 X171.02 Y111.4 E246.8572
+G1 X170.19 Y111 E246.8955
+G1 X169.32 Y110.7 E246.9338
+G1 X168.42 Y110.52 E246.9721
+G1 X167.5 Y110.46 E247.0104
+G1 X166.58 Y110.52 E247.0486
+G1 X165.68 Y110.7 E247.0869
+G1 X164.81 Y111 E247.1252
+G1 X163.98 Y111.4 E247.1635
+G1 X163.21 Y111.91 E247.2018
+G1 X162.52 Y112.52 E247.2401
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E247.2803
+G1 X161.09 Y113.8 E247.3206
+G1 X160.66 Y114.67 E247.3608
+G1 X160.35 Y115.58 E247.401
+G1 X160.16 Y116.53 E247.4413
+G1 X160.1 Y117.5 E247.4815
+G1 X160.16 Y118.47 E247.5218
+G1 X160.35 Y119.42 E247.562
+G1 X160.66 Y120.33 E247.6023
+G1 X161.09 Y121.2 E247.6425
+G1 X161.63 Y122 E247.6828
+G1 X162.27 Y122.73 E247.723
+G1 X163 Y123.37 E247.7632
+G1 X163.8 Y123.91 E247.8035
+G1 X164.67 Y124.34 E247.8437
+G1 X165.58 Y124.65 E247.884
+G1 X166.53 Y124.84 E247.9242
+G1 X167.5 Y124.9 E247.9645
+G1 X168.47 Y124.84 E248.0047
+G1 X169.42 Y124.65 E248.0449
+G1 X170.33 Y124.34 E248.0852
+G1 X171.2 Y123.91 E248.1254
+G1 X172 Y123.37 E248.1657
+G1 X172.73 Y122.73 E248.2059
+G1 X173.37 Y122 E248.2462
+G1 X173.91 Y121.2 E248.2864
+G1 X174.34 Y120.33 E248.3266
+G1 X174.65 Y119.42 E248.3669
+G1 X174.84 Y118.47 E248.4071
+G1 X174.9 Y117.5 E248.4474
+G1 X174.84 Y116.53 E248.4876
+G1 X174.65 Y115.58 E248.5279
+G1 X174.34 Y114.67 E248.5681
+G1 X173.91 Y113.8 E248.6083
+G1 X173.37 Y113 E248.6486
+G1 X172.73 Y112.27 E248.6888
+G1 X172 Y111.63 E248.7291
+G1 X171.2 Y111.09 E248.7693
+G1 X170.33 Y110.66 E248.8096
+G1 X169.42 Y110.35 E248.8498
+G1 X168.47 Y110.16 E248.8901
+G1 X167.5 Y110.1 E248.9303
+G1 X166.53 Y110.16 E248.9705
+G1 X165.58 Y110.35 E249.0108
+G1 X164.67 Y110.66 E249.051
+G1 X163.8 Y111.09 E249.0913
+G1 X163 Y111.63 E249.1315
+G1 X162.27 Y112.27 E249.1718
+;layer #8
+G1 E248.57 F1800
+G1 X162.52 Y112.52 F9000
+G1 E249.17 F1800
+G1 Z2 F300
+G1 X161.91 Y113.21 E249.21 F3600
+G1 X161.4 Y113.98 E249.2483
+G1 X161 Y114.81 E249.2866
+G1 X160.7 Y115.68 E249.3249
+G1 X160.52 Y116.58 E249.3632
+G1 X160.46 Y117.5 E249.4015
+G1 X160.52 Y118.42 E249.4398
+G1 X160.7 Y119.32 E249.478
+G1 X161 Y120.19 E249.5163
+G1 X161.4 Y121.02 E249.5546
+G1 X161.91 Y121.79 E249.5929
+G1 X162.52 Y122.48 E249.6312
+G1 X163.21 Y123.09 E249.6695
+G1 X163.98 Y123.6 E249.7078
+G1 X164.81 Y124 E249.746
+G1 X165.68 Y124.3 E249.7843
+G1 X166.58 Y124.48 E249.8226
+G1 X167.5 Y124.54 E249.8609
+G1 X168.42 Y124.48 E249.8992
+G1 X169.32 Y124.3 E249.9375
+G1 X170.19 Y124 E249.9758
+G1 X171.02 Y123.6 E250.014
+G1 X171.79 Y123.09 E250.0523
+G1 X172.48 Y122.48 E250.0906
+G1 X173.09 Y121.79 E250.1289
+G1 X173.6 Y121.02 E250.1672
+G1 X174 Y120.19 E250.2055
+G1 X174.3 Y119.32 E250.2438
+G1 X174.48 Y118.42 E250.282
+G1 X174.54 Y117.5 E250.3203
+G1 X174.48 Y116.58 E250.3586
+G1 X174.3 Y115.68 E250.3969
+G1 X174 Y114.81 E250.4352
+G1 X173.6 Y113.98 E250.4735
+G1 X173.09 Y113.21 E250.5118
+G1 X172.48 Y112.52 E250.55
+G1 X171.79 Y111.91 E250.5883
+G1 X171.02 Y111.4 E250.6266
+G1 X170.19 Y111 E250.6649
+G1 X169.32 Y110.7 E250.7032
+G1 X168.42 Y110.52 E250.7415
+G1 X167.5 Y110.46 E250.7797
+G1 X166.58 Y110.52 E250.818
+G1 X165.68 Y110.7 E250.8563
+G1 X164.81 Y111 E250.8946
+G1 X163.98 Y111.4 E250.9329
+G1 X163.21 Y111.91 E250.9712
+G1 X162.52 Y112.52 E251.0095
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E251.0497
+G1 X161.09 Y113.8 E251.0899
+G1 X160.66 Y114.67 E251.1302
+G1 X160.35 Y115.58 E251.1704
+G1 X160.16 Y116.53 E251.2107
+G1 X160.1 Y117.5 E251.2509
+G1 X160.16 Y118.47 E251.2912
+G1 X160.35 Y119.42 E251.3314
+G1 X160.66 Y120.33 E251.3717
+G1 X161.09 Y121.2 E251.4119
+G1 X161.63 Y122 E251.4521
+G1 X162.27 Y122.73 E251.4924
+G1 X163 Y123.37 E251.5326
+G1 X163.8 Y123.91 E251.5729
+G1 X164.67 Y124.34 E251.6131
+G1 X165.58 Y124.65 E251.6534
+G1 X166.53 Y124.84 E251.6936
+G1 X167.5 Y124.9 E251.7338
+G1 X168.47 Y124.84 E251.7741
+G1 X169.42 Y124.65 E251.8143
+G1 X170.33 Y124.34 E251.8546
+G1 X171.2 Y123.91 E251.8948
+G1 X172 Y123.37 E251.9351
+G1 X172.73 Y122.73 E251.9753
+G1 X173.37 Y122 E252.0155
+G1 X173.91 Y121.2 E252.0558
+G1 X174.34 Y120.33 E252.096
+G1 X174.65 Y119.42 E252.1363
+G1 X174.84 Y118.47 E252.1765
+G1 X174.9 Y117.5 E252.2168
+G1 X174.84 Y116.53 E252.257
+G1 X174.65 Y115.58 E252.2973
+G1 X174.34 Y114.67 E252.3375
+G1 X173.91 Y113.8 E252.3777
+G1 X173.37 Y113 E252.418
+G1 X172.73 Y112.27 E252.4582
+G1 X172 Y111.63 E252.4985
+G1 X171.2 Y111.09 E252.5387
+G1 X170.33 Y110.66 E252.579
+G1 X169.42 Y110.35 E252.6192
+G1 X168.47 Y110.16 E252.6594
+G1 X167.5 Y110.1 E252.6997
+G1 X166.53 Y110.16 E252.7399
+G1 X165.58 Y110.35 E252.7802
+G1 X164.67 Y110.66 E252.8204
+G1 X163.8 Y111.09 E252.8607
+G1 X163 Y111.63 E252.9009
+G1 X162.27 Y112.27 E252.9411
+G1 E252.34 F1800
+G1 X72.48 Y112.52 F9000
+G1 E252.94 F1800
+G1 X71.79 Y111.91 E252.9794 F3600
+G1 X71.02 Y111.4 E253.0177
+G1 X70.19 Y111 E253.056
+G1 X69.32 Y110.7 E253.0943
+G1 X68.42 Y110.52 E253.1326
+G1 X67.5 Y110.46 E253.1709
+G1 X66.58 Y110.52 E253.2091
+G1 X65.68 Y110.7 E253.2474
+G1 X64.81 Y111 E253.2857
+G1 X63.98 Y111.4 E253.324
+G1 X63.21 Y111.91 E253.3623
+G1 X62.52 Y112.52 E253.4006
+G1 X61.91 Y113.21 E253.4389
+G1 X61.4 Y113.98 E253.4771
+G1 X61 Y114.81 E253.5154
+G1 X60.7 Y115.68 E253.5537
+G1 X60.52 Y116.58 E253.592
+G1 X60.46 Y117.5 E253.6303
+G1 X60.52 Y118.42 E253.6686
+G1 X60.7 Y119.32 E253.7069
+G1 X61 Y120.19 E253.7451
+G1 X61.4 Y121.02 E253.7834
+G1 X61.91 Y121.79 E253.8217
+G1 X62.52 Y122.48 E253.86
+G1 X63.21 Y123.09 E253.8983
+G1 X63.98 Y123.6 E253.9366
+G1 X64.81 Y124 E253.9749
+G1 X65.68 Y124.3 E254.0131
+G1 X66.58 Y124.48 E254.0514
+G1 X67.5 Y124.54 E254.0897
+G1 X68.42 Y124.48 E254.128
+G1 X69.32 Y124.3 E254.1663
+G1 X70.19 Y124 E254.2046
+G1 X71.02 Y123.6 E254.2429
+G1 X71.79 Y123.09 E254.2811
+G1 X72.48 Y122.48 E254.3194
+G1 X73.09 Y121.79 E254.3577
+G1 X73.6 Y121.02 E254.396
+G1 X74 Y120.19 E254.4343
+G1 X74.3 Y119.32 E254.4726
+G1 X74.48 Y118.42 E254.5109
+G1 X74.54 Y117.5 E254.5491
+G1 X74.48 Y116.58 E254.5874
+G1 X74.3 Y115.68 E254.6257
+G1 X74 Y114.81 E254.664
+G1 X73.6 Y113.98 E254.7023
+G1 X73.09 Y113.21 E254.7406
+G1 X72.48 Y112.52 E254.7789
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E254.8191
+G1 X71.2 Y111.09 E254.8593
+G1 X70.33 Y110.66 E254.8996
+G1 X69.42 Y110.35 E254.9398
+G1 X68.47 Y110.16 E254.9801
+G1 X67.5 Y110.1 E255.0203
+G1 X66.53 Y110.16 E255.0606
+G1 X65.58 Y110.35 E255.1008
+G1 X64.67 Y110.66 E255.141
+G1 X63.8 Y111.09 E255.1813
+G1 X63 Y111.63 E255.2215
+G1 X62.27 Y112.27 E255.2618
+G1 X61.63 Y113 E255.302
+G1 X61.09 Y113.8 E255.3423
+G1 X60.66 Y114.67 E255.3825
+G1 X60.35 Y115.58 E255.4227
+G1 X60.16 Y116.53 E255.463
+G1 X60.1 Y117.5 E255.5032
+G1 X60.16 Y118.47 E255.5435
+G1 X60.35 Y119.42 E255.5837
+G1 X60.66 Y120.33 E255.624
+G1 X61.09 Y121.2 E255.6642
+G1 X61.63 Y122 E255.7044
+G1 X62.27 Y122.73 E255.7447
+G1 X63 Y123.37 E255.7849
+G1 X63.8 Y123.91 E255.8252
+G1 X64.67 Y124.34 E255.8654
+G1 X65.58 Y124.65 E255.9057
+G1 X66.53 Y124.84 E255.9459
+G1 X67.5 Y124.9 E255.9862
+G1 X68.47 Y124.84 E256.0264
+G1 X69.42 Y124.65 E256.0666
+G1 X70.33 Y124.34 E256.1069
+G1 X71.2 Y123.91 E256.1471
+G1 X72 Y123.37 E256.1874
+G1 X72.73 Y122.73 E256.2276
+G1 X73.37 Y122 E256.2679
+G1 X73.91 Y121.2 E256.3081
+G1 X74.34 Y120.33 E256.3483
+G1 X74.65 Y119.42 E256.3886
+G1 X74.84 Y118.47 E256.4288
+G1 X74.9 Y117.5 E256.4691
+G1 X74.84 Y116.53 E256.5093
+G1 X74.65 Y115.58 E256.5496
+G1 X74.34 Y114.67 E256.5898
+G1 X73.91 Y113.8 E256.63
+G1 X73.37 Y113 E256.6703
+G1 X72.73 Y112.27 E256.7105
+;layer #9
+G1 E256.51 F1800
+G1 X72.55 Y112.45 F9000
+G1 E256.71 F1800
+G1 Z2.25 F300
+G1 X71.85 Y111.84 E256.7494 F3600
+G1 X71.07 Y111.32 E256.7882
+G1 X70.23 Y110.9 E256.827
+G1 X69.35 Y110.6 E256.8659
+G1 X68.43 Y110.42 E256.9047
+G1 X67.5 Y110.36 E256.9435
+G1 X66.57 Y110.42 E256.9823
+G1 X65.65 Y110.6 E257.0212
+G1 X64.77 Y110.9 E257.06
+G1 X63.93 Y111.32 E257.0988
+G1 X63.15 Y111.84 E257.1377
+G1 X62.45 Y112.45 E257.1765
+G1 X61.84 Y113.15 E257.2153
+G1 X61.32 Y113.93 E257.2541
+G1 X60.9 Y114.77 E257.293
+G1 X60.6 Y115.65 E257.3318
+G1 X60.42 Y116.57 E257.3706
+G1 X60.36 Y117.5 E257.4095
+G1 X60.42 Y118.43 E257.4483
+G1 X60.6 Y119.35 E257.4871
+G1 X60.9 Y120.23 E257.526
+G1 X61.32 Y121.07 E257.5648
+G1 X61.84 Y121.85 E257.6036
+G1 X62.45 Y122.55 E257.6424
+G1 X63.15 Y123.16 E257.6813
+G1 X63.93 Y123.68 E257.7201
+G1 X64.77 Y124.1 E257.7589
+G1 X65.65 Y124.4 E257.7978
+G1 X66.57 Y124.58 E257.8366
+G1 X67.5 Y124.64 E257.8754
+G1 X68.43 Y124.58 E257.9142
+G1 X69.35 Y124.4 E257.9531
+G1 X70.23 Y124.1 E257.9919
+G1 X71.07 Y123.68 E258.0307
+G1 X71.85 Y123.16 E258.0696
+G1 X72.55 Y122.55 E258.1084
+G1 X73.16 Y121.85 E258.1472
+G1 X73.68 Y121.07 E258.1861
+G1 X74.1 Y120.23 E258.2249
+G1 X74.4 Y119.35 E258.2637
+G1 X74.58 Y118.43 E258.3025
+G1 X74.64 Y117.5 E258.3414
+G1 X74.58 Y116.57 E258.3802
+G1 X74.4 Y115.65 E258.419
+G1 X74.1 Y114.77 E258.4579
+G1 X73.68 Y113.93 E258.4967
+G1 X73.16 Y113.15 E258.5355
+G1 X72.55 Y112.45 E258.5743
+G1 X72.8 Y112.2
+G1 X72.07 Y111.55 E258.6151
+G1 X71.25 Y111 E258.6559
+G1 X70.37 Y110.57 E258.6967
+G1 X69.44 Y110.26 E258.7375
+G1 X68.48 Y110.06 E258.7783
+G1 X67.5 Y110 E258.8191
+G1 X66.52 Y110.06 E258.8599
+G1 X65.56 Y110.26 E258.9006
+G1 X64.63 Y110.57 E258.9414
+G1 X63.75 Y111 E258.9822
+G1 X62.93 Y111.55 E259.023
+G1 X62.2 Y112.2 E259.0638
+G1 X61.55 Y112.93 E259.1046
+G1 X61 Y113.75 E259.1454
+G1 X60.57 Y114.63 E259.1862
+G1 X60.26 Y115.56 E259.2269
+G1 X60.06 Y116.52 E259.2677
+G1 X60 Y117.5 E259.3085
+G1 X60.06 Y118.48 E259.3493
+G1 X60.26 Y119.44 E259.3901
+G1 X60.57 Y120.37 E259.4309
+G1 X61 Y121.25 E259.4717
+G1 X61.55 Y122.07 E259.5125
+G1 X62.2 Y122.8 E259.5532
+G1 X62.93 Y123.45 E259.594
+G1 X63.75 Y124 E259.6348
+G1 X64.63 Y124.43 E259.6756
+G1 X65.56 Y124.74 E259.7164
+G1 X66.52 Y124.94 E259.7572
+G1 X67.5 Y125 E259.798
+G1 X68.48 Y124.94 E259.8387
+G1 X69.44 Y124.74 E259.8795
+G1 X70.37 Y124.43 E259.9203
+G1 X71.25 Y124 E259.9611
+G1 X72.07 Y123.45 E260.0019
+G1 X72.8 Y122.8 E260.0427
+G1 X73.45 Y122.07 E260.0835
+G1 X74 Y121.25 E260.1243
+G1 X74.43 Y120.37 E260.165
+G1 X74.74 Y119.44 E260.2058
+G1 X74.94 Y118.48 E260.2466
+G1 X75 Y117.5 E260.2874
+G1 X74.94 Y116.52 E260.3282
+G1 X74.74 Y115.56 E260.369
+G1 X74.43 Y114.63 E260.4098
+G1 X74 Y113.75 E260.4506
+G1 X73.45 Y112.93 E260.4913
+G1 X72.8 Y112.2 E260.5321
+G1 E260.33 F1800
+G1 X162.45 Y112.45 F9000
+G1 E260.53 F1800
+G1 X161.84 Y113.15 E260.571 F3600
+G1 X161.32 Y113.93 E260.6098
+G1 X160.9 Y114.77 E260.6486
+G1 X160.6 Y115.65 E260.6874
+G1 X160.42 Y116.57 E260.7263
+G1 X160.36 Y117.5 E260.7651
+G1 X160.42 Y118.43 E260.8039
+G1 X160.6 Y119.35 E260.8428
+G1 X160.9 Y120.23 E260.8816
+G1 X161.32 Y121.07 E260.9204
+G1 X161.84 Y121.85 E260.9593
+G1 X162.45 Y122.55 E260.9981
+G1 X163.15 Y123.16 E261.0369
+G1 X163.93 Y123.68 E261.0757
+G1 X164.77 Y124.1 E261.1146
+G1 X165.65 Y124.4 E261.1534
+G1 X166.57 Y124.58 E261.1922
+G1 X167.5 Y124.64 E261.2311
+G1 X168.43 Y124.58 E261.2699
+G1 X169.35 Y124.4 E261.3087
+G1 X170.23 Y124.1 E261.3475
+G1 X171.07 Y123.68 E261.3864
+G1 X171.85 Y123.16 E261.4252
+G1 X172.55 Y122.55 E261.464
+G1 X173.16 Y121.85 E261.5029
+G1 X173.68 Y121.07 E261.5417
+G1 X174.1 Y120.23 E261.5805
+G1 X174.4 Y119.35 E261.6194
+G1 X174.58 Y118.43 E261.6582
+G1 X174.64 Y117.5 E261.697
+G1 X174.58 Y116.57 E261.7358
+G1 X174.4 Y115.65 E261.7747
+G1 X174.1 Y114.77 E261.8135
+G1 X173.68 Y113.93 E261.8523
+G1 X173.16 Y113.15 E261.8912
+G1 X172.55 Y112.45 E261.93
+G1 X171.85 Y111.84 E261.9688
+G1 X171.07 Y111.32 E262.0076
+G1 X170.23 Y110.9 E262.0465
+G1 X169.35 Y110.6 E262.0853
+G1 X168.43 Y110.42 E262.1241
+G1 X167.5 Y110.36 E262.163
+G1 X166.57 Y110.42 E262.2018
+G1 X165.65 Y110.6 E262.2406
+G1 X164.77 Y110.9 E262.2795
+G1 X163.93 Y111.32 E262.3183
+G1 X163.15 Y111.84 E262.3571
+G1 X162.45 Y112.45 E262.3959
+G1 X162.2 Y112.2
+G1 X161.55 Y112.93 E262.4367
+G1 X161 Y113.75 E262.4775
+G1 X160.57 Y114.63 E262.5183
+G1 X160.26 Y115.56 E262.5591
+G1 X160.06 Y116.52 E262.5999
+G1 X160 Y117.5 E262.6407
+G1 X160.06 Y118.48 E262.6815
+G1 X160.26 Y119.44 E262.7222
+G1 X160.57 Y120.37 E262.763
+G1 X161 Y121.25 E262.8038
+G1 X161.55 Y122.07 E262.8446
+G1 X162.2 Y122.8 E262.8854
+G1 X162.93 Y123.45 E262.9262
+G1 X163.75 Y124 E262.967
+G1 X164.63 Y124.43 E263.0077
+G1 X165.56 Y124.74 E263.0485
+G1 X166.52 Y124.94 E263.0893
+G1 X167.5 Y125 E263.1301
+G1 X168.48 Y124.94 E263.1709
+G1 X169.44 Y124.74 E263.2117
+G1 X170.37 Y124.43 E263.2525
+G1 X171.25 Y124 E263.2933
+G1 X172.07 Y123.45 E263.334
+G1 X172.8 Y122.8 E263.3748
+G1 X173.45 Y122.07 E263.4156
+G1 X174 Y121.25 E263.4564
+G1 X174.43 Y120.37 E263.4972
+G1 X174.74 Y119.44 E263.538
+G1 X174.94 Y118.48 E263.5788
+G1 X175 Y117.5 E263.6196
+G1 X174.94 Y116.52 E263.6603
+G1 X174.74 Y115.56 E263.7011
+G1 X174.43 Y114.63 E263.7419
+G1 X174 Y113.75 E263.7827
+G1 X173.45 Y112.93 E263.8235
+G1 X172.8 Y112.2 E263.8643
+G1 X172.07 Y111.55 E263.9051
+G1 X171.25 Y111 E263.9459
+G1 X170.37 Y110.57 E263.9866
+G1 X169.44 Y110.26 E264.0274
+G1 X168.48 Y110.06 E264.0682
+G1 X167.5 Y110 E264.109
+G1 X166.52 Y110.06 E264.1498
+G1 X165.56 Y110.26 E264.1906
+G1 X164.63 Y110.57 E264.2314
+G1 X163.75 Y111 E264.2722
+G1 X162.93 Y111.55 E264.3129
+G1 X162.2 Y112.2 E264.3537
+;layer #10
+G1 E264.15 F1800
+G1 X162.52 Y112.52 F9000
+G1 E264.35 F1800
+G1 Z2.5 F300
+G1 X161.91 Y113.21 E264.392 F3600
+G1 X161.4 Y113.98 E264.4303
+G1 X161 Y114.81 E264.4686
+G1 X160.7 Y115.68 E264.5069
+G1 X160.52 Y116.58 E264.5452
+G1 X160.46 Y117.5 E264.5834
+G1 X160.52 Y118.42 E264.6217
+G1 X160.7 Y119.32 E264.66
+G1 X161 Y120.19 E264.6983
+G1 X161.4 Y121.02 E264.7366
+G1 X161.91 Y121.79 E264.7749
+G1 X162.52 Y122.48 E264.8132
+G1 X163.21 Y123.09 E264.8514
+G1 X163.98 Y123.6 E264.8897
+G1 X164.81 Y124 E264.928
+G1 X165.68 Y124.3 E264.9663
+G1 X166.58 Y124.48 E265.0046
+G1 X167.5 Y124.54 E265.0429
+G1 X168.42 Y124.48 E265.0812
+G1 X169.32 Y124.3 E265.1194
+G1 X170.19 Y124 E265.1577
+G1 X171.02 Y123.6 E265.196
+G1 X171.79 Y123.09 E265.2343
+G1 X172.48 Y122.48 E265.2726
+G1 X173.09 Y121.79 E265.3109
+G1 X173.6 Y121.02 E265.3492
+G1 X174 Y120.19 E265.3874
+G1 X174.3 Y119.32 E265.4257
+G1 X174.48 Y118.42 E265.464
+G1 X174.54 Y117.5 E265.5023
+G1 X174.48 Y116.58 E265.5406
+G1 X174.3 Y115.68 E265.5789
+G1 X174 Y114.81 E265.6172
+G1 X173.6 Y113.98 E265.6554
+G1 X173.09 Y113.21 E265.6937
+G1 X172.48 Y112.52 E265.732
+G1 X171.79 Y111.91 E265.7703
+G1 X171.02 Y111.4 E265.8086
+G1 X170.19 Y111 E265.8469
+G1 X169.32 Y110.7 E265.8852
+G1 X168.42 Y110.52 E265.9234
+G1 X167.5 Y110.46 E265.9617
+G1 X166.58 Y110.52 E266
+G1 X165.68 Y110.7 E266.0383
+G1 X164.81 Y111 E266.0766
+G1 X163.98 Y111.4 E266.1149
+G1 X163.21 Y111.91 E266.1531
+G1 X162.52 Y112.52 E266.1914
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E266.2317
+G1 X161.09 Y113.8 E266.2719
+G1 X160.66 Y114.67 E266.3122
+G1 X160.35 Y115.58 E266.3524
+G1 X160.16 Y116.53 E266.3927
+G1 X160.1 Y117.5 E266.4329
+G1 X160.16 Y118.47 E266.4731
+G1 X160.35 Y119.42 E266.5134
+G1 X160.66 Y120.33 E266.5536
+G1 X161.09 Y121.2 E266.5939
+G1 X161.63 Y122 E266.6341
+G1 X162.27 Y122.73 E266.6744
+G1 X163 Y123.37 E266.7146
+G1 X163.8 Y123.91 E266.7548
+G1 X164.67 Y124.34 E266.7951
+G1 X165.58 Y124.65 E266.8353
+G1 X166.53 Y124.84 E266.8756
+G1 X167.5 Y124.9 E266.9158
+G1 X168.47 Y124.84 E266.9561
+G1 X169.42 Y124.65 E266.9963
+G1 X170.33 Y124.34 E267.0365
+G1 X171.2 Y123.91 E267.0768
+G1 X172 Y123.37 E267.117
+G1 X172.73 Y122.73 E267.1573
+G1 X173.37 Y122 E267.1975
+G1 X173.91 Y121.2 E267.2378
+G1 X174.34 Y120.33 E267.278
+G1 X174.65 Y119.42 E267.3182
+G1 X174.84 Y118.47 E267.3585
+G1 X174.9 Y117.5 E267.3987
+G1 X174.84 Y116.53 E267.439
+G1 X174.65 Y115.58 E267.4792
+G1 X174.34 Y114.67 E267.5195
+G1 X173.91 Y113.8 E267.5597
+G1 X173.37 Y113 E267.6
+G1 X172.73 Y112.27 E267.6402
+G1 X172 Y111.63 E267.6804
+G1 X171.2 Y111.09 E267.7207
+G1 X170.33 Y110.66 E267.7609
+G1 X169.42 Y110.35 E267.8012
+G1 X168.47 Y110.16 E267.8414
+G1 X167.5 Y110.1 E267.8817
+G1 X166.53 Y110.16 E267.9219
+G1 X165.58 Y110.35 E267.9621
+G1 X164.67 Y110.66 E268.0024
+G1 X163.8 Y111.09 E268.0426
+G1 X163 Y111.63 E268.0829
+G1 X162.27 Y112.27 E268.1231
+G1 E267.92 F1800
+G1 X72.48 Y112.52 F9000
+G1 E268.12 F1800
+G1 X71.79 Y111.91 E268.1614 F3600
+G1 X71.02 Y111.4 E268.1997
+G1 X70.19 Y111 E268.238
+G1 X69.32 Y110.7 E268.2763
+G1 X68.42 Y110.52 E268.3145
+G1 X67.5 Y110.46 E268.3528
+G1 X66.58 Y110.52 E268.3911
+G1 X65.68 Y110.7 E268.4294
+G1 X64.81 Y111 E268.4677
+G1 X63.98 Y111.4 E268.506
+G1 X63.21 Y111.91 E268.5443
+G1 X62.52 Y112.52 E268.5825
+G1 X61.91 Y113.21 E268.6208
+G1 X61.4 Y113.98 E268.6591
+G1 X61 Y114.81 E268.6974
+G1 X60.7 Y115.68 E268.7357
+G1 X60.52 Y116.58 E268.774
+G1 X60.46 Y117.5 E268.8123
+G1 X60.52 Y118.42 E268.8505
+G1 X60.7 Y119.32 E268.8888
+G1 X61 Y120.19 E268.9271
+G1 X61.4 Y121.02 E268.9654
+G1 X61.91 Y121.79 E269.0037
+G1 X62.52 Y122.48 E269.042
+G1 X63.21 Y123.09 E269.0803
+G1 X63.98 Y123.6 E269.1185
+G1 X64.81 Y124 E269.1568
+G1 X65.68 Y124.3 E269.1951
+G1 X66.58 Y124.48 E269.2334
+G1 X67.5 Y124.54 E269.2717
+G1 X68.42 Y124.48 E269.31
+G1 X69.32 Y124.3 E269.3483
+G1 X70.19 Y124 E269.3865
+G1 X71.02 Y123.6 E269.4248
+G1 X71.79 Y123.09 E269.4631
+G1 X72.48 Y122.48 E269.5014
+G1 X73.09 Y121.79 E269.5397
+G1 X73.6 Y121.02 E269.578
+G1 X74 Y120.19 E269.6163
+G1 X74.3 Y119.32 E269.6545
+G1 X74.48 Y118.42 E269.6928
+G1 X74.54 Y117.5 E269.7311
+G1 X74.48 Y116.58 E269.7694
+G1 X74.3 Y115.68 E269.8077
+G1 X74 Y114.81 E269.846
+G1 X73.6 Y113.98 E269.8843
+G1 X73.09 Y113.21 E269.9225
+G1 X72.48 Y112.52 E269.9608
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E270.0011
+G1 X71.2 Y111.09 E270.0413
+G1 X70.33 Y110.66 E270.0816
+G1 X69.42 Y110.35 E270.1218
+G1 X68.47 Y110.16 E270.162
+G1 X67.5 Y110.1 E270.2023
+G1 X66.53 Y110.16 E270.2425
+G1 X65.58 Y110.35 E270.2828
+G1 X64.67 Y110.66 E270.323
+G1 X63.8 Y111.09 E270.3633
+G1 X63 Y111.63 E270.4035
+G1 X62.27 Y112.27 E270.4437
+G1 X61.63 Y113 E270.484
+G1 X61.09 Y113.8 E270.5242
+G1 X60.66 Y114.67 E270.5645
+G1 X60.35 Y115.58 E270.6047
+G1 X60.16 Y116.53 E270.645
+G1 X60.1 Y117.5 E270.6852
+G1 X60.16 Y118.47 E270.7254
+G1 X60.35 Y119.42 E270.7657
+G1 X60.66 Y120.33 E270.8059
+G1 X61.09 Y121.2 E270.8462
+G1 X61.63 Y122 E270.8864
+G1 X62.27 Y122.73 E270.9267
+G1 X63 Y123.37 E270.9669
+G1 X63.8 Y123.91 E271.0072
+G1 X64.67 Y124.34 E271.0474
+G1 X65.58 Y124.65 E271.0876
+G1 X66.53 Y124.84 E271.1279
+G1 X67.5 Y124.9 E271.1681
+G1 X68.47 Y124.84 E271.2084
+G1 X69.42 Y124.65 E271.2486
+G1 X70.33 Y124.34 E271.2889
+G1 X71.2 Y123.91 E271.3291
+G1 X72 Y123.37 E271.3693
+G1 X72.73 Y122.73 E271.4096
+G1 X73.37 Y122 E271.4498
+G1 X73.91 Y121.2 E271.4901
+G1 X74.34 Y120.33 E271.5303
+G1 X74.65 Y119.42 E271.5706
+G1 X74.84 Y118.47 E271.6108
+G1 X74.9 Y117.5 E271.651
+G1 X74.84 Y116.53 E271.6913
+G1 X74.65 Y115.58 E271.7315
+G1 X74.34 Y114.67 E271.7718
+G1 X73.91 Y113.8 E271.812
+G1 X73.37 Y113 E271.8523
+G1 X72.73 Y112.27 E271.8925
+;layer #11
+G1 E271.69 F1800
+G1 X72.48 Y112.52 F9000
+G1 E271.89 F1800
+G1 Z2.75 F300
+G1 X71.79 Y111.91 E271.9308 F3600
+G1 X71.02 Y111.4 E271.9691
+G1 X70.19 Y111 E272.0074
+G1 X69.32 Y110.7 E272.0456
+G1 X68.42 Y110.52 E272.0839
+G1 X67.5 Y110.46 E272.1222
+G1 X66.58 Y110.52 E272.1605
+G1 X65.68 Y110.7 E272.1988
+G1 X64.81 Y111 E272.2371
+G1 X63.98 Y111.4 E272.2754
+G1 X63.21 Y111.91 E272.3136
+G1 X62.52 Y112.52 E272.3519
+G1 X61.91 Y113.21 E272.3902
+G1 X61.4 Y113.98 E272.4285
+G1 X61 Y114.81 E272.4668
+G1 X60.7 Y115.68 E272.5051
+G1 X60.52 Y116.58 E272.5434
+G1 X60.46 Y117.5 E272.5816
+G1 X60.52 Y118.42 E272.6199
+G1 X60.7 Y119.32 E272.6582
+G1 X61 Y120.19 E272.6965
+G1 X61.4 Y121.02 E272.7348
+G1 X61.91 Y121.79 E272.7731
+G1 X62.52 Y122.48 E272.8114
+G1 X63.21 Y123.09 E272.8496
+G1 X63.98 Y123.6 E272.8879
+G1 X64.81 Y124 E272.9262
+G1 X65.68 Y124.3 E272.9645
+G1 X66.58 Y124.48 E273.0028
+G1 X67.5 Y124.54 E273.0411
+G1 X68.42 Y124.48 E273.0794
+G1 X69.32 Y124.3 E273.1176
+G1 X70.19 Y124 E273.1559
+G1 X71.02 Y123.6 E273.1942
+G1 X71.79 Y123.09 E273.2325
+G1 X72.48 Y122.48 E273.2708
+G1 X73.09 Y121.79 E273.3091
+G1 X73.6 Y121.02 E273.3474
+G1 X74 Y120.19 E273.3856
+G1 X74.3 Y119.32 E273.4239
+G1 X74.48 Y118.42 E273.4622
+G1 X74.54 Y117.5 E273.5005
+G1 X74.48 Y116.58 E273.5388
+G1 X74.3 Y115.68 E273.5771
+G1 X74 Y114.81 E273.6154
+G1 X73.6 Y113.98 E273.6536
+G1 X73.09 Y113.21 E273.6919
+G1 X72.48 Y112.52 E273.7302
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E273.7705
+G1 X71.2 Y111.09 E273.8107
+G1 X70.33 Y110.66 E273.8509
+G1 X69.42 Y110.35 E273.8912
+G1 X68.47 Y110.16 E273.9314
+G1 X67.5 Y110.1 E273.9717
+G1 X66.53 Y110.16 E274.0119
+G1 X65.58 Y110.35 E274.0522
+G1 X64.67 Y110.66 E274.0924
+G1 X63.8 Y111.09 E274.1326
+G1 X63 Y111.63 E274.1729
+G1 X62.27 Y112.27 E274.2131
+G1 X61.63 Y113 E274.2534
+G1 X61.09 Y113.8 E274.2936
+G1 X60.66 Y114.67 E274.3339
+G1 X60.35 Y115.58 E274.3741
+G1 X60.16 Y116.53 E274.4144
+G1 X60.1 Y117.5 E274.4546
+G1 X60.16 Y118.47 E274.4948
+G1 X60.35 Y119.42 E274.5351
+G1 X60.66 Y120.33 E274.5753
+G1 X61.09 Y121.2 E274.6156
+G1 X61.63 Y122 E274.6558
+G1 X62.27 Y122.73 E274.6961
+G1 X63 Y123.37 E274.7363
+G1 X63.8 Y123.91 E274.7765
+G1 X64.67 Y124.34 E274.8168
+G1 X65.58 Y124.65 E274.857
+G1 X66.53 Y124.84 E274.8973
+G1 X67.5 Y124.9 E274.9375
+G1 X68.47 Y124.84 E274.9778
+G1 X69.42 Y124.65 E275.018
+G1 X70.33 Y124.34 E275.0582
+G1 X71.2 Y123.91 E275.0985
+G1 X72 Y123.37 E275.1387
+G1 X72.73 Y122.73 E275.179
+G1 X73.37 Y122 E275.2192
+G1 X73.91 Y121.2 E275.2595
+G1 X74.34 Y120.33 E275.2997
+G1 X74.65 Y119.42 E275.3399
+G1 X74.84 Y118.47 E275.3802
+G1 X74.9 Y117.5 E275.4204
+G1 X74.84 Y116.53 E275.4607
+G1 X74.65 Y115.58 E275.5009
+G1 X74.34 Y114.67 E275.5412
+G1 X73.91 Y113.8 E275.5814
+G1 X73.37 Y113 E275.6217
+G1 X72.73 Y112.27 E275.6619
+G1 E275.46 F1800
+G1 X162.52 Y112.52 F9000
+G1 E275.66 F1800
+G1 X161.91 Y113.21 E275.7002 F3600
+G1 X161.4 Y113.98 E275.7385
+G1 X161 Y114.81 E275.7768
+G1 X160.7 Y115.68 E275.815
+G1 X160.52 Y116.58 E275.8533
+G1 X160.46 Y117.5 E275.8916
+G1 X160.52 Y118.42 E275.9299
+G1 X160.7 Y119.32 E275.9682
+G1 X161 Y120.19 E276.0065
+G1 X161.4 Y121.02 E276.0448
+G1 X161.91 Y121.79 E276.083
+G1 X162.52 Y122.48 E276.1213
+G1 X163.21 Y123.09 E276.1596
+G1 X163.98 Y123.6 E276.1979
+G1 X164.81 Y124 E276.2362
+G1 X165.68 Y124.3 E276.2745
+G1 X166.58 Y124.48 E276.3127
+G1 X167.5 Y124.54 E276.351
+G1 X168.42 Y124.48 E276.3893
+G1 X169.32 Y124.3 E276.4276
+G1 X170.19 Y124 E276.4659
+G1 X171.02 Y123.6 E276.5042
+G1 X171.79 Y123.09 E276.5425
+G1 X172.48 Y122.48 E276.5807
+G1 X173.09 Y121.79 E276.619
+G1 X173.6 Y121.02 E276.6573
+G1 X174 Y120.19 E276.6956
+G1 X174.3 Y119.32 E276.7339
+G1 X174.48 Y118.42 E276.7722
+G1 X174.54 Y117.5 E276.8105
+G1 X174.48 Y116.58 E276.8487
+G1 X174.3 Y115.68 E276.887
+G1 X174 Y114.81 E276.9253
+G1 X173.6 Y113.98 E276.9636
+G1 X173.09 Y113.21 E277.0019
+G1 X172.48 Y112.52 E277.0402
+G1 X171.79 Y111.91 E277.0785
+G1 X171.02 Y111.4 E277.1167
+G1 X170.19 Y111 E277.155
+G1 X169.32 Y110.7 E277.1933
+G1 X168.42 Y110.52 E277.2316
+G1 X167.5 Y110.46 E277.2699
+G1 X166.58 Y110.52 E277.3082
+G1 X165.68 Y110.7 E277.3465
+G1 X164.81 Y111 E277.3847
+G1 X163.98 Y111.4 E277.423
+G1 X163.21 Y111.91 E277.4613
+G1 X162.52 Y112.52 E277.4996
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E277.5398
+G1 X161.09 Y113.8 E277.5801
+G1 X160.66 Y114.67 E277.6203
+G1 X160.35 Y115.58 E277.6606
+G1 X160.16 Y116.53 E277.7008
+G1 X160.1 Y117.5 E277.7411
+G1 X160.16 Y118.47 E277.7813
+G1 X160.35 Y119.42 E277.8215
+G1 X160.66 Y120.33 E277.8618
+G1 X161.09 Y121.2 E277.902
+G1 X161.63 Y122 E277.9423
+G1 X162.27 Y122.73 E277.9825
+G1 X163 Y123.37 E278.0228
+G1 X163.8 Y123.91 E278.063
+G1 X164.67 Y124.34 E278.1033
+G1 X165.58 Y124.65 E278.1435
+G1 X166.53 Y124.84 E278.1837
+G1 X167.5 Y124.9 E278.224
+G1 X168.47 Y124.84 E278.2642
+G1 X169.42 Y124.65 E278.3045
+G1 X170.33 Y124.34 E278.3447
+G1 X171.2 Y123.91 E278.385
+G1 X172 Y123.37 E278.4252
+G1 X172.73 Y122.73 E278.4654
+G1 X173.37 Y122 E278.5057
+G1 X173.91 Y121.2 E278.5459
+G1 X174.34 Y120.33 E278.5862
+G1 X174.65 Y119.42 E278.6264
+G1 X174.84 Y118.47 E278.6667
+G1 X174.9 Y117.5 E278.7069
+G1 X174.84 Y116.53 E278.7471
+G1 X174.65 Y115.58 E278.7874
+G1 X174.34 Y114.67 E278.8276
+G1 X173.91 Y113.8 E278.8679
+G1 X173.37 Y113 E278.9081
+G1 X172.73 Y112.27 E278.9484
+G1 X172 Y111.63 E278.9886
+G1 X171.2 Y111.09 E279.0288
+G1 X170.33 Y110.66 E279.0691
+G1 X169.42 Y110.35 E279.1093
+G1 X168.47 Y110.16 E279.1496
+G1 X167.5 Y110.1 E279.1898
+G1 X166.53 Y110.16 E279.2301
+G1 X165.58 Y110.35 E279.2703
+G1 X164.67 Y110.66 E279.3106
+G1 X163.8 Y111.09 E279.3508
+G1 X163 Y111.63 E279.391
+G1 X162.27 Y112.27 E279.4313
+;layer #12
+G1 E279.23 F1800
+G1 X162.52 Y112.52 F9000
+G1 E279.43 F1800
+G1 Z3 F300
+G1 X161.91 Y113.21 E279.4696 F3600
+G1 X161.4 Y113.98 E279.5079
+G1 X161 Y114.81 E279.5461
+G1 X160.7 Y115.68 E279.5844
+G1 X160.52 Y116.58 E279.6227
+G1 X160.46 Y117.5 E279.661
+G1 X160.52 Y118.42 E279.6993
+G1 X160.7 Y119.32 E279.7376
+G1 X161 Y120.19 E279.7759
+G1 X161.4 Y121.02 E279.8141
+G1 X161.91 Y121.79 E279.8524
+G1 X162.52 Y122.48 E279.8907
+G1 X163.21 Y123.09 E279.929
+G1 X163.98 Y123.6 E279.9673
+G1 X164.81 Y124 E280.0056
+G1 X165.68 Y124.3 E280.0439
+G1 X166.58 Y124.48 E280.0821
+G1 X167.5 Y124.54 E280.1204
+G1 X168.42 Y124.48 E280.1587
+G1 X169.32 Y124.3 E280.197
+G1 X170.19 Y124 E280.2353
+G1 X171.02 Y123.6 E280.2736
+G1 X171.79 Y123.09 E280.3119
+G1 X172.48 Y122.48 E280.3501
+G1 X173.09 Y121.79 E280.3884
+G1 X173.6 Y121.02 E280.4267
+G1 X174 Y120.19 E280.465
+G1 X174.3 Y119.32 E280.5033
+G1 X174.48 Y118.42 E280.5416
+G1 X174.54 Y117.5 E280.5799
+G1 X174.48 Y116.58 E280.6181
+G1 X174.3 Y115.68 E280.6564
+G1 X174 Y114.81 E280.6947
+G1 X173.6 Y113.98 E280.733
+G1 X173.09 Y113.21 E280.7713
+G1 X172.48 Y112.52 E280.8096
+G1 X171.79 Y111.91 E280.8478
+G1 X171.02 Y111.4 E280.8861
+G1 X170.19 Y111 E280.9244
+G1 X169.32 Y110.7 E280.9627
+G1 X168.42 Y110.52 E281.001
+G1 X167.5 Y110.46 E281.0393
+G1 X166.58 Y110.52 E281.0776
+G1 X165.68 Y110.7 E281.1158
+G1 X164.81 Y111 E281.1541
+G1 X163.98 Y111.4 E281.1924
+G1 X163.21 Y111.91 E281.2307
+G1 X162.52 Y112.52 E281.269
+G1 X162.27 Y112.27
+G1 X161.63 Y113 E281.3092
+G1 X161.09 Y113.8 E281.3495
+G1 X160.66 Y114.67 E281.3897
+G1 X160.35 Y115.58 E281.43
+G1 X160.16 Y116.53 E281.4702
+G1 X160.1 Y117.5 E281.5105
+G1 X160.16 Y118.47 E281.5507
+G1 X160.35 Y119.42 E281.5909
+G1 X160.66 Y120.33 E281.6312
+G1 X161.09 Y121.2 E281.6714
+G1 X161.63 Y122 E281.7117
+G1 X162.27 Y122.73 E281.7519
+G1 X163 Y123.37 E281.7922
+G1 X163.8 Y123.91 E281.8324
+G1 X164.67 Y124.34 E281.8726
+G1 X165.58 Y124.65 E281.9129
+G1 X166.53 Y124.84 E281.9531
+G1 X167.5 Y124.9 E281.9934
+G1 X168.47 Y124.84 E282.0336
+G1 X169.42 Y124.65 E282.0739
+G1 X170.33 Y124.34 E282.1141
+G1 X171.2 Y123.91 E282.1543
+G1 X172 Y123.37 E282.1946
+G1 X172.73 Y122.73 E282.2348
+G1 X173.37 Y122 E282.2751
+G1 X173.91 Y121.2 E282.3153
+G1 X174.34 Y120.33 E282.3556
+G1 X174.65 Y119.42 E282.3958
+G1 X174.84 Y118.47 E282.436
+G1 X174.9 Y117.5 E282.4763
+G1 X174.84 Y116.53 E282.5165
+G1 X174.65 Y115.58 E282.5568
+G1 X174.34 Y114.67 E282.597
+G1 X173.91 Y113.8 E282.6373
+G1 X173.37 Y113 E282.6775
+G1 X172.73 Y112.27 E282.7178
+G1 X172 Y111.63 E282.758
+G1 X171.2 Y111.09 E282.7982
+G1 X170.33 Y110.66 E282.8385
+G1 X169.42 Y110.35 E282.8787
+G1 X168.47 Y110.16 E282.919
+G1 X167.5 Y110.1 E282.9592
+G1 X166.53 Y110.16 E282.9995
+G1 X165.58 Y110.35 E283.0397
+G1 X164.67 Y110.66 E283.0799
+G1 X163.8 Y111.09 E283.1202
+G1 X163 Y111.63 E283.1604
+G1 X162.27 Y112.27 E283.2007
+G1 E283 F1800
+G1 X72.48 Y112.52 F9000
+G1 E283.2 F1800
+G1 X71.79 Y111.91 E283.239 F3600
+G1 X71.02 Y111.4 E283.2772
+G1 X70.19 Y111 E283.3155
+G1 X69.32 Y110.7 E283.3538
+G1 X68.42 Y110.52 E283.3921
+G1 X67.5 Y110.46 E283.4304
+G1 X66.58 Y110.52 E283.4687
+G1 X65.68 Y110.7 E283.507
+G1 X64.81 Y111 E283.5452
+G1 X63.98 Y111.4 E283.5835
+G1 X63.21 Y111.91 E283.6218
+G1 X62.52 Y112.52 E283.6601
+G1 X61.91 Y113.21 E283.6984
+G1 X61.4 Y113.98 E283.7367
+G1 X61 Y114.81 E283.775
+G1 X60.7 Y115.68 E283.8132
+G1 X60.52 Y116.58 E283.8515
+G1 X60.46 Y117.5 E283.8898
+G1 X60.52 Y118.42 E283.9281
+G1 X60.7 Y119.32 E283.9664
+G1 X61 Y120.19 E284.0047
+G1 X61.4 Y121.02 E284.043
+G1 X61.91 Y121.79 E284.0812
+G1 X62.52 Y122.48 E284.1195
+G1 X63.21 Y123.09 E284.1578
+G1 X63.98 Y123.6 E284.1961
+G1 X64.81 Y124 E284.2344
+G1 X65.68 Y124.3 E284.2727
+G1 X66.58 Y124.48 E284.311
+G1 X67.5 Y124.54 E284.3492
+G1 X68.42 Y124.48 E284.3875
+G1 X69.32 Y124.3 E284.4258
+G1 X70.19 Y124 E284.4641
+G1 X71.02 Y123.6 E284.5024
+G1 X71.79 Y123.09 E284.5407
+G1 X72.48 Y122.48 E284.579
+G1 X73.09 Y121.79 E284.6172
+G1 X73.6 Y121.02 E284.6555
+G1 X74 Y120.19 E284.6938
+G1 X74.3 Y119.32 E284.7321
+G1 X74.48 Y118.42 E284.7704
+G1 X74.54 Y117.5 E284.8087
+G1 X74.48 Y116.58 E284.847
+G1 X74.3 Y115.68 E284.8852
+G1 X74 Y114.81 E284.9235
+G1 X73.6 Y113.98 E284.9618
+G1 X73.09 Y113.21 E285.0001
+G1 X72.48 Y112.52 E285.0384
+G1 X72.73 Y112.27
+G1 X72 Y111.63 E285.0786
+G1 X71.2 Y111.09 E285.1189
+G1 X70.33 Y110.66 E285.1591
+G1 X69.42 Y110.35 E285.1994
+G1 X68.47 Y110.16 E285.2396
+G1 X67.5 Y110.1 E285.2798
+G1 X66.53 Y110.16 E285.3201
+G1 X65.58 Y110.35 E285.3603
+G1 X64.67 Y110.66 E285.4006
+G1 X63.8 Y111.09 E285.4408
+G1 X63 Y111.63 E285.4811
+G1 X62.27 Y112.27 E285.5213
+G1 X61.63 Y113 E285.5615
+G1 X61.09 Y113.8 E285.6018
+G1 X60.66 Y114.67 E285.642
+G1 X60.35 Y115.58 E285.6823
+G1 X60.16 Y116.53 E285.7225
+G1 X60.1 Y117.5 E285.7628
+G1 X60.16 Y118.47 E285.803
+G1 X60.35 Y119.42 E285.8432
+G1 X60.66 Y120.33 E285.8835
+G1 X61.09 Y121.2 E285.9237
+G1 X61.63 Y122 E285.964
+G1 X62.27 Y122.73 E286.0042
+G1 X63 Y123.37 E286.0445
+G1 X63.8 Y123.91 E286.0847
+G1 X64.67 Y124.34 E286.125
+G1 X65.58 Y124.65 E286.1652
+G1 X66.53 Y124.84 E286.2054
+G1 X67.5 Y124.9 E286.2457
+G1 X68.47 Y124.84 E286.2859
+G1 X69.42 Y124.65 E286.3262
+G1 X70.33 Y124.34 E286.3664
+G1 X71.2 Y123.91 E286.4067
+G1 X72 Y123.37 E286.4469
+G1 X72.73 Y122.73 E286.4871
+G1 X73.37 Y122 E286.5274
+G1 X73.91 Y121.2 E286.5676
+G1 X74.34 Y120.33 E286.6079
+G1 X74.65 Y119.42 E286.6481
+G1 X74.84 Y118.47 E286.6884
+G1 X74.9 Y117.5 E286.7286
+G1 X74.84 Y116.53 E286.7688
+G1 X74.65 Y115.58 E286.8091
+G1 X74.34 Y114.67 E286.8493
+G1 X73.91 Y113.8 E286.8896
+G1 X73.37 Y113 E286.9298
+G1 X72.73 Y112.27 E286.9701
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,853 @@
+; generated by K3D Retraction calibration towers generator golden
+; Written by Dmitry Sorkin @ http://k3d.tech/, Kekht and YTKAB0BP
+;Bedsize: 235:235 [mm]
+;Firmware (0-Marlin, 1-Klipper, 2-RRF): 0
+;Z-offset: 0 [mm]
+;Delta: false
+;G29: false
+;Temp: 210/60 [°C]
+;Flow: 100
+;Fan: 99.6
+;Line width: 0.4 [mm]
+;First layer line width: 0.4 [mm]
+;Layer height: 0.25 [mm]
+;Print speed: 60 [mm/s]
+;First layer print speed: 30 [mm/s]
+;Travel speed: 150 [mm/s]
+;K-Factor: 0 [s]
+;Segment height: 1 [mm]
+;Towers spacing: 100 [mm]
+;Hardmode: false
+;Tower size: 15 [mm], wall spacing: 120%, raft margin: 7.5 [mm]
+;Tower shape: triangle
+;Matrix: 2 pairs, retraction length from bottom to top, speed from front to back
+;Segment 2:   0.2mm @ 30-30mm/s
+;Segment 1:   1mm @ 30-30mm/s
+;Pair 1:   30mm/s
+;Pair 2:   30mm/s
+M900 K0 ;set k-factor for Linear/Pressure Advance
+M190 S60 ;heat bed to the temperature from settings
+M109 S210 ;heat hotend to the temperature from settings
+G28 ;home all axes
+ ;probe bed heightmap
+G90 ;absolute positioning
+G92 E0 ;reset extruder position
+M220 S100 ;speed multiplier 100%
+M221 S100 ;flow multiplier from settings
+M82
+M106 S84
+G1 Z0.25 F450
+G92 Z0.25
+G1 E-1 F1800
+G1 X52.5 Y72.5 F9000
+G1 E0 F1800
+G1 X182.5 E8.1072 F1800
+G1 Y73.1 F1800
+G1 X52.5 E16.2143 F1800
+G1 E15.21 F1800
+G1 X52.8 Y112.2 F9000
+G1 E16.21 F1800
+G1 Y111.28 E16.2764 F1800
+G1 X53.72 Y112.2 E16.3641 F1800
+G1 X54.64 E16.4261 F1800
+G1 X52.8 Y110.36 E16.6016 F1800
+G1 Y109.44 E16.6636 F1800
+G1 X55.56 Y112.2 E16.9268 F1800
+G1 X56.47 E16.9889 F1800
+G1 X52.8 Y108.53 E17.3398 F1800
+G1 Y107.61 E17.4018 F1800
+G1 X57.39 Y112.2 E17.8405 F1800
+G1 X58.31 E17.9026 F1800
+G1 X52.8 Y106.69 E18.429 F1800
+G1 Y105.77 E18.491 F1800
+G1 X59.23 Y112.2 E19.1051 F1800
+G1 X60.15 E19.1672 F1800
+G1 X52.8 Y104.85 E19.869 F1800
+G1 Y103.93 E19.9311 F1800
+G1 X61.07 Y112.2 E20.7207 F1800
+G1 X61.99 E20.7827 F1800
+G1 X52.8 Y103.01 E21.6601 F1800
+G1 Y102.09 E21.7221 F1800
+G1 X62.91 Y112.2 E22.6872 F1800
+G1 X63.83 E22.7492 F1800
+G1 X52.8 Y101.18 E23.802 F1800
+G1 Y100.26 E23.8641 F1800
+G1 X64.74 Y112.2 E25.0046 F1800
+G1 X65.66 E25.0666 F1800
+G1 X52.8 Y99.34 E26.2949 F1800
+G1 Y98.42 E26.357 F1800
+G1 X66.58 Y112.2 E27.673 F1800
+G1 X67.5 E27.735 F1800
+G1 X52.8 Y97.5 E29.1388 F1800
+G1 Y96.58 E29.2008 F1800
+G1 X68.42 Y112.2 E30.6923 F1800
+G1 X69.34 E30.7543 F1800
+G1 X52.8 Y95.66 E32.3335 F1800
+G1 Y94.74 E32.3956 F1800
+G1 X70.26 Y112.2 E34.0625 F1800
+G1 X71.18 E34.1246 F1800
+G1 X52.8 Y93.82 E35.8792 F1800
+G1 Y92.91 E35.9413 F1800
+G1 X72.09 Y112.2 E37.7837 F1800
+G1 X73.01 E37.8457 F1800
+G1 X52.8 Y91.99 E39.7759 F1800
+G1 Y91.07 E39.8379 F1800
+G1 X73.93 Y112.2 E41.8558 F1800
+G1 X74.85 E41.9178 F1800
+G1 X52.8 Y90.15 E44.0235 F1800
+G1 Y89.23 E44.0855 F1800
+G1 X75.77 Y112.2 E46.2789 F1800
+G1 X76.69 E46.3409 F1800
+G1 X52.8 Y88.31 E48.622 F1800
+G1 Y87.39 E48.684 F1800
+G1 X77.61 Y112.2 E51.0528 F1800
+G1 X78.52 E51.1149 F1800
+G1 X52.8 Y86.48 E53.5714 F1800
+G1 Y85.56 E53.6335 F1800
+G1 X79.44 Y112.2 E56.1778 F1800
+G1 X80.36 E56.2398 F1800
+G1 X52.8 Y84.64 E58.8718 F1800
+G1 Y83.72 E58.9339 F1800
+G1 X81.28 Y112.2 E61.6536 F1800
+G1 X82.2 E61.7157 F1800
+G1 X52.8 Y82.8 E64.5232 F1800
+G1 X53.72 E64.5852 F1800
+G1 X82.2 Y111.28 E67.3049 F1800
+G1 Y110.36 E67.367 F1800
+G1 X54.64 Y82.8 E69.999 F1800
+G1 X55.56 E70.061 F1800
+G1 X82.2 Y109.44 E72.6053 F1800
+G1 Y108.53 E72.6674 F1800
+G1 X56.48 Y82.8 E75.1239 F1800
+G1 X57.39 E75.186 F1800
+G1 X82.2 Y107.61 E77.5548 F1800
+G1 Y106.69 E77.6168 F1800
+G1 X58.31 Y82.8 E79.8979 F1800
+G1 X59.23 E79.96 F1800
+G1 X82.2 Y105.77 E82.1533 F1800
+G1 Y104.85 E82.2153 F1800
+G1 X60.15 Y82.8 E84.321 F1800
+G1 X61.07 E84.383 F1800
+G1 X82.2 Y103.93 E86.4009 F1800
+G1 Y103.01 E86.4629 F1800
+G1 X61.99 Y82.8 E88.3931 F1800
+G1 X62.91 E88.4551 F1800
+G1 X82.2 Y102.09 E90.2975 F1800
+G1 Y101.18 E90.3596 F1800
+G1 X63.83 Y82.8 E92.1143 F1800
+G1 X64.74 E92.1763 F1800
+G1 X82.2 Y100.26 E93.8432 F1800
+G1 Y99.34 E93.9053 F1800
+G1 X65.66 Y82.8 E95.4845 F1800
+G1 X66.58 E95.5465 F1800
+G1 X82.2 Y98.42 E97.038 F1800
+G1 Y97.5 E97.1 F1800
+G1 X67.5 Y82.8 E98.5038 F1800
+G1 X68.42 E98.5658 F1800
+G1 X82.2 Y96.58 E99.8818 F1800
+G1 Y95.66 E99.9439 F1800
+G1 X69.34 Y82.8 E101.1722 F1800
+G1 X70.26 E101.2342 F1800
+G1 X82.2 Y94.74 E102.3747 F1800
+G1 Y93.83 E102.4368 F1800
+G1 X71.18 Y82.8 E103.4896 F1800
+G1 X72.09 E103.5516 F1800
+G1 X82.2 Y92.91 E104.5167 F1800
+G1 Y91.99 E104.5787 F1800
+G1 X73.01 Y82.8 E105.4561 F1800
+G1 X73.93 E105.5181 F1800
+G1 X82.2 Y91.07 E106.3077 F1800
+G1 Y90.15 E106.3698 F1800
+G1 X74.85 Y82.8 E107.0716 F1800
+G1 X75.77 E107.1337 F1800
+G1 X82.2 Y89.23 E107.7478 F1800
+G1 Y88.31 E107.8099 F1800
+G1 X76.69 Y82.8 E108.3363 F1800
+G1 X77.61 E108.3983 F1800
+G1 X82.2 Y87.39 E108.837 F1800
+G1 Y86.48 E108.899 F1800
+G1 X78.53 Y82.8 E109.2499 F1800
+G1 X79.44 E109.312 F1800
+G1 X82.2 Y85.56 E109.5752 F1800
+G1 Y84.64 E109.6372 F1800
+G1 X80.36 Y82.8 E109.8127 F1800
+G1 X81.28 E109.8747 F1800
+G1 X82.2 Y83.72 E109.9625 F1800
+G1 Y82.8 E110.0245 F1800
+G1 E109.02 F1800
+G1 X152.8 Y112.2 F9000
+G1 E110.02 F1800
+G1 Y111.28 E110.0865 F1800
+G1 X153.72 Y112.2 E110.1743 F1800
+G1 X154.64 E110.2363 F1800
+G1 X152.8 Y110.36 E110.4118 F1800
+G1 Y109.44 E110.4738 F1800
+G1 X155.56 Y112.2 E110.737 F1800
+G1 X156.48 E110.799 F1800
+G1 X152.8 Y108.53 E111.15 F1800
+G1 Y107.61 E111.212 F1800
+G1 X157.39 Y112.2 E111.6507 F1800
+G1 X158.31 E111.7127 F1800
+G1 X152.8 Y106.69 E112.2391 F1800
+G1 Y105.77 E112.3012 F1800
+G1 X159.23 Y112.2 E112.9153 F1800
+G1 X160.15 E112.9773 F1800
+G1 X152.8 Y104.85 E113.6792 F1800
+G1 Y103.93 E113.7413 F1800
+G1 X161.07 Y112.2 E114.5309 F1800
+G1 X161.99 E114.5929 F1800
+G1 X152.8 Y103.01 E115.4702 F1800
+G1 Y102.09 E115.5323 F1800
+G1 X162.91 Y112.2 E116.4974 F1800
+G1 X163.82 E116.5594 F1800
+G1 X152.8 Y101.18 E117.6122 F1800
+G1 Y100.26 E117.6742 F1800
+G1 X164.74 Y112.2 E118.8148 F1800
+G1 X165.66 E118.8768 F1800
+G1 X152.8 Y99.34 E120.1051 F1800
+G1 Y98.42 E120.1671 F1800
+G1 X166.58 Y112.2 E121.4832 F1800
+G1 X167.5 E121.5452 F1800
+G1 X152.8 Y97.5 E122.9489 F1800
+G1 Y96.58 E123.011 F1800
+G1 X168.42 Y112.2 E124.5025 F1800
+G1 X169.34 E124.5645 F1800
+G1 X152.8 Y95.66 E126.1437 F1800
+G1 Y94.74 E126.2057 F1800
+G1 X170.26 Y112.2 E127.8727 F1800
+G1 X171.18 E127.9347 F1800
+G1 X152.8 Y93.82 E129.6894 F1800
+G1 Y92.91 E129.7515 F1800
+G1 X172.09 Y112.2 E131.5939 F1800
+G1 X173.01 E131.6559 F1800
+G1 X152.8 Y91.99 E133.5861 F1800
+G1 Y91.07 E133.6481 F1800
+G1 X173.93 Y112.2 E135.666 F1800
+G1 X174.85 E135.728 F1800
+G1 X152.8 Y90.15 E137.8336 F1800
+G1 Y89.23 E137.8957 F1800
+G1 X175.77 Y112.2 E140.089 F1800
+G1 X176.69 E140.1511 F1800
+G1 X152.8 Y88.31 E142.4322 F1800
+G1 Y87.39 E142.4942 F1800
+G1 X177.61 Y112.2 E144.863 F1800
+G1 X178.52 E144.9251 F1800
+G1 X152.8 Y86.48 E147.3816 F1800
+G1 Y85.56 E147.4436 F1800
+G1 X179.44 Y112.2 E149.9879 F1800
+G1 X180.36 E150.05 F1800
+G1 X152.8 Y84.64 E152.682 F1800
+G1 Y83.72 E152.744 F1800
+G1 X181.28 Y112.2 E155.4638 F1800
+G1 X182.2 E155.5258 F1800
+G1 X152.8 Y82.8 E158.3333 F1800
+G1 X153.72 E158.3954 F1800
+G1 X182.2 Y111.28 E161.1151 F1800
+G1 Y110.36 E161.1772 F1800
+G1 X154.64 Y82.8 E163.8092 F1800
+G1 X155.56 E163.8712 F1800
+G1 X182.2 Y109.44 E166.4155 F1800
+G1 Y108.53 E166.4776 F1800
+G1 X156.48 Y82.8 E168.9341 F1800
+G1 X157.39 E168.9961 F1800
+G1 X182.2 Y107.61 E171.365 F1800
+G1 Y106.69 E171.427 F1800
+G1 X158.31 Y82.8 E173.7081 F1800
+G1 X159.23 E173.7701 F1800
+G1 X182.2 Y105.77 E175.9635 F1800
+G1 Y104.85 E176.0255 F1800
+G1 X160.15 Y82.8 E178.1311 F1800
+G1 X161.07 E178.1932 F1800
+G1 X182.2 Y103.93 E180.2111 F1800
+G1 Y103.01 E180.2731 F1800
+G1 X161.99 Y82.8 E182.2033 F1800
+G1 X162.91 E182.2653 F1800
+G1 X182.2 Y102.09 E184.1077 F1800
+G1 Y101.18 E184.1697 F1800
+G1 X163.82 Y82.8 E185.9244 F1800
+G1 X164.74 E185.9865 F1800
+G1 X182.2 Y100.26 E187.6534 F1800
+G1 Y99.34 E187.7155 F1800
+G1 X165.66 Y82.8 E189.2947 F1800
+G1 X166.58 E189.3567 F1800
+G1 X182.2 Y98.42 E190.8482 F1800
+G1 Y97.5 E190.9102 F1800
+G1 X167.5 Y82.8 E192.314 F1800
+G1 X168.42 E192.376 F1800
+G1 X182.2 Y96.58 E193.692 F1800
+G1 Y95.66 E193.7541 F1800
+G1 X169.34 Y82.8 E194.9823 F1800
+G1 X170.26 E195.0444 F1800
+G1 X182.2 Y94.74 E196.1849 F1800
+G1 Y93.83 E196.247 F1800
+G1 X171.18 Y82.8 E197.2998 F1800
+G1 X172.09 E197.3618 F1800
+G1 X182.2 Y92.91 E198.3269 F1800
+G1 Y91.99 E198.3889 F1800
+G1 X173.01 Y82.8 E199.2663 F1800
+G1 X173.93 E199.3283 F1800
+G1 X182.2 Y91.07 E200.1179 F1800
+G1 Y90.15 E200.1799 F1800
+G1 X174.85 Y82.8 E200.8818 F1800
+G1 X175.77 E200.9439 F1800
+G1 X182.2 Y89.23 E201.558 F1800
+G1 Y88.31 E201.62 F1800
+G1 X176.69 Y82.8 E202.1464 F1800
+G1 X177.61 E202.2085 F1800
+G1 X182.2 Y87.39 E202.6471 F1800
+G1 Y86.48 E202.7092 F1800
+G1 X178.53 Y82.8 E203.0601 F1800
+G1 X179.44 E203.1222 F1800
+G1 X182.2 Y85.56 E203.3854 F1800
+G1 Y84.64 E203.4474 F1800
+G1 X180.36 Y82.8 E203.6229 F1800
+G1 X181.28 E203.6849 F1800
+G1 X182.2 Y83.72 E203.7726 F1800
+G1 Y82.8 E203.8347 F1800
+G1 E202.83 F1800
+G1 X52.82 Y152.18 F9000
+G1 E203.83 F1800
+G1 Y151.2 E203.905 F1800
+G1 X53.8 Y152.18 E204.0045 F1800
+G1 X54.78 E204.0748 F1800
+G1 X52.82 Y150.22 E204.2738 F1800
+G1 Y149.24 E204.3442 F1800
+G1 X55.76 Y152.18 E204.6426 F1800
+G1 X56.74 E204.713 F1800
+G1 X52.82 Y148.26 E205.1109 F1800
+G1 Y147.28 E205.1813 F1800
+G1 X57.72 Y152.18 E205.6787 F1800
+G1 X58.69 E205.749 F1800
+G1 X52.82 Y146.31 E206.3459 F1800
+G1 Y145.33 E206.4163 F1800
+G1 X59.67 Y152.18 E207.1127 F1800
+G1 X60.65 E207.183 F1800
+G1 X52.82 Y144.35 E207.9789 F1800
+G1 Y143.37 E208.0493 F1800
+G1 X61.63 Y152.18 E208.9446 F1800
+G1 X62.61 E209.015 F1800
+G1 X52.82 Y142.39 E210.0098 F1800
+G1 Y141.41 E210.0802 F1800
+G1 X63.59 Y152.18 E211.1745 F1800
+G1 X64.56 E211.2448 F1800
+G1 X52.82 Y140.44 E212.4387 F1800
+G1 Y139.46 E212.509 F1800
+G1 X65.54 Y152.18 E213.8023 F1800
+G1 X66.52 E213.8727 F1800
+G1 X52.82 Y138.48 E215.2655 F1800
+G1 Y137.5 E215.3358 F1800
+G1 X67.5 Y152.18 E216.8281 F1800
+G1 X68.48 E216.8984 F1800
+G1 X52.82 Y136.52 E218.4902 F1800
+G1 Y135.54 E218.5605 F1800
+G1 X69.46 Y152.18 E220.2518 F1800
+G1 X70.44 E220.3221 F1800
+G1 X52.82 Y134.56 E222.1129 F1800
+G1 Y133.59 E222.1832 F1800
+G1 X71.41 Y152.18 E224.0734 F1800
+G1 X72.39 E224.1438 F1800
+G1 X52.82 Y132.61 E226.1335 F1800
+G1 Y131.63 E226.2038 F1800
+G1 X73.37 Y152.18 E228.293 F1800
+G1 X74.35 E228.3633 F1800
+G1 X52.82 Y130.65 E230.552 F1800
+G1 Y129.67 E230.6224 F1800
+G1 X75.33 Y152.18 E232.9105 F1800
+G1 X76.31 E232.9809 F1800
+G1 X52.82 Y128.69 E235.3685 F1800
+G1 Y127.72 E235.4389 F1800
+G1 X77.28 Y152.18 E237.926 F1800
+G1 X78.26 E237.9963 F1800
+G1 X52.82 Y126.74 E240.5829 F1800
+G1 Y125.76 E240.6533 F1800
+G1 X79.24 Y152.18 E243.3394 F1800
+G1 X80.22 E243.4097 F1800
+G1 X52.82 Y124.78 E246.1953 F1800
+G1 Y123.8 E246.2657 F1800
+G1 X81.2 Y152.18 E249.1507 F1800
+G1 X82.18 E249.2211 F1800
+G1 X52.82 Y122.82 E252.2056 F1800
+G1 X53.8 E252.276 F1800
+G1 X82.18 Y151.2 E255.161 F1800
+G1 Y150.22 E255.2314 F1800
+G1 X54.78 Y122.82 E258.017 F1800
+G1 X55.76 E258.0873 F1800
+G1 X82.18 Y149.24 E260.7734 F1800
+G1 Y148.26 E260.8438 F1800
+G1 X56.74 Y122.82 E263.4304 F1800
+G1 X57.72 E263.5007 F1800
+G1 X82.18 Y147.28 E265.9878 F1800
+G1 Y146.31 E266.0582 F1800
+G1 X58.69 Y122.82 E268.4458 F1800
+G1 X59.67 E268.5162 F1800
+G1 X82.18 Y145.33 E270.8043 F1800
+G1 Y144.35 E270.8747 F1800
+G1 X60.65 Y122.82 E273.0634 F1800
+G1 X61.63 E273.1337 F1800
+G1 X82.18 Y143.37 E275.2229 F1800
+G1 Y142.39 E275.2932 F1800
+G1 X62.61 Y122.82 E277.2829 F1800
+G1 X63.59 E277.3533 F1800
+G1 X82.18 Y141.41 E279.2435 F1800
+G1 Y140.44 E279.3139 F1800
+G1 X64.56 Y122.82 E281.1046 F1800
+G1 X65.54 E281.1749 F1800
+G1 X82.18 Y139.46 E282.8662 F1800
+G1 Y138.48 E282.9365 F1800
+G1 X66.52 Y122.82 E284.5283 F1800
+G1 X67.5 E284.5986 F1800
+G1 X82.18 Y137.5 E286.0909 F1800
+G1 Y136.52 E286.1613 F1800
+G1 X68.48 Y122.82 E287.554 F1800
+G1 X69.46 E287.6244 F1800
+G1 X82.18 Y135.54 E288.9177 F1800
+G1 Y134.56 E288.988 F1800
+G1 X70.44 Y122.82 E290.1819 F1800
+G1 X71.41 E290.2522 F1800
+G1 X82.18 Y133.59 E291.3465 F1800
+G1 Y132.61 E291.4169 F1800
+G1 X72.39 Y122.82 E292.4117 F1800
+G1 X73.37 E292.4821 F1800
+G1 X82.18 Y131.63 E293.3775 F1800
+G1 Y130.65 E293.4478 F1800
+G1 X74.35 Y122.82 E294.2437 F1800
+G1 X75.33 E294.314 F1800
+G1 X82.18 Y129.67 E295.0104 F1800
+G1 Y128.69 E295.0808 F1800
+G1 X76.31 Y122.82 E295.6777 F1800
+G1 X77.28 E295.748 F1800
+G1 X82.18 Y127.72 E296.2455 F1800
+G1 Y126.74 E296.3158 F1800
+G1 X78.26 Y122.82 E296.7137 F1800
+G1 X79.24 E296.7841 F1800
+G1 X82.18 Y125.76 E297.0825 F1800
+G1 Y124.78 E297.1529 F1800
+G1 X80.22 Y122.82 E297.3519 F1800
+G1 X81.2 E297.4222 F1800
+G1 X82.18 Y123.8 E297.5217 F1800
+G1 Y122.82 E297.592 F1800
+G1 E296.59 F1800
+G1 X152.82 Y152.18 F9000
+G1 E297.59 F1800
+G1 Y151.2 E297.6624 F1800
+G1 X153.8 Y152.18 E297.7619 F1800
+G1 X154.78 E297.8322 F1800
+G1 X152.82 Y150.22 E298.0312 F1800
+G1 Y149.24 E298.1015 F1800
+G1 X155.76 Y152.18 E298.4 F1800
+G1 X156.74 E298.4703 F1800
+G1 X152.82 Y148.26 E298.8683 F1800
+G1 Y147.28 E298.9386 F1800
+G1 X157.72 Y152.18 E299.436 F1800
+G1 X158.69 E299.5064 F1800
+G1 X152.82 Y146.31 E300.1033 F1800
+G1 Y145.33 E300.1737 F1800
+G1 X159.67 Y152.18 E300.87 F1800
+G1 X160.65 E300.9404 F1800
+G1 X152.82 Y144.35 E301.7363 F1800
+G1 Y143.37 E301.8066 F1800
+G1 X161.63 Y152.18 E302.702 F1800
+G1 X162.61 E302.7723 F1800
+G1 X152.82 Y142.39 E303.7672 F1800
+G1 Y141.41 E303.8375 F1800
+G1 X163.59 Y152.18 E304.9319 F1800
+G1 X164.56 E305.0022 F1800
+G1 X152.82 Y140.44 E306.196 F1800
+G1 Y139.46 E306.2664 F1800
+G1 X165.54 Y152.18 E307.5597 F1800
+G1 X166.52 E307.63 F1800
+G1 X152.82 Y138.48 E309.0228 F1800
+G1 Y137.5 E309.0932 F1800
+G1 X167.5 Y152.18 E310.5854 F1800
+G1 X168.48 E310.6558 F1800
+G1 X152.82 Y136.52 E312.2476 F1800
+G1 Y135.54 E312.3179 F1800
+G1 X169.46 Y152.18 E314.0091 F1800
+G1 X170.44 E314.0795 F1800
+G1 X152.82 Y134.56 E315.8702 F1800
+G1 Y133.59 E315.9406 F1800
+G1 X171.41 Y152.18 E317.8308 F1800
+G1 X172.39 E317.9011 F1800
+G1 X152.82 Y132.61 E319.8908 F1800
+G1 Y131.63 E319.9612 F1800
+G1 X173.37 Y152.18 E322.0504 F1800
+G1 X174.35 E322.1207 F1800
+G1 X152.82 Y130.65 E324.3094 F1800
+G1 Y129.67 E324.3797 F1800
+G1 X175.33 Y152.18 E326.6679 F1800
+G1 X176.31 E326.7382 F1800
+G1 X152.82 Y128.69 E329.1259 F1800
+G1 Y127.72 E329.1962 F1800
+G1 X177.28 Y152.18 E331.6834 F1800
+G1 X178.26 E331.7537 F1800
+G1 X152.82 Y126.74 E334.3403 F1800
+G1 Y125.76 E334.4107 F1800
+G1 X179.24 Y152.18 E337.0968 F1800
+G1 X180.22 E337.1671 F1800
+G1 X152.82 Y124.78 E339.9527 F1800
+G1 Y123.8 E340.023 F1800
+G1 X181.2 Y152.18 E342.9081 F1800
+G1 X182.18 E342.9784 F1800
+G1 X152.82 Y122.82 E345.963 F1800
+G1 X153.8 E346.0333 F1800
+G1 X182.18 Y151.2 E348.9184 F1800
+G1 Y150.22 E348.9888 F1800
+G1 X154.78 Y122.82 E351.7743 F1800
+G1 X155.76 E351.8447 F1800
+G1 X182.18 Y149.24 E354.5308 F1800
+G1 Y148.26 E354.6011 F1800
+G1 X156.74 Y122.82 E357.1877 F1800
+G1 X157.72 E357.2581 F1800
+G1 X182.18 Y147.28 E359.7452 F1800
+G1 Y146.31 E359.8156 F1800
+G1 X158.69 Y122.82 E362.2032 F1800
+G1 X159.67 E362.2736 F1800
+G1 X182.18 Y145.33 E364.5617 F1800
+G1 Y144.35 E364.6321 F1800
+G1 X160.65 Y122.82 E366.8207 F1800
+G1 X161.63 E366.8911 F1800
+G1 X182.18 Y143.37 E368.9803 F1800
+G1 Y142.39 E369.0506 F1800
+G1 X162.61 Y122.82 E371.0403 F1800
+G1 X163.59 E371.1107 F1800
+G1 X182.18 Y141.41 E373.0009 F1800
+G1 Y140.44 E373.0712 F1800
+G1 X164.56 Y122.82 E374.862 F1800
+G1 X165.54 E374.9323 F1800
+G1 X182.18 Y139.46 E376.6235 F1800
+G1 Y138.48 E376.6939 F1800
+G1 X166.52 Y122.82 E378.2857 F1800
+G1 X167.5 E378.356 F1800
+G1 X182.18 Y137.5 E379.8483 F1800
+G1 Y136.52 E379.9186 F1800
+G1 X168.48 Y122.82 E381.3114 F1800
+G1 X169.46 E381.3818 F1800
+G1 X182.18 Y135.54 E382.6751 F1800
+G1 Y134.56 E382.7454 F1800
+G1 X170.44 Y122.82 E383.9392 F1800
+G1 X171.41 E384.0096 F1800
+G1 X182.18 Y133.59 E385.1039 F1800
+G1 Y132.61 E385.1743 F1800
+G1 X172.39 Y122.82 E386.1691 F1800
+G1 X173.37 E386.2395 F1800
+G1 X182.18 Y131.63 E387.1348 F1800
+G1 Y130.65 E387.2052 F1800
+G1 X174.35 Y122.82 E388.0011 F1800
+G1 X175.33 E388.0714 F1800
+G1 X182.18 Y129.67 E388.7678 F1800
+G1 Y128.69 E388.8381 F1800
+G1 X176.31 Y122.82 E389.4351 F1800
+G1 X177.28 E389.5054 F1800
+G1 X182.18 Y127.72 E390.0028 F1800
+G1 Y126.74 E390.0732 F1800
+G1 X178.26 Y122.82 E390.4711 F1800
+G1 X179.24 E390.5415 F1800
+G1 X182.18 Y125.76 E390.8399 F1800
+G1 Y124.78 E390.9103 F1800
+G1 X180.22 Y122.82 E391.1092 F1800
+G1 X181.2 E391.1796 F1800
+G1 X182.18 Y123.8 E391.2791 F1800
+G1 Y122.82 E391.3494 F1800
+;layer #2
+M106 S169
+G1 E390.35 F1800
+G1 X160.94 Y130.58 F9000
+G1 E391.35 F1800
+G1 Z0.5 F300
+G1 X167.5 Y143.7 E391.9594 F3600
+G1 X174.06 Y130.58 E392.5694
+G1 X160.94 E393.115
+G1 X160.16 Y130.1 E393.1529
+G1 X167.5 Y144.78 E393.8351
+G1 X174.84 Y130.1 E394.5173
+G1 X160.16 E395.1275
+G1 E394.13 F1800
+G1 X74.06 Y130.58 F9000
+G1 E395.13 F1800
+G1 X60.94 E395.6731 F3600
+G1 X67.5 Y143.7 E396.2831
+G1 X74.06 Y130.58 E396.8931
+G1 X74.84 Y130.1 E396.931
+G1 X60.16 E397.5412
+G1 X67.5 Y144.78 E398.2234
+G1 X74.84 Y130.1 E398.9056
+G1 E397.91 F1800
+G1 X160.94 Y90.58 F9000
+G1 E398.91 F1800
+G1 X167.5 Y103.7 E399.5156 F3600
+G1 X174.06 Y90.58 E400.1256
+G1 X160.94 E400.6712
+G1 X160.16 Y90.1 E400.7091
+G1 X167.5 Y104.78 E401.3913
+G1 X174.84 Y90.1 E402.0735
+G1 X160.16 E402.6837
+G1 E401.68 F1800
+G1 X74.06 Y90.58 F9000
+G1 E402.68 F1800
+G1 X60.94 E403.2293 F3600
+G1 X67.5 Y103.7 E403.8393
+G1 X74.06 Y90.58 E404.4493
+G1 X74.84 Y90.1 E404.4873
+G1 X60.16 E405.0974
+G1 X67.5 Y104.78 E405.7796
+G1 X74.84 Y90.1 E406.4618
+;layer #3
+M106 S254
+G1 E405.46 F1800
+G1 X74.06 Y90.58 F9000
+G1 E406.46 F1800
+G1 Z0.75 F300
+G1 X60.94 E407.0074 F3600
+G1 X67.5 Y103.7 E407.6174
+G1 X74.06 Y90.58 E408.2274
+G1 X74.84 Y90.1 E408.2654
+G1 X60.16 E408.8755
+G1 X67.5 Y104.78 E409.5577
+G1 X74.84 Y90.1 E410.2399
+G1 E409.24 F1800
+G1 X160.94 Y90.58 F9000
+G1 E410.24 F1800
+G1 X167.5 Y103.7 E410.8499 F3600
+G1 X174.06 Y90.58 E411.4599
+G1 X160.94 E412.0055
+G1 X160.16 Y90.1 E412.0435
+G1 X167.5 Y104.78 E412.7256
+G1 X174.84 Y90.1 E413.4078
+G1 X160.16 E414.018
+G1 E413.02 F1800
+G1 X74.06 Y130.58 F9000
+G1 E414.02 F1800
+G1 X60.94 E414.5636 F3600
+G1 X67.5 Y143.7 E415.1736
+G1 X74.06 Y130.58 E415.7836
+G1 X74.84 Y130.1 E415.8216
+G1 X60.16 E416.4317
+G1 X67.5 Y144.78 E417.1139
+G1 X74.84 Y130.1 E417.7961
+G1 E416.8 F1800
+G1 X160.94 Y130.58 F9000
+G1 E417.8 F1800
+G1 X167.5 Y143.7 E418.4061 F3600
+G1 X174.06 Y130.58 E419.0161
+G1 X160.94 E419.5617
+G1 X160.16 Y130.1 E419.5997
+G1 X167.5 Y144.78 E420.2819
+G1 X174.84 Y130.1 E420.964
+G1 X160.16 E421.5742
+;layer #4
+G1 E420.57 F1800
+G1 X160.94 Y130.58 F9000
+G1 E421.57 F1800
+G1 Z1 F300
+G1 X167.5 Y143.7 E422.1842 F3600
+G1 X174.06 Y130.58 E422.7942
+G1 X160.94 E423.3398
+G1 X160.16 Y130.1 E423.3778
+G1 X167.5 Y144.78 E424.06
+G1 X174.84 Y130.1 E424.7422
+G1 X160.16 E425.3523
+G1 E424.35 F1800
+G1 X74.06 Y130.58 F9000
+G1 E425.35 F1800
+G1 X60.94 E425.8979 F3600
+G1 X67.5 Y143.7 E426.5079
+G1 X74.06 Y130.58 E427.1179
+G1 X74.84 Y130.1 E427.1559
+G1 X60.16 E427.766
+G1 X67.5 Y144.78 E428.4482
+G1 X74.84 Y130.1 E429.1304
+G1 E428.13 F1800
+G1 X160.94 Y90.58 F9000
+G1 E429.13 F1800
+G1 X167.5 Y103.7 E429.7404 F3600
+G1 X174.06 Y90.58 E430.3504
+G1 X160.94 E430.896
+G1 X160.16 Y90.1 E430.934
+G1 X167.5 Y104.78 E431.6162
+G1 X174.84 Y90.1 E432.2984
+G1 X160.16 E432.9085
+G1 E431.91 F1800
+G1 X74.06 Y90.58 F9000
+G1 E432.91 F1800
+G1 X60.94 E433.4541 F3600
+G1 X67.5 Y103.7 E434.0641
+G1 X74.06 Y90.58 E434.6741
+G1 X74.84 Y90.1 E434.7121
+G1 X60.16 E435.3222
+G1 X67.5 Y104.78 E436.0044
+G1 X74.84 Y90.1 E436.6866
+;layer #5
+G1 E436.49 F1800
+G1 X74.16 Y90.48 F9000
+G1 E436.69 F1800
+G1 Z1.25 F300
+G1 X60.84 E437.2405 F3600
+G1 X67.5 Y103.8 E437.8598
+G1 X74.16 Y90.48 E438.4791
+G1 X74.94 Y90 E438.5171
+G1 X60.06 E439.1356
+G1 X67.5 Y104.88 E439.8271
+G1 X74.94 Y90 E440.5185
+G1 E440.32 F1800
+G1 X160.84 Y90.48 F9000
+G1 E440.52 F1800
+G1 X167.5 Y103.8 E441.1378 F3600
+G1 X174.16 Y90.48 E441.7571
+G1 X160.84 E442.311
+G1 X160.06 Y90 E442.349
+G1 X167.5 Y104.88 E443.0405
+G1 X174.94 Y90 E443.732
+G1 X160.06 E444.3505
+G1 E444.15 F1800
+G1 X74.16 Y130.48 F9000
+G1 E444.35 F1800
+G1 X60.84 E444.9044 F3600
+G1 X67.5 Y143.8 E445.5237
+G1 X74.16 Y130.48 E446.143
+G1 X74.94 Y130 E446.1809
+G1 X60.06 E446.7994
+G1 X67.5 Y144.88 E447.4909
+G1 X74.94 Y130 E448.1824
+G1 E447.98 F1800
+G1 X160.84 Y130.48 F9000
+G1 E448.18 F1800
+G1 X167.5 Y143.8 E448.8017 F3600
+G1 X174.16 Y130.48 E449.421
+G1 X160.84 E449.9749
+G1 X160.06 Y130 E450.0128
+G1 X167.5 Y144.88 E450.7043
+G1 X174.94 Y130 E451.3958
+G1 X160.06 E452.0143
+;layer #6
+G1 E451.81 F1800
+G1 X160.94 Y130.58 F9000
+G1 E452.01 F1800
+G1 Z1.5 F300
+G1 X167.5 Y143.7 E452.6243 F3600
+G1 X174.06 Y130.58 E453.2343
+G1 X160.94 E453.7799
+G1 X160.16 Y130.1 E453.8178
+G1 X167.5 Y144.78 E454.5
+G1 X174.84 Y130.1 E455.1822
+G1 X160.16 E455.7924
+G1 E455.59 F1800
+G1 X74.06 Y130.58 F9000
+G1 E455.79 F1800
+G1 X60.94 E456.338 F3600
+G1 X67.5 Y143.7 E456.948
+G1 X74.06 Y130.58 E457.558
+G1 X74.84 Y130.1 E457.5959
+G1 X60.16 E458.2061
+G1 X67.5 Y144.78 E458.8883
+G1 X74.84 Y130.1 E459.5705
+G1 E459.37 F1800
+G1 X160.94 Y90.58 F9000
+G1 E459.57 F1800
+G1 X167.5 Y103.7 E460.1805 F3600
+G1 X174.06 Y90.58 E460.7905
+G1 X160.94 E461.3361
+G1 X160.16 Y90.1 E461.374
+G1 X167.5 Y104.78 E462.0562
+G1 X174.84 Y90.1 E462.7384
+G1 X160.16 E463.3486
+G1 E463.15 F1800
+G1 X74.06 Y90.58 F9000
+G1 E463.35 F1800
+G1 X60.94 E463.8942 F3600
+G1 X67.5 Y103.7 E464.5042
+G1 X74.06 Y90.58 E465.1142
+G1 X74.84 Y90.1 E465.1521
+G1 X60.16 E465.7623
+G1 X67.5 Y104.78 E466.4445
+G1 X74.84 Y90.1 E467.1267
+;layer #7
+G1 E466.93 F1800
+G1 X74.06 Y90.58 F9000
+G1 E467.13 F1800
+G1 Z1.75 F300
+G1 X60.94 E467.6723 F3600
+G1 X67.5 Y103.7 E468.2823
+G1 X74.06 Y90.58 E468.8923
+G1 X74.84 Y90.1 E468.9302
+G1 X60.16 E469.5404
+G1 X67.5 Y104.78 E470.2226
+G1 X74.84 Y90.1 E470.9048
+G1 E470.7 F1800
+G1 X160.94 Y90.58 F9000
+G1 E470.9 F1800
+G1 X167.5 Y103.7 E471.5148 F3600
+G1 X174.06 Y90.58 E472.1248
+G1 X160.94 E472.6704
+G1 X160.16 Y90.1 E472.7084
+G1 X167.5 Y104.78 E473.3905
+G1 X174.84 Y90.1 E474.0727
+G1 X160.16 E474.6829
+G1 E474.48 F1800
+G1 X74.06 Y130.58 F9000
+G1 E474.68 F1800
+G1 X60.94 E475.2285 F3600
+G1 X67.5 Y143.7 E475.8385
+G1 X74.06 Y130.58 E476.4485
+G1 X74.84 Y130.1 E476.4865
+G1 X60.16 E477.0966
+G1 X67.5 Y144.78 E477.7788
+G1 X74.84 Y130.1 E478.461
+G1 E478.26 F1800
+G1 X160.94 Y130.58 F9000
+G1 E478.46 F1800
+G1 X167.5 Y143.7 E479.071 F3600
+G1 X174.06 Y130.58 E479.681
+G1 X160.94 E480.2266
+G1 X160.16 Y130.1 E480.2646
+G1 X167.5 Y144.78 E480.9467
+G1 X174.84 Y130.1 E481.6289
+G1 X160.16 E482.2391
+;layer #8
+G1 E482.04 F1800
+G1 X160.94 Y130.58 F9000
+G1 E482.24 F1800
+G1 Z2 F300
+G1 X167.5 Y143.7 E482.8491 F3600
+G1 X174.06 Y130.58 E483.4591
+G1 X160.94 E484.0047
+G1 X160.16 Y130.1 E484.0427
+G1 X167.5 Y144.78 E484.7249
+G1 X174.84 Y130.1 E485.407
+G1 X160.16 E486.0172
+G1 E485.82 F1800
+G1 X74.06 Y130.58 F9000
+G1 E486.02 F1800
+G1 X60.94 E486.5628 F3600
+G1 X67.5 Y143.7 E487.1728
+G1 X74.06 Y130.58 E487.7828
+G1 X74.84 Y130.1 E487.8208
+G1 X60.16 E488.4309
+G1 X67.5 Y144.78 E489.1131
+G1 X74.84 Y130.1 E489.7953
+G1 E489.6 F1800
+G1 X160.94 Y90.58 F9000
+G1 E489.8 F1800
+G1 X167.5 Y103.7 E490.4053 F3600
+G1 X174.06 Y90.58 E491.0153
+G1 X160.94 E491.5609
+G1 X160.16 Y90.1 E491.5989
+G1 X167.5 Y104.78 E492.2811
+G1 X174.84 Y90.1 E492.9632
+G1 X160.16 E493.5734
+G1 E493.37 F1800
+G1 X74.06 Y90.58 F9000
+G1 E493.57 F1800
+G1 X60.94 E494.119 F3600
+G1 X67.5 Y103.7 E494.729
+G1 X74.06 Y90.58 E495.339
+G1 X74.84 Y90.1 E495.377
+G1 X60.16 E495.9871
+G1 X67.5 Y104.78 E496.6693
+G1 X74.84 Y90.1 E497.3515
+;end gcode
+M104 S0 ;turn off hotend
+M140 S0 ;turn off bed
+M106 S0 ;turn off part cooling fan
+G91 ;relative positioning
+G1 E-5 F600 ;retract 5mm
+G1 Z1 F300 ;lift head by 1mm
\ No newline at end of file
@@ -0,0 +1,73 @@
+package generator
+
+// MoveKind is the type of a toolpath move.
+type MoveKind int
+
+const (
+	MoveRaw         MoveKind = iota // verbatim G-code, such as the start and end G-code
+	MoveComment                     // comment line, Text holds the comment without ";"
+	MoveTravel                      // non-extruding XY move
+	MoveExtrude                     // extruding move of the given Width
+	MoveRetract                     // filament retraction at the current position
+	MoveUnretract                   // filament deretraction at the current position
+	MoveZ                           // Z only move to the next layer or of a Z-hop
+	MoveSetPosition                 // the nozzle at From is declared to be at To (G92)
+	MoveFan                         // fan speed change, Value is 0..255
+	MoveTemperature                 // hotend temperature change, Value is in °C
+	MoveDwell                       // pause, Value is in seconds
+	MoveWipe                        // non-extruding XY move back along the printed line, retracting by -Extrusion
+	MoveCoast                       // non-extruding XY move along the line instead of printing its end
+)
+
+var moveKindNames = []string{"raw", "comment", "travel", "extrude", "retract", "unretract", "z", "set_position", "fan", "temperature", "dwell", "wipe", "coast"}
+
+func (k MoveKind) String() string {
+	if k < 0 || int(k) >= len(moveKindNames) {
+		return "unknown"
+	}
+	return moveKindNames[k]
+}
+
+// Move is a single step of the calibration print. The generator builds the
+// print as a sequence of moves, which is then turned into G-code. Other
+// consumers, e.g. previews or statistics, can use the moves directly instead
+// of parsing the G-code.
+type Move struct {
+	Kind MoveKind
+
+	// Layer is the number of the layer, 1 for the raft. Moves before the
+	// first layer and after the last one have layer 0.
+	Layer int
+	// Segment is the number of the tower segment, counted from 1 at the bottom.
+	// The raft belongs to the first segment. Moves outside of the towers have segment 0.
+	Segment int
+
+	From, To Point
+	// Arc is set for XY moves along a clockwise arc around Center instead of a line.
+	Arc    bool
+	Center Point
+	// E is the absolute extruder position after the move and Extrusion
+	// is the change of it caused by the move.
+	E, Extrusion float64
+	// Feedrate is the speed of the move in mm/s.
+	Feedrate float64
+	// Width is the line width of extruding moves.
+	Width float64
+	// Value is the new fan speed or temperature, or the duration of a dwell.
+	Value float64
+	// Wait is set for temperature changes which have to be reached before printing continues.
+	Wait bool
+	// Text is the content of raw G-code and comments.
+	Text string
+}
+
+// Toolpath validates p and calls fn for every move of the calibration print
+// in printing order. If p is invalid, fn is not called and the returned error
+// is ValidationErrors.
+func Toolpath(p Params, fn func(Move)) error {
+	if errs := Validate(p); len(errs) > 0 {
+		return errs
+	}
+	newGenerator(p, fn).generate()
+	return nil
+}
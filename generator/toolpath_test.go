@@ -0,0 +1,311 @@
+package generator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToolpathMetadata(t *testing.T) {
+	p := DefaultParams()
+	layersPerSegment := int(p.SegmentHeight / p.LayerHeight)
+
+	maxLayer, retracted := 0, false
+	err := Toolpath(p, func(m Move) {
+		if m.Layer > 0 {
+			if m.Layer < maxLayer {
+				t.Fatalf("layer goes down from %d to %d", maxLayer, m.Layer)
+			}
+			maxLayer = m.Layer
+			if want := (m.Layer-1)/layersPerSegment + 1; m.Segment != want {
+				t.Fatalf("layer %d is in segment %d, want %d", m.Layer, m.Segment, want)
+			}
+		}
+
+		switch m.Kind {
+		case MoveRetract:
+			if retracted {
+				t.Fatal("retraction while retracted")
+			}
+			retracted = true
+		case MoveUnretract:
+			if !retracted {
+				t.Fatal("deretraction while not retracted")
+			}
+			retracted = false
+		case MoveExtrude:
+			if retracted {
+				t.Fatal("extrusion while retracted")
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := p.NumSegments * layersPerSegment; maxLayer != want {
+		t.Errorf("last layer is %d, want %d", maxLayer, want)
+	}
+}
+
+func TestToolpathWipe(t *testing.T) {
+	p := DefaultParams()
+	p.InitWipeDistance, p.EndWipeDistance, p.WipeRetract = 2, 6, 60
+
+	var last Move
+	wipes, wiped, printedE, retractedE := 0, 0.0, 0.0, 0.0
+	err := Toolpath(p, func(m Move) {
+		switch m.Kind {
+		case MoveExtrude:
+			printedE = m.E
+		case MoveRetract:
+			retractedE = m.E
+		case MoveWipe:
+			retractedE = m.E
+			if m.From != last.To {
+				t.Fatalf("wipe starts at %v, the nozzle is at %v", m.From, last.To)
+			}
+			if m.Extrusion >= 0 {
+				t.Fatalf("wipe doesn't retract: %+v", m)
+			}
+			if last.Kind != MoveWipe {
+				wipes++
+				wiped = 0
+			}
+			wiped += distance(m.From, m.To)
+		case MoveTravel, MoveZ:
+			if m.From != last.To {
+				t.Fatalf("%v starts at %v, the nozzle is at %v", m.Kind, m.From, last.To)
+			}
+			if last.Kind == MoveWipe && wiped > p.segmentWipeDistance(m.Segment-1)+1e-9 {
+				t.Fatalf("wiped %v mm in segment %d", wiped, m.Segment)
+			}
+		case MoveUnretract:
+			// the deretraction restores exactly what the retraction and the wipe removed
+			if math.Abs(m.E-printedE) > 1e-9 || math.Abs(m.E-m.Extrusion-retractedE) > 1e-9 {
+				t.Fatalf("deretraction %+v after E %v, printed up to %v", m, retractedE, printedE)
+			}
+		}
+		if m.Kind != MoveComment && m.Kind != MoveFan && m.Kind != MoveRaw {
+			last = m
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wipes == 0 {
+		t.Error("no wipes")
+	}
+}
+
+func TestToolpathCoast(t *testing.T) {
+	p := DefaultParams()
+	p.InitCoast, p.EndCoast = 0.5, 3
+
+	var last Move
+	coasts, coasted, retractedE := 0, 0.0, 0.0
+	err := Toolpath(p, func(m Move) {
+		switch m.Kind {
+		case MoveCoast:
+			if m.From != last.To || m.E != last.E {
+				t.Fatalf("coast %+v after %+v", m, last)
+			}
+			if last.Kind != MoveCoast {
+				coasts++
+				coasted = 0
+			}
+			coasted += distance(m.From, m.To)
+		case MoveRetract:
+			retractedE = m.E
+			// the retraction may already be on the next layer
+			if last.Kind == MoveCoast && math.Abs(coasted-p.segmentCoastDistance(last.Segment-1)) > 1e-9 {
+				t.Fatalf("coasted %v mm in segment %d", coasted, last.Segment)
+			}
+		case MoveUnretract:
+			// coasting doesn't change what the deretraction restores
+			if math.Abs(m.E-m.Extrusion-retractedE) > 1e-9 {
+				t.Fatalf("deretraction %+v after E %v", m, retractedE)
+			}
+		}
+		if m.Kind != MoveComment && m.Kind != MoveFan && m.Kind != MoveRaw {
+			last = m
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// both towers of every tower layer
+	if want := 2 * (p.NumSegments*p.layersPerSegment() - 1); coasts != want {
+		t.Errorf("%d coasts, want %d", coasts, want)
+	}
+}
+
+// TestToolpathCoastLimit checks that the towers coast at most half of
+// their outer perimeter, however long the coasting is.
+func TestToolpathCoastLimit(t *testing.T) {
+	for _, shape := range []TowerShape{ShapeSquare, ShapeRound, ShapeTriangle} {
+		p := DefaultParams()
+		p.TowerShape, p.ArcMoves = shape, true
+		p.CoastVolume, p.InitCoast, p.EndCoast = true, 10, 10
+		outer := towerLoop(shape, Point{}, p.TowerWidth, p.TowerWidth-0.5*p.LineWidth, p.arcMoves())
+
+		var last Move
+		coasted := 0.0
+		err := Toolpath(p, func(m Move) {
+			if m.Kind == MoveCoast {
+				if last.Kind != MoveCoast {
+					coasted = 0
+				}
+				coasted += moveLength(m)
+				if coasted > outer.perimeter()/2+1e-9 {
+					t.Fatalf("%v towers coast %v mm of %v mm", shape, coasted, outer.perimeter())
+				}
+			}
+			if m.Kind != MoveComment && m.Kind != MoveFan && m.Kind != MoveRaw {
+				last = m
+			}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestToolpathArcs(t *testing.T) {
+	p := DefaultParams()
+	p.TowerShape, p.ArcMoves = ShapeRound, true
+	p.InitCoast, p.EndCoast = 1, 1
+	p.ShortHops = true
+
+	arcs := 0
+	err := Toolpath(p, func(m Move) {
+		if !m.Arc {
+			return
+		}
+		arcs++
+		if m.Kind != MoveExtrude && m.Kind != MoveCoast {
+			t.Fatalf("arc %+v", m)
+		}
+		from := math.Hypot(m.From.X-m.Center.X, m.From.Y-m.Center.Y)
+		to := math.Hypot(m.To.X-m.Center.X, m.To.Y-m.Center.Y)
+		if math.Abs(from-to) > 1e-9 || m.From.Z != m.To.Z {
+			t.Fatalf("arc %+v leaves its circle", m)
+		}
+		// the extrusion is the one of a line as long as the arc
+		want := m.Width * p.LayerHeight * moveLength(m) * 4 / math.Pi / math.Pow(filamentDiameter, 2)
+		if m.Kind == MoveExtrude && math.Abs(m.Extrusion-want) > 1e-9 {
+			t.Fatalf("arc %+v extrudes %v, want %v", m, m.Extrusion, want)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arcs == 0 {
+		t.Error("no arcs")
+	}
+
+	// the polygons of round towers are a bit shorter than the circles
+	p.ArcMoves = false
+	polygon, err := PrintStats(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.ArcMoves = true
+	circle, err := PrintStats(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := circle.PrintDistance - polygon.PrintDistance; d <= 0 || d > 0.01*circle.PrintDistance {
+		t.Errorf("print distance %v with arcs, %v without", circle.PrintDistance, polygon.PrintDistance)
+	}
+}
+
+func TestToolpathTemperatureDwell(t *testing.T) {
+	p := DefaultParams()
+	p.TemperatureSweep = true
+
+	dwells, retracted := 0, false
+	err := Toolpath(p, func(m Move) {
+		switch m.Kind {
+		case MoveRetract:
+			retracted = true
+		case MoveUnretract:
+			retracted = false
+		case MoveDwell:
+			dwells++
+			if !retracted {
+				t.Fatalf("dwell %+v on the tower without retraction", m)
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dwells != p.NumSegments-1 {
+		t.Errorf("%d dwells, want %d", dwells, p.NumSegments-1)
+	}
+}
+
+func TestToolpathMatrixPairSpeeds(t *testing.T) {
+	p := DefaultParams()
+	p.Matrix, p.MatrixPairs = true, 3
+	p.InitRetractSpeed, p.EndRetractSpeed = 20, 60
+	pairs := towerPairs(p, Point{X: p.BedX / 2, Y: p.BedY / 2})
+
+	// the pair an extrusion is on, the purge line belongs to the front one
+	pairOf := func(m Move) int {
+		k := 0
+		for i, pair := range pairs {
+			if math.Abs(m.To.Y-pair.left.Y) < math.Abs(m.To.Y-pairs[k].left.Y) {
+				k = i
+			}
+		}
+		return k
+	}
+
+	// a retraction leaves the pair printed last, a deretraction arrives at
+	// the next one; the print starts with the purge line of the front pair
+	var unretracts []Move
+	last := 0
+	err := Toolpath(p, func(m Move) {
+		switch m.Kind {
+		case MoveRetract:
+			if want := p.pairRetractSpeed(last); m.Feedrate != want {
+				t.Fatalf("retraction %+v leaving pair %d, want F %v", m, last, want)
+			}
+		case MoveUnretract:
+			unretracts = append(unretracts, m)
+		case MoveExtrude:
+			last = pairOf(m)
+			for _, u := range unretracts {
+				if want := p.pairRetractSpeed(last); u.Feedrate != want {
+					t.Fatalf("deretraction %+v arriving at pair %d, want F %v", u, last, want)
+				}
+			}
+			unretracts = unretracts[:0]
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestToolpathLoopSidesExtrude(t *testing.T) {
+	for _, shape := range []TowerShape{ShapeSquare, ShapeRound, ShapeTriangle} {
+		for _, arcs := range []bool{false, true} {
+			for _, spacing := range []int{90, 200} {
+				// the smallest tower, with short hops there is no unextruded connection
+				p := DefaultParams()
+				p.TowerShape, p.ArcMoves, p.WallSpacing = shape, arcs, spacing
+				p.TowerWidth, p.ShortHops, p.ShortHopDistance = 5, true, 2
+
+				err := Toolpath(p, func(m Move) {
+					if m.Kind == MoveExtrude && m.Layer >= 2 && m.Extrusion <= 0 {
+						t.Fatalf("%s, arcs %v, spacing %d%%: side %+v not extruded", shape, arcs, spacing, m)
+					}
+				})
+				if err != nil {
+					t.Fatalf("%s, arcs %v, spacing %d%%: %v", shape, arcs, spacing, err)
+				}
+			}
+		}
+	}
+}
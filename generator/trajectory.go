@@ -0,0 +1,246 @@
+package generator
+
+import "math"
+
+// Point is a position of the nozzle in printer coordinates.
+type Point struct {
+	X float64
+	Y float64
+	Z float64
+}
+
+func (g *generator) generateZigZagTrajectory(towerCenter Point, lineWidth float64) []Point {
+	raftWidth := g.p.raftWidth()
+	sideLength := raftWidth - lineWidth
+	pointsOnOneSide := int(sideLength / (lineWidth * math.Sqrt(2)))
+	pointsOnOneSide = pointsOnOneSide - (pointsOnOneSide-1)%2
+	// small rafts of wide lines still get a zigzag
+	if pointsOnOneSide < 3 {
+		pointsOnOneSide = 3
+	}
+	pointSpacing := sideLength / float64(pointsOnOneSide-1)
+	g.firstLayerLineWidth = pointSpacing / math.Sqrt(2)
+
+	totalPoints := pointsOnOneSide*4 - 4
+	unsortedPoints := make([]Point, totalPoints)
+
+	minX := towerCenter.X - sideLength/2
+	minY := towerCenter.Y - sideLength/2
+	maxX := towerCenter.X + sideLength/2
+	maxY := towerCenter.Y + sideLength/2
+
+	// Generate unsorted slice of points clockwise
+	for i := 0; i <= pointsOnOneSide-1; i++ {
+		unsortedPoints[i].X = minX + pointSpacing*float64(i)
+		unsortedPoints[i].Y = maxY
+	}
+	for i := 1; i <= pointsOnOneSide-1; i++ {
+		unsortedPoints[pointsOnOneSide+i-1].X = maxX
+		unsortedPoints[pointsOnOneSide+i-1].Y = maxY - pointSpacing*float64(i)
+	}
+	for i := 1; i <= pointsOnOneSide-1; i++ {
+		unsortedPoints[pointsOnOneSide*2+i-2].X = maxX - pointSpacing*float64(i)
+		unsortedPoints[pointsOnOneSide*2+i-2].Y = minY
+	}
+	for i := 1; i < pointsOnOneSide-1; i++ {
+		unsortedPoints[pointsOnOneSide*3+i-3].X = minX
+		unsortedPoints[pointsOnOneSide*3+i-3].Y = minY + pointSpacing*float64(i)
+	}
+
+	// Sort points to make zigzag moves
+	trajectory := make([]Point, len(unsortedPoints))
+
+	trajectory[0] = unsortedPoints[0]
+	trajectory[1] = unsortedPoints[len(unsortedPoints)-1]
+	trajectory[2] = unsortedPoints[1]
+	trajectory[3] = unsortedPoints[2]
+	for i := 4; i < len(unsortedPoints); i = i + 4 {
+		j := int(i / 2)
+		trajectory[i] = unsortedPoints[len(unsortedPoints)-j]
+		trajectory[i+1] = unsortedPoints[len(unsortedPoints)-j-1]
+		trajectory[i+2] = unsortedPoints[j+1]
+		trajectory[i+3] = unsortedPoints[j+2]
+	}
+
+	for i := 0; i < len(trajectory); i++ {
+		trajectory[i].Z = towerCenter.Z
+	}
+
+	return trajectory
+}
+
+// loop is a closed perimeter of a tower, printed clockwise from its first
+// point to its last one, which is the first point again.
+type loop struct {
+	points []Point
+	// arcs is set when the sides are clockwise arcs around center instead of lines.
+	arcs   bool
+	center Point
+}
+
+// towerLoop returns the perimeter of a tower of the given shape at center,
+// size wide for a tower width wide. The seam is at the front right.
+func towerLoop(shape TowerShape, center Point, width, size float64, arcs bool) loop {
+	switch shape {
+	case ShapeRound:
+		if arcs {
+			return loop{points: generateCircleTrajectory(center, size, 4), arcs: true, center: center}
+		}
+		// sides of about 1 mm
+		return loop{points: generateCircleTrajectory(center, size, 4*int(math.Ceil(math.Pi*size/4)))}
+	case ShapeTriangle:
+		return loop{points: generateTriangleTrajectory(center, width, size)}
+	}
+	return loop{points: generateSquareTrajectory(center, size)}
+}
+
+// seamStep is the number of points the seam of the right tower is moved
+// along the loop: to the next corner, a quarter turn on circles.
+func (l loop) seamStep() int {
+	if step := (len(l.points) - 1) / 4; step > 0 {
+		return step
+	}
+	return 1
+}
+
+// rotate returns the loop starting k points later.
+func (l loop) rotate(k int) loop {
+	n := len(l.points) - 1
+	points := append([]Point{}, l.points[k:n]...)
+	l.points = append(points, l.points[:k+1]...)
+	return l
+}
+
+// length returns the length of the side ending at the i-th point.
+func (l loop) length(i int) float64 {
+	a, b := l.points[i-1], l.points[i]
+	if l.arcs {
+		return arcSweep(l.center, a, b) * math.Hypot(a.X-l.center.X, a.Y-l.center.Y)
+	}
+	return math.Sqrt(math.Pow(b.X-a.X, 2) + math.Pow(b.Y-a.Y, 2))
+}
+
+// perimeter returns the length of the whole loop.
+func (l loop) perimeter() float64 {
+	var perimeter float64
+	for i := 1; i < len(l.points); i++ {
+		perimeter += l.length(i)
+	}
+	return perimeter
+}
+
+// at returns the point d mm along the side ending at the i-th point.
+func (l loop) at(i int, d float64) Point {
+	a, b := l.points[i-1], l.points[i]
+	if l.arcs {
+		r := math.Hypot(a.X-l.center.X, a.Y-l.center.Y)
+		angle := math.Atan2(a.Y-l.center.Y, a.X-l.center.X) - d/r
+		return Point{X: l.center.X + r*math.Cos(angle), Y: l.center.Y + r*math.Sin(angle), Z: a.Z}
+	}
+	length := l.length(i)
+	a.X += (b.X - a.X) * d / length
+	a.Y += (b.Y - a.Y) * d / length
+	return a
+}
+
+// shift returns the loop starting and ending offset mm after its first point.
+func (l loop) shift(offset float64) loop {
+	n := len(l.points) - 1
+	for i := 1; i <= n; i++ {
+		length := l.length(i)
+		if offset < length {
+			start := l.at(i, offset)
+			points := append([]Point{start}, l.points[i:n]...)
+			points = append(points, l.points[:i]...)
+			l.points = append(points, start)
+			return l
+		}
+		offset -= length
+	}
+	return l
+}
+
+// splitEnd splits the side where the last d mm of the loop start and returns
+// the index of the point they start at.
+func (l loop) splitEnd(d float64) (loop, int) {
+	from := len(l.points) - 1
+	for i := from; i > 0 && d > 0; i-- {
+		length := l.length(i)
+		if length > d {
+			split := l.at(i, length-d)
+			l.points = append(l.points[:i:i], append([]Point{split}, l.points[i:]...)...)
+			return l, i
+		}
+		d -= length
+		from = i - 1
+	}
+	return l, from
+}
+
+// arcSweep returns the clockwise angle from a to b around center. The arcs
+// of the towers are shorter than half a turn.
+func arcSweep(center, a, b Point) float64 {
+	sweep := math.Atan2(a.Y-center.Y, a.X-center.X) - math.Atan2(b.Y-center.Y, b.X-center.X)
+	return math.Abs(math.Remainder(sweep, 2*math.Pi))
+}
+
+// generateCircleTrajectory returns a regular polygon of n sides inscribed in
+// a circle size wide, clockwise from the front right.
+func generateCircleTrajectory(center Point, size float64, n int) []Point {
+	trajectory := make([]Point, n+1)
+	for i := 0; i < n; i++ {
+		angle := -math.Pi/4 - 2*math.Pi*float64(i)/float64(n)
+		trajectory[i] = Point{X: center.X + size/2*math.Cos(angle), Y: center.Y + size/2*math.Sin(angle), Z: center.Z}
+	}
+	trajectory[n] = trajectory[0]
+	return trajectory
+}
+
+func generateTriangleTrajectory(center Point, width, size float64) []Point {
+	//     2
+	//    / \
+	//   /   \
+	//  1---0,3
+
+	// the triangle width wide and high is inset by (width - size) / 2,
+	// which scales it around its incenter
+	r := width / (1 + math.Sqrt(5))
+	incenter := Point{X: center.X, Y: center.Y - width/2 + r, Z: center.Z}
+	scale := (r - (width-size)/2) / r
+	corners := []Point{{X: width / 2, Y: -width / 2}, {X: -width / 2, Y: -width / 2}, {X: 0, Y: width / 2}}
+
+	trajectory := make([]Point, 4)
+	for i, c := range corners {
+		trajectory[i].X = center.X + c.X*scale
+		trajectory[i].Y = incenter.Y + (center.Y+c.Y-incenter.Y)*scale
+		trajectory[i].Z = center.Z
+	}
+	trajectory[3] = trajectory[0]
+	return trajectory
+}
+
+func generateSquareTrajectory(squareCenter Point, size float64) []Point {
+	// 2----3
+	// |    |
+	// 1---0,4
+
+	trajectory := make([]Point, 5)
+	trajectory[0].X = squareCenter.X + size/2
+	trajectory[0].Y = squareCenter.Y - size/2
+
+	trajectory[1].X = squareCenter.X - size/2
+	trajectory[1].Y = trajectory[0].Y
+
+	trajectory[2].X = trajectory[1].X
+	trajectory[2].Y = squareCenter.Y + size/2
+
+	trajectory[3].X = trajectory[0].X
+	trajectory[3].Y = trajectory[2].Y
+
+	trajectory[4] = trajectory[0]
+
+	for i := 0; i < len(trajectory); i++ {
+		trajectory[i].Z = squareCenter.Z
+	}
+	return trajectory
+}
@@ -0,0 +1,171 @@
+package generator
+
+import "strings"
+
+// ErrorCode classifies a validation error.
+type ErrorCode string
+
+const (
+	ErrFormat     ErrorCode = "format"      // the value can't be parsed or is not one of the allowed values
+	ErrTooLow     ErrorCode = "too_low"     // the value is less than Min
+	ErrTooHigh    ErrorCode = "too_high"    // the value is greater than Max
+	ErrCrossField ErrorCode = "cross_field" // the value is outside of a limit derived from Related
+)
+
+// ValidationError describes a parameter that failed validation. It carries
+// no localized text; Message is the localization key of the web page
+// and the English text is available through Error.
+type ValidationError struct {
+	Field   string    `json:"field"`
+	Code    ErrorCode `json:"code"`
+	Related string    `json:"related,omitempty"` // field the limit depends on, for ErrCrossField
+	Min     *float64  `json:"min,omitempty"`
+	Max     *float64  `json:"max,omitempty"`
+	Value   float64   `json:"value"`
+	Input   string    `json:"input,omitempty"` // unparsed input, for ErrFormat
+	Message string    `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	return Message(e.Message)
+}
+
+// FormatError returns the error for an input of field which couldn't be parsed.
+func FormatError(field, input string) ValidationError {
+	return ValidationError{
+		Field:   field,
+		Code:    ErrFormat,
+		Input:   input,
+		Message: "error." + FieldKey(field) + ".format",
+	}
+}
+
+// ValidationErrors is returned by Generate when the parameters are invalid.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// ByField returns the first error of the field, if any.
+func (e ValidationErrors) ByField(field string) (ValidationError, bool) {
+	for _, err := range e {
+		if err.Field == field {
+			return err, true
+		}
+	}
+	return ValidationError{}, false
+}
+
+// validate checks the value of the field in p against its limits.
+// A violated limit which depends on another parameter is reported
+// as ErrCrossField, static limits as ErrTooLow or ErrTooHigh.
+func (f Field) validate(p Params) (ValidationError, bool) {
+	switch f.Type {
+	case TypeEnum:
+		value := f.number(&p)
+		if value < 0 || int(value) >= len(f.Options) {
+			return ValidationError{
+				Field:   f.ID,
+				Code:    ErrFormat,
+				Value:   value,
+				Message: "error." + f.Key + "." + f.lowMsg,
+			}, false
+		}
+		return ValidationError{}, true
+	case TypeList:
+		return f.validateList(p)
+	case TypeNumber, TypeInteger:
+	default:
+		return ValidationError{}, true
+	}
+
+	value := f.number(&p)
+	min, max := f.Min, f.Max
+	var minRelated, maxRelated bool
+	// the tighter of the static and the computed limit applies
+	if c := f.Constraint; c != nil {
+		if c.min != nil {
+			if v := c.min(p); min == nil || v > *min {
+				min, minRelated = limit(v), true
+			}
+		}
+		if c.max != nil {
+			if v := c.max(p); max == nil || v < *max {
+				max, maxRelated = limit(v), true
+			}
+		}
+	}
+
+	e := ValidationError{Field: f.ID, Min: min, Max: max, Value: value}
+	if max != nil && value > *max {
+		e.Code, e.Message = ErrTooHigh, "error."+f.Key+"."+f.highMsg
+		if maxRelated {
+			e.Code, e.Related = ErrCrossField, f.Constraint.Related
+		}
+	} else if min != nil && value < *min {
+		e.Code, e.Message = ErrTooLow, "error."+f.Key+"."+f.lowMsg
+		if minRelated {
+			e.Code, e.Related = ErrCrossField, f.Constraint.Related
+		}
+	} else {
+		return ValidationError{}, true
+	}
+	return e, false
+}
+
+// validateList checks the syntax of a value list, the number of its values,
+// which has to be the number of segments, and every value against the limits
+// of the field f.item.
+func (f Field) validateList(p Params) (ValidationError, bool) {
+	text := *f.Pointer(&p).(*string)
+	values, err := parseValues(text)
+	if err != nil {
+		return FormatError(f.ID, text), false
+	}
+	if values == nil {
+		return ValidationError{}, true
+	}
+	if len(values) != p.NumSegments {
+		n := float64(p.NumSegments)
+		return ValidationError{
+			Field:   f.ID,
+			Code:    ErrCrossField,
+			Related: "numSegments",
+			Min:     &n,
+			Max:     &n,
+			Value:   float64(len(values)),
+			Message: "error." + f.Key + ".count",
+		}, false
+	}
+
+	item, _ := FieldByID(f.item)
+	for _, v := range values {
+		e := ValidationError{Field: f.ID, Min: item.Min, Max: item.Max, Value: v}
+		if item.Max != nil && v > *item.Max {
+			e.Code, e.Message = ErrTooHigh, "error."+f.Key+"."+item.highMsg
+		} else if item.Min != nil && v < *item.Min {
+			e.Code, e.Message = ErrTooLow, "error."+f.Key+"."+item.lowMsg
+		} else {
+			continue
+		}
+		return e, false
+	}
+	return ValidationError{}, true
+}
+
+// Validate checks p against the limits of Fields and returns every
+// violation in field order. An empty result means p can be generated.
+func Validate(p Params) ValidationErrors {
+	errs := make(ValidationErrors, 0)
+	for _, f := range Fields {
+		if e, ok := f.validate(p); !ok {
+			errs = append(errs, e)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseValues reads a list of segment values. Items are separated by commas,
+// semicolons or spaces; an item is either a number or a range "start:step:end"
+// which includes end if the steps hit it, e.g. "0.2:0.2:1" is 0.2, 0.4, 0.6,
+// 0.8 and 1. Descending ranges have a negative step. An empty list is nil.
+func parseValues(s string) ([]float64, error) {
+	var values []float64
+	items := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	})
+	for _, item := range items {
+		parts := strings.Split(item, ":")
+		numbers := make([]float64, len(parts))
+		for i, part := range parts {
+			n, err := strconv.ParseFloat(part, 64)
+			if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+				return nil, fmt.Errorf("invalid number %q", part)
+			}
+			numbers[i] = n
+		}
+
+		switch len(numbers) {
+		case 1:
+			values = append(values, numbers[0])
+		case 3:
+			start, step, end := numbers[0], numbers[1], numbers[2]
+			if step == 0 || (end-start)/step < 0 {
+				return nil, fmt.Errorf("range %q never reaches its end", item)
+			}
+			// the tolerance keeps the end of "0.2:0.2:1" despite rounding errors
+			count := int(math.Floor((end-start)/step+1e-9)) + 1
+			if len(values)+count > maxValues {
+				return nil, fmt.Errorf("range %q has too many values", item)
+			}
+			for i := 0; i < count; i++ {
+				values = append(values, roundFloat(start+step*float64(i), 6))
+			}
+		default:
+			return nil, fmt.Errorf("invalid range %q, expected start:step:end", item)
+		}
+	}
+	return values, nil
+}
+
+// maxValues limits the length of a value list, it is the largest number of segments.
+const maxValues = 100
+
+// segmentValues returns the value of every segment, bottom first: the parsed
+// list if there is one, or the sweep from init to end distributed by p.Stepping.
+// It returns nil for the linear sweep in the given order, whose values the
+// generator steps by itself. list has to be valid.
+func (p Params) segmentValues(init, end float64, list string) []float64 {
+	values, _ := parseValues(list)
+	if values != nil || p.Stepping == SteppingLinear && p.Order == OrderAsGiven {
+		return values
+	}
+	values = make([]float64, p.NumSegments)
+	for i := range values {
+		t := float64(i) / float64(p.NumSegments-1)
+		switch p.Stepping {
+		case SteppingGeometric:
+			t = (math.Pow(10, t) - 1) / 9
+		case SteppingLogarithmic:
+			t = math.Log10(1 + 9*t)
+		}
+		values[i] = init + (end-init)*t
+	}
+	return values
+}
+
+// retractions is the retraction length and speed of every segment, computed
+// once from the parameters.
+type retractions struct {
+	p Params
+	// lengths is nil for the linear sweep and speeds also in a matrix,
+	// whose pairs have their own speeds.
+	lengths, speeds []float64
+}
+
+// retractions returns the retractions of the segments sorted by p.Order.
+// The segments are sorted by length, then by speed, so that every segment
+// keeps the length and the speed it was given.
+func (p Params) retractions() retractions {
+	r := retractions{p: p, lengths: p.segmentValues(p.InitRetractLength, p.EndRetractLength, p.RetractLengthValues)}
+	if !p.Matrix {
+		r.speeds = p.segmentValues(p.InitRetractSpeed, p.EndRetractSpeed, p.RetractSpeedValues)
+	}
+	if p.Order == OrderAsGiven {
+		return r
+	}
+
+	segments := make([]int, p.NumSegments)
+	for i := range segments {
+		segments[i] = i
+	}
+	sort.SliceStable(segments, func(a, b int) bool {
+		i, j := segments[a], segments[b]
+		if p.Order == OrderDescending {
+			i, j = j, i
+		}
+		if r.lengths[i] != r.lengths[j] || r.speeds == nil {
+			return r.lengths[i] < r.lengths[j]
+		}
+		return r.speeds[i] < r.speeds[j]
+	})
+	lengths, speeds := make([]float64, len(segments)), make([]float64, len(segments))
+	for i, k := range segments {
+		lengths[i] = r.lengths[k]
+		if r.speeds != nil {
+			speeds[i] = r.speeds[k]
+		}
+	}
+	r.lengths = lengths
+	if r.speeds != nil {
+		r.speeds = speeds
+	}
+	return r
+}
+
+// length is the retraction length of the segment with the given index,
+// counted from 0 at the bottom, before clamping to minRetractLength.
+func (r retractions) length(i int) float64 {
+	if r.lengths != nil {
+		return r.lengths[i]
+	}
+	return r.p.InitRetractLength - r.p.retractLengthDelta()*float64(i)
+}
+
+// speed is the retraction speed of the segment with the given index,
+// counted from 0 at the bottom.
+func (r retractions) speed(i int) float64 {
+	if r.speeds != nil {
+		return r.speeds[i]
+	}
+	return r.p.InitRetractSpeed - r.p.retractSpeedDelta()*float64(i)
+}
@@ -0,0 +1,128 @@
+package generator
+
+import "math"
+
+// Warning is a remark about valid parameters which are probably not printed
+// the way the user expects. Like ValidationError it carries no localized text;
+// Message is the localization key and the English text is available through String.
+type Warning struct {
+	Field   string  `json:"field"`
+	Value   float64 `json:"value"`
+	Actual  float64 `json:"actual"` // value the towers are printed with
+	Message string  `json:"message"`
+}
+
+func (w Warning) String() string {
+	return Message(w.Message)
+}
+
+// minRetractLength is the shortest retraction the segments above the first one are printed with.
+const minRetractLength = 0.1
+
+// Warnings returns the remarks about p. It expects p to be valid.
+func Warnings(p Params) []Warning {
+	warnings := make([]Warning, 0)
+
+	// every segment has a whole number of layers
+	actualHeight := float64(p.layersPerSegment()) * p.LayerHeight
+	if math.Abs(actualHeight-p.SegmentHeight) > 1e-6 {
+		warnings = append(warnings, Warning{
+			Field:   "segmentHeight",
+			Value:   p.SegmentHeight,
+			Actual:  roundFloat(actualHeight, 2),
+			Message: "warning.segment_height.rounded",
+		})
+	}
+
+	// retractions shorter than minRetractLength are printed with minRetractLength,
+	// except for the first segment
+	retractions := p.retractions()
+	for i := 1; i < p.NumSegments; i++ {
+		if length := retractions.length(i); length < minRetractLength-1e-9 {
+			field := "endRetractLength"
+			if p.RetractLengthValues != "" {
+				field = "retractLengthValues"
+			}
+			warnings = append(warnings, Warning{
+				Field:   field,
+				Value:   roundFloat(length, 2),
+				Actual:  minRetractLength,
+				Message: "warning.end_retract_length.clamped",
+			})
+			break
+		}
+	}
+
+	if p.FirmwareRetraction && p.wipe() {
+		warnings = append(warnings, Warning{
+			Field:   "initWipeDistance",
+			Value:   p.InitWipeDistance,
+			Actual:  0,
+			Message: "warning.wipe.firmware_retraction",
+		})
+	}
+	if p.FirmwareRetraction && p.Firmware == FirmwareKlipper && math.Min(p.InitExtraPrime, p.EndExtraPrime) < 0 {
+		field, value := "initExtraPrime", p.InitExtraPrime
+		if p.EndExtraPrime < p.InitExtraPrime {
+			field, value = "endExtraPrime", p.EndExtraPrime
+		}
+		warnings = append(warnings, Warning{
+			Field:   field,
+			Value:   value,
+			Actual:  0,
+			Message: "warning.extra_prime.klipper_negative",
+		})
+	}
+
+	if p.Firmware == FirmwareKlipper && (p.InitTravelAccel > 0 || p.EndTravelAccel > 0) {
+		warnings = append(warnings, Warning{
+			Field:   "initTravelAccel",
+			Value:   p.InitTravelAccel,
+			Actual:  0,
+			Message: "warning.travel_accel.klipper",
+		})
+	}
+
+	// at most half of the outer perimeter is coasted
+	if maxCoast := p.maxCoastDistance(); p.coastDistance(math.Max(p.InitCoast, p.EndCoast)) > maxCoast+1e-9 {
+		field, value := "endCoast", p.EndCoast
+		if p.InitCoast > p.EndCoast {
+			field, value = "initCoast", p.InitCoast
+		}
+		actual := maxCoast
+		if p.CoastVolume {
+			actual *= p.LineWidth * p.LayerHeight
+		}
+		warnings = append(warnings, Warning{
+			Field:   field,
+			Value:   value,
+			Actual:  roundFloat(actual, 2),
+			Message: "warning.coast.too_long",
+		})
+	}
+
+	// only round towers have arcs
+	if p.ArcMoves && p.TowerShape != ShapeRound {
+		warnings = append(warnings, Warning{
+			Field:   "arcMoves",
+			Value:   1,
+			Actual:  0,
+			Message: "warning.arc_moves.shape",
+		})
+	}
+	// arcs need to be enabled in the printer configuration, except on RRF
+	if p.arcMoves() && p.Firmware != FirmwareRRF {
+		message := "warning.arc_moves.marlin"
+		if p.Firmware == FirmwareKlipper {
+			message = "warning.arc_moves.klipper"
+		}
+		warnings = append(warnings, Warning{
+			Field:   "arcMoves",
+			Value:   1,
+			Actual:  1,
+			Message: message,
+		})
+	}
+
+	return warnings
+}